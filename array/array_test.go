@@ -1,55 +1,115 @@
 package array
 
 import (
+	"flag"
+	"fmt"
+	"math/rand"
 	"testing"
+
 	"github.com/chris-tomich/immutability-benchmarking"
-	"github.com/chris-tomich/immutability-benchmarking/array/mutable"
-	"math/rand"
 	"github.com/chris-tomich/immutability-benchmarking/array/immutable"
+	"github.com/chris-tomich/immutability-benchmarking/array/mutable"
+	"github.com/chris-tomich/immutability-benchmarking/immutabilitycheck"
+	"github.com/chris-tomich/immutability-benchmarking/overflow"
 )
 
-type MatrixGenerator interface {
-	GenerateMatrix() (immutabilitybenchmarking.Matrix, immutabilitybenchmarking.Matrix)
+var checkImmutability = flag.Bool("immutabilitycheck", false, "verify immutable matrices are never modified by the benchmarked operations")
+
+type MatrixGenerator[T immutabilitybenchmarking.Number] interface {
+	Immutable() bool
+	GenerateMatrix() (immutabilitybenchmarking.Matrix[T], immutabilitybenchmarking.Matrix[T])
 }
 
-type MutableMatrixGenerator struct {}
+type MutableMatrixGenerator[T immutabilitybenchmarking.Number] struct {
+	MatrixSize int
+	Random     func() T
+}
+
+func (g MutableMatrixGenerator[T]) Immutable() bool {
+	return false
+}
 
-func (MutableMatrixGenerator) GenerateMatrix() (immutabilitybenchmarking.Matrix, immutabilitybenchmarking.Matrix) {
-	m1 := [immutabilitybenchmarking.MatrixHeight][immutabilitybenchmarking.MatrixWidth]int{}
-	m2 := [immutabilitybenchmarking.MatrixHeight][immutabilitybenchmarking.MatrixWidth]int{}
+func (g MutableMatrixGenerator[T]) GenerateMatrix() (immutabilitybenchmarking.Matrix[T], immutabilitybenchmarking.Matrix[T]) {
+	m1 := make([][]T, g.MatrixSize)
+	m2 := make([][]T, g.MatrixSize)
 
-	for i := 0; i < immutabilitybenchmarking.MatrixHeight; i++ {
-		for j := 0; j < immutabilitybenchmarking.MatrixWidth; j++ {
-			m1[i][j] = rand.Int()
-			m2[i][j] = rand.Int()
+	for i := 0; i < g.MatrixSize; i++ {
+		m1[i] = make([]T, g.MatrixSize)
+		m2[i] = make([]T, g.MatrixSize)
+
+		for j := 0; j < g.MatrixSize; j++ {
+			m1[i][j] = g.Random()
+			m2[i][j] = g.Random()
 		}
 	}
 
-	return mutable.New(m1), mutable.New(m2)
+	mm1, err := mutable.New(m1)
+	if err != nil {
+		panic(err)
+	}
+
+	mm2, err := mutable.New(m2)
+	if err != nil {
+		panic(err)
+	}
+
+	return mm1, mm2
+}
+
+type ImmutableMatrixGenerator[T immutabilitybenchmarking.Number] struct {
+	MatrixSize int
+	Random     func() T
+}
+
+func (g ImmutableMatrixGenerator[T]) Immutable() bool {
+	return true
 }
 
-type ImmutableMatrixGenerator struct {}
+func (g ImmutableMatrixGenerator[T]) GenerateMatrix() (immutabilitybenchmarking.Matrix[T], immutabilitybenchmarking.Matrix[T]) {
+	m1 := make([][]T, g.MatrixSize)
+	m2 := make([][]T, g.MatrixSize)
 
-func (ImmutableMatrixGenerator) GenerateMatrix() (immutabilitybenchmarking.Matrix, immutabilitybenchmarking.Matrix) {
-	m1 := [immutabilitybenchmarking.MatrixHeight][immutabilitybenchmarking.MatrixWidth]int{}
-	m2 := [immutabilitybenchmarking.MatrixHeight][immutabilitybenchmarking.MatrixWidth]int{}
+	for i := 0; i < g.MatrixSize; i++ {
+		m1[i] = make([]T, g.MatrixSize)
+		m2[i] = make([]T, g.MatrixSize)
 
-	for i := 0; i < immutabilitybenchmarking.MatrixHeight; i++ {
-		for j := 0; j < immutabilitybenchmarking.MatrixWidth; j++ {
-			m1[i][j] = rand.Int()
-			m2[i][j] = rand.Int()
+		for j := 0; j < g.MatrixSize; j++ {
+			m1[i][j] = g.Random()
+			m2[i][j] = g.Random()
 		}
 	}
 
-	return immutable.New(m1), immutable.New(m2)
+	mm1, err := immutable.New(m1)
+	if err != nil {
+		panic(err)
+	}
+
+	mm2, err := immutable.New(m2)
+	if err != nil {
+		panic(err)
+	}
+
+	return mm1, mm2
 }
 
-func MatrixAddRunner(b *testing.B, g MatrixGenerator, totalMatrices int) {
-	mm1 := make([]immutabilitybenchmarking.Matrix, totalMatrices)
-	mm2 := make([]immutabilitybenchmarking.Matrix, totalMatrices)
+// generateMatrix generates a pair of matrices, wrapping immutable ones in an immutability check when
+// -immutabilitycheck is set.
+func generateMatrix[T immutabilitybenchmarking.Number](b *testing.B, g MatrixGenerator[T]) (immutabilitybenchmarking.Matrix[T], immutabilitybenchmarking.Matrix[T]) {
+	m1, m2 := g.GenerateMatrix()
+
+	if *checkImmutability && g.Immutable() {
+		return immutabilitycheck.Wrap(b, m1), immutabilitycheck.Wrap(b, m2)
+	}
+
+	return m1, m2
+}
+
+func MatrixAddRunner[T immutabilitybenchmarking.Number](b *testing.B, g MatrixGenerator[T], totalMatrices int) {
+	mm1 := make([]immutabilitybenchmarking.Matrix[T], totalMatrices)
+	mm2 := make([]immutabilitybenchmarking.Matrix[T], totalMatrices)
 
 	for i := 0; i < totalMatrices; i++ {
-		mm1[i], mm2[i] = g.GenerateMatrix()
+		mm1[i], mm2[i] = generateMatrix(b, g)
 	}
 
 	b.ResetTimer()
@@ -60,12 +120,12 @@ func MatrixAddRunner(b *testing.B, g MatrixGenerator, totalMatrices int) {
 	}
 }
 
-func MatrixScalarRunner(b *testing.B, g MatrixGenerator, totalMatrices int) {
-	mm1 := make([]immutabilitybenchmarking.Matrix, totalMatrices)
-	mm2 := make([]immutabilitybenchmarking.Matrix, totalMatrices)
+func MatrixScalarRunner[T immutabilitybenchmarking.Number](b *testing.B, g MatrixGenerator[T], totalMatrices int) {
+	mm1 := make([]immutabilitybenchmarking.Matrix[T], totalMatrices)
+	mm2 := make([]immutabilitybenchmarking.Matrix[T], totalMatrices)
 
 	for i := 0; i < totalMatrices; i++ {
-		mm1[i], mm2[i] = g.GenerateMatrix()
+		mm1[i], mm2[i] = generateMatrix(b, g)
 	}
 
 	b.ResetTimer()
@@ -76,12 +136,12 @@ func MatrixScalarRunner(b *testing.B, g MatrixGenerator, totalMatrices int) {
 	}
 }
 
-func MatrixMultiplyRunner(b *testing.B, g MatrixGenerator, totalMatrices int) {
-	mm1 := make([]immutabilitybenchmarking.Matrix, totalMatrices)
-	mm2 := make([]immutabilitybenchmarking.Matrix, totalMatrices)
+func MatrixMultiplyRunner[T immutabilitybenchmarking.Number](b *testing.B, g MatrixGenerator[T], totalMatrices int) {
+	mm1 := make([]immutabilitybenchmarking.Matrix[T], totalMatrices)
+	mm2 := make([]immutabilitybenchmarking.Matrix[T], totalMatrices)
 
 	for i := 0; i < totalMatrices; i++ {
-		mm1[i], mm2[i] = g.GenerateMatrix()
+		mm1[i], mm2[i] = generateMatrix(b, g)
 	}
 
 	b.ResetTimer()
@@ -92,12 +152,12 @@ func MatrixMultiplyRunner(b *testing.B, g MatrixGenerator, totalMatrices int) {
 	}
 }
 
-func MatrixSubtractRunner(b *testing.B, g MatrixGenerator, totalMatrices int) {
-	mm1 := make([]immutabilitybenchmarking.Matrix, totalMatrices)
-	mm2 := make([]immutabilitybenchmarking.Matrix, totalMatrices)
+func MatrixSubtractRunner[T immutabilitybenchmarking.Number](b *testing.B, g MatrixGenerator[T], totalMatrices int) {
+	mm1 := make([]immutabilitybenchmarking.Matrix[T], totalMatrices)
+	mm2 := make([]immutabilitybenchmarking.Matrix[T], totalMatrices)
 
 	for i := 0; i < totalMatrices; i++ {
-		mm1[i], mm2[i] = g.GenerateMatrix()
+		mm1[i], mm2[i] = generateMatrix(b, g)
 	}
 
 	b.ResetTimer()
@@ -108,42 +168,260 @@ func MatrixSubtractRunner(b *testing.B, g MatrixGenerator, totalMatrices int) {
 	}
 }
 
+// OverflowPolicyRunner benchmarks every policy on the same inputs each iteration, so Checked never stops early.
+func OverflowPolicyRunner[T overflow.Integer](b *testing.B, g MatrixGenerator[T], f immutabilitybenchmarking.Factory[T], totalMatrices int) {
+	mm1 := make([]immutabilitybenchmarking.Matrix[T], totalMatrices)
+	mm2 := make([]immutabilitybenchmarking.Matrix[T], totalMatrices)
+
+	for i := 0; i < totalMatrices; i++ {
+		mm1[i], mm2[i] = generateMatrix(b, g)
+	}
+
+	ops := []struct {
+		name string
+		op   func(a overflow.Arithmetic[T], m1 immutabilitybenchmarking.Matrix[T], m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error)
+	}{
+		{name: "Add", op: overflow.Arithmetic[T].Add},
+		{name: "Scalar", op: func(a overflow.Arithmetic[T], m1 immutabilitybenchmarking.Matrix[T], _ immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+			return a.ScalarMultiply(m1, 3)
+		}},
+		{name: "Multiply", op: overflow.Arithmetic[T].MatrixMultiply},
+		{name: "Subtract", op: overflow.Arithmetic[T].Subtract},
+	}
+
+	for _, op := range ops {
+		for _, p := range []overflow.Policy{overflow.Wrapping, overflow.Checked, overflow.Saturating} {
+			a := overflow.New(f, p)
+
+			b.Run(fmt.Sprintf("%s/%s", op.name, p), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					for j := 0; j < totalMatrices; j++ {
+						op.op(a, mm1[j], mm2[j])
+					}
+				}
+			})
+		}
+	}
+}
+
+// ReductionsRunner benchmarks each read-only operation. None of them build a matrix, so the difference
+// between the backends is the cost of reaching the elements, which for the immutable value receivers
+// includes copying the whole array on every call. Rank is left out as it copies the matrix to eliminate it.
+func ReductionsRunner[T immutabilitybenchmarking.Number](b *testing.B, g MatrixGenerator[T], totalMatrices int) {
+	mm := make([]immutabilitybenchmarking.Matrix[T], totalMatrices)
+
+	for i := 0; i < totalMatrices; i++ {
+		mm[i], _ = generateMatrix(b, g)
+	}
+
+	ops := []struct {
+		name string
+		op   func(m immutabilitybenchmarking.Matrix[T])
+	}{
+		{name: "Trace", op: func(m immutabilitybenchmarking.Matrix[T]) { m.Trace() }},
+		{name: "Sum", op: func(m immutabilitybenchmarking.Matrix[T]) { m.Sum() }},
+		{name: "Min", op: func(m immutabilitybenchmarking.Matrix[T]) { m.Min() }},
+		{name: "Max", op: func(m immutabilitybenchmarking.Matrix[T]) { m.Max() }},
+		{name: "ArgMin", op: func(m immutabilitybenchmarking.Matrix[T]) { m.ArgMin() }},
+		{name: "ArgMax", op: func(m immutabilitybenchmarking.Matrix[T]) { m.ArgMax() }},
+		{name: "FrobeniusNorm", op: func(m immutabilitybenchmarking.Matrix[T]) { m.FrobeniusNorm() }},
+		{name: "OneNorm", op: func(m immutabilitybenchmarking.Matrix[T]) { m.OneNorm() }},
+		{name: "InfNorm", op: func(m immutabilitybenchmarking.Matrix[T]) { m.InfNorm() }},
+	}
+
+	for _, op := range ops {
+		b.Run(op.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				for j := 0; j < totalMatrices; j++ {
+					op.op(mm[j])
+				}
+			}
+		})
+	}
+}
+
+// smallInt returns values small enough that the benchmarked operations do not overflow, so the checked
+// policy does the full amount of work.
+func smallInt() int {
+	return rand.Intn(1 << 16)
+}
+
 func BenchmarkMutableMatrixAdd(b *testing.B) {
-	g := MutableMatrixGenerator{}
-	MatrixAddRunner(b, g, 10)
+	for _, size := range mutable.Sizes {
+		b.Run(fmt.Sprintf("%dx%d", size, size), func(b *testing.B) {
+			g := MutableMatrixGenerator[int]{MatrixSize: size, Random: rand.Int}
+			MatrixAddRunner(b, g, 10)
+		})
+	}
 }
 
 func BenchmarkImmutableMatrixAdd(b *testing.B) {
-	g := ImmutableMatrixGenerator{}
-	MatrixAddRunner(b, g, 10)
+	for _, size := range immutable.Sizes {
+		b.Run(fmt.Sprintf("%dx%d", size, size), func(b *testing.B) {
+			g := ImmutableMatrixGenerator[int]{MatrixSize: size, Random: rand.Int}
+			MatrixAddRunner(b, g, 10)
+		})
+	}
 }
 
 func BenchmarkMutableMatrixScalar(b *testing.B) {
-	g := MutableMatrixGenerator{}
-	MatrixScalarRunner(b, g, 10)
+	for _, size := range mutable.Sizes {
+		b.Run(fmt.Sprintf("%dx%d", size, size), func(b *testing.B) {
+			g := MutableMatrixGenerator[int]{MatrixSize: size, Random: rand.Int}
+			MatrixScalarRunner(b, g, 10)
+		})
+	}
 }
 
 func BenchmarkImmutableMatrixScalar(b *testing.B) {
-	g := ImmutableMatrixGenerator{}
-	MatrixScalarRunner(b, g, 10)
+	for _, size := range immutable.Sizes {
+		b.Run(fmt.Sprintf("%dx%d", size, size), func(b *testing.B) {
+			g := ImmutableMatrixGenerator[int]{MatrixSize: size, Random: rand.Int}
+			MatrixScalarRunner(b, g, 10)
+		})
+	}
 }
 
 func BenchmarkMutableMatrixMultiply(b *testing.B) {
-	g := MutableMatrixGenerator{}
-	MatrixMultiplyRunner(b, g, 10)
+	for _, size := range mutable.Sizes {
+		b.Run(fmt.Sprintf("%dx%d", size, size), func(b *testing.B) {
+			g := MutableMatrixGenerator[int]{MatrixSize: size, Random: rand.Int}
+			MatrixMultiplyRunner(b, g, 10)
+		})
+	}
 }
 
 func BenchmarkImmutableMatrixMultiply(b *testing.B) {
-	g := ImmutableMatrixGenerator{}
-	MatrixMultiplyRunner(b, g, 10)
+	for _, size := range immutable.Sizes {
+		b.Run(fmt.Sprintf("%dx%d", size, size), func(b *testing.B) {
+			g := ImmutableMatrixGenerator[int]{MatrixSize: size, Random: rand.Int}
+			MatrixMultiplyRunner(b, g, 10)
+		})
+	}
 }
 
 func BenchmarkMutableMatrixSubtract(b *testing.B) {
-	g := MutableMatrixGenerator{}
-	MatrixSubtractRunner(b, g, 10)
+	for _, size := range mutable.Sizes {
+		b.Run(fmt.Sprintf("%dx%d", size, size), func(b *testing.B) {
+			g := MutableMatrixGenerator[int]{MatrixSize: size, Random: rand.Int}
+			MatrixSubtractRunner(b, g, 10)
+		})
+	}
 }
 
 func BenchmarkImmutableMatrixSubtract(b *testing.B) {
-	g := ImmutableMatrixGenerator{}
-	MatrixSubtractRunner(b, g, 10)
+	for _, size := range immutable.Sizes {
+		b.Run(fmt.Sprintf("%dx%d", size, size), func(b *testing.B) {
+			g := ImmutableMatrixGenerator[int]{MatrixSize: size, Random: rand.Int}
+			MatrixSubtractRunner(b, g, 10)
+		})
+	}
+}
+
+func BenchmarkMutableFloat64MatrixAdd(b *testing.B) {
+	for _, size := range mutable.Sizes {
+		b.Run(fmt.Sprintf("%dx%d", size, size), func(b *testing.B) {
+			g := MutableMatrixGenerator[float64]{MatrixSize: size, Random: rand.Float64}
+			MatrixAddRunner(b, g, 10)
+		})
+	}
+}
+
+func BenchmarkImmutableFloat64MatrixAdd(b *testing.B) {
+	for _, size := range immutable.Sizes {
+		b.Run(fmt.Sprintf("%dx%d", size, size), func(b *testing.B) {
+			g := ImmutableMatrixGenerator[float64]{MatrixSize: size, Random: rand.Float64}
+			MatrixAddRunner(b, g, 10)
+		})
+	}
+}
+
+func BenchmarkMutableFloat64MatrixScalar(b *testing.B) {
+	for _, size := range mutable.Sizes {
+		b.Run(fmt.Sprintf("%dx%d", size, size), func(b *testing.B) {
+			g := MutableMatrixGenerator[float64]{MatrixSize: size, Random: rand.Float64}
+			MatrixScalarRunner(b, g, 10)
+		})
+	}
+}
+
+func BenchmarkImmutableFloat64MatrixScalar(b *testing.B) {
+	for _, size := range immutable.Sizes {
+		b.Run(fmt.Sprintf("%dx%d", size, size), func(b *testing.B) {
+			g := ImmutableMatrixGenerator[float64]{MatrixSize: size, Random: rand.Float64}
+			MatrixScalarRunner(b, g, 10)
+		})
+	}
+}
+
+func BenchmarkMutableFloat64MatrixMultiply(b *testing.B) {
+	for _, size := range mutable.Sizes {
+		b.Run(fmt.Sprintf("%dx%d", size, size), func(b *testing.B) {
+			g := MutableMatrixGenerator[float64]{MatrixSize: size, Random: rand.Float64}
+			MatrixMultiplyRunner(b, g, 10)
+		})
+	}
+}
+
+func BenchmarkImmutableFloat64MatrixMultiply(b *testing.B) {
+	for _, size := range immutable.Sizes {
+		b.Run(fmt.Sprintf("%dx%d", size, size), func(b *testing.B) {
+			g := ImmutableMatrixGenerator[float64]{MatrixSize: size, Random: rand.Float64}
+			MatrixMultiplyRunner(b, g, 10)
+		})
+	}
+}
+
+func BenchmarkMutableFloat64MatrixSubtract(b *testing.B) {
+	for _, size := range mutable.Sizes {
+		b.Run(fmt.Sprintf("%dx%d", size, size), func(b *testing.B) {
+			g := MutableMatrixGenerator[float64]{MatrixSize: size, Random: rand.Float64}
+			MatrixSubtractRunner(b, g, 10)
+		})
+	}
+}
+
+func BenchmarkImmutableFloat64MatrixSubtract(b *testing.B) {
+	for _, size := range immutable.Sizes {
+		b.Run(fmt.Sprintf("%dx%d", size, size), func(b *testing.B) {
+			g := ImmutableMatrixGenerator[float64]{MatrixSize: size, Random: rand.Float64}
+			MatrixSubtractRunner(b, g, 10)
+		})
+	}
+}
+
+func BenchmarkMutableMatrixReductions(b *testing.B) {
+	for _, size := range mutable.Sizes {
+		b.Run(fmt.Sprintf("%dx%d", size, size), func(b *testing.B) {
+			g := MutableMatrixGenerator[int]{MatrixSize: size, Random: rand.Int}
+			ReductionsRunner(b, g, 10)
+		})
+	}
+}
+
+func BenchmarkImmutableMatrixReductions(b *testing.B) {
+	for _, size := range immutable.Sizes {
+		b.Run(fmt.Sprintf("%dx%d", size, size), func(b *testing.B) {
+			g := ImmutableMatrixGenerator[int]{MatrixSize: size, Random: rand.Int}
+			ReductionsRunner(b, g, 10)
+		})
+	}
+}
+
+func BenchmarkMutableFactoryMatrixOverflowPolicies(b *testing.B) {
+	for _, size := range mutable.Sizes {
+		b.Run(fmt.Sprintf("%dx%d", size, size), func(b *testing.B) {
+			g := MutableMatrixGenerator[int]{MatrixSize: size, Random: smallInt}
+			OverflowPolicyRunner(b, g, mutable.Factory[int]{}, 10)
+		})
+	}
+}
+
+func BenchmarkImmutableFactoryMatrixOverflowPolicies(b *testing.B) {
+	for _, size := range immutable.Sizes {
+		b.Run(fmt.Sprintf("%dx%d", size, size), func(b *testing.B) {
+			g := ImmutableMatrixGenerator[int]{MatrixSize: size, Random: smallInt}
+			OverflowPolicyRunner(b, g, immutable.Factory[int]{}, 10)
+		})
+	}
 }
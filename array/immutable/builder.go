@@ -0,0 +1,97 @@
+package immutable
+
+import (
+	"github.com/chris-tomich/immutability-benchmarking"
+	"github.com/pkg/errors"
+)
+
+// target is implemented by a pointer to each generated matrix type so a builder can write to it directly.
+type target[T immutabilitybenchmarking.Number] interface {
+	Width() int
+	Height() int
+	set(row int, col int, v T)
+	freeze() immutabilitybenchmarking.Matrix[T]
+}
+
+// Builder constructs an immutable matrix in place. Once Freeze has been called the builder can no longer
+// be used.
+type Builder[T immutabilitybenchmarking.Number] struct {
+	matrix target[T]
+}
+
+// NewBuilder creates a new builder for a matrix with the given dimensions.
+func NewBuilder[T immutabilitybenchmarking.Number](width int, height int) *Builder[T] {
+	if width <= 0 || height <= 0 {
+		panic(&immutabilitybenchmarking.InvalidDimensionsError{Rows: height, Cols: width})
+	}
+
+	m, ok := newTarget[T](height, width)
+	if !ok {
+		panic(&immutabilitybenchmarking.SizeLimitError{Rows: height, Cols: width, Limit: Sizes[len(Sizes)-1]})
+	}
+
+	return &Builder[T]{matrix: m}
+}
+
+// Set sets the element at the provided coordinates. It panics with an OutOfBoundsError if they are outside
+// the matrix.
+func (b *Builder[T]) Set(row int, col int, v T) *Builder[T] {
+	b.check()
+	b.checkBounds(row, col)
+
+	b.matrix.set(row, col, v)
+
+	return b
+}
+
+// SetRow sets every element in the given row to the provided values. It panics with an OutOfBoundsError if
+// the row is outside the matrix, or a DimensionMismatchError if there is not one value for each column.
+func (b *Builder[T]) SetRow(row int, values []T) *Builder[T] {
+	b.check()
+	b.checkBounds(row, 0)
+
+	if len(values) != b.matrix.Width() {
+		panic(&immutabilitybenchmarking.DimensionMismatchError{Op: "SetRow", LeftRows: 1, LeftCols: b.matrix.Width(), RightRows: 1, RightCols: len(values)})
+	}
+
+	for c := 0; c < len(values); c++ {
+		b.Set(row, c, values[c])
+	}
+
+	return b
+}
+
+// Fill sets every element in the matrix to the given value.
+func (b *Builder[T]) Fill(v T) *Builder[T] {
+	b.check()
+
+	for r := 0; r < b.matrix.Height(); r++ {
+		for c := 0; c < b.matrix.Width(); c++ {
+			b.matrix.set(r, c, v)
+		}
+	}
+
+	return b
+}
+
+// Freeze returns the built matrix and invalidates the builder.
+func (b *Builder[T]) Freeze() immutabilitybenchmarking.Matrix[T] {
+	b.check()
+
+	m := b.matrix.freeze()
+	b.matrix = nil
+
+	return m
+}
+
+func (b *Builder[T]) check() {
+	if b.matrix == nil {
+		panic(errors.New("the builder has already been frozen"))
+	}
+}
+
+func (b *Builder[T]) checkBounds(row int, col int) {
+	if row < 0 || row >= b.matrix.Height() || col < 0 || col >= b.matrix.Width() {
+		panic(&immutabilitybenchmarking.OutOfBoundsError{Row: row, Col: col, Rows: b.matrix.Height(), Cols: b.matrix.Width()})
+	}
+}
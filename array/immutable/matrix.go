@@ -1,153 +1,90 @@
 package immutable
 
 import (
-	"github.com/pkg/errors"
 	"github.com/chris-tomich/immutability-benchmarking"
+	"github.com/chris-tomich/immutability-benchmarking/internal/shape"
 )
 
-// Matrix is an immutable matrix with non-mutating operations.
-type Matrix struct {
-	matrix [immutabilitybenchmarking.MatrixHeight][immutabilitybenchmarking.MatrixWidth]int
-}
-
-// New creates a new immutable matrix with the given initial values.
-func New(matrix [immutabilitybenchmarking.MatrixHeight][immutabilitybenchmarking.MatrixWidth]int) Matrix {
-	return Matrix{matrix: matrix}
-}
+//go:generate go run ../internal/gen -package immutable -sizes 10,30,90,270,810 -output matrix_gen.go
 
-// NewEmpty createas a new empty matrix with the given dimensions.
-func NewEmpty(width int, height int) Matrix {
-	if width == 0 || height == 0 {
-		panic(errors.New("width and height must both be non-zero"))
+// New creates a new immutable matrix with the given initial values, backed by the smallest generated array type
+// able to hold them.
+func New[T immutabilitybenchmarking.Number](matrix [][]T) (immutabilitybenchmarking.Matrix[T], error) {
+	if len(matrix) == 0 || len(matrix[0]) == 0 {
+		return nil, &immutabilitybenchmarking.InvalidDimensionsError{Rows: len(matrix)}
 	}
 
-	m := Matrix{}
-
-	return m
-}
-
-// Width returns the number of columns in the matrix.
-func (m1 Matrix) Width() int {
-	return len(m1.matrix[0])
-}
-
-// Height returns the number of rows in the matrix.
-func (m1 Matrix) Height() int {
-	return len(m1.matrix)
-}
-
-// Get returns the integer at the provided coordinates.
-func (m1 Matrix) Get(row int, col int) int {
-	return m1.matrix[row][col]
-}
-
-// Equals will compare a matrix against this matrix and return if they are equal.
-func (m1 Matrix) Equals(m2 immutabilitybenchmarking.Matrix) bool {
-	if m1.Height() != m2.Height() {
-		return false
-	}
-
-	if m1.Width() != m2.Width() {
-		return false
-	}
-
-	for r := 0; r < m1.Height(); r++ {
-		for c := 0; c < len(m1.matrix[r]); c++ {
-			if m1.matrix[r][c] != m2.Get(r, c) {
-				return false
-			}
+	for r := 1; r < len(matrix); r++ {
+		if len(matrix[r]) != len(matrix[0]) {
+			return nil, &immutabilitybenchmarking.JaggedRowsError{Row: r, Width: len(matrix[r]), Expected: len(matrix[0])}
 		}
 	}
 
-	return true
-}
-
-// Add will add the values of a matrix to this matrix.
-func (m1 Matrix) Add(m2 immutabilitybenchmarking.Matrix) (immutabilitybenchmarking.Matrix, error) {
-	if m1.Height() != m2.Height() {
-		return Matrix{}, errors.New("width of both matrices are not the same")
-	}
-
-	if m1.Width() != m2.Width() {
-		return Matrix{}, errors.New("height of both matrices are not the same")
-	}
-
-	m := NewEmpty(m1.Height(), m1.Width())
-
-	for r := 0; r < m.Height(); r++ {
-		for c := 0; c < len(m.matrix[r]); c++ {
-			m.matrix[r][c] = m1.matrix[r][c] + m2.Get(r, c)
-		}
+	m, ok := fromRows(matrix)
+	if !ok {
+		return nil, &immutabilitybenchmarking.SizeLimitError{Rows: len(matrix), Cols: len(matrix[0]), Limit: Sizes[len(Sizes)-1]}
 	}
 
 	return m, nil
 }
 
-// Subtract will subtract the values of a matrix from this matrix.
-func (m1 Matrix) Subtract(m2 immutabilitybenchmarking.Matrix) (immutabilitybenchmarking.Matrix, error) {
-	if m1.Height() != m2.Height() {
-		return Matrix{}, errors.New("width of both matrices are not the same")
-	}
-
-	if m1.Width() != m2.Width() {
-		return Matrix{}, errors.New("height of both matrices are not the same")
+// NewEmpty createas a new empty matrix with the given dimensions.
+func NewEmpty[T immutabilitybenchmarking.Number](width int, height int) immutabilitybenchmarking.Matrix[T] {
+	if width <= 0 || height <= 0 {
+		panic(&immutabilitybenchmarking.InvalidDimensionsError{Rows: height, Cols: width})
 	}
 
-	m := NewEmpty(m1.Height(), m1.Width())
-
-	for r := 0; r < m.Height(); r++ {
-		for c := 0; c < len(m.matrix[r]); c++ {
-			m.matrix[r][c] = m1.matrix[r][c] - m2.Get(r, c)
-		}
+	m, ok := newEmpty[T](height, width)
+	if !ok {
+		panic(&immutabilitybenchmarking.SizeLimitError{Rows: height, Cols: width, Limit: Sizes[len(Sizes)-1]})
 	}
 
-	return m, nil
+	return m
 }
 
-// ScalarMultiply will multiply this matrix by a given scalar value.
-func (m1 Matrix) ScalarMultiply(s int) immutabilitybenchmarking.Matrix {
-	m := NewEmpty(m1.Height(), m1.Width())
+// kronecker calculates the Kronecker product of m1 and m2 in the smallest generated type able to hold it,
+// as the product is usually larger than either of them.
+func kronecker[T immutabilitybenchmarking.Number](m1 immutabilitybenchmarking.Matrix[T], m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	rows, cols := m1.Height()*m2.Height(), m1.Width()*m2.Width()
 
-	for r := 0; r < m1.Height(); r++ {
-		for c := 0; c < len(m1.matrix[r]); c++ {
-			m.matrix[r][c] = m1.matrix[r][c] * s
-		}
+	m, ok := newTarget[T](rows, cols)
+	if !ok {
+		return nil, &immutabilitybenchmarking.SizeLimitError{Rows: rows, Cols: cols, Limit: Sizes[len(Sizes)-1]}
 	}
 
-	return m
-}
-
-// Transpose will transpose this matrix.
-func (m1 Matrix) Transpose() immutabilitybenchmarking.Matrix {
-	m := NewEmpty(m1.Width(), m1.Height())
+	for r1 := 0; r1 < m1.Height(); r1++ {
+		for r2 := 0; r2 < m2.Height(); r2++ {
+			for c1 := 0; c1 < m1.Width(); c1++ {
+				v := m1.Get(r1, c1)
 
-	for rt := 0; rt < m.Height(); rt++ {
-		for ct := 0; ct < m1.Height(); ct++ {
-			m.matrix[rt][ct] = m1.matrix[ct][rt]
+				for c2 := 0; c2 < m2.Width(); c2++ {
+					m.set(r1*m2.Height()+r2, c1*m2.Width()+c2, v*m2.Get(r2, c2))
+				}
+			}
 		}
 	}
 
-	return m
+	return m.freeze(), nil
 }
 
-// MatrixMultiply will multiple the given matrix against this matrix.
-func (m1 Matrix) MatrixMultiply(m2 immutabilitybenchmarking.Matrix) (immutabilitybenchmarking.Matrix, error) {
-	if m1.Width() != m2.Height() {
-		return Matrix{}, errors.New("the dimensions of the matrices are incompatible, try transposing one first")
+// rearrange builds the matrix a shape operation describes in the smallest generated type able to hold it,
+// as concatenating or padding a matrix makes it larger.
+func rearrange[T immutabilitybenchmarking.Number](res shape.Result[T], err error) (immutabilitybenchmarking.Matrix[T], error) {
+	if err != nil {
+		return nil, err
 	}
 
-	m := NewEmpty(m2.Width(), m1.Height())
+	return Factory[T]{}.FromFunc(res.Rows, res.Cols, res.At)
+}
 
-	for rm := 0; rm < m1.Height(); rm++ {
-		for cm2 := 0; cm2 < m2.Width(); cm2++ {
-			product := 0
-			for cm := 0; cm < len(m1.matrix[rm]); cm++ {
-				product = product + m1.matrix[rm][cm]*m2.Get(cm, cm2)
-			}
-			m.matrix[rm][cm2] = product
-		}
+// split builds both parts of a split in the smallest generated types able to hold them.
+func split[T immutabilitybenchmarking.Number](first shape.Result[T], second shape.Result[T], err error) (immutabilitybenchmarking.Matrix[T], immutabilitybenchmarking.Matrix[T], error) {
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return m, nil
-}
+	m1, _ := rearrange(first, nil)
+	m2, _ := rearrange(second, nil)
 
+	return m1, m2, nil
+}
@@ -0,0 +1,2636 @@
+// Code generated by array/internal/gen; DO NOT EDIT.
+
+package immutable
+
+import (
+	"github.com/chris-tomich/immutability-benchmarking"
+	"github.com/chris-tomich/immutability-benchmarking/internal/numeric"
+	"github.com/chris-tomich/immutability-benchmarking/internal/power"
+	"github.com/chris-tomich/immutability-benchmarking/internal/shape"
+)
+
+// Sizes lists the dimensions of the generated array backed matrix types.
+var Sizes = []int{10, 30, 90, 270, 810}
+
+// newEmpty returns an empty matrix of the smallest generated type able to hold rows x cols values.
+func newEmpty[T immutabilitybenchmarking.Number](rows int, cols int) (immutabilitybenchmarking.Matrix[T], bool) {
+	switch {
+	case rows <= 10 && cols <= 10:
+		return Matrix10x10[T]{rows: rows, cols: cols}, true
+	case rows <= 30 && cols <= 30:
+		return Matrix30x30[T]{rows: rows, cols: cols}, true
+	case rows <= 90 && cols <= 90:
+		return Matrix90x90[T]{rows: rows, cols: cols}, true
+	case rows <= 270 && cols <= 270:
+		return Matrix270x270[T]{rows: rows, cols: cols}, true
+	case rows <= 810 && cols <= 810:
+		return Matrix810x810[T]{rows: rows, cols: cols}, true
+	}
+
+	return nil, false
+}
+
+// fromRows returns a matrix of the smallest generated type able to hold the given rectangular values.
+func fromRows[T immutabilitybenchmarking.Number](matrix [][]T) (immutabilitybenchmarking.Matrix[T], bool) {
+	rows := len(matrix)
+	cols := len(matrix[0])
+
+	switch {
+	case rows <= 10 && cols <= 10:
+		m := Matrix10x10[T]{rows: rows, cols: cols}
+		for r := 0; r < rows; r++ {
+			copy(m.matrix[r][:cols], matrix[r])
+		}
+		return m, true
+	case rows <= 30 && cols <= 30:
+		m := Matrix30x30[T]{rows: rows, cols: cols}
+		for r := 0; r < rows; r++ {
+			copy(m.matrix[r][:cols], matrix[r])
+		}
+		return m, true
+	case rows <= 90 && cols <= 90:
+		m := Matrix90x90[T]{rows: rows, cols: cols}
+		for r := 0; r < rows; r++ {
+			copy(m.matrix[r][:cols], matrix[r])
+		}
+		return m, true
+	case rows <= 270 && cols <= 270:
+		m := Matrix270x270[T]{rows: rows, cols: cols}
+		for r := 0; r < rows; r++ {
+			copy(m.matrix[r][:cols], matrix[r])
+		}
+		return m, true
+	case rows <= 810 && cols <= 810:
+		m := Matrix810x810[T]{rows: rows, cols: cols}
+		for r := 0; r < rows; r++ {
+			copy(m.matrix[r][:cols], matrix[r])
+		}
+		return m, true
+	}
+
+	return nil, false
+}
+
+// newTarget returns a writable pointer to an empty matrix of the smallest generated type able to hold
+// rows x cols values.
+func newTarget[T immutabilitybenchmarking.Number](rows int, cols int) (target[T], bool) {
+	switch {
+	case rows <= 10 && cols <= 10:
+		return &Matrix10x10[T]{rows: rows, cols: cols}, true
+	case rows <= 30 && cols <= 30:
+		return &Matrix30x30[T]{rows: rows, cols: cols}, true
+	case rows <= 90 && cols <= 90:
+		return &Matrix90x90[T]{rows: rows, cols: cols}, true
+	case rows <= 270 && cols <= 270:
+		return &Matrix270x270[T]{rows: rows, cols: cols}, true
+	case rows <= 810 && cols <= 810:
+		return &Matrix810x810[T]{rows: rows, cols: cols}, true
+	}
+
+	return nil, false
+}
+
+// Matrix10x10 is an immutable matrix with non-mutating operations backed by a 10x10 array.
+type Matrix10x10[T immutabilitybenchmarking.Number] struct {
+	rows   int
+	cols   int
+	matrix [10][10]T
+}
+
+// Width returns the number of columns in the matrix.
+func (m1 Matrix10x10[T]) Width() int {
+	return m1.cols
+}
+
+// Height returns the number of rows in the matrix.
+func (m1 Matrix10x10[T]) Height() int {
+	return m1.rows
+}
+
+// Get returns the element at the provided coordinates. It panics with an OutOfBoundsError if they are
+// outside the matrix, as the unused part of the array would otherwise be read as zeros.
+func (m1 Matrix10x10[T]) Get(row int, col int) T {
+	if row < 0 || row >= m1.rows || col < 0 || col >= m1.cols {
+		panic(&immutabilitybenchmarking.OutOfBoundsError{Row: row, Col: col, Rows: m1.rows, Cols: m1.cols})
+	}
+
+	return m1.matrix[row][col]
+}
+
+// At returns the element at the provided coordinates or an OutOfBoundsError if they are outside the matrix.
+func (m1 Matrix10x10[T]) At(row int, col int) (T, error) {
+	if row < 0 || row >= m1.Height() || col < 0 || col >= m1.Width() {
+		var zero T
+		return zero, &immutabilitybenchmarking.OutOfBoundsError{Row: row, Col: col, Rows: m1.Height(), Cols: m1.Width()}
+	}
+
+	return m1.matrix[row][col], nil
+}
+
+// MustAt returns the element at the provided coordinates and panics with an OutOfBoundsError if they are
+// outside the matrix.
+func (m1 Matrix10x10[T]) MustAt(row int, col int) T {
+	v, err := m1.At(row, col)
+	if err != nil {
+		panic(err)
+	}
+
+	return v
+}
+
+func (m1 *Matrix10x10[T]) set(row int, col int, v T) {
+	m1.matrix[row][col] = v
+}
+
+func (m1 *Matrix10x10[T]) freeze() immutabilitybenchmarking.Matrix[T] {
+	return *m1
+}
+
+// Equals will compare a matrix against this matrix and return if they are equal.
+func (m1 Matrix10x10[T]) Equals(m2 immutabilitybenchmarking.Matrix[T]) bool {
+	if m1.Height() != m2.Height() {
+		return false
+	}
+
+	if m1.Width() != m2.Width() {
+		return false
+	}
+
+	for r := 0; r < m1.rows; r++ {
+		for c := 0; c < m1.cols; c++ {
+			if m1.matrix[r][c] != m2.Get(r, c) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// Add will add the values of a matrix to this matrix.
+func (m1 Matrix10x10[T]) Add(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Height() != m2.Height() || m1.Width() != m2.Width() {
+		return Matrix10x10[T]{}, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "Add",
+			LeftRows:  m1.Height(),
+			LeftCols:  m1.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	m := Matrix10x10[T]{rows: m1.rows, cols: m1.cols}
+
+	for r := 0; r < m.rows; r++ {
+		for c := 0; c < m.cols; c++ {
+			m.matrix[r][c] = m1.matrix[r][c] + m2.Get(r, c)
+		}
+	}
+
+	return m, nil
+}
+
+// Subtract will subtract the values of a matrix from this matrix.
+func (m1 Matrix10x10[T]) Subtract(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Height() != m2.Height() || m1.Width() != m2.Width() {
+		return Matrix10x10[T]{}, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "Subtract",
+			LeftRows:  m1.Height(),
+			LeftCols:  m1.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	m := Matrix10x10[T]{rows: m1.rows, cols: m1.cols}
+
+	for r := 0; r < m.rows; r++ {
+		for c := 0; c < m.cols; c++ {
+			m.matrix[r][c] = m1.matrix[r][c] - m2.Get(r, c)
+		}
+	}
+
+	return m, nil
+}
+
+// ScalarMultiply will multiply this matrix by a given scalar value.
+func (m1 Matrix10x10[T]) ScalarMultiply(s T) immutabilitybenchmarking.Matrix[T] {
+	m := Matrix10x10[T]{rows: m1.rows, cols: m1.cols}
+
+	for r := 0; r < m1.rows; r++ {
+		for c := 0; c < m1.cols; c++ {
+			m.matrix[r][c] = m1.matrix[r][c] * s
+		}
+	}
+
+	return m
+}
+
+// Transpose will transpose this matrix.
+func (m1 Matrix10x10[T]) Transpose() immutabilitybenchmarking.Matrix[T] {
+	m := Matrix10x10[T]{rows: m1.cols, cols: m1.rows}
+
+	for rt := 0; rt < m.rows; rt++ {
+		for ct := 0; ct < m.cols; ct++ {
+			m.matrix[rt][ct] = m1.matrix[ct][rt]
+		}
+	}
+
+	return m
+}
+
+// MatrixMultiply will multiple the given matrix against this matrix.
+func (m1 Matrix10x10[T]) MatrixMultiply(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Width() != m2.Height() {
+		return Matrix10x10[T]{}, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "MatrixMultiply",
+			LeftRows:  m1.Height(),
+			LeftCols:  m1.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	if m2.Width() > 10 {
+		return Factory[T]{}.FromFunc(m1.rows, m2.Width(), func(rm int, cm2 int) T {
+			var product T
+			for cm := 0; cm < m1.cols; cm++ {
+				product = product + m1.matrix[rm][cm]*m2.Get(cm, cm2)
+			}
+			return product
+		})
+	}
+
+	m := Matrix10x10[T]{rows: m1.rows, cols: m2.Width()}
+
+	for rm := 0; rm < m1.rows; rm++ {
+		for cm2 := 0; cm2 < m2.Width(); cm2++ {
+			var product T
+			for cm := 0; cm < m1.cols; cm++ {
+				product = product + m1.matrix[rm][cm]*m2.Get(cm, cm2)
+			}
+			m.matrix[rm][cm2] = product
+		}
+	}
+
+	return m, nil
+}
+
+// Map will apply f to every element of this matrix.
+func (m1 Matrix10x10[T]) Map(f func(v T) T) immutabilitybenchmarking.Matrix[T] {
+	m := Matrix10x10[T]{rows: m1.rows, cols: m1.cols}
+
+	for r := 0; r < m1.rows; r++ {
+		for c := 0; c < m1.cols; c++ {
+			m.matrix[r][c] = f(m1.matrix[r][c])
+		}
+	}
+
+	return m
+}
+
+// MapIndexed will apply f to every element of this matrix along with its coordinates.
+func (m1 Matrix10x10[T]) MapIndexed(f func(row int, col int, v T) T) immutabilitybenchmarking.Matrix[T] {
+	m := Matrix10x10[T]{rows: m1.rows, cols: m1.cols}
+
+	for r := 0; r < m1.rows; r++ {
+		for c := 0; c < m1.cols; c++ {
+			m.matrix[r][c] = f(r, c, m1.matrix[r][c])
+		}
+	}
+
+	return m
+}
+
+// ZipWith will combine each element of this matrix with the element at the same coordinates of the given
+// matrix using f.
+func (m1 Matrix10x10[T]) ZipWith(m2 immutabilitybenchmarking.Matrix[T], f func(a T, b T) T) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Height() != m2.Height() || m1.Width() != m2.Width() {
+		return Matrix10x10[T]{}, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "ZipWith",
+			LeftRows:  m1.Height(),
+			LeftCols:  m1.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	m := Matrix10x10[T]{rows: m1.rows, cols: m1.cols}
+
+	for r := 0; r < m.rows; r++ {
+		for c := 0; c < m.cols; c++ {
+			m.matrix[r][c] = f(m1.matrix[r][c], m2.Get(r, c))
+		}
+	}
+
+	return m, nil
+}
+
+// Fold will combine every element of this matrix into a single value, starting from initial and visiting
+// the elements in row-major order.
+func (m1 Matrix10x10[T]) Fold(initial T, f func(acc T, v T) T) T {
+	acc := initial
+
+	for r := 0; r < m1.rows; r++ {
+		for c := 0; c < m1.cols; c++ {
+			acc = f(acc, m1.matrix[r][c])
+		}
+	}
+
+	return acc
+}
+
+// RowReduce will fold each row of this matrix into a single value starting from initial, returning a
+// column of the results.
+func (m1 Matrix10x10[T]) RowReduce(initial T, f func(acc T, v T) T) immutabilitybenchmarking.Matrix[T] {
+	m := Matrix10x10[T]{rows: m1.rows, cols: 1}
+
+	for r := 0; r < m1.rows; r++ {
+		acc := initial
+
+		for c := 0; c < m1.cols; c++ {
+			acc = f(acc, m1.matrix[r][c])
+		}
+
+		m.matrix[r][0] = acc
+	}
+
+	return m
+}
+
+// ColReduce will fold each column of this matrix into a single value starting from initial, returning a
+// row of the results.
+func (m1 Matrix10x10[T]) ColReduce(initial T, f func(acc T, v T) T) immutabilitybenchmarking.Matrix[T] {
+	m := Matrix10x10[T]{rows: 1, cols: m1.cols}
+
+	for c := 0; c < m1.cols; c++ {
+		m.matrix[0][c] = initial
+	}
+
+	for r := 0; r < m1.rows; r++ {
+		for c := 0; c < m1.cols; c++ {
+			m.matrix[0][c] = f(m.matrix[0][c], m1.matrix[r][c])
+		}
+	}
+
+	return m
+}
+
+// HadamardProduct will multiply each element of this matrix by the element at the same coordinates of the
+// given matrix.
+func (m1 Matrix10x10[T]) HadamardProduct(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Height() != m2.Height() || m1.Width() != m2.Width() {
+		return Matrix10x10[T]{}, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "HadamardProduct",
+			LeftRows:  m1.Height(),
+			LeftCols:  m1.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	m := Matrix10x10[T]{rows: m1.rows, cols: m1.cols}
+
+	for r := 0; r < m1.rows; r++ {
+		for c := 0; c < m1.cols; c++ {
+			m.matrix[r][c] = m1.matrix[r][c] * m2.Get(r, c)
+		}
+	}
+
+	return m, nil
+}
+
+// ElementwiseDivide will divide each element of this matrix by the element at the same coordinates of the
+// given matrix, returning a DivisionByZeroError if any of them is zero.
+func (m1 Matrix10x10[T]) ElementwiseDivide(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Height() != m2.Height() || m1.Width() != m2.Width() {
+		return Matrix10x10[T]{}, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "ElementwiseDivide",
+			LeftRows:  m1.Height(),
+			LeftCols:  m1.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	m := Matrix10x10[T]{rows: m1.rows, cols: m1.cols}
+
+	for r := 0; r < m1.rows; r++ {
+		for c := 0; c < m1.cols; c++ {
+			d := m2.Get(r, c)
+			if d == 0 {
+				return Matrix10x10[T]{}, &immutabilitybenchmarking.DivisionByZeroError{Op: "ElementwiseDivide", Row: r, Col: c}
+			}
+
+			m.matrix[r][c] = m1.matrix[r][c] / d
+		}
+	}
+
+	return m, nil
+}
+
+// KroneckerProduct will multiply every element of this matrix by the whole of the given matrix, returning
+// the blocks of products in the smallest generated type able to hold them.
+func (m1 Matrix10x10[T]) KroneckerProduct(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	return kronecker[T](m1, m2)
+}
+
+// Trace will sum the elements on the diagonal of this matrix, returning a NotSquareError if it is not square.
+func (m1 Matrix10x10[T]) Trace() (T, error) {
+	if m1.rows != m1.cols {
+		var zero T
+		return zero, &immutabilitybenchmarking.NotSquareError{Rows: m1.rows, Cols: m1.cols}
+	}
+
+	var trace T
+
+	for i := 0; i < m1.rows; i++ {
+		trace += m1.matrix[i][i]
+	}
+
+	return trace, nil
+}
+
+// Sum will add together every element of this matrix.
+func (m1 Matrix10x10[T]) Sum() T {
+	return numeric.Sum(m1.rows, func(r int) []T { return m1.matrix[r][:m1.cols] })
+}
+
+// Min will return the smallest element of this matrix. Complex elements are ordered by their real parts and
+// then by their imaginary parts.
+func (m1 Matrix10x10[T]) Min() T {
+	r, c := numeric.ArgMin(m1.rows, func(r int) []T { return m1.matrix[r][:m1.cols] })
+
+	return m1.matrix[r][c]
+}
+
+// Max will return the largest element of this matrix. Complex elements are ordered by their real parts and
+// then by their imaginary parts.
+func (m1 Matrix10x10[T]) Max() T {
+	r, c := numeric.ArgMax(m1.rows, func(r int) []T { return m1.matrix[r][:m1.cols] })
+
+	return m1.matrix[r][c]
+}
+
+// ArgMin will return the coordinates of the first smallest element of this matrix in row-major order.
+func (m1 Matrix10x10[T]) ArgMin() (int, int) {
+	return numeric.ArgMin(m1.rows, func(r int) []T { return m1.matrix[r][:m1.cols] })
+}
+
+// ArgMax will return the coordinates of the first largest element of this matrix in row-major order.
+func (m1 Matrix10x10[T]) ArgMax() (int, int) {
+	return numeric.ArgMax(m1.rows, func(r int) []T { return m1.matrix[r][:m1.cols] })
+}
+
+// FrobeniusNorm will return the square root of the sum of the squared absolute values of the elements of
+// this matrix.
+func (m1 Matrix10x10[T]) FrobeniusNorm() float64 {
+	return numeric.FrobeniusNorm(m1.rows, func(r int) []T { return m1.matrix[r][:m1.cols] })
+}
+
+// OneNorm will return the largest sum of the absolute values in a column of this matrix.
+func (m1 Matrix10x10[T]) OneNorm() float64 {
+	return numeric.OneNorm(m1.rows, m1.cols, func(r int) []T { return m1.matrix[r][:m1.cols] })
+}
+
+// InfNorm will return the largest sum of the absolute values in a row of this matrix.
+func (m1 Matrix10x10[T]) InfNorm() float64 {
+	return numeric.InfNorm(m1.rows, func(r int) []T { return m1.matrix[r][:m1.cols] })
+}
+
+// Rank will return the number of linearly independent rows of this matrix, treating values within rounding
+// error of zero as zero.
+func (m1 Matrix10x10[T]) Rank() int {
+	return numeric.Rank(m1.rows, m1.cols, func(r int) []T { return m1.matrix[r][:m1.cols] })
+}
+
+// Power will raise this matrix to the power n by repeated squaring, returning a NotSquareError if it is not
+// square or a NegativeExponentError if n is negative. The zeroth power is the identity matrix.
+func (m1 Matrix10x10[T]) Power(n int) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.rows != m1.cols {
+		return Matrix10x10[T]{}, &immutabilitybenchmarking.NotSquareError{Rows: m1.rows, Cols: m1.cols}
+	}
+
+	if n < 0 {
+		return Matrix10x10[T]{}, &immutabilitybenchmarking.NegativeExponentError{Exponent: n}
+	}
+
+	return power.BySquaring[immutabilitybenchmarking.Matrix[T]](m1, n, func() (immutabilitybenchmarking.Matrix[T], error) {
+		m := Matrix10x10[T]{rows: m1.rows, cols: m1.cols}
+
+		for i := 0; i < m1.rows; i++ {
+			m.matrix[i][i] = 1
+		}
+
+		return m, nil
+	}, nil)
+}
+
+// Reshape will read the elements of this matrix in row-major order into a matrix with the given dimensions,
+// returning a ReshapeError if it would not hold the same number of elements.
+func (m1 Matrix10x10[T]) Reshape(rows int, cols int) (immutabilitybenchmarking.Matrix[T], error) {
+	return rearrange[T](shape.Reshape[T](m1, rows, cols))
+}
+
+// HConcat will place the given matrix to the right of this matrix, returning a DimensionMismatchError if
+// they do not have the same number of rows.
+func (m1 Matrix10x10[T]) HConcat(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	return rearrange[T](shape.HConcat[T](m1, m2))
+}
+
+// VConcat will place the given matrix below this matrix, returning a DimensionMismatchError if they do not
+// have the same number of columns.
+func (m1 Matrix10x10[T]) VConcat(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	return rearrange[T](shape.Stack[T]("VConcat", m1, m2))
+}
+
+// Stack will place the given matrices below this matrix in order, returning a DimensionMismatchError for
+// the first that does not have the same number of columns.
+func (m1 Matrix10x10[T]) Stack(ms ...immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	return rearrange[T](shape.Stack[T]("Stack", m1, ms...))
+}
+
+// SplitRows will split this matrix into its rows before at and its rows from at onwards, returning an
+// InvalidDimensionsError if either part would be empty.
+func (m1 Matrix10x10[T]) SplitRows(at int) (immutabilitybenchmarking.Matrix[T], immutabilitybenchmarking.Matrix[T], error) {
+	return split[T](shape.SplitRows[T](m1, at))
+}
+
+// SplitCols will split this matrix into its columns before at and its columns from at onwards, returning an
+// InvalidDimensionsError if either part would be empty.
+func (m1 Matrix10x10[T]) SplitCols(at int) (immutabilitybenchmarking.Matrix[T], immutabilitybenchmarking.Matrix[T], error) {
+	return split[T](shape.SplitCols[T](m1, at))
+}
+
+// Pad will surround this matrix with the given number of rows and columns of zeros on each side, returning
+// a PaddingError if any of them is negative.
+func (m1 Matrix10x10[T]) Pad(top int, bottom int, left int, right int) (immutabilitybenchmarking.Matrix[T], error) {
+	return rearrange[T](shape.Pad[T](m1, top, bottom, left, right))
+}
+
+// Flip will reverse the order of the rows or the columns of this matrix.
+func (m1 Matrix10x10[T]) Flip(axis immutabilitybenchmarking.Axis) immutabilitybenchmarking.Matrix[T] {
+	m := Matrix10x10[T]{rows: m1.rows, cols: m1.cols}
+
+	for r := 0; r < m1.rows; r++ {
+		if axis == immutabilitybenchmarking.RowAxis {
+			m.matrix[m1.rows-1-r] = m1.matrix[r]
+			continue
+		}
+
+		for c := 0; c < m1.cols; c++ {
+			m.matrix[r][m1.cols-1-c] = m1.matrix[r][c]
+		}
+	}
+
+	return m
+}
+
+// Rotate90 will turn this matrix a quarter turn clockwise.
+func (m1 Matrix10x10[T]) Rotate90() immutabilitybenchmarking.Matrix[T] {
+	m := Matrix10x10[T]{rows: m1.cols, cols: m1.rows}
+
+	for r := 0; r < m1.rows; r++ {
+		for c := 0; c < m1.cols; c++ {
+			m.matrix[c][m1.rows-1-r] = m1.matrix[r][c]
+		}
+	}
+
+	return m
+}
+
+// Matrix30x30 is an immutable matrix with non-mutating operations backed by a 30x30 array.
+type Matrix30x30[T immutabilitybenchmarking.Number] struct {
+	rows   int
+	cols   int
+	matrix [30][30]T
+}
+
+// Width returns the number of columns in the matrix.
+func (m1 Matrix30x30[T]) Width() int {
+	return m1.cols
+}
+
+// Height returns the number of rows in the matrix.
+func (m1 Matrix30x30[T]) Height() int {
+	return m1.rows
+}
+
+// Get returns the element at the provided coordinates. It panics with an OutOfBoundsError if they are
+// outside the matrix, as the unused part of the array would otherwise be read as zeros.
+func (m1 Matrix30x30[T]) Get(row int, col int) T {
+	if row < 0 || row >= m1.rows || col < 0 || col >= m1.cols {
+		panic(&immutabilitybenchmarking.OutOfBoundsError{Row: row, Col: col, Rows: m1.rows, Cols: m1.cols})
+	}
+
+	return m1.matrix[row][col]
+}
+
+// At returns the element at the provided coordinates or an OutOfBoundsError if they are outside the matrix.
+func (m1 Matrix30x30[T]) At(row int, col int) (T, error) {
+	if row < 0 || row >= m1.Height() || col < 0 || col >= m1.Width() {
+		var zero T
+		return zero, &immutabilitybenchmarking.OutOfBoundsError{Row: row, Col: col, Rows: m1.Height(), Cols: m1.Width()}
+	}
+
+	return m1.matrix[row][col], nil
+}
+
+// MustAt returns the element at the provided coordinates and panics with an OutOfBoundsError if they are
+// outside the matrix.
+func (m1 Matrix30x30[T]) MustAt(row int, col int) T {
+	v, err := m1.At(row, col)
+	if err != nil {
+		panic(err)
+	}
+
+	return v
+}
+
+func (m1 *Matrix30x30[T]) set(row int, col int, v T) {
+	m1.matrix[row][col] = v
+}
+
+func (m1 *Matrix30x30[T]) freeze() immutabilitybenchmarking.Matrix[T] {
+	return *m1
+}
+
+// Equals will compare a matrix against this matrix and return if they are equal.
+func (m1 Matrix30x30[T]) Equals(m2 immutabilitybenchmarking.Matrix[T]) bool {
+	if m1.Height() != m2.Height() {
+		return false
+	}
+
+	if m1.Width() != m2.Width() {
+		return false
+	}
+
+	for r := 0; r < m1.rows; r++ {
+		for c := 0; c < m1.cols; c++ {
+			if m1.matrix[r][c] != m2.Get(r, c) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// Add will add the values of a matrix to this matrix.
+func (m1 Matrix30x30[T]) Add(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Height() != m2.Height() || m1.Width() != m2.Width() {
+		return Matrix30x30[T]{}, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "Add",
+			LeftRows:  m1.Height(),
+			LeftCols:  m1.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	m := Matrix30x30[T]{rows: m1.rows, cols: m1.cols}
+
+	for r := 0; r < m.rows; r++ {
+		for c := 0; c < m.cols; c++ {
+			m.matrix[r][c] = m1.matrix[r][c] + m2.Get(r, c)
+		}
+	}
+
+	return m, nil
+}
+
+// Subtract will subtract the values of a matrix from this matrix.
+func (m1 Matrix30x30[T]) Subtract(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Height() != m2.Height() || m1.Width() != m2.Width() {
+		return Matrix30x30[T]{}, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "Subtract",
+			LeftRows:  m1.Height(),
+			LeftCols:  m1.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	m := Matrix30x30[T]{rows: m1.rows, cols: m1.cols}
+
+	for r := 0; r < m.rows; r++ {
+		for c := 0; c < m.cols; c++ {
+			m.matrix[r][c] = m1.matrix[r][c] - m2.Get(r, c)
+		}
+	}
+
+	return m, nil
+}
+
+// ScalarMultiply will multiply this matrix by a given scalar value.
+func (m1 Matrix30x30[T]) ScalarMultiply(s T) immutabilitybenchmarking.Matrix[T] {
+	m := Matrix30x30[T]{rows: m1.rows, cols: m1.cols}
+
+	for r := 0; r < m1.rows; r++ {
+		for c := 0; c < m1.cols; c++ {
+			m.matrix[r][c] = m1.matrix[r][c] * s
+		}
+	}
+
+	return m
+}
+
+// Transpose will transpose this matrix.
+func (m1 Matrix30x30[T]) Transpose() immutabilitybenchmarking.Matrix[T] {
+	m := Matrix30x30[T]{rows: m1.cols, cols: m1.rows}
+
+	for rt := 0; rt < m.rows; rt++ {
+		for ct := 0; ct < m.cols; ct++ {
+			m.matrix[rt][ct] = m1.matrix[ct][rt]
+		}
+	}
+
+	return m
+}
+
+// MatrixMultiply will multiple the given matrix against this matrix.
+func (m1 Matrix30x30[T]) MatrixMultiply(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Width() != m2.Height() {
+		return Matrix30x30[T]{}, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "MatrixMultiply",
+			LeftRows:  m1.Height(),
+			LeftCols:  m1.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	if m2.Width() > 30 {
+		return Factory[T]{}.FromFunc(m1.rows, m2.Width(), func(rm int, cm2 int) T {
+			var product T
+			for cm := 0; cm < m1.cols; cm++ {
+				product = product + m1.matrix[rm][cm]*m2.Get(cm, cm2)
+			}
+			return product
+		})
+	}
+
+	m := Matrix30x30[T]{rows: m1.rows, cols: m2.Width()}
+
+	for rm := 0; rm < m1.rows; rm++ {
+		for cm2 := 0; cm2 < m2.Width(); cm2++ {
+			var product T
+			for cm := 0; cm < m1.cols; cm++ {
+				product = product + m1.matrix[rm][cm]*m2.Get(cm, cm2)
+			}
+			m.matrix[rm][cm2] = product
+		}
+	}
+
+	return m, nil
+}
+
+// Map will apply f to every element of this matrix.
+func (m1 Matrix30x30[T]) Map(f func(v T) T) immutabilitybenchmarking.Matrix[T] {
+	m := Matrix30x30[T]{rows: m1.rows, cols: m1.cols}
+
+	for r := 0; r < m1.rows; r++ {
+		for c := 0; c < m1.cols; c++ {
+			m.matrix[r][c] = f(m1.matrix[r][c])
+		}
+	}
+
+	return m
+}
+
+// MapIndexed will apply f to every element of this matrix along with its coordinates.
+func (m1 Matrix30x30[T]) MapIndexed(f func(row int, col int, v T) T) immutabilitybenchmarking.Matrix[T] {
+	m := Matrix30x30[T]{rows: m1.rows, cols: m1.cols}
+
+	for r := 0; r < m1.rows; r++ {
+		for c := 0; c < m1.cols; c++ {
+			m.matrix[r][c] = f(r, c, m1.matrix[r][c])
+		}
+	}
+
+	return m
+}
+
+// ZipWith will combine each element of this matrix with the element at the same coordinates of the given
+// matrix using f.
+func (m1 Matrix30x30[T]) ZipWith(m2 immutabilitybenchmarking.Matrix[T], f func(a T, b T) T) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Height() != m2.Height() || m1.Width() != m2.Width() {
+		return Matrix30x30[T]{}, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "ZipWith",
+			LeftRows:  m1.Height(),
+			LeftCols:  m1.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	m := Matrix30x30[T]{rows: m1.rows, cols: m1.cols}
+
+	for r := 0; r < m.rows; r++ {
+		for c := 0; c < m.cols; c++ {
+			m.matrix[r][c] = f(m1.matrix[r][c], m2.Get(r, c))
+		}
+	}
+
+	return m, nil
+}
+
+// Fold will combine every element of this matrix into a single value, starting from initial and visiting
+// the elements in row-major order.
+func (m1 Matrix30x30[T]) Fold(initial T, f func(acc T, v T) T) T {
+	acc := initial
+
+	for r := 0; r < m1.rows; r++ {
+		for c := 0; c < m1.cols; c++ {
+			acc = f(acc, m1.matrix[r][c])
+		}
+	}
+
+	return acc
+}
+
+// RowReduce will fold each row of this matrix into a single value starting from initial, returning a
+// column of the results.
+func (m1 Matrix30x30[T]) RowReduce(initial T, f func(acc T, v T) T) immutabilitybenchmarking.Matrix[T] {
+	m := Matrix30x30[T]{rows: m1.rows, cols: 1}
+
+	for r := 0; r < m1.rows; r++ {
+		acc := initial
+
+		for c := 0; c < m1.cols; c++ {
+			acc = f(acc, m1.matrix[r][c])
+		}
+
+		m.matrix[r][0] = acc
+	}
+
+	return m
+}
+
+// ColReduce will fold each column of this matrix into a single value starting from initial, returning a
+// row of the results.
+func (m1 Matrix30x30[T]) ColReduce(initial T, f func(acc T, v T) T) immutabilitybenchmarking.Matrix[T] {
+	m := Matrix30x30[T]{rows: 1, cols: m1.cols}
+
+	for c := 0; c < m1.cols; c++ {
+		m.matrix[0][c] = initial
+	}
+
+	for r := 0; r < m1.rows; r++ {
+		for c := 0; c < m1.cols; c++ {
+			m.matrix[0][c] = f(m.matrix[0][c], m1.matrix[r][c])
+		}
+	}
+
+	return m
+}
+
+// HadamardProduct will multiply each element of this matrix by the element at the same coordinates of the
+// given matrix.
+func (m1 Matrix30x30[T]) HadamardProduct(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Height() != m2.Height() || m1.Width() != m2.Width() {
+		return Matrix30x30[T]{}, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "HadamardProduct",
+			LeftRows:  m1.Height(),
+			LeftCols:  m1.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	m := Matrix30x30[T]{rows: m1.rows, cols: m1.cols}
+
+	for r := 0; r < m1.rows; r++ {
+		for c := 0; c < m1.cols; c++ {
+			m.matrix[r][c] = m1.matrix[r][c] * m2.Get(r, c)
+		}
+	}
+
+	return m, nil
+}
+
+// ElementwiseDivide will divide each element of this matrix by the element at the same coordinates of the
+// given matrix, returning a DivisionByZeroError if any of them is zero.
+func (m1 Matrix30x30[T]) ElementwiseDivide(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Height() != m2.Height() || m1.Width() != m2.Width() {
+		return Matrix30x30[T]{}, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "ElementwiseDivide",
+			LeftRows:  m1.Height(),
+			LeftCols:  m1.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	m := Matrix30x30[T]{rows: m1.rows, cols: m1.cols}
+
+	for r := 0; r < m1.rows; r++ {
+		for c := 0; c < m1.cols; c++ {
+			d := m2.Get(r, c)
+			if d == 0 {
+				return Matrix30x30[T]{}, &immutabilitybenchmarking.DivisionByZeroError{Op: "ElementwiseDivide", Row: r, Col: c}
+			}
+
+			m.matrix[r][c] = m1.matrix[r][c] / d
+		}
+	}
+
+	return m, nil
+}
+
+// KroneckerProduct will multiply every element of this matrix by the whole of the given matrix, returning
+// the blocks of products in the smallest generated type able to hold them.
+func (m1 Matrix30x30[T]) KroneckerProduct(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	return kronecker[T](m1, m2)
+}
+
+// Trace will sum the elements on the diagonal of this matrix, returning a NotSquareError if it is not square.
+func (m1 Matrix30x30[T]) Trace() (T, error) {
+	if m1.rows != m1.cols {
+		var zero T
+		return zero, &immutabilitybenchmarking.NotSquareError{Rows: m1.rows, Cols: m1.cols}
+	}
+
+	var trace T
+
+	for i := 0; i < m1.rows; i++ {
+		trace += m1.matrix[i][i]
+	}
+
+	return trace, nil
+}
+
+// Sum will add together every element of this matrix.
+func (m1 Matrix30x30[T]) Sum() T {
+	return numeric.Sum(m1.rows, func(r int) []T { return m1.matrix[r][:m1.cols] })
+}
+
+// Min will return the smallest element of this matrix. Complex elements are ordered by their real parts and
+// then by their imaginary parts.
+func (m1 Matrix30x30[T]) Min() T {
+	r, c := numeric.ArgMin(m1.rows, func(r int) []T { return m1.matrix[r][:m1.cols] })
+
+	return m1.matrix[r][c]
+}
+
+// Max will return the largest element of this matrix. Complex elements are ordered by their real parts and
+// then by their imaginary parts.
+func (m1 Matrix30x30[T]) Max() T {
+	r, c := numeric.ArgMax(m1.rows, func(r int) []T { return m1.matrix[r][:m1.cols] })
+
+	return m1.matrix[r][c]
+}
+
+// ArgMin will return the coordinates of the first smallest element of this matrix in row-major order.
+func (m1 Matrix30x30[T]) ArgMin() (int, int) {
+	return numeric.ArgMin(m1.rows, func(r int) []T { return m1.matrix[r][:m1.cols] })
+}
+
+// ArgMax will return the coordinates of the first largest element of this matrix in row-major order.
+func (m1 Matrix30x30[T]) ArgMax() (int, int) {
+	return numeric.ArgMax(m1.rows, func(r int) []T { return m1.matrix[r][:m1.cols] })
+}
+
+// FrobeniusNorm will return the square root of the sum of the squared absolute values of the elements of
+// this matrix.
+func (m1 Matrix30x30[T]) FrobeniusNorm() float64 {
+	return numeric.FrobeniusNorm(m1.rows, func(r int) []T { return m1.matrix[r][:m1.cols] })
+}
+
+// OneNorm will return the largest sum of the absolute values in a column of this matrix.
+func (m1 Matrix30x30[T]) OneNorm() float64 {
+	return numeric.OneNorm(m1.rows, m1.cols, func(r int) []T { return m1.matrix[r][:m1.cols] })
+}
+
+// InfNorm will return the largest sum of the absolute values in a row of this matrix.
+func (m1 Matrix30x30[T]) InfNorm() float64 {
+	return numeric.InfNorm(m1.rows, func(r int) []T { return m1.matrix[r][:m1.cols] })
+}
+
+// Rank will return the number of linearly independent rows of this matrix, treating values within rounding
+// error of zero as zero.
+func (m1 Matrix30x30[T]) Rank() int {
+	return numeric.Rank(m1.rows, m1.cols, func(r int) []T { return m1.matrix[r][:m1.cols] })
+}
+
+// Power will raise this matrix to the power n by repeated squaring, returning a NotSquareError if it is not
+// square or a NegativeExponentError if n is negative. The zeroth power is the identity matrix.
+func (m1 Matrix30x30[T]) Power(n int) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.rows != m1.cols {
+		return Matrix30x30[T]{}, &immutabilitybenchmarking.NotSquareError{Rows: m1.rows, Cols: m1.cols}
+	}
+
+	if n < 0 {
+		return Matrix30x30[T]{}, &immutabilitybenchmarking.NegativeExponentError{Exponent: n}
+	}
+
+	return power.BySquaring[immutabilitybenchmarking.Matrix[T]](m1, n, func() (immutabilitybenchmarking.Matrix[T], error) {
+		m := Matrix30x30[T]{rows: m1.rows, cols: m1.cols}
+
+		for i := 0; i < m1.rows; i++ {
+			m.matrix[i][i] = 1
+		}
+
+		return m, nil
+	}, nil)
+}
+
+// Reshape will read the elements of this matrix in row-major order into a matrix with the given dimensions,
+// returning a ReshapeError if it would not hold the same number of elements.
+func (m1 Matrix30x30[T]) Reshape(rows int, cols int) (immutabilitybenchmarking.Matrix[T], error) {
+	return rearrange[T](shape.Reshape[T](m1, rows, cols))
+}
+
+// HConcat will place the given matrix to the right of this matrix, returning a DimensionMismatchError if
+// they do not have the same number of rows.
+func (m1 Matrix30x30[T]) HConcat(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	return rearrange[T](shape.HConcat[T](m1, m2))
+}
+
+// VConcat will place the given matrix below this matrix, returning a DimensionMismatchError if they do not
+// have the same number of columns.
+func (m1 Matrix30x30[T]) VConcat(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	return rearrange[T](shape.Stack[T]("VConcat", m1, m2))
+}
+
+// Stack will place the given matrices below this matrix in order, returning a DimensionMismatchError for
+// the first that does not have the same number of columns.
+func (m1 Matrix30x30[T]) Stack(ms ...immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	return rearrange[T](shape.Stack[T]("Stack", m1, ms...))
+}
+
+// SplitRows will split this matrix into its rows before at and its rows from at onwards, returning an
+// InvalidDimensionsError if either part would be empty.
+func (m1 Matrix30x30[T]) SplitRows(at int) (immutabilitybenchmarking.Matrix[T], immutabilitybenchmarking.Matrix[T], error) {
+	return split[T](shape.SplitRows[T](m1, at))
+}
+
+// SplitCols will split this matrix into its columns before at and its columns from at onwards, returning an
+// InvalidDimensionsError if either part would be empty.
+func (m1 Matrix30x30[T]) SplitCols(at int) (immutabilitybenchmarking.Matrix[T], immutabilitybenchmarking.Matrix[T], error) {
+	return split[T](shape.SplitCols[T](m1, at))
+}
+
+// Pad will surround this matrix with the given number of rows and columns of zeros on each side, returning
+// a PaddingError if any of them is negative.
+func (m1 Matrix30x30[T]) Pad(top int, bottom int, left int, right int) (immutabilitybenchmarking.Matrix[T], error) {
+	return rearrange[T](shape.Pad[T](m1, top, bottom, left, right))
+}
+
+// Flip will reverse the order of the rows or the columns of this matrix.
+func (m1 Matrix30x30[T]) Flip(axis immutabilitybenchmarking.Axis) immutabilitybenchmarking.Matrix[T] {
+	m := Matrix30x30[T]{rows: m1.rows, cols: m1.cols}
+
+	for r := 0; r < m1.rows; r++ {
+		if axis == immutabilitybenchmarking.RowAxis {
+			m.matrix[m1.rows-1-r] = m1.matrix[r]
+			continue
+		}
+
+		for c := 0; c < m1.cols; c++ {
+			m.matrix[r][m1.cols-1-c] = m1.matrix[r][c]
+		}
+	}
+
+	return m
+}
+
+// Rotate90 will turn this matrix a quarter turn clockwise.
+func (m1 Matrix30x30[T]) Rotate90() immutabilitybenchmarking.Matrix[T] {
+	m := Matrix30x30[T]{rows: m1.cols, cols: m1.rows}
+
+	for r := 0; r < m1.rows; r++ {
+		for c := 0; c < m1.cols; c++ {
+			m.matrix[c][m1.rows-1-r] = m1.matrix[r][c]
+		}
+	}
+
+	return m
+}
+
+// Matrix90x90 is an immutable matrix with non-mutating operations backed by a 90x90 array.
+type Matrix90x90[T immutabilitybenchmarking.Number] struct {
+	rows   int
+	cols   int
+	matrix [90][90]T
+}
+
+// Width returns the number of columns in the matrix.
+func (m1 Matrix90x90[T]) Width() int {
+	return m1.cols
+}
+
+// Height returns the number of rows in the matrix.
+func (m1 Matrix90x90[T]) Height() int {
+	return m1.rows
+}
+
+// Get returns the element at the provided coordinates. It panics with an OutOfBoundsError if they are
+// outside the matrix, as the unused part of the array would otherwise be read as zeros.
+func (m1 Matrix90x90[T]) Get(row int, col int) T {
+	if row < 0 || row >= m1.rows || col < 0 || col >= m1.cols {
+		panic(&immutabilitybenchmarking.OutOfBoundsError{Row: row, Col: col, Rows: m1.rows, Cols: m1.cols})
+	}
+
+	return m1.matrix[row][col]
+}
+
+// At returns the element at the provided coordinates or an OutOfBoundsError if they are outside the matrix.
+func (m1 Matrix90x90[T]) At(row int, col int) (T, error) {
+	if row < 0 || row >= m1.Height() || col < 0 || col >= m1.Width() {
+		var zero T
+		return zero, &immutabilitybenchmarking.OutOfBoundsError{Row: row, Col: col, Rows: m1.Height(), Cols: m1.Width()}
+	}
+
+	return m1.matrix[row][col], nil
+}
+
+// MustAt returns the element at the provided coordinates and panics with an OutOfBoundsError if they are
+// outside the matrix.
+func (m1 Matrix90x90[T]) MustAt(row int, col int) T {
+	v, err := m1.At(row, col)
+	if err != nil {
+		panic(err)
+	}
+
+	return v
+}
+
+func (m1 *Matrix90x90[T]) set(row int, col int, v T) {
+	m1.matrix[row][col] = v
+}
+
+func (m1 *Matrix90x90[T]) freeze() immutabilitybenchmarking.Matrix[T] {
+	return *m1
+}
+
+// Equals will compare a matrix against this matrix and return if they are equal.
+func (m1 Matrix90x90[T]) Equals(m2 immutabilitybenchmarking.Matrix[T]) bool {
+	if m1.Height() != m2.Height() {
+		return false
+	}
+
+	if m1.Width() != m2.Width() {
+		return false
+	}
+
+	for r := 0; r < m1.rows; r++ {
+		for c := 0; c < m1.cols; c++ {
+			if m1.matrix[r][c] != m2.Get(r, c) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// Add will add the values of a matrix to this matrix.
+func (m1 Matrix90x90[T]) Add(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Height() != m2.Height() || m1.Width() != m2.Width() {
+		return Matrix90x90[T]{}, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "Add",
+			LeftRows:  m1.Height(),
+			LeftCols:  m1.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	m := Matrix90x90[T]{rows: m1.rows, cols: m1.cols}
+
+	for r := 0; r < m.rows; r++ {
+		for c := 0; c < m.cols; c++ {
+			m.matrix[r][c] = m1.matrix[r][c] + m2.Get(r, c)
+		}
+	}
+
+	return m, nil
+}
+
+// Subtract will subtract the values of a matrix from this matrix.
+func (m1 Matrix90x90[T]) Subtract(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Height() != m2.Height() || m1.Width() != m2.Width() {
+		return Matrix90x90[T]{}, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "Subtract",
+			LeftRows:  m1.Height(),
+			LeftCols:  m1.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	m := Matrix90x90[T]{rows: m1.rows, cols: m1.cols}
+
+	for r := 0; r < m.rows; r++ {
+		for c := 0; c < m.cols; c++ {
+			m.matrix[r][c] = m1.matrix[r][c] - m2.Get(r, c)
+		}
+	}
+
+	return m, nil
+}
+
+// ScalarMultiply will multiply this matrix by a given scalar value.
+func (m1 Matrix90x90[T]) ScalarMultiply(s T) immutabilitybenchmarking.Matrix[T] {
+	m := Matrix90x90[T]{rows: m1.rows, cols: m1.cols}
+
+	for r := 0; r < m1.rows; r++ {
+		for c := 0; c < m1.cols; c++ {
+			m.matrix[r][c] = m1.matrix[r][c] * s
+		}
+	}
+
+	return m
+}
+
+// Transpose will transpose this matrix.
+func (m1 Matrix90x90[T]) Transpose() immutabilitybenchmarking.Matrix[T] {
+	m := Matrix90x90[T]{rows: m1.cols, cols: m1.rows}
+
+	for rt := 0; rt < m.rows; rt++ {
+		for ct := 0; ct < m.cols; ct++ {
+			m.matrix[rt][ct] = m1.matrix[ct][rt]
+		}
+	}
+
+	return m
+}
+
+// MatrixMultiply will multiple the given matrix against this matrix.
+func (m1 Matrix90x90[T]) MatrixMultiply(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Width() != m2.Height() {
+		return Matrix90x90[T]{}, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "MatrixMultiply",
+			LeftRows:  m1.Height(),
+			LeftCols:  m1.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	if m2.Width() > 90 {
+		return Factory[T]{}.FromFunc(m1.rows, m2.Width(), func(rm int, cm2 int) T {
+			var product T
+			for cm := 0; cm < m1.cols; cm++ {
+				product = product + m1.matrix[rm][cm]*m2.Get(cm, cm2)
+			}
+			return product
+		})
+	}
+
+	m := Matrix90x90[T]{rows: m1.rows, cols: m2.Width()}
+
+	for rm := 0; rm < m1.rows; rm++ {
+		for cm2 := 0; cm2 < m2.Width(); cm2++ {
+			var product T
+			for cm := 0; cm < m1.cols; cm++ {
+				product = product + m1.matrix[rm][cm]*m2.Get(cm, cm2)
+			}
+			m.matrix[rm][cm2] = product
+		}
+	}
+
+	return m, nil
+}
+
+// Map will apply f to every element of this matrix.
+func (m1 Matrix90x90[T]) Map(f func(v T) T) immutabilitybenchmarking.Matrix[T] {
+	m := Matrix90x90[T]{rows: m1.rows, cols: m1.cols}
+
+	for r := 0; r < m1.rows; r++ {
+		for c := 0; c < m1.cols; c++ {
+			m.matrix[r][c] = f(m1.matrix[r][c])
+		}
+	}
+
+	return m
+}
+
+// MapIndexed will apply f to every element of this matrix along with its coordinates.
+func (m1 Matrix90x90[T]) MapIndexed(f func(row int, col int, v T) T) immutabilitybenchmarking.Matrix[T] {
+	m := Matrix90x90[T]{rows: m1.rows, cols: m1.cols}
+
+	for r := 0; r < m1.rows; r++ {
+		for c := 0; c < m1.cols; c++ {
+			m.matrix[r][c] = f(r, c, m1.matrix[r][c])
+		}
+	}
+
+	return m
+}
+
+// ZipWith will combine each element of this matrix with the element at the same coordinates of the given
+// matrix using f.
+func (m1 Matrix90x90[T]) ZipWith(m2 immutabilitybenchmarking.Matrix[T], f func(a T, b T) T) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Height() != m2.Height() || m1.Width() != m2.Width() {
+		return Matrix90x90[T]{}, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "ZipWith",
+			LeftRows:  m1.Height(),
+			LeftCols:  m1.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	m := Matrix90x90[T]{rows: m1.rows, cols: m1.cols}
+
+	for r := 0; r < m.rows; r++ {
+		for c := 0; c < m.cols; c++ {
+			m.matrix[r][c] = f(m1.matrix[r][c], m2.Get(r, c))
+		}
+	}
+
+	return m, nil
+}
+
+// Fold will combine every element of this matrix into a single value, starting from initial and visiting
+// the elements in row-major order.
+func (m1 Matrix90x90[T]) Fold(initial T, f func(acc T, v T) T) T {
+	acc := initial
+
+	for r := 0; r < m1.rows; r++ {
+		for c := 0; c < m1.cols; c++ {
+			acc = f(acc, m1.matrix[r][c])
+		}
+	}
+
+	return acc
+}
+
+// RowReduce will fold each row of this matrix into a single value starting from initial, returning a
+// column of the results.
+func (m1 Matrix90x90[T]) RowReduce(initial T, f func(acc T, v T) T) immutabilitybenchmarking.Matrix[T] {
+	m := Matrix90x90[T]{rows: m1.rows, cols: 1}
+
+	for r := 0; r < m1.rows; r++ {
+		acc := initial
+
+		for c := 0; c < m1.cols; c++ {
+			acc = f(acc, m1.matrix[r][c])
+		}
+
+		m.matrix[r][0] = acc
+	}
+
+	return m
+}
+
+// ColReduce will fold each column of this matrix into a single value starting from initial, returning a
+// row of the results.
+func (m1 Matrix90x90[T]) ColReduce(initial T, f func(acc T, v T) T) immutabilitybenchmarking.Matrix[T] {
+	m := Matrix90x90[T]{rows: 1, cols: m1.cols}
+
+	for c := 0; c < m1.cols; c++ {
+		m.matrix[0][c] = initial
+	}
+
+	for r := 0; r < m1.rows; r++ {
+		for c := 0; c < m1.cols; c++ {
+			m.matrix[0][c] = f(m.matrix[0][c], m1.matrix[r][c])
+		}
+	}
+
+	return m
+}
+
+// HadamardProduct will multiply each element of this matrix by the element at the same coordinates of the
+// given matrix.
+func (m1 Matrix90x90[T]) HadamardProduct(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Height() != m2.Height() || m1.Width() != m2.Width() {
+		return Matrix90x90[T]{}, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "HadamardProduct",
+			LeftRows:  m1.Height(),
+			LeftCols:  m1.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	m := Matrix90x90[T]{rows: m1.rows, cols: m1.cols}
+
+	for r := 0; r < m1.rows; r++ {
+		for c := 0; c < m1.cols; c++ {
+			m.matrix[r][c] = m1.matrix[r][c] * m2.Get(r, c)
+		}
+	}
+
+	return m, nil
+}
+
+// ElementwiseDivide will divide each element of this matrix by the element at the same coordinates of the
+// given matrix, returning a DivisionByZeroError if any of them is zero.
+func (m1 Matrix90x90[T]) ElementwiseDivide(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Height() != m2.Height() || m1.Width() != m2.Width() {
+		return Matrix90x90[T]{}, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "ElementwiseDivide",
+			LeftRows:  m1.Height(),
+			LeftCols:  m1.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	m := Matrix90x90[T]{rows: m1.rows, cols: m1.cols}
+
+	for r := 0; r < m1.rows; r++ {
+		for c := 0; c < m1.cols; c++ {
+			d := m2.Get(r, c)
+			if d == 0 {
+				return Matrix90x90[T]{}, &immutabilitybenchmarking.DivisionByZeroError{Op: "ElementwiseDivide", Row: r, Col: c}
+			}
+
+			m.matrix[r][c] = m1.matrix[r][c] / d
+		}
+	}
+
+	return m, nil
+}
+
+// KroneckerProduct will multiply every element of this matrix by the whole of the given matrix, returning
+// the blocks of products in the smallest generated type able to hold them.
+func (m1 Matrix90x90[T]) KroneckerProduct(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	return kronecker[T](m1, m2)
+}
+
+// Trace will sum the elements on the diagonal of this matrix, returning a NotSquareError if it is not square.
+func (m1 Matrix90x90[T]) Trace() (T, error) {
+	if m1.rows != m1.cols {
+		var zero T
+		return zero, &immutabilitybenchmarking.NotSquareError{Rows: m1.rows, Cols: m1.cols}
+	}
+
+	var trace T
+
+	for i := 0; i < m1.rows; i++ {
+		trace += m1.matrix[i][i]
+	}
+
+	return trace, nil
+}
+
+// Sum will add together every element of this matrix.
+func (m1 Matrix90x90[T]) Sum() T {
+	return numeric.Sum(m1.rows, func(r int) []T { return m1.matrix[r][:m1.cols] })
+}
+
+// Min will return the smallest element of this matrix. Complex elements are ordered by their real parts and
+// then by their imaginary parts.
+func (m1 Matrix90x90[T]) Min() T {
+	r, c := numeric.ArgMin(m1.rows, func(r int) []T { return m1.matrix[r][:m1.cols] })
+
+	return m1.matrix[r][c]
+}
+
+// Max will return the largest element of this matrix. Complex elements are ordered by their real parts and
+// then by their imaginary parts.
+func (m1 Matrix90x90[T]) Max() T {
+	r, c := numeric.ArgMax(m1.rows, func(r int) []T { return m1.matrix[r][:m1.cols] })
+
+	return m1.matrix[r][c]
+}
+
+// ArgMin will return the coordinates of the first smallest element of this matrix in row-major order.
+func (m1 Matrix90x90[T]) ArgMin() (int, int) {
+	return numeric.ArgMin(m1.rows, func(r int) []T { return m1.matrix[r][:m1.cols] })
+}
+
+// ArgMax will return the coordinates of the first largest element of this matrix in row-major order.
+func (m1 Matrix90x90[T]) ArgMax() (int, int) {
+	return numeric.ArgMax(m1.rows, func(r int) []T { return m1.matrix[r][:m1.cols] })
+}
+
+// FrobeniusNorm will return the square root of the sum of the squared absolute values of the elements of
+// this matrix.
+func (m1 Matrix90x90[T]) FrobeniusNorm() float64 {
+	return numeric.FrobeniusNorm(m1.rows, func(r int) []T { return m1.matrix[r][:m1.cols] })
+}
+
+// OneNorm will return the largest sum of the absolute values in a column of this matrix.
+func (m1 Matrix90x90[T]) OneNorm() float64 {
+	return numeric.OneNorm(m1.rows, m1.cols, func(r int) []T { return m1.matrix[r][:m1.cols] })
+}
+
+// InfNorm will return the largest sum of the absolute values in a row of this matrix.
+func (m1 Matrix90x90[T]) InfNorm() float64 {
+	return numeric.InfNorm(m1.rows, func(r int) []T { return m1.matrix[r][:m1.cols] })
+}
+
+// Rank will return the number of linearly independent rows of this matrix, treating values within rounding
+// error of zero as zero.
+func (m1 Matrix90x90[T]) Rank() int {
+	return numeric.Rank(m1.rows, m1.cols, func(r int) []T { return m1.matrix[r][:m1.cols] })
+}
+
+// Power will raise this matrix to the power n by repeated squaring, returning a NotSquareError if it is not
+// square or a NegativeExponentError if n is negative. The zeroth power is the identity matrix.
+func (m1 Matrix90x90[T]) Power(n int) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.rows != m1.cols {
+		return Matrix90x90[T]{}, &immutabilitybenchmarking.NotSquareError{Rows: m1.rows, Cols: m1.cols}
+	}
+
+	if n < 0 {
+		return Matrix90x90[T]{}, &immutabilitybenchmarking.NegativeExponentError{Exponent: n}
+	}
+
+	return power.BySquaring[immutabilitybenchmarking.Matrix[T]](m1, n, func() (immutabilitybenchmarking.Matrix[T], error) {
+		m := Matrix90x90[T]{rows: m1.rows, cols: m1.cols}
+
+		for i := 0; i < m1.rows; i++ {
+			m.matrix[i][i] = 1
+		}
+
+		return m, nil
+	}, nil)
+}
+
+// Reshape will read the elements of this matrix in row-major order into a matrix with the given dimensions,
+// returning a ReshapeError if it would not hold the same number of elements.
+func (m1 Matrix90x90[T]) Reshape(rows int, cols int) (immutabilitybenchmarking.Matrix[T], error) {
+	return rearrange[T](shape.Reshape[T](m1, rows, cols))
+}
+
+// HConcat will place the given matrix to the right of this matrix, returning a DimensionMismatchError if
+// they do not have the same number of rows.
+func (m1 Matrix90x90[T]) HConcat(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	return rearrange[T](shape.HConcat[T](m1, m2))
+}
+
+// VConcat will place the given matrix below this matrix, returning a DimensionMismatchError if they do not
+// have the same number of columns.
+func (m1 Matrix90x90[T]) VConcat(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	return rearrange[T](shape.Stack[T]("VConcat", m1, m2))
+}
+
+// Stack will place the given matrices below this matrix in order, returning a DimensionMismatchError for
+// the first that does not have the same number of columns.
+func (m1 Matrix90x90[T]) Stack(ms ...immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	return rearrange[T](shape.Stack[T]("Stack", m1, ms...))
+}
+
+// SplitRows will split this matrix into its rows before at and its rows from at onwards, returning an
+// InvalidDimensionsError if either part would be empty.
+func (m1 Matrix90x90[T]) SplitRows(at int) (immutabilitybenchmarking.Matrix[T], immutabilitybenchmarking.Matrix[T], error) {
+	return split[T](shape.SplitRows[T](m1, at))
+}
+
+// SplitCols will split this matrix into its columns before at and its columns from at onwards, returning an
+// InvalidDimensionsError if either part would be empty.
+func (m1 Matrix90x90[T]) SplitCols(at int) (immutabilitybenchmarking.Matrix[T], immutabilitybenchmarking.Matrix[T], error) {
+	return split[T](shape.SplitCols[T](m1, at))
+}
+
+// Pad will surround this matrix with the given number of rows and columns of zeros on each side, returning
+// a PaddingError if any of them is negative.
+func (m1 Matrix90x90[T]) Pad(top int, bottom int, left int, right int) (immutabilitybenchmarking.Matrix[T], error) {
+	return rearrange[T](shape.Pad[T](m1, top, bottom, left, right))
+}
+
+// Flip will reverse the order of the rows or the columns of this matrix.
+func (m1 Matrix90x90[T]) Flip(axis immutabilitybenchmarking.Axis) immutabilitybenchmarking.Matrix[T] {
+	m := Matrix90x90[T]{rows: m1.rows, cols: m1.cols}
+
+	for r := 0; r < m1.rows; r++ {
+		if axis == immutabilitybenchmarking.RowAxis {
+			m.matrix[m1.rows-1-r] = m1.matrix[r]
+			continue
+		}
+
+		for c := 0; c < m1.cols; c++ {
+			m.matrix[r][m1.cols-1-c] = m1.matrix[r][c]
+		}
+	}
+
+	return m
+}
+
+// Rotate90 will turn this matrix a quarter turn clockwise.
+func (m1 Matrix90x90[T]) Rotate90() immutabilitybenchmarking.Matrix[T] {
+	m := Matrix90x90[T]{rows: m1.cols, cols: m1.rows}
+
+	for r := 0; r < m1.rows; r++ {
+		for c := 0; c < m1.cols; c++ {
+			m.matrix[c][m1.rows-1-r] = m1.matrix[r][c]
+		}
+	}
+
+	return m
+}
+
+// Matrix270x270 is an immutable matrix with non-mutating operations backed by a 270x270 array.
+type Matrix270x270[T immutabilitybenchmarking.Number] struct {
+	rows   int
+	cols   int
+	matrix [270][270]T
+}
+
+// Width returns the number of columns in the matrix.
+func (m1 Matrix270x270[T]) Width() int {
+	return m1.cols
+}
+
+// Height returns the number of rows in the matrix.
+func (m1 Matrix270x270[T]) Height() int {
+	return m1.rows
+}
+
+// Get returns the element at the provided coordinates. It panics with an OutOfBoundsError if they are
+// outside the matrix, as the unused part of the array would otherwise be read as zeros.
+func (m1 Matrix270x270[T]) Get(row int, col int) T {
+	if row < 0 || row >= m1.rows || col < 0 || col >= m1.cols {
+		panic(&immutabilitybenchmarking.OutOfBoundsError{Row: row, Col: col, Rows: m1.rows, Cols: m1.cols})
+	}
+
+	return m1.matrix[row][col]
+}
+
+// At returns the element at the provided coordinates or an OutOfBoundsError if they are outside the matrix.
+func (m1 Matrix270x270[T]) At(row int, col int) (T, error) {
+	if row < 0 || row >= m1.Height() || col < 0 || col >= m1.Width() {
+		var zero T
+		return zero, &immutabilitybenchmarking.OutOfBoundsError{Row: row, Col: col, Rows: m1.Height(), Cols: m1.Width()}
+	}
+
+	return m1.matrix[row][col], nil
+}
+
+// MustAt returns the element at the provided coordinates and panics with an OutOfBoundsError if they are
+// outside the matrix.
+func (m1 Matrix270x270[T]) MustAt(row int, col int) T {
+	v, err := m1.At(row, col)
+	if err != nil {
+		panic(err)
+	}
+
+	return v
+}
+
+func (m1 *Matrix270x270[T]) set(row int, col int, v T) {
+	m1.matrix[row][col] = v
+}
+
+func (m1 *Matrix270x270[T]) freeze() immutabilitybenchmarking.Matrix[T] {
+	return *m1
+}
+
+// Equals will compare a matrix against this matrix and return if they are equal.
+func (m1 Matrix270x270[T]) Equals(m2 immutabilitybenchmarking.Matrix[T]) bool {
+	if m1.Height() != m2.Height() {
+		return false
+	}
+
+	if m1.Width() != m2.Width() {
+		return false
+	}
+
+	for r := 0; r < m1.rows; r++ {
+		for c := 0; c < m1.cols; c++ {
+			if m1.matrix[r][c] != m2.Get(r, c) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// Add will add the values of a matrix to this matrix.
+func (m1 Matrix270x270[T]) Add(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Height() != m2.Height() || m1.Width() != m2.Width() {
+		return Matrix270x270[T]{}, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "Add",
+			LeftRows:  m1.Height(),
+			LeftCols:  m1.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	m := Matrix270x270[T]{rows: m1.rows, cols: m1.cols}
+
+	for r := 0; r < m.rows; r++ {
+		for c := 0; c < m.cols; c++ {
+			m.matrix[r][c] = m1.matrix[r][c] + m2.Get(r, c)
+		}
+	}
+
+	return m, nil
+}
+
+// Subtract will subtract the values of a matrix from this matrix.
+func (m1 Matrix270x270[T]) Subtract(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Height() != m2.Height() || m1.Width() != m2.Width() {
+		return Matrix270x270[T]{}, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "Subtract",
+			LeftRows:  m1.Height(),
+			LeftCols:  m1.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	m := Matrix270x270[T]{rows: m1.rows, cols: m1.cols}
+
+	for r := 0; r < m.rows; r++ {
+		for c := 0; c < m.cols; c++ {
+			m.matrix[r][c] = m1.matrix[r][c] - m2.Get(r, c)
+		}
+	}
+
+	return m, nil
+}
+
+// ScalarMultiply will multiply this matrix by a given scalar value.
+func (m1 Matrix270x270[T]) ScalarMultiply(s T) immutabilitybenchmarking.Matrix[T] {
+	m := Matrix270x270[T]{rows: m1.rows, cols: m1.cols}
+
+	for r := 0; r < m1.rows; r++ {
+		for c := 0; c < m1.cols; c++ {
+			m.matrix[r][c] = m1.matrix[r][c] * s
+		}
+	}
+
+	return m
+}
+
+// Transpose will transpose this matrix.
+func (m1 Matrix270x270[T]) Transpose() immutabilitybenchmarking.Matrix[T] {
+	m := Matrix270x270[T]{rows: m1.cols, cols: m1.rows}
+
+	for rt := 0; rt < m.rows; rt++ {
+		for ct := 0; ct < m.cols; ct++ {
+			m.matrix[rt][ct] = m1.matrix[ct][rt]
+		}
+	}
+
+	return m
+}
+
+// MatrixMultiply will multiple the given matrix against this matrix.
+func (m1 Matrix270x270[T]) MatrixMultiply(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Width() != m2.Height() {
+		return Matrix270x270[T]{}, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "MatrixMultiply",
+			LeftRows:  m1.Height(),
+			LeftCols:  m1.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	if m2.Width() > 270 {
+		return Factory[T]{}.FromFunc(m1.rows, m2.Width(), func(rm int, cm2 int) T {
+			var product T
+			for cm := 0; cm < m1.cols; cm++ {
+				product = product + m1.matrix[rm][cm]*m2.Get(cm, cm2)
+			}
+			return product
+		})
+	}
+
+	m := Matrix270x270[T]{rows: m1.rows, cols: m2.Width()}
+
+	for rm := 0; rm < m1.rows; rm++ {
+		for cm2 := 0; cm2 < m2.Width(); cm2++ {
+			var product T
+			for cm := 0; cm < m1.cols; cm++ {
+				product = product + m1.matrix[rm][cm]*m2.Get(cm, cm2)
+			}
+			m.matrix[rm][cm2] = product
+		}
+	}
+
+	return m, nil
+}
+
+// Map will apply f to every element of this matrix.
+func (m1 Matrix270x270[T]) Map(f func(v T) T) immutabilitybenchmarking.Matrix[T] {
+	m := Matrix270x270[T]{rows: m1.rows, cols: m1.cols}
+
+	for r := 0; r < m1.rows; r++ {
+		for c := 0; c < m1.cols; c++ {
+			m.matrix[r][c] = f(m1.matrix[r][c])
+		}
+	}
+
+	return m
+}
+
+// MapIndexed will apply f to every element of this matrix along with its coordinates.
+func (m1 Matrix270x270[T]) MapIndexed(f func(row int, col int, v T) T) immutabilitybenchmarking.Matrix[T] {
+	m := Matrix270x270[T]{rows: m1.rows, cols: m1.cols}
+
+	for r := 0; r < m1.rows; r++ {
+		for c := 0; c < m1.cols; c++ {
+			m.matrix[r][c] = f(r, c, m1.matrix[r][c])
+		}
+	}
+
+	return m
+}
+
+// ZipWith will combine each element of this matrix with the element at the same coordinates of the given
+// matrix using f.
+func (m1 Matrix270x270[T]) ZipWith(m2 immutabilitybenchmarking.Matrix[T], f func(a T, b T) T) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Height() != m2.Height() || m1.Width() != m2.Width() {
+		return Matrix270x270[T]{}, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "ZipWith",
+			LeftRows:  m1.Height(),
+			LeftCols:  m1.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	m := Matrix270x270[T]{rows: m1.rows, cols: m1.cols}
+
+	for r := 0; r < m.rows; r++ {
+		for c := 0; c < m.cols; c++ {
+			m.matrix[r][c] = f(m1.matrix[r][c], m2.Get(r, c))
+		}
+	}
+
+	return m, nil
+}
+
+// Fold will combine every element of this matrix into a single value, starting from initial and visiting
+// the elements in row-major order.
+func (m1 Matrix270x270[T]) Fold(initial T, f func(acc T, v T) T) T {
+	acc := initial
+
+	for r := 0; r < m1.rows; r++ {
+		for c := 0; c < m1.cols; c++ {
+			acc = f(acc, m1.matrix[r][c])
+		}
+	}
+
+	return acc
+}
+
+// RowReduce will fold each row of this matrix into a single value starting from initial, returning a
+// column of the results.
+func (m1 Matrix270x270[T]) RowReduce(initial T, f func(acc T, v T) T) immutabilitybenchmarking.Matrix[T] {
+	m := Matrix270x270[T]{rows: m1.rows, cols: 1}
+
+	for r := 0; r < m1.rows; r++ {
+		acc := initial
+
+		for c := 0; c < m1.cols; c++ {
+			acc = f(acc, m1.matrix[r][c])
+		}
+
+		m.matrix[r][0] = acc
+	}
+
+	return m
+}
+
+// ColReduce will fold each column of this matrix into a single value starting from initial, returning a
+// row of the results.
+func (m1 Matrix270x270[T]) ColReduce(initial T, f func(acc T, v T) T) immutabilitybenchmarking.Matrix[T] {
+	m := Matrix270x270[T]{rows: 1, cols: m1.cols}
+
+	for c := 0; c < m1.cols; c++ {
+		m.matrix[0][c] = initial
+	}
+
+	for r := 0; r < m1.rows; r++ {
+		for c := 0; c < m1.cols; c++ {
+			m.matrix[0][c] = f(m.matrix[0][c], m1.matrix[r][c])
+		}
+	}
+
+	return m
+}
+
+// HadamardProduct will multiply each element of this matrix by the element at the same coordinates of the
+// given matrix.
+func (m1 Matrix270x270[T]) HadamardProduct(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Height() != m2.Height() || m1.Width() != m2.Width() {
+		return Matrix270x270[T]{}, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "HadamardProduct",
+			LeftRows:  m1.Height(),
+			LeftCols:  m1.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	m := Matrix270x270[T]{rows: m1.rows, cols: m1.cols}
+
+	for r := 0; r < m1.rows; r++ {
+		for c := 0; c < m1.cols; c++ {
+			m.matrix[r][c] = m1.matrix[r][c] * m2.Get(r, c)
+		}
+	}
+
+	return m, nil
+}
+
+// ElementwiseDivide will divide each element of this matrix by the element at the same coordinates of the
+// given matrix, returning a DivisionByZeroError if any of them is zero.
+func (m1 Matrix270x270[T]) ElementwiseDivide(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Height() != m2.Height() || m1.Width() != m2.Width() {
+		return Matrix270x270[T]{}, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "ElementwiseDivide",
+			LeftRows:  m1.Height(),
+			LeftCols:  m1.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	m := Matrix270x270[T]{rows: m1.rows, cols: m1.cols}
+
+	for r := 0; r < m1.rows; r++ {
+		for c := 0; c < m1.cols; c++ {
+			d := m2.Get(r, c)
+			if d == 0 {
+				return Matrix270x270[T]{}, &immutabilitybenchmarking.DivisionByZeroError{Op: "ElementwiseDivide", Row: r, Col: c}
+			}
+
+			m.matrix[r][c] = m1.matrix[r][c] / d
+		}
+	}
+
+	return m, nil
+}
+
+// KroneckerProduct will multiply every element of this matrix by the whole of the given matrix, returning
+// the blocks of products in the smallest generated type able to hold them.
+func (m1 Matrix270x270[T]) KroneckerProduct(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	return kronecker[T](m1, m2)
+}
+
+// Trace will sum the elements on the diagonal of this matrix, returning a NotSquareError if it is not square.
+func (m1 Matrix270x270[T]) Trace() (T, error) {
+	if m1.rows != m1.cols {
+		var zero T
+		return zero, &immutabilitybenchmarking.NotSquareError{Rows: m1.rows, Cols: m1.cols}
+	}
+
+	var trace T
+
+	for i := 0; i < m1.rows; i++ {
+		trace += m1.matrix[i][i]
+	}
+
+	return trace, nil
+}
+
+// Sum will add together every element of this matrix.
+func (m1 Matrix270x270[T]) Sum() T {
+	return numeric.Sum(m1.rows, func(r int) []T { return m1.matrix[r][:m1.cols] })
+}
+
+// Min will return the smallest element of this matrix. Complex elements are ordered by their real parts and
+// then by their imaginary parts.
+func (m1 Matrix270x270[T]) Min() T {
+	r, c := numeric.ArgMin(m1.rows, func(r int) []T { return m1.matrix[r][:m1.cols] })
+
+	return m1.matrix[r][c]
+}
+
+// Max will return the largest element of this matrix. Complex elements are ordered by their real parts and
+// then by their imaginary parts.
+func (m1 Matrix270x270[T]) Max() T {
+	r, c := numeric.ArgMax(m1.rows, func(r int) []T { return m1.matrix[r][:m1.cols] })
+
+	return m1.matrix[r][c]
+}
+
+// ArgMin will return the coordinates of the first smallest element of this matrix in row-major order.
+func (m1 Matrix270x270[T]) ArgMin() (int, int) {
+	return numeric.ArgMin(m1.rows, func(r int) []T { return m1.matrix[r][:m1.cols] })
+}
+
+// ArgMax will return the coordinates of the first largest element of this matrix in row-major order.
+func (m1 Matrix270x270[T]) ArgMax() (int, int) {
+	return numeric.ArgMax(m1.rows, func(r int) []T { return m1.matrix[r][:m1.cols] })
+}
+
+// FrobeniusNorm will return the square root of the sum of the squared absolute values of the elements of
+// this matrix.
+func (m1 Matrix270x270[T]) FrobeniusNorm() float64 {
+	return numeric.FrobeniusNorm(m1.rows, func(r int) []T { return m1.matrix[r][:m1.cols] })
+}
+
+// OneNorm will return the largest sum of the absolute values in a column of this matrix.
+func (m1 Matrix270x270[T]) OneNorm() float64 {
+	return numeric.OneNorm(m1.rows, m1.cols, func(r int) []T { return m1.matrix[r][:m1.cols] })
+}
+
+// InfNorm will return the largest sum of the absolute values in a row of this matrix.
+func (m1 Matrix270x270[T]) InfNorm() float64 {
+	return numeric.InfNorm(m1.rows, func(r int) []T { return m1.matrix[r][:m1.cols] })
+}
+
+// Rank will return the number of linearly independent rows of this matrix, treating values within rounding
+// error of zero as zero.
+func (m1 Matrix270x270[T]) Rank() int {
+	return numeric.Rank(m1.rows, m1.cols, func(r int) []T { return m1.matrix[r][:m1.cols] })
+}
+
+// Power will raise this matrix to the power n by repeated squaring, returning a NotSquareError if it is not
+// square or a NegativeExponentError if n is negative. The zeroth power is the identity matrix.
+func (m1 Matrix270x270[T]) Power(n int) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.rows != m1.cols {
+		return Matrix270x270[T]{}, &immutabilitybenchmarking.NotSquareError{Rows: m1.rows, Cols: m1.cols}
+	}
+
+	if n < 0 {
+		return Matrix270x270[T]{}, &immutabilitybenchmarking.NegativeExponentError{Exponent: n}
+	}
+
+	return power.BySquaring[immutabilitybenchmarking.Matrix[T]](m1, n, func() (immutabilitybenchmarking.Matrix[T], error) {
+		m := Matrix270x270[T]{rows: m1.rows, cols: m1.cols}
+
+		for i := 0; i < m1.rows; i++ {
+			m.matrix[i][i] = 1
+		}
+
+		return m, nil
+	}, nil)
+}
+
+// Reshape will read the elements of this matrix in row-major order into a matrix with the given dimensions,
+// returning a ReshapeError if it would not hold the same number of elements.
+func (m1 Matrix270x270[T]) Reshape(rows int, cols int) (immutabilitybenchmarking.Matrix[T], error) {
+	return rearrange[T](shape.Reshape[T](m1, rows, cols))
+}
+
+// HConcat will place the given matrix to the right of this matrix, returning a DimensionMismatchError if
+// they do not have the same number of rows.
+func (m1 Matrix270x270[T]) HConcat(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	return rearrange[T](shape.HConcat[T](m1, m2))
+}
+
+// VConcat will place the given matrix below this matrix, returning a DimensionMismatchError if they do not
+// have the same number of columns.
+func (m1 Matrix270x270[T]) VConcat(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	return rearrange[T](shape.Stack[T]("VConcat", m1, m2))
+}
+
+// Stack will place the given matrices below this matrix in order, returning a DimensionMismatchError for
+// the first that does not have the same number of columns.
+func (m1 Matrix270x270[T]) Stack(ms ...immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	return rearrange[T](shape.Stack[T]("Stack", m1, ms...))
+}
+
+// SplitRows will split this matrix into its rows before at and its rows from at onwards, returning an
+// InvalidDimensionsError if either part would be empty.
+func (m1 Matrix270x270[T]) SplitRows(at int) (immutabilitybenchmarking.Matrix[T], immutabilitybenchmarking.Matrix[T], error) {
+	return split[T](shape.SplitRows[T](m1, at))
+}
+
+// SplitCols will split this matrix into its columns before at and its columns from at onwards, returning an
+// InvalidDimensionsError if either part would be empty.
+func (m1 Matrix270x270[T]) SplitCols(at int) (immutabilitybenchmarking.Matrix[T], immutabilitybenchmarking.Matrix[T], error) {
+	return split[T](shape.SplitCols[T](m1, at))
+}
+
+// Pad will surround this matrix with the given number of rows and columns of zeros on each side, returning
+// a PaddingError if any of them is negative.
+func (m1 Matrix270x270[T]) Pad(top int, bottom int, left int, right int) (immutabilitybenchmarking.Matrix[T], error) {
+	return rearrange[T](shape.Pad[T](m1, top, bottom, left, right))
+}
+
+// Flip will reverse the order of the rows or the columns of this matrix.
+func (m1 Matrix270x270[T]) Flip(axis immutabilitybenchmarking.Axis) immutabilitybenchmarking.Matrix[T] {
+	m := Matrix270x270[T]{rows: m1.rows, cols: m1.cols}
+
+	for r := 0; r < m1.rows; r++ {
+		if axis == immutabilitybenchmarking.RowAxis {
+			m.matrix[m1.rows-1-r] = m1.matrix[r]
+			continue
+		}
+
+		for c := 0; c < m1.cols; c++ {
+			m.matrix[r][m1.cols-1-c] = m1.matrix[r][c]
+		}
+	}
+
+	return m
+}
+
+// Rotate90 will turn this matrix a quarter turn clockwise.
+func (m1 Matrix270x270[T]) Rotate90() immutabilitybenchmarking.Matrix[T] {
+	m := Matrix270x270[T]{rows: m1.cols, cols: m1.rows}
+
+	for r := 0; r < m1.rows; r++ {
+		for c := 0; c < m1.cols; c++ {
+			m.matrix[c][m1.rows-1-r] = m1.matrix[r][c]
+		}
+	}
+
+	return m
+}
+
+// Matrix810x810 is an immutable matrix with non-mutating operations backed by a 810x810 array.
+type Matrix810x810[T immutabilitybenchmarking.Number] struct {
+	rows   int
+	cols   int
+	matrix [810][810]T
+}
+
+// Width returns the number of columns in the matrix.
+func (m1 Matrix810x810[T]) Width() int {
+	return m1.cols
+}
+
+// Height returns the number of rows in the matrix.
+func (m1 Matrix810x810[T]) Height() int {
+	return m1.rows
+}
+
+// Get returns the element at the provided coordinates. It panics with an OutOfBoundsError if they are
+// outside the matrix, as the unused part of the array would otherwise be read as zeros.
+func (m1 Matrix810x810[T]) Get(row int, col int) T {
+	if row < 0 || row >= m1.rows || col < 0 || col >= m1.cols {
+		panic(&immutabilitybenchmarking.OutOfBoundsError{Row: row, Col: col, Rows: m1.rows, Cols: m1.cols})
+	}
+
+	return m1.matrix[row][col]
+}
+
+// At returns the element at the provided coordinates or an OutOfBoundsError if they are outside the matrix.
+func (m1 Matrix810x810[T]) At(row int, col int) (T, error) {
+	if row < 0 || row >= m1.Height() || col < 0 || col >= m1.Width() {
+		var zero T
+		return zero, &immutabilitybenchmarking.OutOfBoundsError{Row: row, Col: col, Rows: m1.Height(), Cols: m1.Width()}
+	}
+
+	return m1.matrix[row][col], nil
+}
+
+// MustAt returns the element at the provided coordinates and panics with an OutOfBoundsError if they are
+// outside the matrix.
+func (m1 Matrix810x810[T]) MustAt(row int, col int) T {
+	v, err := m1.At(row, col)
+	if err != nil {
+		panic(err)
+	}
+
+	return v
+}
+
+func (m1 *Matrix810x810[T]) set(row int, col int, v T) {
+	m1.matrix[row][col] = v
+}
+
+func (m1 *Matrix810x810[T]) freeze() immutabilitybenchmarking.Matrix[T] {
+	return *m1
+}
+
+// Equals will compare a matrix against this matrix and return if they are equal.
+func (m1 Matrix810x810[T]) Equals(m2 immutabilitybenchmarking.Matrix[T]) bool {
+	if m1.Height() != m2.Height() {
+		return false
+	}
+
+	if m1.Width() != m2.Width() {
+		return false
+	}
+
+	for r := 0; r < m1.rows; r++ {
+		for c := 0; c < m1.cols; c++ {
+			if m1.matrix[r][c] != m2.Get(r, c) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// Add will add the values of a matrix to this matrix.
+func (m1 Matrix810x810[T]) Add(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Height() != m2.Height() || m1.Width() != m2.Width() {
+		return Matrix810x810[T]{}, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "Add",
+			LeftRows:  m1.Height(),
+			LeftCols:  m1.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	m := Matrix810x810[T]{rows: m1.rows, cols: m1.cols}
+
+	for r := 0; r < m.rows; r++ {
+		for c := 0; c < m.cols; c++ {
+			m.matrix[r][c] = m1.matrix[r][c] + m2.Get(r, c)
+		}
+	}
+
+	return m, nil
+}
+
+// Subtract will subtract the values of a matrix from this matrix.
+func (m1 Matrix810x810[T]) Subtract(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Height() != m2.Height() || m1.Width() != m2.Width() {
+		return Matrix810x810[T]{}, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "Subtract",
+			LeftRows:  m1.Height(),
+			LeftCols:  m1.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	m := Matrix810x810[T]{rows: m1.rows, cols: m1.cols}
+
+	for r := 0; r < m.rows; r++ {
+		for c := 0; c < m.cols; c++ {
+			m.matrix[r][c] = m1.matrix[r][c] - m2.Get(r, c)
+		}
+	}
+
+	return m, nil
+}
+
+// ScalarMultiply will multiply this matrix by a given scalar value.
+func (m1 Matrix810x810[T]) ScalarMultiply(s T) immutabilitybenchmarking.Matrix[T] {
+	m := Matrix810x810[T]{rows: m1.rows, cols: m1.cols}
+
+	for r := 0; r < m1.rows; r++ {
+		for c := 0; c < m1.cols; c++ {
+			m.matrix[r][c] = m1.matrix[r][c] * s
+		}
+	}
+
+	return m
+}
+
+// Transpose will transpose this matrix.
+func (m1 Matrix810x810[T]) Transpose() immutabilitybenchmarking.Matrix[T] {
+	m := Matrix810x810[T]{rows: m1.cols, cols: m1.rows}
+
+	for rt := 0; rt < m.rows; rt++ {
+		for ct := 0; ct < m.cols; ct++ {
+			m.matrix[rt][ct] = m1.matrix[ct][rt]
+		}
+	}
+
+	return m
+}
+
+// MatrixMultiply will multiple the given matrix against this matrix.
+func (m1 Matrix810x810[T]) MatrixMultiply(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Width() != m2.Height() {
+		return Matrix810x810[T]{}, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "MatrixMultiply",
+			LeftRows:  m1.Height(),
+			LeftCols:  m1.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	if m2.Width() > 810 {
+		return Factory[T]{}.FromFunc(m1.rows, m2.Width(), func(rm int, cm2 int) T {
+			var product T
+			for cm := 0; cm < m1.cols; cm++ {
+				product = product + m1.matrix[rm][cm]*m2.Get(cm, cm2)
+			}
+			return product
+		})
+	}
+
+	m := Matrix810x810[T]{rows: m1.rows, cols: m2.Width()}
+
+	for rm := 0; rm < m1.rows; rm++ {
+		for cm2 := 0; cm2 < m2.Width(); cm2++ {
+			var product T
+			for cm := 0; cm < m1.cols; cm++ {
+				product = product + m1.matrix[rm][cm]*m2.Get(cm, cm2)
+			}
+			m.matrix[rm][cm2] = product
+		}
+	}
+
+	return m, nil
+}
+
+// Map will apply f to every element of this matrix.
+func (m1 Matrix810x810[T]) Map(f func(v T) T) immutabilitybenchmarking.Matrix[T] {
+	m := Matrix810x810[T]{rows: m1.rows, cols: m1.cols}
+
+	for r := 0; r < m1.rows; r++ {
+		for c := 0; c < m1.cols; c++ {
+			m.matrix[r][c] = f(m1.matrix[r][c])
+		}
+	}
+
+	return m
+}
+
+// MapIndexed will apply f to every element of this matrix along with its coordinates.
+func (m1 Matrix810x810[T]) MapIndexed(f func(row int, col int, v T) T) immutabilitybenchmarking.Matrix[T] {
+	m := Matrix810x810[T]{rows: m1.rows, cols: m1.cols}
+
+	for r := 0; r < m1.rows; r++ {
+		for c := 0; c < m1.cols; c++ {
+			m.matrix[r][c] = f(r, c, m1.matrix[r][c])
+		}
+	}
+
+	return m
+}
+
+// ZipWith will combine each element of this matrix with the element at the same coordinates of the given
+// matrix using f.
+func (m1 Matrix810x810[T]) ZipWith(m2 immutabilitybenchmarking.Matrix[T], f func(a T, b T) T) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Height() != m2.Height() || m1.Width() != m2.Width() {
+		return Matrix810x810[T]{}, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "ZipWith",
+			LeftRows:  m1.Height(),
+			LeftCols:  m1.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	m := Matrix810x810[T]{rows: m1.rows, cols: m1.cols}
+
+	for r := 0; r < m.rows; r++ {
+		for c := 0; c < m.cols; c++ {
+			m.matrix[r][c] = f(m1.matrix[r][c], m2.Get(r, c))
+		}
+	}
+
+	return m, nil
+}
+
+// Fold will combine every element of this matrix into a single value, starting from initial and visiting
+// the elements in row-major order.
+func (m1 Matrix810x810[T]) Fold(initial T, f func(acc T, v T) T) T {
+	acc := initial
+
+	for r := 0; r < m1.rows; r++ {
+		for c := 0; c < m1.cols; c++ {
+			acc = f(acc, m1.matrix[r][c])
+		}
+	}
+
+	return acc
+}
+
+// RowReduce will fold each row of this matrix into a single value starting from initial, returning a
+// column of the results.
+func (m1 Matrix810x810[T]) RowReduce(initial T, f func(acc T, v T) T) immutabilitybenchmarking.Matrix[T] {
+	m := Matrix810x810[T]{rows: m1.rows, cols: 1}
+
+	for r := 0; r < m1.rows; r++ {
+		acc := initial
+
+		for c := 0; c < m1.cols; c++ {
+			acc = f(acc, m1.matrix[r][c])
+		}
+
+		m.matrix[r][0] = acc
+	}
+
+	return m
+}
+
+// ColReduce will fold each column of this matrix into a single value starting from initial, returning a
+// row of the results.
+func (m1 Matrix810x810[T]) ColReduce(initial T, f func(acc T, v T) T) immutabilitybenchmarking.Matrix[T] {
+	m := Matrix810x810[T]{rows: 1, cols: m1.cols}
+
+	for c := 0; c < m1.cols; c++ {
+		m.matrix[0][c] = initial
+	}
+
+	for r := 0; r < m1.rows; r++ {
+		for c := 0; c < m1.cols; c++ {
+			m.matrix[0][c] = f(m.matrix[0][c], m1.matrix[r][c])
+		}
+	}
+
+	return m
+}
+
+// HadamardProduct will multiply each element of this matrix by the element at the same coordinates of the
+// given matrix.
+func (m1 Matrix810x810[T]) HadamardProduct(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Height() != m2.Height() || m1.Width() != m2.Width() {
+		return Matrix810x810[T]{}, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "HadamardProduct",
+			LeftRows:  m1.Height(),
+			LeftCols:  m1.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	m := Matrix810x810[T]{rows: m1.rows, cols: m1.cols}
+
+	for r := 0; r < m1.rows; r++ {
+		for c := 0; c < m1.cols; c++ {
+			m.matrix[r][c] = m1.matrix[r][c] * m2.Get(r, c)
+		}
+	}
+
+	return m, nil
+}
+
+// ElementwiseDivide will divide each element of this matrix by the element at the same coordinates of the
+// given matrix, returning a DivisionByZeroError if any of them is zero.
+func (m1 Matrix810x810[T]) ElementwiseDivide(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Height() != m2.Height() || m1.Width() != m2.Width() {
+		return Matrix810x810[T]{}, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "ElementwiseDivide",
+			LeftRows:  m1.Height(),
+			LeftCols:  m1.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	m := Matrix810x810[T]{rows: m1.rows, cols: m1.cols}
+
+	for r := 0; r < m1.rows; r++ {
+		for c := 0; c < m1.cols; c++ {
+			d := m2.Get(r, c)
+			if d == 0 {
+				return Matrix810x810[T]{}, &immutabilitybenchmarking.DivisionByZeroError{Op: "ElementwiseDivide", Row: r, Col: c}
+			}
+
+			m.matrix[r][c] = m1.matrix[r][c] / d
+		}
+	}
+
+	return m, nil
+}
+
+// KroneckerProduct will multiply every element of this matrix by the whole of the given matrix, returning
+// the blocks of products in the smallest generated type able to hold them.
+func (m1 Matrix810x810[T]) KroneckerProduct(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	return kronecker[T](m1, m2)
+}
+
+// Trace will sum the elements on the diagonal of this matrix, returning a NotSquareError if it is not square.
+func (m1 Matrix810x810[T]) Trace() (T, error) {
+	if m1.rows != m1.cols {
+		var zero T
+		return zero, &immutabilitybenchmarking.NotSquareError{Rows: m1.rows, Cols: m1.cols}
+	}
+
+	var trace T
+
+	for i := 0; i < m1.rows; i++ {
+		trace += m1.matrix[i][i]
+	}
+
+	return trace, nil
+}
+
+// Sum will add together every element of this matrix.
+func (m1 Matrix810x810[T]) Sum() T {
+	return numeric.Sum(m1.rows, func(r int) []T { return m1.matrix[r][:m1.cols] })
+}
+
+// Min will return the smallest element of this matrix. Complex elements are ordered by their real parts and
+// then by their imaginary parts.
+func (m1 Matrix810x810[T]) Min() T {
+	r, c := numeric.ArgMin(m1.rows, func(r int) []T { return m1.matrix[r][:m1.cols] })
+
+	return m1.matrix[r][c]
+}
+
+// Max will return the largest element of this matrix. Complex elements are ordered by their real parts and
+// then by their imaginary parts.
+func (m1 Matrix810x810[T]) Max() T {
+	r, c := numeric.ArgMax(m1.rows, func(r int) []T { return m1.matrix[r][:m1.cols] })
+
+	return m1.matrix[r][c]
+}
+
+// ArgMin will return the coordinates of the first smallest element of this matrix in row-major order.
+func (m1 Matrix810x810[T]) ArgMin() (int, int) {
+	return numeric.ArgMin(m1.rows, func(r int) []T { return m1.matrix[r][:m1.cols] })
+}
+
+// ArgMax will return the coordinates of the first largest element of this matrix in row-major order.
+func (m1 Matrix810x810[T]) ArgMax() (int, int) {
+	return numeric.ArgMax(m1.rows, func(r int) []T { return m1.matrix[r][:m1.cols] })
+}
+
+// FrobeniusNorm will return the square root of the sum of the squared absolute values of the elements of
+// this matrix.
+func (m1 Matrix810x810[T]) FrobeniusNorm() float64 {
+	return numeric.FrobeniusNorm(m1.rows, func(r int) []T { return m1.matrix[r][:m1.cols] })
+}
+
+// OneNorm will return the largest sum of the absolute values in a column of this matrix.
+func (m1 Matrix810x810[T]) OneNorm() float64 {
+	return numeric.OneNorm(m1.rows, m1.cols, func(r int) []T { return m1.matrix[r][:m1.cols] })
+}
+
+// InfNorm will return the largest sum of the absolute values in a row of this matrix.
+func (m1 Matrix810x810[T]) InfNorm() float64 {
+	return numeric.InfNorm(m1.rows, func(r int) []T { return m1.matrix[r][:m1.cols] })
+}
+
+// Rank will return the number of linearly independent rows of this matrix, treating values within rounding
+// error of zero as zero.
+func (m1 Matrix810x810[T]) Rank() int {
+	return numeric.Rank(m1.rows, m1.cols, func(r int) []T { return m1.matrix[r][:m1.cols] })
+}
+
+// Power will raise this matrix to the power n by repeated squaring, returning a NotSquareError if it is not
+// square or a NegativeExponentError if n is negative. The zeroth power is the identity matrix.
+func (m1 Matrix810x810[T]) Power(n int) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.rows != m1.cols {
+		return Matrix810x810[T]{}, &immutabilitybenchmarking.NotSquareError{Rows: m1.rows, Cols: m1.cols}
+	}
+
+	if n < 0 {
+		return Matrix810x810[T]{}, &immutabilitybenchmarking.NegativeExponentError{Exponent: n}
+	}
+
+	return power.BySquaring[immutabilitybenchmarking.Matrix[T]](m1, n, func() (immutabilitybenchmarking.Matrix[T], error) {
+		m := Matrix810x810[T]{rows: m1.rows, cols: m1.cols}
+
+		for i := 0; i < m1.rows; i++ {
+			m.matrix[i][i] = 1
+		}
+
+		return m, nil
+	}, nil)
+}
+
+// Reshape will read the elements of this matrix in row-major order into a matrix with the given dimensions,
+// returning a ReshapeError if it would not hold the same number of elements.
+func (m1 Matrix810x810[T]) Reshape(rows int, cols int) (immutabilitybenchmarking.Matrix[T], error) {
+	return rearrange[T](shape.Reshape[T](m1, rows, cols))
+}
+
+// HConcat will place the given matrix to the right of this matrix, returning a DimensionMismatchError if
+// they do not have the same number of rows.
+func (m1 Matrix810x810[T]) HConcat(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	return rearrange[T](shape.HConcat[T](m1, m2))
+}
+
+// VConcat will place the given matrix below this matrix, returning a DimensionMismatchError if they do not
+// have the same number of columns.
+func (m1 Matrix810x810[T]) VConcat(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	return rearrange[T](shape.Stack[T]("VConcat", m1, m2))
+}
+
+// Stack will place the given matrices below this matrix in order, returning a DimensionMismatchError for
+// the first that does not have the same number of columns.
+func (m1 Matrix810x810[T]) Stack(ms ...immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	return rearrange[T](shape.Stack[T]("Stack", m1, ms...))
+}
+
+// SplitRows will split this matrix into its rows before at and its rows from at onwards, returning an
+// InvalidDimensionsError if either part would be empty.
+func (m1 Matrix810x810[T]) SplitRows(at int) (immutabilitybenchmarking.Matrix[T], immutabilitybenchmarking.Matrix[T], error) {
+	return split[T](shape.SplitRows[T](m1, at))
+}
+
+// SplitCols will split this matrix into its columns before at and its columns from at onwards, returning an
+// InvalidDimensionsError if either part would be empty.
+func (m1 Matrix810x810[T]) SplitCols(at int) (immutabilitybenchmarking.Matrix[T], immutabilitybenchmarking.Matrix[T], error) {
+	return split[T](shape.SplitCols[T](m1, at))
+}
+
+// Pad will surround this matrix with the given number of rows and columns of zeros on each side, returning
+// a PaddingError if any of them is negative.
+func (m1 Matrix810x810[T]) Pad(top int, bottom int, left int, right int) (immutabilitybenchmarking.Matrix[T], error) {
+	return rearrange[T](shape.Pad[T](m1, top, bottom, left, right))
+}
+
+// Flip will reverse the order of the rows or the columns of this matrix.
+func (m1 Matrix810x810[T]) Flip(axis immutabilitybenchmarking.Axis) immutabilitybenchmarking.Matrix[T] {
+	m := Matrix810x810[T]{rows: m1.rows, cols: m1.cols}
+
+	for r := 0; r < m1.rows; r++ {
+		if axis == immutabilitybenchmarking.RowAxis {
+			m.matrix[m1.rows-1-r] = m1.matrix[r]
+			continue
+		}
+
+		for c := 0; c < m1.cols; c++ {
+			m.matrix[r][m1.cols-1-c] = m1.matrix[r][c]
+		}
+	}
+
+	return m
+}
+
+// Rotate90 will turn this matrix a quarter turn clockwise.
+func (m1 Matrix810x810[T]) Rotate90() immutabilitybenchmarking.Matrix[T] {
+	m := Matrix810x810[T]{rows: m1.cols, cols: m1.rows}
+
+	for r := 0; r < m1.rows; r++ {
+		for c := 0; c < m1.cols; c++ {
+			m.matrix[c][m1.rows-1-r] = m1.matrix[r][c]
+		}
+	}
+
+	return m
+}
@@ -0,0 +1,71 @@
+// Command gen generates the fixed-size array backed matrix types used by the array packages.
+//
+// Each requested size N produces a MatrixNxN type backed by an [N][N] array along with the factory
+// functions that pick the smallest generated type able to hold a matrix of a given shape.
+package main
+
+import (
+	"bytes"
+	"embed"
+	"flag"
+	"go/format"
+	"log"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+//go:embed *.go.tmpl
+var templates embed.FS
+
+type data struct {
+	Package string
+	Sizes   []int
+}
+
+func main() {
+	pkg := flag.String("package", "", "the package to generate, either mutable or immutable")
+	sizes := flag.String("sizes", "10,30,90,270,810", "comma separated list of matrix sizes to generate")
+	output := flag.String("output", "matrix_gen.go", "the file to write the generated code to")
+	flag.Parse()
+
+	d := data{Package: *pkg}
+
+	for _, s := range strings.Split(*sizes, ",") {
+		size, err := strconv.Atoi(strings.TrimSpace(s))
+		if err != nil || size <= 0 {
+			log.Fatalf("invalid size %q", s)
+		}
+		d.Sizes = append(d.Sizes, size)
+	}
+
+	sort.Ints(d.Sizes)
+
+	for i := 1; i < len(d.Sizes); i++ {
+		if d.Sizes[i] == d.Sizes[i-1] {
+			log.Fatalf("size %d listed more than once", d.Sizes[i])
+		}
+	}
+
+	t, err := template.ParseFS(templates, *pkg+".go.tmpl")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := t.Execute(buf, d); err != nil {
+		log.Fatal(err)
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := os.WriteFile(*output, src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
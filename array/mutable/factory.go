@@ -0,0 +1,58 @@
+package mutable
+
+import "github.com/chris-tomich/immutability-benchmarking"
+
+// target is implemented by a pointer to each generated matrix type so a factory can write to it directly.
+type target[T immutabilitybenchmarking.Number] interface {
+	immutabilitybenchmarking.Matrix[T]
+	set(row int, col int, v T)
+}
+
+// Factory creates mutable matrices backed by the smallest generated array type able to hold them.
+type Factory[T immutabilitybenchmarking.Number] struct{}
+
+// Zeros creates a rows x cols matrix with every element set to zero.
+func (Factory[T]) Zeros(rows int, cols int) (immutabilitybenchmarking.Matrix[T], error) {
+	m, err := NewEmpty[T](cols, rows)
+	if err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// Identity creates an n x n identity matrix.
+func (f Factory[T]) Identity(n int) (immutabilitybenchmarking.Matrix[T], error) {
+	return f.FromFunc(n, n, func(row int, col int) T {
+		if row == col {
+			return 1
+		}
+
+		return 0
+	})
+}
+
+// FromRows creates a matrix holding a copy of the given rectangular values.
+func (Factory[T]) FromRows(rows [][]T) (immutabilitybenchmarking.Matrix[T], error) {
+	return New(rows)
+}
+
+// FromFunc creates a rows x cols matrix with each element set to the result of f.
+func (Factory[T]) FromFunc(rows int, cols int, f func(row int, col int) T) (immutabilitybenchmarking.Matrix[T], error) {
+	if rows <= 0 || cols <= 0 {
+		return nil, &immutabilitybenchmarking.InvalidDimensionsError{Rows: rows, Cols: cols}
+	}
+
+	m, ok := newTarget[T](rows, cols)
+	if !ok {
+		return nil, &immutabilitybenchmarking.SizeLimitError{Rows: rows, Cols: cols, Limit: Sizes[len(Sizes)-1]}
+	}
+
+	for r := 0; r < rows; r++ {
+		for c := 0; c < cols; c++ {
+			m.set(r, c, f(r, c))
+		}
+	}
+
+	return m, nil
+}
@@ -1,150 +1,53 @@
 package mutable
 
 import (
-	"github.com/pkg/errors"
 	"github.com/chris-tomich/immutability-benchmarking"
+	"github.com/chris-tomich/immutability-benchmarking/internal/shape"
 )
 
-// Matrix is a matrix with mutating operations.
-type Matrix struct {
-	matrix [immutabilitybenchmarking.MatrixHeight][immutabilitybenchmarking.MatrixWidth]int
-}
-
-// New creates a new matrix with the given initial values.
-func New(matrix [immutabilitybenchmarking.MatrixHeight][immutabilitybenchmarking.MatrixWidth]int) *Matrix {
-	return &Matrix{matrix: matrix}
-}
-
-// NewEmpty createas a new empty matrix with the given dimensions.
-func NewEmpty(width int, height int) (*Matrix, error) {
-	if width == 0 || height == 0 {
-		return nil, errors.New("width and height must both be non-zero")
-	}
-
-	m := &Matrix{}
-
-	return m, nil
-}
-
-// Width returns the number of columns in the matrix.
-func (m *Matrix) Width() int {
-	return len(m.matrix[0])
-}
+//go:generate go run ../internal/gen -package mutable -sizes 10,30,90,270,810 -output matrix_gen.go
 
-// Height returns the number of rows in the matrix.
-func (m *Matrix) Height() int {
-	return len(m.matrix)
-}
-
-// Get returns the integer at the provided coordinates.
-func (m *Matrix) Get(row int, col int) int {
-	return m.matrix[row][col]
-}
-
-// Equals will compare a matrix against this matrix and return if they are equal.
-func (m *Matrix) Equals(m2 immutabilitybenchmarking.Matrix) bool {
-	if m.Height() != m2.Height() {
-		return false
-	}
-
-	if m.Width() != m2.Width() {
-		return false
+// New creates a new matrix with the given initial values, backed by the smallest generated array type
+// able to hold them.
+func New[T immutabilitybenchmarking.Number](matrix [][]T) (immutabilitybenchmarking.Matrix[T], error) {
+	if len(matrix) == 0 || len(matrix[0]) == 0 {
+		return nil, &immutabilitybenchmarking.InvalidDimensionsError{Rows: len(matrix)}
 	}
 
-	for r := 0; r < len(m.matrix); r++ {
-		for c := 0; c < len(m.matrix[r]); c++ {
-			if m.matrix[r][c] != m2.Get(r, c) {
-				return false
-			}
+	for r := 1; r < len(matrix); r++ {
+		if len(matrix[r]) != len(matrix[0]) {
+			return nil, &immutabilitybenchmarking.JaggedRowsError{Row: r, Width: len(matrix[r]), Expected: len(matrix[0])}
 		}
 	}
 
-	return true
-}
-
-// Add will add the values of a matrix to this matrix.
-func (m *Matrix) Add(m2 immutabilitybenchmarking.Matrix) (immutabilitybenchmarking.Matrix, error) {
-	if m.Height() != m2.Height() {
-		return nil, errors.New("width of both matrices are not the same")
-	}
-
-	if m.Width() != m2.Width() {
-		return nil, errors.New("height of both matrices are not the same")
-	}
-
-	for r := 0; r < len(m.matrix); r++ {
-		for c := 0; c < len(m.matrix[r]); c++ {
-			m.matrix[r][c] = m.matrix[r][c] + m2.Get(r ,c)
-		}
+	m, ok := fromRows(matrix)
+	if !ok {
+		return nil, &immutabilitybenchmarking.SizeLimitError{Rows: len(matrix), Cols: len(matrix[0]), Limit: Sizes[len(Sizes)-1]}
 	}
 
 	return m, nil
 }
 
-// Subtract will subtract the values of a matrix from this matrix.
-func (m *Matrix) Subtract(m2 immutabilitybenchmarking.Matrix) (immutabilitybenchmarking.Matrix, error) {
-	if m.Height() != m2.Height() {
-		return nil, errors.New("width of both matrices are not the same")
-	}
-
-	if m.Width() != m2.Width() {
-		return nil, errors.New("height of both matrices are not the same")
+// NewEmpty createas a new empty matrix with the given dimensions.
+func NewEmpty[T immutabilitybenchmarking.Number](width int, height int) (immutabilitybenchmarking.Matrix[T], error) {
+	if width <= 0 || height <= 0 {
+		return nil, &immutabilitybenchmarking.InvalidDimensionsError{Rows: height, Cols: width}
 	}
 
-	for r := 0; r < len(m.matrix); r++ {
-		for c := 0; c < len(m.matrix[r]); c++ {
-			m.matrix[r][c] = m.matrix[r][c] - m2.Get(r, c)
-		}
+	m, ok := newEmpty[T](height, width)
+	if !ok {
+		return nil, &immutabilitybenchmarking.SizeLimitError{Rows: height, Cols: width, Limit: Sizes[len(Sizes)-1]}
 	}
 
 	return m, nil
 }
 
-// ScalarMultiply will multiply this matrix by a given scalar value.
-func (m *Matrix) ScalarMultiply(s int) immutabilitybenchmarking.Matrix {
-	for r := 0; r < len(m.matrix); r++ {
-		for c := 0; c < len(m.matrix[r]); c++ {
-			m.matrix[r][c] = m.matrix[r][c] * s
-		}
-	}
-
-	return m
-}
-
-// Transpose will transpose this matrix.
-func (m *Matrix) Transpose() immutabilitybenchmarking.Matrix {
-	t := [immutabilitybenchmarking.MatrixHeight][immutabilitybenchmarking.MatrixWidth]int{}
-
-	for rt := 0; rt < len(t); rt++ {
-		for ct := 0; ct < len(m.matrix); ct++ {
-			t[rt][ct] = m.matrix[ct][rt]
-		}
+// rearrange builds the matrix a shape operation describes in the smallest generated type able to hold it,
+// for the results that no longer fit in the array of the matrix they came from.
+func rearrange[T immutabilitybenchmarking.Number](res shape.Result[T], err error) (immutabilitybenchmarking.Matrix[T], error) {
+	if err != nil {
+		return nil, err
 	}
 
-	m.matrix = t
-
-	return m
-}
-
-// MatrixMultiply will multiple the given matrix against this matrix.
-func (m *Matrix) MatrixMultiply(m2 immutabilitybenchmarking.Matrix) (immutabilitybenchmarking.Matrix, error) {
-	if m.Width() != m2.Height() {
-		return nil, errors.New("the dimensions of the matrices are incompatible, try transposing one first")
-	}
-
-	n := [immutabilitybenchmarking.MatrixHeight][immutabilitybenchmarking.MatrixWidth]int{}
-
-	for rm := 0; rm < m.Height(); rm++ {
-		for cm2 := 0; cm2 < m2.Width(); cm2++ {
-			product := 0
-			for cm := 0; cm < m.Width(); cm++ {
-				product = product + m.matrix[rm][cm]*m2.Get(cm, cm2)
-			}
-			n[rm][cm2] = product
-		}
-	}
-
-	m.matrix = n
-
-	return m, nil
+	return Factory[T]{}.FromFunc(res.Rows, res.Cols, res.At)
 }
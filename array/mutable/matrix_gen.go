@@ -0,0 +1,3480 @@
+// Code generated by array/internal/gen; DO NOT EDIT.
+
+package mutable
+
+import (
+	"github.com/chris-tomich/immutability-benchmarking"
+	"github.com/chris-tomich/immutability-benchmarking/internal/numeric"
+	"github.com/chris-tomich/immutability-benchmarking/internal/power"
+	"github.com/chris-tomich/immutability-benchmarking/internal/shape"
+)
+
+// Sizes lists the dimensions of the generated array backed matrix types.
+var Sizes = []int{10, 30, 90, 270, 810}
+
+// newEmpty returns an empty matrix of the smallest generated type able to hold rows x cols values.
+func newEmpty[T immutabilitybenchmarking.Number](rows int, cols int) (immutabilitybenchmarking.Matrix[T], bool) {
+	switch {
+	case rows <= 10 && cols <= 10:
+		return &Matrix10x10[T]{rows: rows, cols: cols}, true
+	case rows <= 30 && cols <= 30:
+		return &Matrix30x30[T]{rows: rows, cols: cols}, true
+	case rows <= 90 && cols <= 90:
+		return &Matrix90x90[T]{rows: rows, cols: cols}, true
+	case rows <= 270 && cols <= 270:
+		return &Matrix270x270[T]{rows: rows, cols: cols}, true
+	case rows <= 810 && cols <= 810:
+		return &Matrix810x810[T]{rows: rows, cols: cols}, true
+	}
+
+	return nil, false
+}
+
+// fromRows returns a matrix of the smallest generated type able to hold the given rectangular values.
+func fromRows[T immutabilitybenchmarking.Number](matrix [][]T) (immutabilitybenchmarking.Matrix[T], bool) {
+	rows := len(matrix)
+	cols := len(matrix[0])
+
+	switch {
+	case rows <= 10 && cols <= 10:
+		m := &Matrix10x10[T]{rows: rows, cols: cols}
+		for r := 0; r < rows; r++ {
+			copy(m.matrix[r][:cols], matrix[r])
+		}
+		return m, true
+	case rows <= 30 && cols <= 30:
+		m := &Matrix30x30[T]{rows: rows, cols: cols}
+		for r := 0; r < rows; r++ {
+			copy(m.matrix[r][:cols], matrix[r])
+		}
+		return m, true
+	case rows <= 90 && cols <= 90:
+		m := &Matrix90x90[T]{rows: rows, cols: cols}
+		for r := 0; r < rows; r++ {
+			copy(m.matrix[r][:cols], matrix[r])
+		}
+		return m, true
+	case rows <= 270 && cols <= 270:
+		m := &Matrix270x270[T]{rows: rows, cols: cols}
+		for r := 0; r < rows; r++ {
+			copy(m.matrix[r][:cols], matrix[r])
+		}
+		return m, true
+	case rows <= 810 && cols <= 810:
+		m := &Matrix810x810[T]{rows: rows, cols: cols}
+		for r := 0; r < rows; r++ {
+			copy(m.matrix[r][:cols], matrix[r])
+		}
+		return m, true
+	}
+
+	return nil, false
+}
+
+// newTarget returns a writable empty matrix of the smallest generated type able to hold rows x cols values.
+func newTarget[T immutabilitybenchmarking.Number](rows int, cols int) (target[T], bool) {
+	switch {
+	case rows <= 10 && cols <= 10:
+		return &Matrix10x10[T]{rows: rows, cols: cols}, true
+	case rows <= 30 && cols <= 30:
+		return &Matrix30x30[T]{rows: rows, cols: cols}, true
+	case rows <= 90 && cols <= 90:
+		return &Matrix90x90[T]{rows: rows, cols: cols}, true
+	case rows <= 270 && cols <= 270:
+		return &Matrix270x270[T]{rows: rows, cols: cols}, true
+	case rows <= 810 && cols <= 810:
+		return &Matrix810x810[T]{rows: rows, cols: cols}, true
+	}
+
+	return nil, false
+}
+
+// Matrix10x10 is a matrix with mutating operations backed by a 10x10 array. An operation whose result
+// outgrows the array leaves this matrix unchanged and returns the result as a new matrix of the smallest
+// generated type able to hold it.
+type Matrix10x10[T immutabilitybenchmarking.Number] struct {
+	rows   int
+	cols   int
+	matrix [10][10]T
+}
+
+// Width returns the number of columns in the matrix.
+func (m *Matrix10x10[T]) Width() int {
+	return m.cols
+}
+
+// Height returns the number of rows in the matrix.
+func (m *Matrix10x10[T]) Height() int {
+	return m.rows
+}
+
+// Get returns the element at the provided coordinates. It panics with an OutOfBoundsError if they are
+// outside the matrix, as the unused part of the array would otherwise be read as zeros.
+func (m *Matrix10x10[T]) Get(row int, col int) T {
+	if row < 0 || row >= m.rows || col < 0 || col >= m.cols {
+		panic(&immutabilitybenchmarking.OutOfBoundsError{Row: row, Col: col, Rows: m.rows, Cols: m.cols})
+	}
+
+	return m.matrix[row][col]
+}
+
+// At returns the element at the provided coordinates or an OutOfBoundsError if they are outside the matrix.
+func (m *Matrix10x10[T]) At(row int, col int) (T, error) {
+	if row < 0 || row >= m.Height() || col < 0 || col >= m.Width() {
+		var zero T
+		return zero, &immutabilitybenchmarking.OutOfBoundsError{Row: row, Col: col, Rows: m.Height(), Cols: m.Width()}
+	}
+
+	return m.matrix[row][col], nil
+}
+
+// MustAt returns the element at the provided coordinates and panics with an OutOfBoundsError if they are
+// outside the matrix.
+func (m *Matrix10x10[T]) MustAt(row int, col int) T {
+	v, err := m.At(row, col)
+	if err != nil {
+		panic(err)
+	}
+
+	return v
+}
+
+func (m *Matrix10x10[T]) set(row int, col int, v T) {
+	m.matrix[row][col] = v
+}
+
+// Equals will compare a matrix against this matrix and return if they are equal.
+func (m *Matrix10x10[T]) Equals(m2 immutabilitybenchmarking.Matrix[T]) bool {
+	if m.Height() != m2.Height() {
+		return false
+	}
+
+	if m.Width() != m2.Width() {
+		return false
+	}
+
+	for r := 0; r < m.rows; r++ {
+		for c := 0; c < m.cols; c++ {
+			if m.matrix[r][c] != m2.Get(r, c) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// Add will add the values of a matrix to this matrix.
+func (m *Matrix10x10[T]) Add(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m.Height() != m2.Height() || m.Width() != m2.Width() {
+		return nil, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "Add",
+			LeftRows:  m.Height(),
+			LeftCols:  m.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	for r := 0; r < m.rows; r++ {
+		for c := 0; c < m.cols; c++ {
+			m.matrix[r][c] = m.matrix[r][c] + m2.Get(r, c)
+		}
+	}
+
+	return m, nil
+}
+
+// Subtract will subtract the values of a matrix from this matrix.
+func (m *Matrix10x10[T]) Subtract(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m.Height() != m2.Height() || m.Width() != m2.Width() {
+		return nil, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "Subtract",
+			LeftRows:  m.Height(),
+			LeftCols:  m.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	for r := 0; r < m.rows; r++ {
+		for c := 0; c < m.cols; c++ {
+			m.matrix[r][c] = m.matrix[r][c] - m2.Get(r, c)
+		}
+	}
+
+	return m, nil
+}
+
+// ScalarMultiply will multiply this matrix by a given scalar value.
+func (m *Matrix10x10[T]) ScalarMultiply(s T) immutabilitybenchmarking.Matrix[T] {
+	for r := 0; r < m.rows; r++ {
+		for c := 0; c < m.cols; c++ {
+			m.matrix[r][c] = m.matrix[r][c] * s
+		}
+	}
+
+	return m
+}
+
+// Transpose will transpose this matrix.
+func (m *Matrix10x10[T]) Transpose() immutabilitybenchmarking.Matrix[T] {
+	t := [10][10]T{}
+
+	for rt := 0; rt < m.cols; rt++ {
+		for ct := 0; ct < m.rows; ct++ {
+			t[rt][ct] = m.matrix[ct][rt]
+		}
+	}
+
+	m.matrix = t
+	m.rows, m.cols = m.cols, m.rows
+
+	return m
+}
+
+// MatrixMultiply will multiple the given matrix against this matrix.
+func (m *Matrix10x10[T]) MatrixMultiply(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m.Width() != m2.Height() {
+		return nil, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "MatrixMultiply",
+			LeftRows:  m.Height(),
+			LeftCols:  m.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	if m2.Width() > 10 {
+		return Factory[T]{}.FromFunc(m.rows, m2.Width(), func(rm int, cm2 int) T {
+			var product T
+			for cm := 0; cm < m.cols; cm++ {
+				product = product + m.matrix[rm][cm]*m2.Get(cm, cm2)
+			}
+			return product
+		})
+	}
+
+	n := [10][10]T{}
+
+	for rm := 0; rm < m.Height(); rm++ {
+		for cm2 := 0; cm2 < m2.Width(); cm2++ {
+			var product T
+			for cm := 0; cm < m.Width(); cm++ {
+				product = product + m.matrix[rm][cm]*m2.Get(cm, cm2)
+			}
+			n[rm][cm2] = product
+		}
+	}
+
+	m.matrix = n
+	m.cols = m2.Width()
+
+	return m, nil
+}
+
+// Map will apply f to every element of this matrix.
+func (m *Matrix10x10[T]) Map(f func(v T) T) immutabilitybenchmarking.Matrix[T] {
+	for r := 0; r < m.rows; r++ {
+		for c := 0; c < m.cols; c++ {
+			m.matrix[r][c] = f(m.matrix[r][c])
+		}
+	}
+
+	return m
+}
+
+// MapIndexed will apply f to every element of this matrix along with its coordinates.
+func (m *Matrix10x10[T]) MapIndexed(f func(row int, col int, v T) T) immutabilitybenchmarking.Matrix[T] {
+	for r := 0; r < m.rows; r++ {
+		for c := 0; c < m.cols; c++ {
+			m.matrix[r][c] = f(r, c, m.matrix[r][c])
+		}
+	}
+
+	return m
+}
+
+// ZipWith will combine each element of this matrix with the element at the same coordinates of the given
+// matrix using f.
+func (m *Matrix10x10[T]) ZipWith(m2 immutabilitybenchmarking.Matrix[T], f func(a T, b T) T) (immutabilitybenchmarking.Matrix[T], error) {
+	if m.Height() != m2.Height() || m.Width() != m2.Width() {
+		return nil, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "ZipWith",
+			LeftRows:  m.Height(),
+			LeftCols:  m.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	for r := 0; r < m.rows; r++ {
+		for c := 0; c < m.cols; c++ {
+			m.matrix[r][c] = f(m.matrix[r][c], m2.Get(r, c))
+		}
+	}
+
+	return m, nil
+}
+
+// Fold will combine every element of this matrix into a single value, starting from initial and visiting
+// the elements in row-major order.
+func (m *Matrix10x10[T]) Fold(initial T, f func(acc T, v T) T) T {
+	acc := initial
+
+	for r := 0; r < m.rows; r++ {
+		for c := 0; c < m.cols; c++ {
+			acc = f(acc, m.matrix[r][c])
+		}
+	}
+
+	return acc
+}
+
+// RowReduce will fold each row of this matrix into a single value starting from initial, replacing this
+// matrix with a column of the results.
+func (m *Matrix10x10[T]) RowReduce(initial T, f func(acc T, v T) T) immutabilitybenchmarking.Matrix[T] {
+	n := [10][10]T{}
+
+	for r := 0; r < m.rows; r++ {
+		acc := initial
+
+		for c := 0; c < m.cols; c++ {
+			acc = f(acc, m.matrix[r][c])
+		}
+
+		n[r][0] = acc
+	}
+
+	m.matrix = n
+	m.cols = 1
+
+	return m
+}
+
+// ColReduce will fold each column of this matrix into a single value starting from initial, replacing this
+// matrix with a row of the results.
+func (m *Matrix10x10[T]) ColReduce(initial T, f func(acc T, v T) T) immutabilitybenchmarking.Matrix[T] {
+	n := [10][10]T{}
+
+	for c := 0; c < m.cols; c++ {
+		n[0][c] = initial
+	}
+
+	for r := 0; r < m.rows; r++ {
+		for c := 0; c < m.cols; c++ {
+			n[0][c] = f(n[0][c], m.matrix[r][c])
+		}
+	}
+
+	m.matrix = n
+	m.rows = 1
+
+	return m
+}
+
+// HadamardProduct will multiply each element of this matrix by the element at the same coordinates of the
+// given matrix.
+func (m *Matrix10x10[T]) HadamardProduct(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m.Height() != m2.Height() || m.Width() != m2.Width() {
+		return nil, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "HadamardProduct",
+			LeftRows:  m.Height(),
+			LeftCols:  m.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	for r := 0; r < m.rows; r++ {
+		for c := 0; c < m.cols; c++ {
+			m.matrix[r][c] = m.matrix[r][c] * m2.Get(r, c)
+		}
+	}
+
+	return m, nil
+}
+
+// ElementwiseDivide will divide each element of this matrix by the element at the same coordinates of the
+// given matrix. The divisor is checked for zeros before any element is changed, so a DivisionByZeroError
+// leaves this matrix as it was.
+func (m *Matrix10x10[T]) ElementwiseDivide(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m.Height() != m2.Height() || m.Width() != m2.Width() {
+		return nil, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "ElementwiseDivide",
+			LeftRows:  m.Height(),
+			LeftCols:  m.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	for r := 0; r < m.rows; r++ {
+		for c := 0; c < m.cols; c++ {
+			if m2.Get(r, c) == 0 {
+				return nil, &immutabilitybenchmarking.DivisionByZeroError{Op: "ElementwiseDivide", Row: r, Col: c}
+			}
+		}
+	}
+
+	for r := 0; r < m.rows; r++ {
+		for c := 0; c < m.cols; c++ {
+			m.matrix[r][c] = m.matrix[r][c] / m2.Get(r, c)
+		}
+	}
+
+	return m, nil
+}
+
+// KroneckerProduct will multiply every element of this matrix by the whole of the given matrix, replacing
+// this matrix with the blocks of products.
+func (m *Matrix10x10[T]) KroneckerProduct(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	rows, cols := m.rows*m2.Height(), m.cols*m2.Width()
+
+	if rows > 10 || cols > 10 {
+		h2, w2 := m2.Height(), m2.Width()
+
+		return Factory[T]{}.FromFunc(rows, cols, func(r int, c int) T {
+			return m.matrix[r/h2][c/w2] * m2.Get(r%h2, c%w2)
+		})
+	}
+
+	n := [10][10]T{}
+
+	for r1 := 0; r1 < m.rows; r1++ {
+		for r2 := 0; r2 < m2.Height(); r2++ {
+			for c1 := 0; c1 < m.cols; c1++ {
+				for c2 := 0; c2 < m2.Width(); c2++ {
+					n[r1*m2.Height()+r2][c1*m2.Width()+c2] = m.matrix[r1][c1] * m2.Get(r2, c2)
+				}
+			}
+		}
+	}
+
+	m.matrix = n
+	m.rows, m.cols = rows, cols
+
+	return m, nil
+}
+
+// Trace will sum the elements on the diagonal of this matrix, returning a NotSquareError if it is not square.
+func (m *Matrix10x10[T]) Trace() (T, error) {
+	if m.rows != m.cols {
+		var zero T
+		return zero, &immutabilitybenchmarking.NotSquareError{Rows: m.rows, Cols: m.cols}
+	}
+
+	var trace T
+
+	for i := 0; i < m.rows; i++ {
+		trace += m.matrix[i][i]
+	}
+
+	return trace, nil
+}
+
+// Sum will add together every element of this matrix.
+func (m *Matrix10x10[T]) Sum() T {
+	return numeric.Sum(m.rows, func(r int) []T { return m.matrix[r][:m.cols] })
+}
+
+// Min will return the smallest element of this matrix. Complex elements are ordered by their real parts and
+// then by their imaginary parts.
+func (m *Matrix10x10[T]) Min() T {
+	r, c := numeric.ArgMin(m.rows, func(r int) []T { return m.matrix[r][:m.cols] })
+
+	return m.matrix[r][c]
+}
+
+// Max will return the largest element of this matrix. Complex elements are ordered by their real parts and
+// then by their imaginary parts.
+func (m *Matrix10x10[T]) Max() T {
+	r, c := numeric.ArgMax(m.rows, func(r int) []T { return m.matrix[r][:m.cols] })
+
+	return m.matrix[r][c]
+}
+
+// ArgMin will return the coordinates of the first smallest element of this matrix in row-major order.
+func (m *Matrix10x10[T]) ArgMin() (int, int) {
+	return numeric.ArgMin(m.rows, func(r int) []T { return m.matrix[r][:m.cols] })
+}
+
+// ArgMax will return the coordinates of the first largest element of this matrix in row-major order.
+func (m *Matrix10x10[T]) ArgMax() (int, int) {
+	return numeric.ArgMax(m.rows, func(r int) []T { return m.matrix[r][:m.cols] })
+}
+
+// FrobeniusNorm will return the square root of the sum of the squared absolute values of the elements of
+// this matrix.
+func (m *Matrix10x10[T]) FrobeniusNorm() float64 {
+	return numeric.FrobeniusNorm(m.rows, func(r int) []T { return m.matrix[r][:m.cols] })
+}
+
+// OneNorm will return the largest sum of the absolute values in a column of this matrix.
+func (m *Matrix10x10[T]) OneNorm() float64 {
+	return numeric.OneNorm(m.rows, m.cols, func(r int) []T { return m.matrix[r][:m.cols] })
+}
+
+// InfNorm will return the largest sum of the absolute values in a row of this matrix.
+func (m *Matrix10x10[T]) InfNorm() float64 {
+	return numeric.InfNorm(m.rows, func(r int) []T { return m.matrix[r][:m.cols] })
+}
+
+// Rank will return the number of linearly independent rows of this matrix, treating values within rounding
+// error of zero as zero.
+func (m *Matrix10x10[T]) Rank() int {
+	return numeric.Rank(m.rows, m.cols, func(r int) []T { return m.matrix[r][:m.cols] })
+}
+
+// Power will raise this matrix to the power n in place by repeated squaring, returning a NotSquareError if it
+// is not square or a NegativeExponentError if n is negative. The zeroth power is the identity matrix.
+func (m *Matrix10x10[T]) Power(n int) (immutabilitybenchmarking.Matrix[T], error) {
+	if m.rows != m.cols {
+		return nil, &immutabilitybenchmarking.NotSquareError{Rows: m.rows, Cols: m.cols}
+	}
+
+	if n < 0 {
+		return nil, &immutabilitybenchmarking.NegativeExponentError{Exponent: n}
+	}
+
+	// Squaring replaces the elements of m, so the first power kept for the result has to be a copy.
+	p, err := power.BySquaring[immutabilitybenchmarking.Matrix[T]](m, n, func() (immutabilitybenchmarking.Matrix[T], error) {
+		id := &Matrix10x10[T]{rows: m.rows, cols: m.cols}
+
+		for i := 0; i < m.rows; i++ {
+			id.matrix[i][i] = 1
+		}
+
+		return id, nil
+	}, func(b immutabilitybenchmarking.Matrix[T]) immutabilitybenchmarking.Matrix[T] {
+		c := *b.(*Matrix10x10[T])
+		return &c
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	*m = *p.(*Matrix10x10[T])
+
+	return m, nil
+}
+
+// Reshape will read the elements of this matrix in row-major order into the given dimensions, returning a
+// ReshapeError if they would not hold the same number of elements.
+func (m *Matrix10x10[T]) Reshape(rows int, cols int) (immutabilitybenchmarking.Matrix[T], error) {
+	if err := shape.CheckReshape(m, rows, cols); err != nil {
+		return nil, err
+	}
+
+	if rows > 10 || cols > 10 {
+		return rearrange(shape.Reshape[T](m, rows, cols))
+	}
+
+	n := [10][10]T{}
+
+	for i := 0; i < rows*cols; i++ {
+		n[i/cols][i%cols] = m.matrix[i/m.cols][i%m.cols]
+	}
+
+	m.matrix = n
+	m.rows, m.cols = rows, cols
+
+	return m, nil
+}
+
+// HConcat will write the columns of the given matrix after the columns of this matrix, returning a
+// DimensionMismatchError if they do not have the same number of rows.
+func (m *Matrix10x10[T]) HConcat(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if err := shape.CheckHConcat(m, m2); err != nil {
+		return nil, err
+	}
+
+	cols := m.cols + m2.Width()
+
+	if cols > 10 {
+		return rearrange(shape.HConcat[T](m, m2))
+	}
+
+	// Only the unused columns are written, so m2 can be m itself.
+	for r := 0; r < m.rows; r++ {
+		for c := m.cols; c < cols; c++ {
+			m.matrix[r][c] = m2.Get(r, c-m.cols)
+		}
+	}
+
+	m.cols = cols
+
+	return m, nil
+}
+
+// VConcat will write the rows of the given matrix after the rows of this matrix, returning a
+// DimensionMismatchError if they do not have the same number of columns.
+func (m *Matrix10x10[T]) VConcat(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	return m.stack("VConcat", m2)
+}
+
+// Stack will write the rows of each of the given matrices after the rows of this matrix in order, returning
+// a DimensionMismatchError for the first that does not have the same number of columns.
+func (m *Matrix10x10[T]) Stack(ms ...immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	return m.stack("Stack", ms...)
+}
+
+func (m *Matrix10x10[T]) stack(op string, ms ...immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if err := shape.CheckStack(op, m, ms...); err != nil {
+		return nil, err
+	}
+
+	// Any of ms can be m itself, so their heights are taken before the first row is written.
+	heights := make([]int, len(ms))
+	rows := m.rows
+
+	for i, m2 := range ms {
+		heights[i] = m2.Height()
+		rows += heights[i]
+	}
+
+	if rows > 10 {
+		return rearrange(shape.Stack[T](op, m, ms...))
+	}
+
+	for i, m2 := range ms {
+		for r := 0; r < heights[i]; r++ {
+			for c := 0; c < m.cols; c++ {
+				m.matrix[m.rows+r][c] = m2.Get(r, c)
+			}
+		}
+
+		m.rows += heights[i]
+	}
+
+	return m, nil
+}
+
+// SplitRows will keep the rows of this matrix before at and return them along with a new matrix holding the
+// rows from at onwards, returning an InvalidDimensionsError if either part would be empty.
+func (m *Matrix10x10[T]) SplitRows(at int) (immutabilitybenchmarking.Matrix[T], immutabilitybenchmarking.Matrix[T], error) {
+	if err := shape.CheckSplitRows(m, at); err != nil {
+		return nil, nil, err
+	}
+
+	rest := &Matrix10x10[T]{rows: m.rows - at, cols: m.cols}
+
+	for r := at; r < m.rows; r++ {
+		rest.matrix[r-at] = m.matrix[r]
+		m.matrix[r] = [10]T{}
+	}
+
+	m.rows = at
+
+	return m, rest, nil
+}
+
+// SplitCols will keep the columns of this matrix before at and return them along with a new matrix holding
+// the columns from at onwards, returning an InvalidDimensionsError if either part would be empty.
+func (m *Matrix10x10[T]) SplitCols(at int) (immutabilitybenchmarking.Matrix[T], immutabilitybenchmarking.Matrix[T], error) {
+	if err := shape.CheckSplitCols(m, at); err != nil {
+		return nil, nil, err
+	}
+
+	rest := &Matrix10x10[T]{rows: m.rows, cols: m.cols - at}
+
+	for r := 0; r < m.rows; r++ {
+		copy(rest.matrix[r][:], m.matrix[r][at:m.cols])
+		clear(m.matrix[r][at:m.cols])
+	}
+
+	m.cols = at
+
+	return m, rest, nil
+}
+
+// Pad will surround this matrix with the given number of rows and columns of zeros on each side, moving the
+// rows within the array starting from the last so none is overwritten before it has moved. It returns a
+// PaddingError if any of them is negative.
+func (m *Matrix10x10[T]) Pad(top int, bottom int, left int, right int) (immutabilitybenchmarking.Matrix[T], error) {
+	if err := shape.CheckPad(top, bottom, left, right); err != nil {
+		return nil, err
+	}
+
+	rows, cols := top+m.rows+bottom, left+m.cols+right
+
+	if rows > 10 || cols > 10 {
+		return rearrange(shape.Pad[T](m, top, bottom, left, right))
+	}
+
+	for r := m.rows - 1; r >= 0; r-- {
+		copy(m.matrix[top+r][left:], m.matrix[r][:m.cols])
+		clear(m.matrix[top+r][:left])
+		clear(m.matrix[top+r][left+m.cols : cols])
+	}
+
+	for r := 0; r < top; r++ {
+		clear(m.matrix[r][:cols])
+	}
+
+	m.rows, m.cols = rows, cols
+
+	return m, nil
+}
+
+// Flip will reverse the order of the rows or the columns of this matrix in place.
+func (m *Matrix10x10[T]) Flip(axis immutabilitybenchmarking.Axis) immutabilitybenchmarking.Matrix[T] {
+	if axis == immutabilitybenchmarking.RowAxis {
+		for i, j := 0, m.rows-1; i < j; i, j = i+1, j-1 {
+			m.matrix[i], m.matrix[j] = m.matrix[j], m.matrix[i]
+		}
+
+		return m
+	}
+
+	for r := 0; r < m.rows; r++ {
+		for i, j := 0, m.cols-1; i < j; i, j = i+1, j-1 {
+			m.matrix[r][i], m.matrix[r][j] = m.matrix[r][j], m.matrix[r][i]
+		}
+	}
+
+	return m
+}
+
+// Rotate90 will turn this matrix a quarter turn clockwise. Square matrices are rotated in place, a ring of
+// four elements at a time.
+func (m *Matrix10x10[T]) Rotate90() immutabilitybenchmarking.Matrix[T] {
+	h := m.rows
+
+	if h == m.cols {
+		a := &m.matrix
+
+		for r := 0; r < h/2; r++ {
+			for c := r; c < h-1-r; c++ {
+				a[r][c], a[c][h-1-r], a[h-1-r][h-1-c], a[h-1-c][r] = a[h-1-c][r], a[r][c], a[c][h-1-r], a[h-1-r][h-1-c]
+			}
+		}
+
+		return m
+	}
+
+	n := [10][10]T{}
+
+	for r := 0; r < h; r++ {
+		for c := 0; c < m.cols; c++ {
+			n[c][h-1-r] = m.matrix[r][c]
+		}
+	}
+
+	m.matrix = n
+	m.rows, m.cols = m.cols, m.rows
+
+	return m
+}
+
+// Matrix30x30 is a matrix with mutating operations backed by a 30x30 array. An operation whose result
+// outgrows the array leaves this matrix unchanged and returns the result as a new matrix of the smallest
+// generated type able to hold it.
+type Matrix30x30[T immutabilitybenchmarking.Number] struct {
+	rows   int
+	cols   int
+	matrix [30][30]T
+}
+
+// Width returns the number of columns in the matrix.
+func (m *Matrix30x30[T]) Width() int {
+	return m.cols
+}
+
+// Height returns the number of rows in the matrix.
+func (m *Matrix30x30[T]) Height() int {
+	return m.rows
+}
+
+// Get returns the element at the provided coordinates. It panics with an OutOfBoundsError if they are
+// outside the matrix, as the unused part of the array would otherwise be read as zeros.
+func (m *Matrix30x30[T]) Get(row int, col int) T {
+	if row < 0 || row >= m.rows || col < 0 || col >= m.cols {
+		panic(&immutabilitybenchmarking.OutOfBoundsError{Row: row, Col: col, Rows: m.rows, Cols: m.cols})
+	}
+
+	return m.matrix[row][col]
+}
+
+// At returns the element at the provided coordinates or an OutOfBoundsError if they are outside the matrix.
+func (m *Matrix30x30[T]) At(row int, col int) (T, error) {
+	if row < 0 || row >= m.Height() || col < 0 || col >= m.Width() {
+		var zero T
+		return zero, &immutabilitybenchmarking.OutOfBoundsError{Row: row, Col: col, Rows: m.Height(), Cols: m.Width()}
+	}
+
+	return m.matrix[row][col], nil
+}
+
+// MustAt returns the element at the provided coordinates and panics with an OutOfBoundsError if they are
+// outside the matrix.
+func (m *Matrix30x30[T]) MustAt(row int, col int) T {
+	v, err := m.At(row, col)
+	if err != nil {
+		panic(err)
+	}
+
+	return v
+}
+
+func (m *Matrix30x30[T]) set(row int, col int, v T) {
+	m.matrix[row][col] = v
+}
+
+// Equals will compare a matrix against this matrix and return if they are equal.
+func (m *Matrix30x30[T]) Equals(m2 immutabilitybenchmarking.Matrix[T]) bool {
+	if m.Height() != m2.Height() {
+		return false
+	}
+
+	if m.Width() != m2.Width() {
+		return false
+	}
+
+	for r := 0; r < m.rows; r++ {
+		for c := 0; c < m.cols; c++ {
+			if m.matrix[r][c] != m2.Get(r, c) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// Add will add the values of a matrix to this matrix.
+func (m *Matrix30x30[T]) Add(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m.Height() != m2.Height() || m.Width() != m2.Width() {
+		return nil, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "Add",
+			LeftRows:  m.Height(),
+			LeftCols:  m.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	for r := 0; r < m.rows; r++ {
+		for c := 0; c < m.cols; c++ {
+			m.matrix[r][c] = m.matrix[r][c] + m2.Get(r, c)
+		}
+	}
+
+	return m, nil
+}
+
+// Subtract will subtract the values of a matrix from this matrix.
+func (m *Matrix30x30[T]) Subtract(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m.Height() != m2.Height() || m.Width() != m2.Width() {
+		return nil, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "Subtract",
+			LeftRows:  m.Height(),
+			LeftCols:  m.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	for r := 0; r < m.rows; r++ {
+		for c := 0; c < m.cols; c++ {
+			m.matrix[r][c] = m.matrix[r][c] - m2.Get(r, c)
+		}
+	}
+
+	return m, nil
+}
+
+// ScalarMultiply will multiply this matrix by a given scalar value.
+func (m *Matrix30x30[T]) ScalarMultiply(s T) immutabilitybenchmarking.Matrix[T] {
+	for r := 0; r < m.rows; r++ {
+		for c := 0; c < m.cols; c++ {
+			m.matrix[r][c] = m.matrix[r][c] * s
+		}
+	}
+
+	return m
+}
+
+// Transpose will transpose this matrix.
+func (m *Matrix30x30[T]) Transpose() immutabilitybenchmarking.Matrix[T] {
+	t := [30][30]T{}
+
+	for rt := 0; rt < m.cols; rt++ {
+		for ct := 0; ct < m.rows; ct++ {
+			t[rt][ct] = m.matrix[ct][rt]
+		}
+	}
+
+	m.matrix = t
+	m.rows, m.cols = m.cols, m.rows
+
+	return m
+}
+
+// MatrixMultiply will multiple the given matrix against this matrix.
+func (m *Matrix30x30[T]) MatrixMultiply(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m.Width() != m2.Height() {
+		return nil, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "MatrixMultiply",
+			LeftRows:  m.Height(),
+			LeftCols:  m.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	if m2.Width() > 30 {
+		return Factory[T]{}.FromFunc(m.rows, m2.Width(), func(rm int, cm2 int) T {
+			var product T
+			for cm := 0; cm < m.cols; cm++ {
+				product = product + m.matrix[rm][cm]*m2.Get(cm, cm2)
+			}
+			return product
+		})
+	}
+
+	n := [30][30]T{}
+
+	for rm := 0; rm < m.Height(); rm++ {
+		for cm2 := 0; cm2 < m2.Width(); cm2++ {
+			var product T
+			for cm := 0; cm < m.Width(); cm++ {
+				product = product + m.matrix[rm][cm]*m2.Get(cm, cm2)
+			}
+			n[rm][cm2] = product
+		}
+	}
+
+	m.matrix = n
+	m.cols = m2.Width()
+
+	return m, nil
+}
+
+// Map will apply f to every element of this matrix.
+func (m *Matrix30x30[T]) Map(f func(v T) T) immutabilitybenchmarking.Matrix[T] {
+	for r := 0; r < m.rows; r++ {
+		for c := 0; c < m.cols; c++ {
+			m.matrix[r][c] = f(m.matrix[r][c])
+		}
+	}
+
+	return m
+}
+
+// MapIndexed will apply f to every element of this matrix along with its coordinates.
+func (m *Matrix30x30[T]) MapIndexed(f func(row int, col int, v T) T) immutabilitybenchmarking.Matrix[T] {
+	for r := 0; r < m.rows; r++ {
+		for c := 0; c < m.cols; c++ {
+			m.matrix[r][c] = f(r, c, m.matrix[r][c])
+		}
+	}
+
+	return m
+}
+
+// ZipWith will combine each element of this matrix with the element at the same coordinates of the given
+// matrix using f.
+func (m *Matrix30x30[T]) ZipWith(m2 immutabilitybenchmarking.Matrix[T], f func(a T, b T) T) (immutabilitybenchmarking.Matrix[T], error) {
+	if m.Height() != m2.Height() || m.Width() != m2.Width() {
+		return nil, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "ZipWith",
+			LeftRows:  m.Height(),
+			LeftCols:  m.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	for r := 0; r < m.rows; r++ {
+		for c := 0; c < m.cols; c++ {
+			m.matrix[r][c] = f(m.matrix[r][c], m2.Get(r, c))
+		}
+	}
+
+	return m, nil
+}
+
+// Fold will combine every element of this matrix into a single value, starting from initial and visiting
+// the elements in row-major order.
+func (m *Matrix30x30[T]) Fold(initial T, f func(acc T, v T) T) T {
+	acc := initial
+
+	for r := 0; r < m.rows; r++ {
+		for c := 0; c < m.cols; c++ {
+			acc = f(acc, m.matrix[r][c])
+		}
+	}
+
+	return acc
+}
+
+// RowReduce will fold each row of this matrix into a single value starting from initial, replacing this
+// matrix with a column of the results.
+func (m *Matrix30x30[T]) RowReduce(initial T, f func(acc T, v T) T) immutabilitybenchmarking.Matrix[T] {
+	n := [30][30]T{}
+
+	for r := 0; r < m.rows; r++ {
+		acc := initial
+
+		for c := 0; c < m.cols; c++ {
+			acc = f(acc, m.matrix[r][c])
+		}
+
+		n[r][0] = acc
+	}
+
+	m.matrix = n
+	m.cols = 1
+
+	return m
+}
+
+// ColReduce will fold each column of this matrix into a single value starting from initial, replacing this
+// matrix with a row of the results.
+func (m *Matrix30x30[T]) ColReduce(initial T, f func(acc T, v T) T) immutabilitybenchmarking.Matrix[T] {
+	n := [30][30]T{}
+
+	for c := 0; c < m.cols; c++ {
+		n[0][c] = initial
+	}
+
+	for r := 0; r < m.rows; r++ {
+		for c := 0; c < m.cols; c++ {
+			n[0][c] = f(n[0][c], m.matrix[r][c])
+		}
+	}
+
+	m.matrix = n
+	m.rows = 1
+
+	return m
+}
+
+// HadamardProduct will multiply each element of this matrix by the element at the same coordinates of the
+// given matrix.
+func (m *Matrix30x30[T]) HadamardProduct(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m.Height() != m2.Height() || m.Width() != m2.Width() {
+		return nil, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "HadamardProduct",
+			LeftRows:  m.Height(),
+			LeftCols:  m.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	for r := 0; r < m.rows; r++ {
+		for c := 0; c < m.cols; c++ {
+			m.matrix[r][c] = m.matrix[r][c] * m2.Get(r, c)
+		}
+	}
+
+	return m, nil
+}
+
+// ElementwiseDivide will divide each element of this matrix by the element at the same coordinates of the
+// given matrix. The divisor is checked for zeros before any element is changed, so a DivisionByZeroError
+// leaves this matrix as it was.
+func (m *Matrix30x30[T]) ElementwiseDivide(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m.Height() != m2.Height() || m.Width() != m2.Width() {
+		return nil, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "ElementwiseDivide",
+			LeftRows:  m.Height(),
+			LeftCols:  m.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	for r := 0; r < m.rows; r++ {
+		for c := 0; c < m.cols; c++ {
+			if m2.Get(r, c) == 0 {
+				return nil, &immutabilitybenchmarking.DivisionByZeroError{Op: "ElementwiseDivide", Row: r, Col: c}
+			}
+		}
+	}
+
+	for r := 0; r < m.rows; r++ {
+		for c := 0; c < m.cols; c++ {
+			m.matrix[r][c] = m.matrix[r][c] / m2.Get(r, c)
+		}
+	}
+
+	return m, nil
+}
+
+// KroneckerProduct will multiply every element of this matrix by the whole of the given matrix, replacing
+// this matrix with the blocks of products.
+func (m *Matrix30x30[T]) KroneckerProduct(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	rows, cols := m.rows*m2.Height(), m.cols*m2.Width()
+
+	if rows > 30 || cols > 30 {
+		h2, w2 := m2.Height(), m2.Width()
+
+		return Factory[T]{}.FromFunc(rows, cols, func(r int, c int) T {
+			return m.matrix[r/h2][c/w2] * m2.Get(r%h2, c%w2)
+		})
+	}
+
+	n := [30][30]T{}
+
+	for r1 := 0; r1 < m.rows; r1++ {
+		for r2 := 0; r2 < m2.Height(); r2++ {
+			for c1 := 0; c1 < m.cols; c1++ {
+				for c2 := 0; c2 < m2.Width(); c2++ {
+					n[r1*m2.Height()+r2][c1*m2.Width()+c2] = m.matrix[r1][c1] * m2.Get(r2, c2)
+				}
+			}
+		}
+	}
+
+	m.matrix = n
+	m.rows, m.cols = rows, cols
+
+	return m, nil
+}
+
+// Trace will sum the elements on the diagonal of this matrix, returning a NotSquareError if it is not square.
+func (m *Matrix30x30[T]) Trace() (T, error) {
+	if m.rows != m.cols {
+		var zero T
+		return zero, &immutabilitybenchmarking.NotSquareError{Rows: m.rows, Cols: m.cols}
+	}
+
+	var trace T
+
+	for i := 0; i < m.rows; i++ {
+		trace += m.matrix[i][i]
+	}
+
+	return trace, nil
+}
+
+// Sum will add together every element of this matrix.
+func (m *Matrix30x30[T]) Sum() T {
+	return numeric.Sum(m.rows, func(r int) []T { return m.matrix[r][:m.cols] })
+}
+
+// Min will return the smallest element of this matrix. Complex elements are ordered by their real parts and
+// then by their imaginary parts.
+func (m *Matrix30x30[T]) Min() T {
+	r, c := numeric.ArgMin(m.rows, func(r int) []T { return m.matrix[r][:m.cols] })
+
+	return m.matrix[r][c]
+}
+
+// Max will return the largest element of this matrix. Complex elements are ordered by their real parts and
+// then by their imaginary parts.
+func (m *Matrix30x30[T]) Max() T {
+	r, c := numeric.ArgMax(m.rows, func(r int) []T { return m.matrix[r][:m.cols] })
+
+	return m.matrix[r][c]
+}
+
+// ArgMin will return the coordinates of the first smallest element of this matrix in row-major order.
+func (m *Matrix30x30[T]) ArgMin() (int, int) {
+	return numeric.ArgMin(m.rows, func(r int) []T { return m.matrix[r][:m.cols] })
+}
+
+// ArgMax will return the coordinates of the first largest element of this matrix in row-major order.
+func (m *Matrix30x30[T]) ArgMax() (int, int) {
+	return numeric.ArgMax(m.rows, func(r int) []T { return m.matrix[r][:m.cols] })
+}
+
+// FrobeniusNorm will return the square root of the sum of the squared absolute values of the elements of
+// this matrix.
+func (m *Matrix30x30[T]) FrobeniusNorm() float64 {
+	return numeric.FrobeniusNorm(m.rows, func(r int) []T { return m.matrix[r][:m.cols] })
+}
+
+// OneNorm will return the largest sum of the absolute values in a column of this matrix.
+func (m *Matrix30x30[T]) OneNorm() float64 {
+	return numeric.OneNorm(m.rows, m.cols, func(r int) []T { return m.matrix[r][:m.cols] })
+}
+
+// InfNorm will return the largest sum of the absolute values in a row of this matrix.
+func (m *Matrix30x30[T]) InfNorm() float64 {
+	return numeric.InfNorm(m.rows, func(r int) []T { return m.matrix[r][:m.cols] })
+}
+
+// Rank will return the number of linearly independent rows of this matrix, treating values within rounding
+// error of zero as zero.
+func (m *Matrix30x30[T]) Rank() int {
+	return numeric.Rank(m.rows, m.cols, func(r int) []T { return m.matrix[r][:m.cols] })
+}
+
+// Power will raise this matrix to the power n in place by repeated squaring, returning a NotSquareError if it
+// is not square or a NegativeExponentError if n is negative. The zeroth power is the identity matrix.
+func (m *Matrix30x30[T]) Power(n int) (immutabilitybenchmarking.Matrix[T], error) {
+	if m.rows != m.cols {
+		return nil, &immutabilitybenchmarking.NotSquareError{Rows: m.rows, Cols: m.cols}
+	}
+
+	if n < 0 {
+		return nil, &immutabilitybenchmarking.NegativeExponentError{Exponent: n}
+	}
+
+	// Squaring replaces the elements of m, so the first power kept for the result has to be a copy.
+	p, err := power.BySquaring[immutabilitybenchmarking.Matrix[T]](m, n, func() (immutabilitybenchmarking.Matrix[T], error) {
+		id := &Matrix30x30[T]{rows: m.rows, cols: m.cols}
+
+		for i := 0; i < m.rows; i++ {
+			id.matrix[i][i] = 1
+		}
+
+		return id, nil
+	}, func(b immutabilitybenchmarking.Matrix[T]) immutabilitybenchmarking.Matrix[T] {
+		c := *b.(*Matrix30x30[T])
+		return &c
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	*m = *p.(*Matrix30x30[T])
+
+	return m, nil
+}
+
+// Reshape will read the elements of this matrix in row-major order into the given dimensions, returning a
+// ReshapeError if they would not hold the same number of elements.
+func (m *Matrix30x30[T]) Reshape(rows int, cols int) (immutabilitybenchmarking.Matrix[T], error) {
+	if err := shape.CheckReshape(m, rows, cols); err != nil {
+		return nil, err
+	}
+
+	if rows > 30 || cols > 30 {
+		return rearrange(shape.Reshape[T](m, rows, cols))
+	}
+
+	n := [30][30]T{}
+
+	for i := 0; i < rows*cols; i++ {
+		n[i/cols][i%cols] = m.matrix[i/m.cols][i%m.cols]
+	}
+
+	m.matrix = n
+	m.rows, m.cols = rows, cols
+
+	return m, nil
+}
+
+// HConcat will write the columns of the given matrix after the columns of this matrix, returning a
+// DimensionMismatchError if they do not have the same number of rows.
+func (m *Matrix30x30[T]) HConcat(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if err := shape.CheckHConcat(m, m2); err != nil {
+		return nil, err
+	}
+
+	cols := m.cols + m2.Width()
+
+	if cols > 30 {
+		return rearrange(shape.HConcat[T](m, m2))
+	}
+
+	// Only the unused columns are written, so m2 can be m itself.
+	for r := 0; r < m.rows; r++ {
+		for c := m.cols; c < cols; c++ {
+			m.matrix[r][c] = m2.Get(r, c-m.cols)
+		}
+	}
+
+	m.cols = cols
+
+	return m, nil
+}
+
+// VConcat will write the rows of the given matrix after the rows of this matrix, returning a
+// DimensionMismatchError if they do not have the same number of columns.
+func (m *Matrix30x30[T]) VConcat(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	return m.stack("VConcat", m2)
+}
+
+// Stack will write the rows of each of the given matrices after the rows of this matrix in order, returning
+// a DimensionMismatchError for the first that does not have the same number of columns.
+func (m *Matrix30x30[T]) Stack(ms ...immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	return m.stack("Stack", ms...)
+}
+
+func (m *Matrix30x30[T]) stack(op string, ms ...immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if err := shape.CheckStack(op, m, ms...); err != nil {
+		return nil, err
+	}
+
+	// Any of ms can be m itself, so their heights are taken before the first row is written.
+	heights := make([]int, len(ms))
+	rows := m.rows
+
+	for i, m2 := range ms {
+		heights[i] = m2.Height()
+		rows += heights[i]
+	}
+
+	if rows > 30 {
+		return rearrange(shape.Stack[T](op, m, ms...))
+	}
+
+	for i, m2 := range ms {
+		for r := 0; r < heights[i]; r++ {
+			for c := 0; c < m.cols; c++ {
+				m.matrix[m.rows+r][c] = m2.Get(r, c)
+			}
+		}
+
+		m.rows += heights[i]
+	}
+
+	return m, nil
+}
+
+// SplitRows will keep the rows of this matrix before at and return them along with a new matrix holding the
+// rows from at onwards, returning an InvalidDimensionsError if either part would be empty.
+func (m *Matrix30x30[T]) SplitRows(at int) (immutabilitybenchmarking.Matrix[T], immutabilitybenchmarking.Matrix[T], error) {
+	if err := shape.CheckSplitRows(m, at); err != nil {
+		return nil, nil, err
+	}
+
+	rest := &Matrix30x30[T]{rows: m.rows - at, cols: m.cols}
+
+	for r := at; r < m.rows; r++ {
+		rest.matrix[r-at] = m.matrix[r]
+		m.matrix[r] = [30]T{}
+	}
+
+	m.rows = at
+
+	return m, rest, nil
+}
+
+// SplitCols will keep the columns of this matrix before at and return them along with a new matrix holding
+// the columns from at onwards, returning an InvalidDimensionsError if either part would be empty.
+func (m *Matrix30x30[T]) SplitCols(at int) (immutabilitybenchmarking.Matrix[T], immutabilitybenchmarking.Matrix[T], error) {
+	if err := shape.CheckSplitCols(m, at); err != nil {
+		return nil, nil, err
+	}
+
+	rest := &Matrix30x30[T]{rows: m.rows, cols: m.cols - at}
+
+	for r := 0; r < m.rows; r++ {
+		copy(rest.matrix[r][:], m.matrix[r][at:m.cols])
+		clear(m.matrix[r][at:m.cols])
+	}
+
+	m.cols = at
+
+	return m, rest, nil
+}
+
+// Pad will surround this matrix with the given number of rows and columns of zeros on each side, moving the
+// rows within the array starting from the last so none is overwritten before it has moved. It returns a
+// PaddingError if any of them is negative.
+func (m *Matrix30x30[T]) Pad(top int, bottom int, left int, right int) (immutabilitybenchmarking.Matrix[T], error) {
+	if err := shape.CheckPad(top, bottom, left, right); err != nil {
+		return nil, err
+	}
+
+	rows, cols := top+m.rows+bottom, left+m.cols+right
+
+	if rows > 30 || cols > 30 {
+		return rearrange(shape.Pad[T](m, top, bottom, left, right))
+	}
+
+	for r := m.rows - 1; r >= 0; r-- {
+		copy(m.matrix[top+r][left:], m.matrix[r][:m.cols])
+		clear(m.matrix[top+r][:left])
+		clear(m.matrix[top+r][left+m.cols : cols])
+	}
+
+	for r := 0; r < top; r++ {
+		clear(m.matrix[r][:cols])
+	}
+
+	m.rows, m.cols = rows, cols
+
+	return m, nil
+}
+
+// Flip will reverse the order of the rows or the columns of this matrix in place.
+func (m *Matrix30x30[T]) Flip(axis immutabilitybenchmarking.Axis) immutabilitybenchmarking.Matrix[T] {
+	if axis == immutabilitybenchmarking.RowAxis {
+		for i, j := 0, m.rows-1; i < j; i, j = i+1, j-1 {
+			m.matrix[i], m.matrix[j] = m.matrix[j], m.matrix[i]
+		}
+
+		return m
+	}
+
+	for r := 0; r < m.rows; r++ {
+		for i, j := 0, m.cols-1; i < j; i, j = i+1, j-1 {
+			m.matrix[r][i], m.matrix[r][j] = m.matrix[r][j], m.matrix[r][i]
+		}
+	}
+
+	return m
+}
+
+// Rotate90 will turn this matrix a quarter turn clockwise. Square matrices are rotated in place, a ring of
+// four elements at a time.
+func (m *Matrix30x30[T]) Rotate90() immutabilitybenchmarking.Matrix[T] {
+	h := m.rows
+
+	if h == m.cols {
+		a := &m.matrix
+
+		for r := 0; r < h/2; r++ {
+			for c := r; c < h-1-r; c++ {
+				a[r][c], a[c][h-1-r], a[h-1-r][h-1-c], a[h-1-c][r] = a[h-1-c][r], a[r][c], a[c][h-1-r], a[h-1-r][h-1-c]
+			}
+		}
+
+		return m
+	}
+
+	n := [30][30]T{}
+
+	for r := 0; r < h; r++ {
+		for c := 0; c < m.cols; c++ {
+			n[c][h-1-r] = m.matrix[r][c]
+		}
+	}
+
+	m.matrix = n
+	m.rows, m.cols = m.cols, m.rows
+
+	return m
+}
+
+// Matrix90x90 is a matrix with mutating operations backed by a 90x90 array. An operation whose result
+// outgrows the array leaves this matrix unchanged and returns the result as a new matrix of the smallest
+// generated type able to hold it.
+type Matrix90x90[T immutabilitybenchmarking.Number] struct {
+	rows   int
+	cols   int
+	matrix [90][90]T
+}
+
+// Width returns the number of columns in the matrix.
+func (m *Matrix90x90[T]) Width() int {
+	return m.cols
+}
+
+// Height returns the number of rows in the matrix.
+func (m *Matrix90x90[T]) Height() int {
+	return m.rows
+}
+
+// Get returns the element at the provided coordinates. It panics with an OutOfBoundsError if they are
+// outside the matrix, as the unused part of the array would otherwise be read as zeros.
+func (m *Matrix90x90[T]) Get(row int, col int) T {
+	if row < 0 || row >= m.rows || col < 0 || col >= m.cols {
+		panic(&immutabilitybenchmarking.OutOfBoundsError{Row: row, Col: col, Rows: m.rows, Cols: m.cols})
+	}
+
+	return m.matrix[row][col]
+}
+
+// At returns the element at the provided coordinates or an OutOfBoundsError if they are outside the matrix.
+func (m *Matrix90x90[T]) At(row int, col int) (T, error) {
+	if row < 0 || row >= m.Height() || col < 0 || col >= m.Width() {
+		var zero T
+		return zero, &immutabilitybenchmarking.OutOfBoundsError{Row: row, Col: col, Rows: m.Height(), Cols: m.Width()}
+	}
+
+	return m.matrix[row][col], nil
+}
+
+// MustAt returns the element at the provided coordinates and panics with an OutOfBoundsError if they are
+// outside the matrix.
+func (m *Matrix90x90[T]) MustAt(row int, col int) T {
+	v, err := m.At(row, col)
+	if err != nil {
+		panic(err)
+	}
+
+	return v
+}
+
+func (m *Matrix90x90[T]) set(row int, col int, v T) {
+	m.matrix[row][col] = v
+}
+
+// Equals will compare a matrix against this matrix and return if they are equal.
+func (m *Matrix90x90[T]) Equals(m2 immutabilitybenchmarking.Matrix[T]) bool {
+	if m.Height() != m2.Height() {
+		return false
+	}
+
+	if m.Width() != m2.Width() {
+		return false
+	}
+
+	for r := 0; r < m.rows; r++ {
+		for c := 0; c < m.cols; c++ {
+			if m.matrix[r][c] != m2.Get(r, c) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// Add will add the values of a matrix to this matrix.
+func (m *Matrix90x90[T]) Add(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m.Height() != m2.Height() || m.Width() != m2.Width() {
+		return nil, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "Add",
+			LeftRows:  m.Height(),
+			LeftCols:  m.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	for r := 0; r < m.rows; r++ {
+		for c := 0; c < m.cols; c++ {
+			m.matrix[r][c] = m.matrix[r][c] + m2.Get(r, c)
+		}
+	}
+
+	return m, nil
+}
+
+// Subtract will subtract the values of a matrix from this matrix.
+func (m *Matrix90x90[T]) Subtract(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m.Height() != m2.Height() || m.Width() != m2.Width() {
+		return nil, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "Subtract",
+			LeftRows:  m.Height(),
+			LeftCols:  m.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	for r := 0; r < m.rows; r++ {
+		for c := 0; c < m.cols; c++ {
+			m.matrix[r][c] = m.matrix[r][c] - m2.Get(r, c)
+		}
+	}
+
+	return m, nil
+}
+
+// ScalarMultiply will multiply this matrix by a given scalar value.
+func (m *Matrix90x90[T]) ScalarMultiply(s T) immutabilitybenchmarking.Matrix[T] {
+	for r := 0; r < m.rows; r++ {
+		for c := 0; c < m.cols; c++ {
+			m.matrix[r][c] = m.matrix[r][c] * s
+		}
+	}
+
+	return m
+}
+
+// Transpose will transpose this matrix.
+func (m *Matrix90x90[T]) Transpose() immutabilitybenchmarking.Matrix[T] {
+	t := [90][90]T{}
+
+	for rt := 0; rt < m.cols; rt++ {
+		for ct := 0; ct < m.rows; ct++ {
+			t[rt][ct] = m.matrix[ct][rt]
+		}
+	}
+
+	m.matrix = t
+	m.rows, m.cols = m.cols, m.rows
+
+	return m
+}
+
+// MatrixMultiply will multiple the given matrix against this matrix.
+func (m *Matrix90x90[T]) MatrixMultiply(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m.Width() != m2.Height() {
+		return nil, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "MatrixMultiply",
+			LeftRows:  m.Height(),
+			LeftCols:  m.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	if m2.Width() > 90 {
+		return Factory[T]{}.FromFunc(m.rows, m2.Width(), func(rm int, cm2 int) T {
+			var product T
+			for cm := 0; cm < m.cols; cm++ {
+				product = product + m.matrix[rm][cm]*m2.Get(cm, cm2)
+			}
+			return product
+		})
+	}
+
+	n := [90][90]T{}
+
+	for rm := 0; rm < m.Height(); rm++ {
+		for cm2 := 0; cm2 < m2.Width(); cm2++ {
+			var product T
+			for cm := 0; cm < m.Width(); cm++ {
+				product = product + m.matrix[rm][cm]*m2.Get(cm, cm2)
+			}
+			n[rm][cm2] = product
+		}
+	}
+
+	m.matrix = n
+	m.cols = m2.Width()
+
+	return m, nil
+}
+
+// Map will apply f to every element of this matrix.
+func (m *Matrix90x90[T]) Map(f func(v T) T) immutabilitybenchmarking.Matrix[T] {
+	for r := 0; r < m.rows; r++ {
+		for c := 0; c < m.cols; c++ {
+			m.matrix[r][c] = f(m.matrix[r][c])
+		}
+	}
+
+	return m
+}
+
+// MapIndexed will apply f to every element of this matrix along with its coordinates.
+func (m *Matrix90x90[T]) MapIndexed(f func(row int, col int, v T) T) immutabilitybenchmarking.Matrix[T] {
+	for r := 0; r < m.rows; r++ {
+		for c := 0; c < m.cols; c++ {
+			m.matrix[r][c] = f(r, c, m.matrix[r][c])
+		}
+	}
+
+	return m
+}
+
+// ZipWith will combine each element of this matrix with the element at the same coordinates of the given
+// matrix using f.
+func (m *Matrix90x90[T]) ZipWith(m2 immutabilitybenchmarking.Matrix[T], f func(a T, b T) T) (immutabilitybenchmarking.Matrix[T], error) {
+	if m.Height() != m2.Height() || m.Width() != m2.Width() {
+		return nil, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "ZipWith",
+			LeftRows:  m.Height(),
+			LeftCols:  m.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	for r := 0; r < m.rows; r++ {
+		for c := 0; c < m.cols; c++ {
+			m.matrix[r][c] = f(m.matrix[r][c], m2.Get(r, c))
+		}
+	}
+
+	return m, nil
+}
+
+// Fold will combine every element of this matrix into a single value, starting from initial and visiting
+// the elements in row-major order.
+func (m *Matrix90x90[T]) Fold(initial T, f func(acc T, v T) T) T {
+	acc := initial
+
+	for r := 0; r < m.rows; r++ {
+		for c := 0; c < m.cols; c++ {
+			acc = f(acc, m.matrix[r][c])
+		}
+	}
+
+	return acc
+}
+
+// RowReduce will fold each row of this matrix into a single value starting from initial, replacing this
+// matrix with a column of the results.
+func (m *Matrix90x90[T]) RowReduce(initial T, f func(acc T, v T) T) immutabilitybenchmarking.Matrix[T] {
+	n := [90][90]T{}
+
+	for r := 0; r < m.rows; r++ {
+		acc := initial
+
+		for c := 0; c < m.cols; c++ {
+			acc = f(acc, m.matrix[r][c])
+		}
+
+		n[r][0] = acc
+	}
+
+	m.matrix = n
+	m.cols = 1
+
+	return m
+}
+
+// ColReduce will fold each column of this matrix into a single value starting from initial, replacing this
+// matrix with a row of the results.
+func (m *Matrix90x90[T]) ColReduce(initial T, f func(acc T, v T) T) immutabilitybenchmarking.Matrix[T] {
+	n := [90][90]T{}
+
+	for c := 0; c < m.cols; c++ {
+		n[0][c] = initial
+	}
+
+	for r := 0; r < m.rows; r++ {
+		for c := 0; c < m.cols; c++ {
+			n[0][c] = f(n[0][c], m.matrix[r][c])
+		}
+	}
+
+	m.matrix = n
+	m.rows = 1
+
+	return m
+}
+
+// HadamardProduct will multiply each element of this matrix by the element at the same coordinates of the
+// given matrix.
+func (m *Matrix90x90[T]) HadamardProduct(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m.Height() != m2.Height() || m.Width() != m2.Width() {
+		return nil, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "HadamardProduct",
+			LeftRows:  m.Height(),
+			LeftCols:  m.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	for r := 0; r < m.rows; r++ {
+		for c := 0; c < m.cols; c++ {
+			m.matrix[r][c] = m.matrix[r][c] * m2.Get(r, c)
+		}
+	}
+
+	return m, nil
+}
+
+// ElementwiseDivide will divide each element of this matrix by the element at the same coordinates of the
+// given matrix. The divisor is checked for zeros before any element is changed, so a DivisionByZeroError
+// leaves this matrix as it was.
+func (m *Matrix90x90[T]) ElementwiseDivide(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m.Height() != m2.Height() || m.Width() != m2.Width() {
+		return nil, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "ElementwiseDivide",
+			LeftRows:  m.Height(),
+			LeftCols:  m.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	for r := 0; r < m.rows; r++ {
+		for c := 0; c < m.cols; c++ {
+			if m2.Get(r, c) == 0 {
+				return nil, &immutabilitybenchmarking.DivisionByZeroError{Op: "ElementwiseDivide", Row: r, Col: c}
+			}
+		}
+	}
+
+	for r := 0; r < m.rows; r++ {
+		for c := 0; c < m.cols; c++ {
+			m.matrix[r][c] = m.matrix[r][c] / m2.Get(r, c)
+		}
+	}
+
+	return m, nil
+}
+
+// KroneckerProduct will multiply every element of this matrix by the whole of the given matrix, replacing
+// this matrix with the blocks of products.
+func (m *Matrix90x90[T]) KroneckerProduct(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	rows, cols := m.rows*m2.Height(), m.cols*m2.Width()
+
+	if rows > 90 || cols > 90 {
+		h2, w2 := m2.Height(), m2.Width()
+
+		return Factory[T]{}.FromFunc(rows, cols, func(r int, c int) T {
+			return m.matrix[r/h2][c/w2] * m2.Get(r%h2, c%w2)
+		})
+	}
+
+	n := [90][90]T{}
+
+	for r1 := 0; r1 < m.rows; r1++ {
+		for r2 := 0; r2 < m2.Height(); r2++ {
+			for c1 := 0; c1 < m.cols; c1++ {
+				for c2 := 0; c2 < m2.Width(); c2++ {
+					n[r1*m2.Height()+r2][c1*m2.Width()+c2] = m.matrix[r1][c1] * m2.Get(r2, c2)
+				}
+			}
+		}
+	}
+
+	m.matrix = n
+	m.rows, m.cols = rows, cols
+
+	return m, nil
+}
+
+// Trace will sum the elements on the diagonal of this matrix, returning a NotSquareError if it is not square.
+func (m *Matrix90x90[T]) Trace() (T, error) {
+	if m.rows != m.cols {
+		var zero T
+		return zero, &immutabilitybenchmarking.NotSquareError{Rows: m.rows, Cols: m.cols}
+	}
+
+	var trace T
+
+	for i := 0; i < m.rows; i++ {
+		trace += m.matrix[i][i]
+	}
+
+	return trace, nil
+}
+
+// Sum will add together every element of this matrix.
+func (m *Matrix90x90[T]) Sum() T {
+	return numeric.Sum(m.rows, func(r int) []T { return m.matrix[r][:m.cols] })
+}
+
+// Min will return the smallest element of this matrix. Complex elements are ordered by their real parts and
+// then by their imaginary parts.
+func (m *Matrix90x90[T]) Min() T {
+	r, c := numeric.ArgMin(m.rows, func(r int) []T { return m.matrix[r][:m.cols] })
+
+	return m.matrix[r][c]
+}
+
+// Max will return the largest element of this matrix. Complex elements are ordered by their real parts and
+// then by their imaginary parts.
+func (m *Matrix90x90[T]) Max() T {
+	r, c := numeric.ArgMax(m.rows, func(r int) []T { return m.matrix[r][:m.cols] })
+
+	return m.matrix[r][c]
+}
+
+// ArgMin will return the coordinates of the first smallest element of this matrix in row-major order.
+func (m *Matrix90x90[T]) ArgMin() (int, int) {
+	return numeric.ArgMin(m.rows, func(r int) []T { return m.matrix[r][:m.cols] })
+}
+
+// ArgMax will return the coordinates of the first largest element of this matrix in row-major order.
+func (m *Matrix90x90[T]) ArgMax() (int, int) {
+	return numeric.ArgMax(m.rows, func(r int) []T { return m.matrix[r][:m.cols] })
+}
+
+// FrobeniusNorm will return the square root of the sum of the squared absolute values of the elements of
+// this matrix.
+func (m *Matrix90x90[T]) FrobeniusNorm() float64 {
+	return numeric.FrobeniusNorm(m.rows, func(r int) []T { return m.matrix[r][:m.cols] })
+}
+
+// OneNorm will return the largest sum of the absolute values in a column of this matrix.
+func (m *Matrix90x90[T]) OneNorm() float64 {
+	return numeric.OneNorm(m.rows, m.cols, func(r int) []T { return m.matrix[r][:m.cols] })
+}
+
+// InfNorm will return the largest sum of the absolute values in a row of this matrix.
+func (m *Matrix90x90[T]) InfNorm() float64 {
+	return numeric.InfNorm(m.rows, func(r int) []T { return m.matrix[r][:m.cols] })
+}
+
+// Rank will return the number of linearly independent rows of this matrix, treating values within rounding
+// error of zero as zero.
+func (m *Matrix90x90[T]) Rank() int {
+	return numeric.Rank(m.rows, m.cols, func(r int) []T { return m.matrix[r][:m.cols] })
+}
+
+// Power will raise this matrix to the power n in place by repeated squaring, returning a NotSquareError if it
+// is not square or a NegativeExponentError if n is negative. The zeroth power is the identity matrix.
+func (m *Matrix90x90[T]) Power(n int) (immutabilitybenchmarking.Matrix[T], error) {
+	if m.rows != m.cols {
+		return nil, &immutabilitybenchmarking.NotSquareError{Rows: m.rows, Cols: m.cols}
+	}
+
+	if n < 0 {
+		return nil, &immutabilitybenchmarking.NegativeExponentError{Exponent: n}
+	}
+
+	// Squaring replaces the elements of m, so the first power kept for the result has to be a copy.
+	p, err := power.BySquaring[immutabilitybenchmarking.Matrix[T]](m, n, func() (immutabilitybenchmarking.Matrix[T], error) {
+		id := &Matrix90x90[T]{rows: m.rows, cols: m.cols}
+
+		for i := 0; i < m.rows; i++ {
+			id.matrix[i][i] = 1
+		}
+
+		return id, nil
+	}, func(b immutabilitybenchmarking.Matrix[T]) immutabilitybenchmarking.Matrix[T] {
+		c := *b.(*Matrix90x90[T])
+		return &c
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	*m = *p.(*Matrix90x90[T])
+
+	return m, nil
+}
+
+// Reshape will read the elements of this matrix in row-major order into the given dimensions, returning a
+// ReshapeError if they would not hold the same number of elements.
+func (m *Matrix90x90[T]) Reshape(rows int, cols int) (immutabilitybenchmarking.Matrix[T], error) {
+	if err := shape.CheckReshape(m, rows, cols); err != nil {
+		return nil, err
+	}
+
+	if rows > 90 || cols > 90 {
+		return rearrange(shape.Reshape[T](m, rows, cols))
+	}
+
+	n := [90][90]T{}
+
+	for i := 0; i < rows*cols; i++ {
+		n[i/cols][i%cols] = m.matrix[i/m.cols][i%m.cols]
+	}
+
+	m.matrix = n
+	m.rows, m.cols = rows, cols
+
+	return m, nil
+}
+
+// HConcat will write the columns of the given matrix after the columns of this matrix, returning a
+// DimensionMismatchError if they do not have the same number of rows.
+func (m *Matrix90x90[T]) HConcat(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if err := shape.CheckHConcat(m, m2); err != nil {
+		return nil, err
+	}
+
+	cols := m.cols + m2.Width()
+
+	if cols > 90 {
+		return rearrange(shape.HConcat[T](m, m2))
+	}
+
+	// Only the unused columns are written, so m2 can be m itself.
+	for r := 0; r < m.rows; r++ {
+		for c := m.cols; c < cols; c++ {
+			m.matrix[r][c] = m2.Get(r, c-m.cols)
+		}
+	}
+
+	m.cols = cols
+
+	return m, nil
+}
+
+// VConcat will write the rows of the given matrix after the rows of this matrix, returning a
+// DimensionMismatchError if they do not have the same number of columns.
+func (m *Matrix90x90[T]) VConcat(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	return m.stack("VConcat", m2)
+}
+
+// Stack will write the rows of each of the given matrices after the rows of this matrix in order, returning
+// a DimensionMismatchError for the first that does not have the same number of columns.
+func (m *Matrix90x90[T]) Stack(ms ...immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	return m.stack("Stack", ms...)
+}
+
+func (m *Matrix90x90[T]) stack(op string, ms ...immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if err := shape.CheckStack(op, m, ms...); err != nil {
+		return nil, err
+	}
+
+	// Any of ms can be m itself, so their heights are taken before the first row is written.
+	heights := make([]int, len(ms))
+	rows := m.rows
+
+	for i, m2 := range ms {
+		heights[i] = m2.Height()
+		rows += heights[i]
+	}
+
+	if rows > 90 {
+		return rearrange(shape.Stack[T](op, m, ms...))
+	}
+
+	for i, m2 := range ms {
+		for r := 0; r < heights[i]; r++ {
+			for c := 0; c < m.cols; c++ {
+				m.matrix[m.rows+r][c] = m2.Get(r, c)
+			}
+		}
+
+		m.rows += heights[i]
+	}
+
+	return m, nil
+}
+
+// SplitRows will keep the rows of this matrix before at and return them along with a new matrix holding the
+// rows from at onwards, returning an InvalidDimensionsError if either part would be empty.
+func (m *Matrix90x90[T]) SplitRows(at int) (immutabilitybenchmarking.Matrix[T], immutabilitybenchmarking.Matrix[T], error) {
+	if err := shape.CheckSplitRows(m, at); err != nil {
+		return nil, nil, err
+	}
+
+	rest := &Matrix90x90[T]{rows: m.rows - at, cols: m.cols}
+
+	for r := at; r < m.rows; r++ {
+		rest.matrix[r-at] = m.matrix[r]
+		m.matrix[r] = [90]T{}
+	}
+
+	m.rows = at
+
+	return m, rest, nil
+}
+
+// SplitCols will keep the columns of this matrix before at and return them along with a new matrix holding
+// the columns from at onwards, returning an InvalidDimensionsError if either part would be empty.
+func (m *Matrix90x90[T]) SplitCols(at int) (immutabilitybenchmarking.Matrix[T], immutabilitybenchmarking.Matrix[T], error) {
+	if err := shape.CheckSplitCols(m, at); err != nil {
+		return nil, nil, err
+	}
+
+	rest := &Matrix90x90[T]{rows: m.rows, cols: m.cols - at}
+
+	for r := 0; r < m.rows; r++ {
+		copy(rest.matrix[r][:], m.matrix[r][at:m.cols])
+		clear(m.matrix[r][at:m.cols])
+	}
+
+	m.cols = at
+
+	return m, rest, nil
+}
+
+// Pad will surround this matrix with the given number of rows and columns of zeros on each side, moving the
+// rows within the array starting from the last so none is overwritten before it has moved. It returns a
+// PaddingError if any of them is negative.
+func (m *Matrix90x90[T]) Pad(top int, bottom int, left int, right int) (immutabilitybenchmarking.Matrix[T], error) {
+	if err := shape.CheckPad(top, bottom, left, right); err != nil {
+		return nil, err
+	}
+
+	rows, cols := top+m.rows+bottom, left+m.cols+right
+
+	if rows > 90 || cols > 90 {
+		return rearrange(shape.Pad[T](m, top, bottom, left, right))
+	}
+
+	for r := m.rows - 1; r >= 0; r-- {
+		copy(m.matrix[top+r][left:], m.matrix[r][:m.cols])
+		clear(m.matrix[top+r][:left])
+		clear(m.matrix[top+r][left+m.cols : cols])
+	}
+
+	for r := 0; r < top; r++ {
+		clear(m.matrix[r][:cols])
+	}
+
+	m.rows, m.cols = rows, cols
+
+	return m, nil
+}
+
+// Flip will reverse the order of the rows or the columns of this matrix in place.
+func (m *Matrix90x90[T]) Flip(axis immutabilitybenchmarking.Axis) immutabilitybenchmarking.Matrix[T] {
+	if axis == immutabilitybenchmarking.RowAxis {
+		for i, j := 0, m.rows-1; i < j; i, j = i+1, j-1 {
+			m.matrix[i], m.matrix[j] = m.matrix[j], m.matrix[i]
+		}
+
+		return m
+	}
+
+	for r := 0; r < m.rows; r++ {
+		for i, j := 0, m.cols-1; i < j; i, j = i+1, j-1 {
+			m.matrix[r][i], m.matrix[r][j] = m.matrix[r][j], m.matrix[r][i]
+		}
+	}
+
+	return m
+}
+
+// Rotate90 will turn this matrix a quarter turn clockwise. Square matrices are rotated in place, a ring of
+// four elements at a time.
+func (m *Matrix90x90[T]) Rotate90() immutabilitybenchmarking.Matrix[T] {
+	h := m.rows
+
+	if h == m.cols {
+		a := &m.matrix
+
+		for r := 0; r < h/2; r++ {
+			for c := r; c < h-1-r; c++ {
+				a[r][c], a[c][h-1-r], a[h-1-r][h-1-c], a[h-1-c][r] = a[h-1-c][r], a[r][c], a[c][h-1-r], a[h-1-r][h-1-c]
+			}
+		}
+
+		return m
+	}
+
+	n := [90][90]T{}
+
+	for r := 0; r < h; r++ {
+		for c := 0; c < m.cols; c++ {
+			n[c][h-1-r] = m.matrix[r][c]
+		}
+	}
+
+	m.matrix = n
+	m.rows, m.cols = m.cols, m.rows
+
+	return m
+}
+
+// Matrix270x270 is a matrix with mutating operations backed by a 270x270 array. An operation whose result
+// outgrows the array leaves this matrix unchanged and returns the result as a new matrix of the smallest
+// generated type able to hold it.
+type Matrix270x270[T immutabilitybenchmarking.Number] struct {
+	rows   int
+	cols   int
+	matrix [270][270]T
+}
+
+// Width returns the number of columns in the matrix.
+func (m *Matrix270x270[T]) Width() int {
+	return m.cols
+}
+
+// Height returns the number of rows in the matrix.
+func (m *Matrix270x270[T]) Height() int {
+	return m.rows
+}
+
+// Get returns the element at the provided coordinates. It panics with an OutOfBoundsError if they are
+// outside the matrix, as the unused part of the array would otherwise be read as zeros.
+func (m *Matrix270x270[T]) Get(row int, col int) T {
+	if row < 0 || row >= m.rows || col < 0 || col >= m.cols {
+		panic(&immutabilitybenchmarking.OutOfBoundsError{Row: row, Col: col, Rows: m.rows, Cols: m.cols})
+	}
+
+	return m.matrix[row][col]
+}
+
+// At returns the element at the provided coordinates or an OutOfBoundsError if they are outside the matrix.
+func (m *Matrix270x270[T]) At(row int, col int) (T, error) {
+	if row < 0 || row >= m.Height() || col < 0 || col >= m.Width() {
+		var zero T
+		return zero, &immutabilitybenchmarking.OutOfBoundsError{Row: row, Col: col, Rows: m.Height(), Cols: m.Width()}
+	}
+
+	return m.matrix[row][col], nil
+}
+
+// MustAt returns the element at the provided coordinates and panics with an OutOfBoundsError if they are
+// outside the matrix.
+func (m *Matrix270x270[T]) MustAt(row int, col int) T {
+	v, err := m.At(row, col)
+	if err != nil {
+		panic(err)
+	}
+
+	return v
+}
+
+func (m *Matrix270x270[T]) set(row int, col int, v T) {
+	m.matrix[row][col] = v
+}
+
+// Equals will compare a matrix against this matrix and return if they are equal.
+func (m *Matrix270x270[T]) Equals(m2 immutabilitybenchmarking.Matrix[T]) bool {
+	if m.Height() != m2.Height() {
+		return false
+	}
+
+	if m.Width() != m2.Width() {
+		return false
+	}
+
+	for r := 0; r < m.rows; r++ {
+		for c := 0; c < m.cols; c++ {
+			if m.matrix[r][c] != m2.Get(r, c) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// Add will add the values of a matrix to this matrix.
+func (m *Matrix270x270[T]) Add(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m.Height() != m2.Height() || m.Width() != m2.Width() {
+		return nil, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "Add",
+			LeftRows:  m.Height(),
+			LeftCols:  m.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	for r := 0; r < m.rows; r++ {
+		for c := 0; c < m.cols; c++ {
+			m.matrix[r][c] = m.matrix[r][c] + m2.Get(r, c)
+		}
+	}
+
+	return m, nil
+}
+
+// Subtract will subtract the values of a matrix from this matrix.
+func (m *Matrix270x270[T]) Subtract(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m.Height() != m2.Height() || m.Width() != m2.Width() {
+		return nil, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "Subtract",
+			LeftRows:  m.Height(),
+			LeftCols:  m.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	for r := 0; r < m.rows; r++ {
+		for c := 0; c < m.cols; c++ {
+			m.matrix[r][c] = m.matrix[r][c] - m2.Get(r, c)
+		}
+	}
+
+	return m, nil
+}
+
+// ScalarMultiply will multiply this matrix by a given scalar value.
+func (m *Matrix270x270[T]) ScalarMultiply(s T) immutabilitybenchmarking.Matrix[T] {
+	for r := 0; r < m.rows; r++ {
+		for c := 0; c < m.cols; c++ {
+			m.matrix[r][c] = m.matrix[r][c] * s
+		}
+	}
+
+	return m
+}
+
+// Transpose will transpose this matrix.
+func (m *Matrix270x270[T]) Transpose() immutabilitybenchmarking.Matrix[T] {
+	t := [270][270]T{}
+
+	for rt := 0; rt < m.cols; rt++ {
+		for ct := 0; ct < m.rows; ct++ {
+			t[rt][ct] = m.matrix[ct][rt]
+		}
+	}
+
+	m.matrix = t
+	m.rows, m.cols = m.cols, m.rows
+
+	return m
+}
+
+// MatrixMultiply will multiple the given matrix against this matrix.
+func (m *Matrix270x270[T]) MatrixMultiply(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m.Width() != m2.Height() {
+		return nil, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "MatrixMultiply",
+			LeftRows:  m.Height(),
+			LeftCols:  m.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	if m2.Width() > 270 {
+		return Factory[T]{}.FromFunc(m.rows, m2.Width(), func(rm int, cm2 int) T {
+			var product T
+			for cm := 0; cm < m.cols; cm++ {
+				product = product + m.matrix[rm][cm]*m2.Get(cm, cm2)
+			}
+			return product
+		})
+	}
+
+	n := [270][270]T{}
+
+	for rm := 0; rm < m.Height(); rm++ {
+		for cm2 := 0; cm2 < m2.Width(); cm2++ {
+			var product T
+			for cm := 0; cm < m.Width(); cm++ {
+				product = product + m.matrix[rm][cm]*m2.Get(cm, cm2)
+			}
+			n[rm][cm2] = product
+		}
+	}
+
+	m.matrix = n
+	m.cols = m2.Width()
+
+	return m, nil
+}
+
+// Map will apply f to every element of this matrix.
+func (m *Matrix270x270[T]) Map(f func(v T) T) immutabilitybenchmarking.Matrix[T] {
+	for r := 0; r < m.rows; r++ {
+		for c := 0; c < m.cols; c++ {
+			m.matrix[r][c] = f(m.matrix[r][c])
+		}
+	}
+
+	return m
+}
+
+// MapIndexed will apply f to every element of this matrix along with its coordinates.
+func (m *Matrix270x270[T]) MapIndexed(f func(row int, col int, v T) T) immutabilitybenchmarking.Matrix[T] {
+	for r := 0; r < m.rows; r++ {
+		for c := 0; c < m.cols; c++ {
+			m.matrix[r][c] = f(r, c, m.matrix[r][c])
+		}
+	}
+
+	return m
+}
+
+// ZipWith will combine each element of this matrix with the element at the same coordinates of the given
+// matrix using f.
+func (m *Matrix270x270[T]) ZipWith(m2 immutabilitybenchmarking.Matrix[T], f func(a T, b T) T) (immutabilitybenchmarking.Matrix[T], error) {
+	if m.Height() != m2.Height() || m.Width() != m2.Width() {
+		return nil, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "ZipWith",
+			LeftRows:  m.Height(),
+			LeftCols:  m.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	for r := 0; r < m.rows; r++ {
+		for c := 0; c < m.cols; c++ {
+			m.matrix[r][c] = f(m.matrix[r][c], m2.Get(r, c))
+		}
+	}
+
+	return m, nil
+}
+
+// Fold will combine every element of this matrix into a single value, starting from initial and visiting
+// the elements in row-major order.
+func (m *Matrix270x270[T]) Fold(initial T, f func(acc T, v T) T) T {
+	acc := initial
+
+	for r := 0; r < m.rows; r++ {
+		for c := 0; c < m.cols; c++ {
+			acc = f(acc, m.matrix[r][c])
+		}
+	}
+
+	return acc
+}
+
+// RowReduce will fold each row of this matrix into a single value starting from initial, replacing this
+// matrix with a column of the results.
+func (m *Matrix270x270[T]) RowReduce(initial T, f func(acc T, v T) T) immutabilitybenchmarking.Matrix[T] {
+	n := [270][270]T{}
+
+	for r := 0; r < m.rows; r++ {
+		acc := initial
+
+		for c := 0; c < m.cols; c++ {
+			acc = f(acc, m.matrix[r][c])
+		}
+
+		n[r][0] = acc
+	}
+
+	m.matrix = n
+	m.cols = 1
+
+	return m
+}
+
+// ColReduce will fold each column of this matrix into a single value starting from initial, replacing this
+// matrix with a row of the results.
+func (m *Matrix270x270[T]) ColReduce(initial T, f func(acc T, v T) T) immutabilitybenchmarking.Matrix[T] {
+	n := [270][270]T{}
+
+	for c := 0; c < m.cols; c++ {
+		n[0][c] = initial
+	}
+
+	for r := 0; r < m.rows; r++ {
+		for c := 0; c < m.cols; c++ {
+			n[0][c] = f(n[0][c], m.matrix[r][c])
+		}
+	}
+
+	m.matrix = n
+	m.rows = 1
+
+	return m
+}
+
+// HadamardProduct will multiply each element of this matrix by the element at the same coordinates of the
+// given matrix.
+func (m *Matrix270x270[T]) HadamardProduct(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m.Height() != m2.Height() || m.Width() != m2.Width() {
+		return nil, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "HadamardProduct",
+			LeftRows:  m.Height(),
+			LeftCols:  m.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	for r := 0; r < m.rows; r++ {
+		for c := 0; c < m.cols; c++ {
+			m.matrix[r][c] = m.matrix[r][c] * m2.Get(r, c)
+		}
+	}
+
+	return m, nil
+}
+
+// ElementwiseDivide will divide each element of this matrix by the element at the same coordinates of the
+// given matrix. The divisor is checked for zeros before any element is changed, so a DivisionByZeroError
+// leaves this matrix as it was.
+func (m *Matrix270x270[T]) ElementwiseDivide(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m.Height() != m2.Height() || m.Width() != m2.Width() {
+		return nil, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "ElementwiseDivide",
+			LeftRows:  m.Height(),
+			LeftCols:  m.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	for r := 0; r < m.rows; r++ {
+		for c := 0; c < m.cols; c++ {
+			if m2.Get(r, c) == 0 {
+				return nil, &immutabilitybenchmarking.DivisionByZeroError{Op: "ElementwiseDivide", Row: r, Col: c}
+			}
+		}
+	}
+
+	for r := 0; r < m.rows; r++ {
+		for c := 0; c < m.cols; c++ {
+			m.matrix[r][c] = m.matrix[r][c] / m2.Get(r, c)
+		}
+	}
+
+	return m, nil
+}
+
+// KroneckerProduct will multiply every element of this matrix by the whole of the given matrix, replacing
+// this matrix with the blocks of products.
+func (m *Matrix270x270[T]) KroneckerProduct(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	rows, cols := m.rows*m2.Height(), m.cols*m2.Width()
+
+	if rows > 270 || cols > 270 {
+		h2, w2 := m2.Height(), m2.Width()
+
+		return Factory[T]{}.FromFunc(rows, cols, func(r int, c int) T {
+			return m.matrix[r/h2][c/w2] * m2.Get(r%h2, c%w2)
+		})
+	}
+
+	n := [270][270]T{}
+
+	for r1 := 0; r1 < m.rows; r1++ {
+		for r2 := 0; r2 < m2.Height(); r2++ {
+			for c1 := 0; c1 < m.cols; c1++ {
+				for c2 := 0; c2 < m2.Width(); c2++ {
+					n[r1*m2.Height()+r2][c1*m2.Width()+c2] = m.matrix[r1][c1] * m2.Get(r2, c2)
+				}
+			}
+		}
+	}
+
+	m.matrix = n
+	m.rows, m.cols = rows, cols
+
+	return m, nil
+}
+
+// Trace will sum the elements on the diagonal of this matrix, returning a NotSquareError if it is not square.
+func (m *Matrix270x270[T]) Trace() (T, error) {
+	if m.rows != m.cols {
+		var zero T
+		return zero, &immutabilitybenchmarking.NotSquareError{Rows: m.rows, Cols: m.cols}
+	}
+
+	var trace T
+
+	for i := 0; i < m.rows; i++ {
+		trace += m.matrix[i][i]
+	}
+
+	return trace, nil
+}
+
+// Sum will add together every element of this matrix.
+func (m *Matrix270x270[T]) Sum() T {
+	return numeric.Sum(m.rows, func(r int) []T { return m.matrix[r][:m.cols] })
+}
+
+// Min will return the smallest element of this matrix. Complex elements are ordered by their real parts and
+// then by their imaginary parts.
+func (m *Matrix270x270[T]) Min() T {
+	r, c := numeric.ArgMin(m.rows, func(r int) []T { return m.matrix[r][:m.cols] })
+
+	return m.matrix[r][c]
+}
+
+// Max will return the largest element of this matrix. Complex elements are ordered by their real parts and
+// then by their imaginary parts.
+func (m *Matrix270x270[T]) Max() T {
+	r, c := numeric.ArgMax(m.rows, func(r int) []T { return m.matrix[r][:m.cols] })
+
+	return m.matrix[r][c]
+}
+
+// ArgMin will return the coordinates of the first smallest element of this matrix in row-major order.
+func (m *Matrix270x270[T]) ArgMin() (int, int) {
+	return numeric.ArgMin(m.rows, func(r int) []T { return m.matrix[r][:m.cols] })
+}
+
+// ArgMax will return the coordinates of the first largest element of this matrix in row-major order.
+func (m *Matrix270x270[T]) ArgMax() (int, int) {
+	return numeric.ArgMax(m.rows, func(r int) []T { return m.matrix[r][:m.cols] })
+}
+
+// FrobeniusNorm will return the square root of the sum of the squared absolute values of the elements of
+// this matrix.
+func (m *Matrix270x270[T]) FrobeniusNorm() float64 {
+	return numeric.FrobeniusNorm(m.rows, func(r int) []T { return m.matrix[r][:m.cols] })
+}
+
+// OneNorm will return the largest sum of the absolute values in a column of this matrix.
+func (m *Matrix270x270[T]) OneNorm() float64 {
+	return numeric.OneNorm(m.rows, m.cols, func(r int) []T { return m.matrix[r][:m.cols] })
+}
+
+// InfNorm will return the largest sum of the absolute values in a row of this matrix.
+func (m *Matrix270x270[T]) InfNorm() float64 {
+	return numeric.InfNorm(m.rows, func(r int) []T { return m.matrix[r][:m.cols] })
+}
+
+// Rank will return the number of linearly independent rows of this matrix, treating values within rounding
+// error of zero as zero.
+func (m *Matrix270x270[T]) Rank() int {
+	return numeric.Rank(m.rows, m.cols, func(r int) []T { return m.matrix[r][:m.cols] })
+}
+
+// Power will raise this matrix to the power n in place by repeated squaring, returning a NotSquareError if it
+// is not square or a NegativeExponentError if n is negative. The zeroth power is the identity matrix.
+func (m *Matrix270x270[T]) Power(n int) (immutabilitybenchmarking.Matrix[T], error) {
+	if m.rows != m.cols {
+		return nil, &immutabilitybenchmarking.NotSquareError{Rows: m.rows, Cols: m.cols}
+	}
+
+	if n < 0 {
+		return nil, &immutabilitybenchmarking.NegativeExponentError{Exponent: n}
+	}
+
+	// Squaring replaces the elements of m, so the first power kept for the result has to be a copy.
+	p, err := power.BySquaring[immutabilitybenchmarking.Matrix[T]](m, n, func() (immutabilitybenchmarking.Matrix[T], error) {
+		id := &Matrix270x270[T]{rows: m.rows, cols: m.cols}
+
+		for i := 0; i < m.rows; i++ {
+			id.matrix[i][i] = 1
+		}
+
+		return id, nil
+	}, func(b immutabilitybenchmarking.Matrix[T]) immutabilitybenchmarking.Matrix[T] {
+		c := *b.(*Matrix270x270[T])
+		return &c
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	*m = *p.(*Matrix270x270[T])
+
+	return m, nil
+}
+
+// Reshape will read the elements of this matrix in row-major order into the given dimensions, returning a
+// ReshapeError if they would not hold the same number of elements.
+func (m *Matrix270x270[T]) Reshape(rows int, cols int) (immutabilitybenchmarking.Matrix[T], error) {
+	if err := shape.CheckReshape(m, rows, cols); err != nil {
+		return nil, err
+	}
+
+	if rows > 270 || cols > 270 {
+		return rearrange(shape.Reshape[T](m, rows, cols))
+	}
+
+	n := [270][270]T{}
+
+	for i := 0; i < rows*cols; i++ {
+		n[i/cols][i%cols] = m.matrix[i/m.cols][i%m.cols]
+	}
+
+	m.matrix = n
+	m.rows, m.cols = rows, cols
+
+	return m, nil
+}
+
+// HConcat will write the columns of the given matrix after the columns of this matrix, returning a
+// DimensionMismatchError if they do not have the same number of rows.
+func (m *Matrix270x270[T]) HConcat(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if err := shape.CheckHConcat(m, m2); err != nil {
+		return nil, err
+	}
+
+	cols := m.cols + m2.Width()
+
+	if cols > 270 {
+		return rearrange(shape.HConcat[T](m, m2))
+	}
+
+	// Only the unused columns are written, so m2 can be m itself.
+	for r := 0; r < m.rows; r++ {
+		for c := m.cols; c < cols; c++ {
+			m.matrix[r][c] = m2.Get(r, c-m.cols)
+		}
+	}
+
+	m.cols = cols
+
+	return m, nil
+}
+
+// VConcat will write the rows of the given matrix after the rows of this matrix, returning a
+// DimensionMismatchError if they do not have the same number of columns.
+func (m *Matrix270x270[T]) VConcat(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	return m.stack("VConcat", m2)
+}
+
+// Stack will write the rows of each of the given matrices after the rows of this matrix in order, returning
+// a DimensionMismatchError for the first that does not have the same number of columns.
+func (m *Matrix270x270[T]) Stack(ms ...immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	return m.stack("Stack", ms...)
+}
+
+func (m *Matrix270x270[T]) stack(op string, ms ...immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if err := shape.CheckStack(op, m, ms...); err != nil {
+		return nil, err
+	}
+
+	// Any of ms can be m itself, so their heights are taken before the first row is written.
+	heights := make([]int, len(ms))
+	rows := m.rows
+
+	for i, m2 := range ms {
+		heights[i] = m2.Height()
+		rows += heights[i]
+	}
+
+	if rows > 270 {
+		return rearrange(shape.Stack[T](op, m, ms...))
+	}
+
+	for i, m2 := range ms {
+		for r := 0; r < heights[i]; r++ {
+			for c := 0; c < m.cols; c++ {
+				m.matrix[m.rows+r][c] = m2.Get(r, c)
+			}
+		}
+
+		m.rows += heights[i]
+	}
+
+	return m, nil
+}
+
+// SplitRows will keep the rows of this matrix before at and return them along with a new matrix holding the
+// rows from at onwards, returning an InvalidDimensionsError if either part would be empty.
+func (m *Matrix270x270[T]) SplitRows(at int) (immutabilitybenchmarking.Matrix[T], immutabilitybenchmarking.Matrix[T], error) {
+	if err := shape.CheckSplitRows(m, at); err != nil {
+		return nil, nil, err
+	}
+
+	rest := &Matrix270x270[T]{rows: m.rows - at, cols: m.cols}
+
+	for r := at; r < m.rows; r++ {
+		rest.matrix[r-at] = m.matrix[r]
+		m.matrix[r] = [270]T{}
+	}
+
+	m.rows = at
+
+	return m, rest, nil
+}
+
+// SplitCols will keep the columns of this matrix before at and return them along with a new matrix holding
+// the columns from at onwards, returning an InvalidDimensionsError if either part would be empty.
+func (m *Matrix270x270[T]) SplitCols(at int) (immutabilitybenchmarking.Matrix[T], immutabilitybenchmarking.Matrix[T], error) {
+	if err := shape.CheckSplitCols(m, at); err != nil {
+		return nil, nil, err
+	}
+
+	rest := &Matrix270x270[T]{rows: m.rows, cols: m.cols - at}
+
+	for r := 0; r < m.rows; r++ {
+		copy(rest.matrix[r][:], m.matrix[r][at:m.cols])
+		clear(m.matrix[r][at:m.cols])
+	}
+
+	m.cols = at
+
+	return m, rest, nil
+}
+
+// Pad will surround this matrix with the given number of rows and columns of zeros on each side, moving the
+// rows within the array starting from the last so none is overwritten before it has moved. It returns a
+// PaddingError if any of them is negative.
+func (m *Matrix270x270[T]) Pad(top int, bottom int, left int, right int) (immutabilitybenchmarking.Matrix[T], error) {
+	if err := shape.CheckPad(top, bottom, left, right); err != nil {
+		return nil, err
+	}
+
+	rows, cols := top+m.rows+bottom, left+m.cols+right
+
+	if rows > 270 || cols > 270 {
+		return rearrange(shape.Pad[T](m, top, bottom, left, right))
+	}
+
+	for r := m.rows - 1; r >= 0; r-- {
+		copy(m.matrix[top+r][left:], m.matrix[r][:m.cols])
+		clear(m.matrix[top+r][:left])
+		clear(m.matrix[top+r][left+m.cols : cols])
+	}
+
+	for r := 0; r < top; r++ {
+		clear(m.matrix[r][:cols])
+	}
+
+	m.rows, m.cols = rows, cols
+
+	return m, nil
+}
+
+// Flip will reverse the order of the rows or the columns of this matrix in place.
+func (m *Matrix270x270[T]) Flip(axis immutabilitybenchmarking.Axis) immutabilitybenchmarking.Matrix[T] {
+	if axis == immutabilitybenchmarking.RowAxis {
+		for i, j := 0, m.rows-1; i < j; i, j = i+1, j-1 {
+			m.matrix[i], m.matrix[j] = m.matrix[j], m.matrix[i]
+		}
+
+		return m
+	}
+
+	for r := 0; r < m.rows; r++ {
+		for i, j := 0, m.cols-1; i < j; i, j = i+1, j-1 {
+			m.matrix[r][i], m.matrix[r][j] = m.matrix[r][j], m.matrix[r][i]
+		}
+	}
+
+	return m
+}
+
+// Rotate90 will turn this matrix a quarter turn clockwise. Square matrices are rotated in place, a ring of
+// four elements at a time.
+func (m *Matrix270x270[T]) Rotate90() immutabilitybenchmarking.Matrix[T] {
+	h := m.rows
+
+	if h == m.cols {
+		a := &m.matrix
+
+		for r := 0; r < h/2; r++ {
+			for c := r; c < h-1-r; c++ {
+				a[r][c], a[c][h-1-r], a[h-1-r][h-1-c], a[h-1-c][r] = a[h-1-c][r], a[r][c], a[c][h-1-r], a[h-1-r][h-1-c]
+			}
+		}
+
+		return m
+	}
+
+	n := [270][270]T{}
+
+	for r := 0; r < h; r++ {
+		for c := 0; c < m.cols; c++ {
+			n[c][h-1-r] = m.matrix[r][c]
+		}
+	}
+
+	m.matrix = n
+	m.rows, m.cols = m.cols, m.rows
+
+	return m
+}
+
+// Matrix810x810 is a matrix with mutating operations backed by a 810x810 array. An operation whose result
+// outgrows the array leaves this matrix unchanged and returns the result as a new matrix of the smallest
+// generated type able to hold it.
+type Matrix810x810[T immutabilitybenchmarking.Number] struct {
+	rows   int
+	cols   int
+	matrix [810][810]T
+}
+
+// Width returns the number of columns in the matrix.
+func (m *Matrix810x810[T]) Width() int {
+	return m.cols
+}
+
+// Height returns the number of rows in the matrix.
+func (m *Matrix810x810[T]) Height() int {
+	return m.rows
+}
+
+// Get returns the element at the provided coordinates. It panics with an OutOfBoundsError if they are
+// outside the matrix, as the unused part of the array would otherwise be read as zeros.
+func (m *Matrix810x810[T]) Get(row int, col int) T {
+	if row < 0 || row >= m.rows || col < 0 || col >= m.cols {
+		panic(&immutabilitybenchmarking.OutOfBoundsError{Row: row, Col: col, Rows: m.rows, Cols: m.cols})
+	}
+
+	return m.matrix[row][col]
+}
+
+// At returns the element at the provided coordinates or an OutOfBoundsError if they are outside the matrix.
+func (m *Matrix810x810[T]) At(row int, col int) (T, error) {
+	if row < 0 || row >= m.Height() || col < 0 || col >= m.Width() {
+		var zero T
+		return zero, &immutabilitybenchmarking.OutOfBoundsError{Row: row, Col: col, Rows: m.Height(), Cols: m.Width()}
+	}
+
+	return m.matrix[row][col], nil
+}
+
+// MustAt returns the element at the provided coordinates and panics with an OutOfBoundsError if they are
+// outside the matrix.
+func (m *Matrix810x810[T]) MustAt(row int, col int) T {
+	v, err := m.At(row, col)
+	if err != nil {
+		panic(err)
+	}
+
+	return v
+}
+
+func (m *Matrix810x810[T]) set(row int, col int, v T) {
+	m.matrix[row][col] = v
+}
+
+// Equals will compare a matrix against this matrix and return if they are equal.
+func (m *Matrix810x810[T]) Equals(m2 immutabilitybenchmarking.Matrix[T]) bool {
+	if m.Height() != m2.Height() {
+		return false
+	}
+
+	if m.Width() != m2.Width() {
+		return false
+	}
+
+	for r := 0; r < m.rows; r++ {
+		for c := 0; c < m.cols; c++ {
+			if m.matrix[r][c] != m2.Get(r, c) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// Add will add the values of a matrix to this matrix.
+func (m *Matrix810x810[T]) Add(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m.Height() != m2.Height() || m.Width() != m2.Width() {
+		return nil, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "Add",
+			LeftRows:  m.Height(),
+			LeftCols:  m.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	for r := 0; r < m.rows; r++ {
+		for c := 0; c < m.cols; c++ {
+			m.matrix[r][c] = m.matrix[r][c] + m2.Get(r, c)
+		}
+	}
+
+	return m, nil
+}
+
+// Subtract will subtract the values of a matrix from this matrix.
+func (m *Matrix810x810[T]) Subtract(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m.Height() != m2.Height() || m.Width() != m2.Width() {
+		return nil, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "Subtract",
+			LeftRows:  m.Height(),
+			LeftCols:  m.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	for r := 0; r < m.rows; r++ {
+		for c := 0; c < m.cols; c++ {
+			m.matrix[r][c] = m.matrix[r][c] - m2.Get(r, c)
+		}
+	}
+
+	return m, nil
+}
+
+// ScalarMultiply will multiply this matrix by a given scalar value.
+func (m *Matrix810x810[T]) ScalarMultiply(s T) immutabilitybenchmarking.Matrix[T] {
+	for r := 0; r < m.rows; r++ {
+		for c := 0; c < m.cols; c++ {
+			m.matrix[r][c] = m.matrix[r][c] * s
+		}
+	}
+
+	return m
+}
+
+// Transpose will transpose this matrix.
+func (m *Matrix810x810[T]) Transpose() immutabilitybenchmarking.Matrix[T] {
+	t := [810][810]T{}
+
+	for rt := 0; rt < m.cols; rt++ {
+		for ct := 0; ct < m.rows; ct++ {
+			t[rt][ct] = m.matrix[ct][rt]
+		}
+	}
+
+	m.matrix = t
+	m.rows, m.cols = m.cols, m.rows
+
+	return m
+}
+
+// MatrixMultiply will multiple the given matrix against this matrix.
+func (m *Matrix810x810[T]) MatrixMultiply(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m.Width() != m2.Height() {
+		return nil, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "MatrixMultiply",
+			LeftRows:  m.Height(),
+			LeftCols:  m.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	if m2.Width() > 810 {
+		return Factory[T]{}.FromFunc(m.rows, m2.Width(), func(rm int, cm2 int) T {
+			var product T
+			for cm := 0; cm < m.cols; cm++ {
+				product = product + m.matrix[rm][cm]*m2.Get(cm, cm2)
+			}
+			return product
+		})
+	}
+
+	n := [810][810]T{}
+
+	for rm := 0; rm < m.Height(); rm++ {
+		for cm2 := 0; cm2 < m2.Width(); cm2++ {
+			var product T
+			for cm := 0; cm < m.Width(); cm++ {
+				product = product + m.matrix[rm][cm]*m2.Get(cm, cm2)
+			}
+			n[rm][cm2] = product
+		}
+	}
+
+	m.matrix = n
+	m.cols = m2.Width()
+
+	return m, nil
+}
+
+// Map will apply f to every element of this matrix.
+func (m *Matrix810x810[T]) Map(f func(v T) T) immutabilitybenchmarking.Matrix[T] {
+	for r := 0; r < m.rows; r++ {
+		for c := 0; c < m.cols; c++ {
+			m.matrix[r][c] = f(m.matrix[r][c])
+		}
+	}
+
+	return m
+}
+
+// MapIndexed will apply f to every element of this matrix along with its coordinates.
+func (m *Matrix810x810[T]) MapIndexed(f func(row int, col int, v T) T) immutabilitybenchmarking.Matrix[T] {
+	for r := 0; r < m.rows; r++ {
+		for c := 0; c < m.cols; c++ {
+			m.matrix[r][c] = f(r, c, m.matrix[r][c])
+		}
+	}
+
+	return m
+}
+
+// ZipWith will combine each element of this matrix with the element at the same coordinates of the given
+// matrix using f.
+func (m *Matrix810x810[T]) ZipWith(m2 immutabilitybenchmarking.Matrix[T], f func(a T, b T) T) (immutabilitybenchmarking.Matrix[T], error) {
+	if m.Height() != m2.Height() || m.Width() != m2.Width() {
+		return nil, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "ZipWith",
+			LeftRows:  m.Height(),
+			LeftCols:  m.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	for r := 0; r < m.rows; r++ {
+		for c := 0; c < m.cols; c++ {
+			m.matrix[r][c] = f(m.matrix[r][c], m2.Get(r, c))
+		}
+	}
+
+	return m, nil
+}
+
+// Fold will combine every element of this matrix into a single value, starting from initial and visiting
+// the elements in row-major order.
+func (m *Matrix810x810[T]) Fold(initial T, f func(acc T, v T) T) T {
+	acc := initial
+
+	for r := 0; r < m.rows; r++ {
+		for c := 0; c < m.cols; c++ {
+			acc = f(acc, m.matrix[r][c])
+		}
+	}
+
+	return acc
+}
+
+// RowReduce will fold each row of this matrix into a single value starting from initial, replacing this
+// matrix with a column of the results.
+func (m *Matrix810x810[T]) RowReduce(initial T, f func(acc T, v T) T) immutabilitybenchmarking.Matrix[T] {
+	n := [810][810]T{}
+
+	for r := 0; r < m.rows; r++ {
+		acc := initial
+
+		for c := 0; c < m.cols; c++ {
+			acc = f(acc, m.matrix[r][c])
+		}
+
+		n[r][0] = acc
+	}
+
+	m.matrix = n
+	m.cols = 1
+
+	return m
+}
+
+// ColReduce will fold each column of this matrix into a single value starting from initial, replacing this
+// matrix with a row of the results.
+func (m *Matrix810x810[T]) ColReduce(initial T, f func(acc T, v T) T) immutabilitybenchmarking.Matrix[T] {
+	n := [810][810]T{}
+
+	for c := 0; c < m.cols; c++ {
+		n[0][c] = initial
+	}
+
+	for r := 0; r < m.rows; r++ {
+		for c := 0; c < m.cols; c++ {
+			n[0][c] = f(n[0][c], m.matrix[r][c])
+		}
+	}
+
+	m.matrix = n
+	m.rows = 1
+
+	return m
+}
+
+// HadamardProduct will multiply each element of this matrix by the element at the same coordinates of the
+// given matrix.
+func (m *Matrix810x810[T]) HadamardProduct(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m.Height() != m2.Height() || m.Width() != m2.Width() {
+		return nil, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "HadamardProduct",
+			LeftRows:  m.Height(),
+			LeftCols:  m.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	for r := 0; r < m.rows; r++ {
+		for c := 0; c < m.cols; c++ {
+			m.matrix[r][c] = m.matrix[r][c] * m2.Get(r, c)
+		}
+	}
+
+	return m, nil
+}
+
+// ElementwiseDivide will divide each element of this matrix by the element at the same coordinates of the
+// given matrix. The divisor is checked for zeros before any element is changed, so a DivisionByZeroError
+// leaves this matrix as it was.
+func (m *Matrix810x810[T]) ElementwiseDivide(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m.Height() != m2.Height() || m.Width() != m2.Width() {
+		return nil, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "ElementwiseDivide",
+			LeftRows:  m.Height(),
+			LeftCols:  m.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	for r := 0; r < m.rows; r++ {
+		for c := 0; c < m.cols; c++ {
+			if m2.Get(r, c) == 0 {
+				return nil, &immutabilitybenchmarking.DivisionByZeroError{Op: "ElementwiseDivide", Row: r, Col: c}
+			}
+		}
+	}
+
+	for r := 0; r < m.rows; r++ {
+		for c := 0; c < m.cols; c++ {
+			m.matrix[r][c] = m.matrix[r][c] / m2.Get(r, c)
+		}
+	}
+
+	return m, nil
+}
+
+// KroneckerProduct will multiply every element of this matrix by the whole of the given matrix, replacing
+// this matrix with the blocks of products.
+func (m *Matrix810x810[T]) KroneckerProduct(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	rows, cols := m.rows*m2.Height(), m.cols*m2.Width()
+
+	if rows > 810 || cols > 810 {
+		h2, w2 := m2.Height(), m2.Width()
+
+		return Factory[T]{}.FromFunc(rows, cols, func(r int, c int) T {
+			return m.matrix[r/h2][c/w2] * m2.Get(r%h2, c%w2)
+		})
+	}
+
+	n := [810][810]T{}
+
+	for r1 := 0; r1 < m.rows; r1++ {
+		for r2 := 0; r2 < m2.Height(); r2++ {
+			for c1 := 0; c1 < m.cols; c1++ {
+				for c2 := 0; c2 < m2.Width(); c2++ {
+					n[r1*m2.Height()+r2][c1*m2.Width()+c2] = m.matrix[r1][c1] * m2.Get(r2, c2)
+				}
+			}
+		}
+	}
+
+	m.matrix = n
+	m.rows, m.cols = rows, cols
+
+	return m, nil
+}
+
+// Trace will sum the elements on the diagonal of this matrix, returning a NotSquareError if it is not square.
+func (m *Matrix810x810[T]) Trace() (T, error) {
+	if m.rows != m.cols {
+		var zero T
+		return zero, &immutabilitybenchmarking.NotSquareError{Rows: m.rows, Cols: m.cols}
+	}
+
+	var trace T
+
+	for i := 0; i < m.rows; i++ {
+		trace += m.matrix[i][i]
+	}
+
+	return trace, nil
+}
+
+// Sum will add together every element of this matrix.
+func (m *Matrix810x810[T]) Sum() T {
+	return numeric.Sum(m.rows, func(r int) []T { return m.matrix[r][:m.cols] })
+}
+
+// Min will return the smallest element of this matrix. Complex elements are ordered by their real parts and
+// then by their imaginary parts.
+func (m *Matrix810x810[T]) Min() T {
+	r, c := numeric.ArgMin(m.rows, func(r int) []T { return m.matrix[r][:m.cols] })
+
+	return m.matrix[r][c]
+}
+
+// Max will return the largest element of this matrix. Complex elements are ordered by their real parts and
+// then by their imaginary parts.
+func (m *Matrix810x810[T]) Max() T {
+	r, c := numeric.ArgMax(m.rows, func(r int) []T { return m.matrix[r][:m.cols] })
+
+	return m.matrix[r][c]
+}
+
+// ArgMin will return the coordinates of the first smallest element of this matrix in row-major order.
+func (m *Matrix810x810[T]) ArgMin() (int, int) {
+	return numeric.ArgMin(m.rows, func(r int) []T { return m.matrix[r][:m.cols] })
+}
+
+// ArgMax will return the coordinates of the first largest element of this matrix in row-major order.
+func (m *Matrix810x810[T]) ArgMax() (int, int) {
+	return numeric.ArgMax(m.rows, func(r int) []T { return m.matrix[r][:m.cols] })
+}
+
+// FrobeniusNorm will return the square root of the sum of the squared absolute values of the elements of
+// this matrix.
+func (m *Matrix810x810[T]) FrobeniusNorm() float64 {
+	return numeric.FrobeniusNorm(m.rows, func(r int) []T { return m.matrix[r][:m.cols] })
+}
+
+// OneNorm will return the largest sum of the absolute values in a column of this matrix.
+func (m *Matrix810x810[T]) OneNorm() float64 {
+	return numeric.OneNorm(m.rows, m.cols, func(r int) []T { return m.matrix[r][:m.cols] })
+}
+
+// InfNorm will return the largest sum of the absolute values in a row of this matrix.
+func (m *Matrix810x810[T]) InfNorm() float64 {
+	return numeric.InfNorm(m.rows, func(r int) []T { return m.matrix[r][:m.cols] })
+}
+
+// Rank will return the number of linearly independent rows of this matrix, treating values within rounding
+// error of zero as zero.
+func (m *Matrix810x810[T]) Rank() int {
+	return numeric.Rank(m.rows, m.cols, func(r int) []T { return m.matrix[r][:m.cols] })
+}
+
+// Power will raise this matrix to the power n in place by repeated squaring, returning a NotSquareError if it
+// is not square or a NegativeExponentError if n is negative. The zeroth power is the identity matrix.
+func (m *Matrix810x810[T]) Power(n int) (immutabilitybenchmarking.Matrix[T], error) {
+	if m.rows != m.cols {
+		return nil, &immutabilitybenchmarking.NotSquareError{Rows: m.rows, Cols: m.cols}
+	}
+
+	if n < 0 {
+		return nil, &immutabilitybenchmarking.NegativeExponentError{Exponent: n}
+	}
+
+	// Squaring replaces the elements of m, so the first power kept for the result has to be a copy.
+	p, err := power.BySquaring[immutabilitybenchmarking.Matrix[T]](m, n, func() (immutabilitybenchmarking.Matrix[T], error) {
+		id := &Matrix810x810[T]{rows: m.rows, cols: m.cols}
+
+		for i := 0; i < m.rows; i++ {
+			id.matrix[i][i] = 1
+		}
+
+		return id, nil
+	}, func(b immutabilitybenchmarking.Matrix[T]) immutabilitybenchmarking.Matrix[T] {
+		c := *b.(*Matrix810x810[T])
+		return &c
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	*m = *p.(*Matrix810x810[T])
+
+	return m, nil
+}
+
+// Reshape will read the elements of this matrix in row-major order into the given dimensions, returning a
+// ReshapeError if they would not hold the same number of elements.
+func (m *Matrix810x810[T]) Reshape(rows int, cols int) (immutabilitybenchmarking.Matrix[T], error) {
+	if err := shape.CheckReshape(m, rows, cols); err != nil {
+		return nil, err
+	}
+
+	if rows > 810 || cols > 810 {
+		return rearrange(shape.Reshape[T](m, rows, cols))
+	}
+
+	n := [810][810]T{}
+
+	for i := 0; i < rows*cols; i++ {
+		n[i/cols][i%cols] = m.matrix[i/m.cols][i%m.cols]
+	}
+
+	m.matrix = n
+	m.rows, m.cols = rows, cols
+
+	return m, nil
+}
+
+// HConcat will write the columns of the given matrix after the columns of this matrix, returning a
+// DimensionMismatchError if they do not have the same number of rows.
+func (m *Matrix810x810[T]) HConcat(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if err := shape.CheckHConcat(m, m2); err != nil {
+		return nil, err
+	}
+
+	cols := m.cols + m2.Width()
+
+	if cols > 810 {
+		return rearrange(shape.HConcat[T](m, m2))
+	}
+
+	// Only the unused columns are written, so m2 can be m itself.
+	for r := 0; r < m.rows; r++ {
+		for c := m.cols; c < cols; c++ {
+			m.matrix[r][c] = m2.Get(r, c-m.cols)
+		}
+	}
+
+	m.cols = cols
+
+	return m, nil
+}
+
+// VConcat will write the rows of the given matrix after the rows of this matrix, returning a
+// DimensionMismatchError if they do not have the same number of columns.
+func (m *Matrix810x810[T]) VConcat(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	return m.stack("VConcat", m2)
+}
+
+// Stack will write the rows of each of the given matrices after the rows of this matrix in order, returning
+// a DimensionMismatchError for the first that does not have the same number of columns.
+func (m *Matrix810x810[T]) Stack(ms ...immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	return m.stack("Stack", ms...)
+}
+
+func (m *Matrix810x810[T]) stack(op string, ms ...immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if err := shape.CheckStack(op, m, ms...); err != nil {
+		return nil, err
+	}
+
+	// Any of ms can be m itself, so their heights are taken before the first row is written.
+	heights := make([]int, len(ms))
+	rows := m.rows
+
+	for i, m2 := range ms {
+		heights[i] = m2.Height()
+		rows += heights[i]
+	}
+
+	if rows > 810 {
+		return rearrange(shape.Stack[T](op, m, ms...))
+	}
+
+	for i, m2 := range ms {
+		for r := 0; r < heights[i]; r++ {
+			for c := 0; c < m.cols; c++ {
+				m.matrix[m.rows+r][c] = m2.Get(r, c)
+			}
+		}
+
+		m.rows += heights[i]
+	}
+
+	return m, nil
+}
+
+// SplitRows will keep the rows of this matrix before at and return them along with a new matrix holding the
+// rows from at onwards, returning an InvalidDimensionsError if either part would be empty.
+func (m *Matrix810x810[T]) SplitRows(at int) (immutabilitybenchmarking.Matrix[T], immutabilitybenchmarking.Matrix[T], error) {
+	if err := shape.CheckSplitRows(m, at); err != nil {
+		return nil, nil, err
+	}
+
+	rest := &Matrix810x810[T]{rows: m.rows - at, cols: m.cols}
+
+	for r := at; r < m.rows; r++ {
+		rest.matrix[r-at] = m.matrix[r]
+		m.matrix[r] = [810]T{}
+	}
+
+	m.rows = at
+
+	return m, rest, nil
+}
+
+// SplitCols will keep the columns of this matrix before at and return them along with a new matrix holding
+// the columns from at onwards, returning an InvalidDimensionsError if either part would be empty.
+func (m *Matrix810x810[T]) SplitCols(at int) (immutabilitybenchmarking.Matrix[T], immutabilitybenchmarking.Matrix[T], error) {
+	if err := shape.CheckSplitCols(m, at); err != nil {
+		return nil, nil, err
+	}
+
+	rest := &Matrix810x810[T]{rows: m.rows, cols: m.cols - at}
+
+	for r := 0; r < m.rows; r++ {
+		copy(rest.matrix[r][:], m.matrix[r][at:m.cols])
+		clear(m.matrix[r][at:m.cols])
+	}
+
+	m.cols = at
+
+	return m, rest, nil
+}
+
+// Pad will surround this matrix with the given number of rows and columns of zeros on each side, moving the
+// rows within the array starting from the last so none is overwritten before it has moved. It returns a
+// PaddingError if any of them is negative.
+func (m *Matrix810x810[T]) Pad(top int, bottom int, left int, right int) (immutabilitybenchmarking.Matrix[T], error) {
+	if err := shape.CheckPad(top, bottom, left, right); err != nil {
+		return nil, err
+	}
+
+	rows, cols := top+m.rows+bottom, left+m.cols+right
+
+	if rows > 810 || cols > 810 {
+		return rearrange(shape.Pad[T](m, top, bottom, left, right))
+	}
+
+	for r := m.rows - 1; r >= 0; r-- {
+		copy(m.matrix[top+r][left:], m.matrix[r][:m.cols])
+		clear(m.matrix[top+r][:left])
+		clear(m.matrix[top+r][left+m.cols : cols])
+	}
+
+	for r := 0; r < top; r++ {
+		clear(m.matrix[r][:cols])
+	}
+
+	m.rows, m.cols = rows, cols
+
+	return m, nil
+}
+
+// Flip will reverse the order of the rows or the columns of this matrix in place.
+func (m *Matrix810x810[T]) Flip(axis immutabilitybenchmarking.Axis) immutabilitybenchmarking.Matrix[T] {
+	if axis == immutabilitybenchmarking.RowAxis {
+		for i, j := 0, m.rows-1; i < j; i, j = i+1, j-1 {
+			m.matrix[i], m.matrix[j] = m.matrix[j], m.matrix[i]
+		}
+
+		return m
+	}
+
+	for r := 0; r < m.rows; r++ {
+		for i, j := 0, m.cols-1; i < j; i, j = i+1, j-1 {
+			m.matrix[r][i], m.matrix[r][j] = m.matrix[r][j], m.matrix[r][i]
+		}
+	}
+
+	return m
+}
+
+// Rotate90 will turn this matrix a quarter turn clockwise. Square matrices are rotated in place, a ring of
+// four elements at a time.
+func (m *Matrix810x810[T]) Rotate90() immutabilitybenchmarking.Matrix[T] {
+	h := m.rows
+
+	if h == m.cols {
+		a := &m.matrix
+
+		for r := 0; r < h/2; r++ {
+			for c := r; c < h-1-r; c++ {
+				a[r][c], a[c][h-1-r], a[h-1-r][h-1-c], a[h-1-c][r] = a[h-1-c][r], a[r][c], a[c][h-1-r], a[h-1-r][h-1-c]
+			}
+		}
+
+		return m
+	}
+
+	n := [810][810]T{}
+
+	for r := 0; r < h; r++ {
+		for c := 0; c < m.cols; c++ {
+			n[c][h-1-r] = m.matrix[r][c]
+		}
+	}
+
+	m.matrix = n
+	m.rows, m.cols = m.cols, m.rows
+
+	return m
+}
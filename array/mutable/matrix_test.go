@@ -0,0 +1,163 @@
+package mutable
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/chris-tomich/immutability-benchmarking"
+	"github.com/chris-tomich/immutability-benchmarking/matrixtest"
+)
+
+func TestMutableMatrixMultiplication(t *testing.T) {
+	m1, _ := New(
+		[][]int{
+			{2, 3, 4},
+			{1, 0, 0},
+		},
+	)
+
+	m2, _ := New(
+		[][]int{
+			{0, 1000},
+			{1, 100},
+			{0, 10},
+		},
+	)
+
+	m3, _ := New(
+		[][]int{
+			{3, 2340},
+			{0, 1000},
+		},
+	)
+
+	m4, err := m1.MatrixMultiply(m2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !m4.Equals(m3) {
+		t.Fail()
+	}
+}
+
+func TestMutableMatrixSizeSelection(t *testing.T) {
+	m1, _ := New(make([][]int, 11, 11))
+	if m1 != nil {
+		t.Error("expected empty rows to be rejected")
+	}
+
+	rows := make([][]float64, 11)
+	for i := range rows {
+		rows[i] = make([]float64, 2)
+	}
+
+	m2, err := New(rows)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := m2.(*Matrix30x30[float64]); !ok {
+		t.Errorf("expected an 11x2 matrix to use Matrix30x30, got %T", m2)
+	}
+
+	if m2.Height() != 11 || m2.Width() != 2 {
+		t.Errorf("expected an 11x2 matrix, got %dx%d", m2.Height(), m2.Width())
+	}
+
+	if m3 := m2.Transpose(); m3.Height() != 2 || m3.Width() != 11 {
+		t.Errorf("expected a 2x11 transpose, got %dx%d", m3.Height(), m3.Width())
+	}
+
+	tooLarge := make([][]float64, Sizes[len(Sizes)-1]+1)
+	for i := range tooLarge {
+		tooLarge[i] = make([]float64, 1)
+	}
+
+	if _, err := New(tooLarge); err == nil {
+		t.Error("expected a matrix larger than every generated size to be rejected")
+	}
+}
+
+func TestMutableMatrixPromotion(t *testing.T) {
+	m1, err := Factory[int]{}.FromFunc(10, 10, func(r int, c int) int { return r*10 + c })
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m2, _ := New([][]int{
+		{1, 0},
+		{0, 2},
+	})
+
+	m3, err := m1.KroneckerProduct(m2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := m3.(*Matrix30x30[int]); !ok {
+		t.Errorf("expected a 20x20 Kronecker product to use Matrix30x30, got %T", m3)
+	}
+
+	if m3.Height() != 20 || m3.Width() != 20 || m3.Get(19, 19) != 198 || m3.Get(19, 18) != 0 {
+		t.Errorf("expected the blocks of the 20x20 Kronecker product, got %dx%d", m3.Height(), m3.Width())
+	}
+
+	m4, err := m1.HConcat(m1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := m4.(*Matrix30x30[int]); !ok || m4.Width() != 20 || m4.Get(9, 19) != 99 {
+		t.Errorf("expected a 10x20 concatenation in a Matrix30x30, got %T", m4)
+	}
+
+	if m1.Height() != 10 || m1.Width() != 10 || m1.Get(9, 9) != 99 {
+		t.Error("expected a promoted result to leave the matrix unchanged")
+	}
+
+	last := Sizes[len(Sizes)-1]
+
+	m5, err := Factory[int]{}.FromFunc(last, 1, func(r int, c int) int { return 1 })
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := m5.VConcat(m5); !errors.As(err, new(*immutabilitybenchmarking.SizeLimitError)) {
+		t.Errorf("expected a result larger than every generated size to be rejected, got %v", err)
+	}
+}
+
+func TestMutableMatrixGetOutOfBounds(t *testing.T) {
+	m, _ := New([][]int{
+		{1, 2, 3},
+		{4, 5, 6},
+	})
+
+	defer func() {
+		err, ok := recover().(*immutabilitybenchmarking.OutOfBoundsError)
+		if !ok || err.Row != 0 || err.Col != 3 || err.Rows != 2 || err.Cols != 3 {
+			t.Errorf("expected a panic with an OutOfBoundsError for (0, 3), got %v", err)
+		}
+	}()
+
+	m.Get(0, 3)
+}
+
+func TestMutableMatrixConformance(t *testing.T) {
+	t.Run("int", func(t *testing.T) {
+		matrixtest.RunConformance[int](t, Factory[int]{})
+	})
+
+	t.Run("uint8", func(t *testing.T) {
+		matrixtest.RunConformance[uint8](t, Factory[uint8]{})
+	})
+
+	t.Run("float64", func(t *testing.T) {
+		matrixtest.RunConformance[float64](t, Factory[float64]{})
+	})
+
+	t.Run("complex128", func(t *testing.T) {
+		matrixtest.RunConformance[complex128](t, Factory[complex128]{})
+	})
+}
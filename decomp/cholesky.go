@@ -0,0 +1,59 @@
+package decomp
+
+import (
+	"github.com/chris-tomich/immutability-benchmarking"
+	"github.com/chris-tomich/immutability-benchmarking/internal/numeric"
+)
+
+// Cholesky is the Cholesky decomposition of a symmetric positive definite matrix, A = L Lᵀ, where L is
+// lower triangular with a positive diagonal.
+type Cholesky[T immutabilitybenchmarking.Float] struct {
+	l [][]T
+}
+
+// NewCholesky returns the Cholesky decomposition of a symmetric positive definite matrix. A matrix that is
+// not symmetric, or not positive definite, returns a StructureError for the element that shows it.
+func NewCholesky[T immutabilitybenchmarking.Float](m immutabilitybenchmarking.Matrix[T]) (Cholesky[T], error) {
+	if err := square(m); err != nil {
+		return Cholesky[T]{}, err
+	}
+
+	if err := symmetric(m); err != nil {
+		return Cholesky[T]{}, err
+	}
+
+	n := m.Height()
+	l := make([][]T, n)
+
+	for r := 0; r < n; r++ {
+		l[r] = make([]T, n)
+
+		for c := 0; c <= r; c++ {
+			sum := m.Get(r, c)
+
+			for k := 0; k < c; k++ {
+				sum -= l[r][k] * l[c][k]
+			}
+
+			if r != c {
+				l[r][c] = sum / l[c][c]
+				continue
+			}
+
+			// A non-positive pivot means no real square root exists, so the matrix is not positive
+			// definite. NaN fails the comparison too.
+			if !(sum > 0) {
+				return Cholesky[T]{}, &immutabilitybenchmarking.StructureError{Structure: "positive definite", Row: r, Col: c}
+			}
+
+			l[r][r] = numeric.Sqrt(sum)
+		}
+	}
+
+	return Cholesky[T]{l: l}, nil
+}
+
+// L returns the lower triangular factor.
+func (d Cholesky[T]) L() immutabilitybenchmarking.Matrix[T] {
+	return matrix(d.l)
+}
@@ -0,0 +1,129 @@
+// Package decomp factorizes matrices from any backend. Each decomposition copies the elements it needs,
+// works on private copies that are never seen outside the package, and returns an immutable value holding
+// its factors as slice backed immutable matrices.
+package decomp
+
+import (
+	"github.com/chris-tomich/immutability-benchmarking"
+	"github.com/chris-tomich/immutability-benchmarking/internal/numeric"
+	sliceimmutable "github.com/chris-tomich/immutability-benchmarking/slice/immutable"
+)
+
+// maxSweeps bounds the number of sweeps the Jacobi methods make. They converge quadratically, so far fewer
+// are needed in practice.
+const maxSweeps = 64
+
+// rows returns a copy of the elements of m that can be changed without affecting it.
+func rows[T immutabilitybenchmarking.Float](m immutabilitybenchmarking.Matrix[T]) [][]T {
+	a := make([][]T, m.Height())
+
+	for r := 0; r < m.Height(); r++ {
+		a[r] = make([]T, m.Width())
+
+		for c := 0; c < m.Width(); c++ {
+			a[r][c] = m.Get(r, c)
+		}
+	}
+
+	return a
+}
+
+// transpose returns the transpose of the given rows.
+func transpose[T immutabilitybenchmarking.Float](a [][]T) [][]T {
+	t := make([][]T, len(a[0]))
+
+	for r := 0; r < len(t); r++ {
+		t[r] = make([]T, len(a))
+
+		for c := 0; c < len(a); c++ {
+			t[r][c] = a[c][r]
+		}
+	}
+
+	return t
+}
+
+// identity returns the rows of an n x n identity matrix.
+func identity[T immutabilitybenchmarking.Float](n int) [][]T {
+	a := make([][]T, n)
+
+	for r := 0; r < n; r++ {
+		a[r] = make([]T, n)
+		a[r][r] = 1
+	}
+
+	return a
+}
+
+// matrix wraps rows that are no longer changed in an immutable matrix without copying them.
+func matrix[T immutabilitybenchmarking.Float](a [][]T) sliceimmutable.Matrix[T] {
+	m, err := sliceimmutable.NewUnsafeNoCopy(a)
+	if err != nil {
+		panic(err)
+	}
+
+	return m
+}
+
+// square returns a NotSquareError if m is not square.
+func square[T immutabilitybenchmarking.Float](m immutabilitybenchmarking.Matrix[T]) error {
+	if m.Height() != m.Width() {
+		return &immutabilitybenchmarking.NotSquareError{Rows: m.Height(), Cols: m.Width()}
+	}
+
+	return nil
+}
+
+// symmetric returns a StructureError for the first element of m that differs from its mirror image.
+func symmetric[T immutabilitybenchmarking.Float](m immutabilitybenchmarking.Matrix[T]) error {
+	for r := 0; r < m.Height(); r++ {
+		for c := r + 1; c < m.Width(); c++ {
+			if m.Get(r, c) != m.Get(c, r) {
+				return &immutabilitybenchmarking.StructureError{Structure: "symmetric", Row: r, Col: c}
+			}
+		}
+	}
+
+	return nil
+}
+
+// rotation returns the cosine and sine of the Jacobi rotation that zeroes the off-diagonal element of the
+// symmetric 2 x 2 matrix [[app, apq], [apq, aqq]].
+func rotation[T immutabilitybenchmarking.Float](app T, aqq T, apq T) (T, T) {
+	theta := (aqq - app) / (2 * apq)
+	t := 1 / (numeric.Abs(theta) + numeric.Sqrt(theta*theta+1))
+
+	if theta < 0 {
+		t = -t
+	}
+
+	c := 1 / numeric.Sqrt(t*t+1)
+
+	return c, t * c
+}
+
+// sortDescending sorts values from largest to smallest, reordering the columns of each of the given
+// matrices to match.
+func sortDescending[T immutabilitybenchmarking.Float](values []T, columns ...[][]T) {
+	for i := 0; i < len(values); i++ {
+		k := i
+
+		for j := i + 1; j < len(values); j++ {
+			if values[j] > values[k] {
+				k = j
+			}
+		}
+
+		if k == i {
+			continue
+		}
+
+		values[i], values[k] = values[k], values[i]
+
+		for _, a := range columns {
+			for r := 0; r < len(a); r++ {
+				a[r][i], a[r][k] = a[r][k], a[r][i]
+			}
+		}
+	}
+}
@@ -0,0 +1,449 @@
+package decomp
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/chris-tomich/immutability-benchmarking"
+	flatimmutable "github.com/chris-tomich/immutability-benchmarking/flat/immutable"
+	flatmutable "github.com/chris-tomich/immutability-benchmarking/flat/mutable"
+	sliceimmutable "github.com/chris-tomich/immutability-benchmarking/slice/immutable"
+	slicemutable "github.com/chris-tomich/immutability-benchmarking/slice/mutable"
+	"github.com/chris-tomich/immutability-benchmarking/structured"
+)
+
+// Decompositions read their input through the Matrix interface, so they are checked against several
+// backends.
+var backends = []struct {
+	name    string
+	factory immutabilitybenchmarking.Factory[float64]
+}{
+	{name: "slice/mutable", factory: slicemutable.Factory[float64]{}},
+	{name: "slice/immutable", factory: sliceimmutable.Factory[float64]{}},
+	{name: "flat/mutable", factory: flatmutable.Factory[float64]{}},
+	{name: "flat/immutable", factory: flatimmutable.Factory[float64]{}},
+}
+
+// shapes are the rows and columns of the matrices decomposed by the tests.
+var shapes = [][2]int{{1, 1}, {12, 12}, {15, 8}, {8, 15}}
+
+const tolerance = 1e-9
+
+func random(t testing.TB, f immutabilitybenchmarking.Factory[float64], rows int, cols int) immutabilitybenchmarking.Matrix[float64] {
+	m, err := f.FromFunc(rows, cols, func(int, int) float64 {
+		return rand.Float64()*2 - 1
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return m
+}
+
+// symmetricRandom returns a random symmetric matrix, made positive definite by adding n to its diagonal.
+func symmetricRandom(t testing.TB, f immutabilitybenchmarking.Factory[float64], n int) immutabilitybenchmarking.Matrix[float64] {
+	b := rows(random(t, sliceimmutable.Factory[float64]{}, n, n))
+
+	m, err := f.FromFunc(n, n, func(row int, col int) float64 {
+		v := b[row][col] + b[col][row]
+
+		if row == col {
+			v += float64(n)
+		}
+
+		return v
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return m
+}
+
+// maxError returns the largest difference between the elements of two matrices of the same size.
+func maxError(t *testing.T, m1 immutabilitybenchmarking.Matrix[float64], m2 immutabilitybenchmarking.Matrix[float64]) float64 {
+	if m1.Height() != m2.Height() || m1.Width() != m2.Width() {
+		t.Fatalf("expected matching sizes but got %dx%d and %dx%d", m1.Height(), m1.Width(), m2.Height(), m2.Width())
+	}
+
+	var e float64
+
+	for r := 0; r < m1.Height(); r++ {
+		for c := 0; c < m1.Width(); c++ {
+			e = math.Max(e, math.Abs(m1.Get(r, c)-m2.Get(r, c)))
+		}
+	}
+
+	return e
+}
+
+func multiply(t *testing.T, ms ...immutabilitybenchmarking.Matrix[float64]) immutabilitybenchmarking.Matrix[float64] {
+	p := ms[0]
+
+	for _, m := range ms[1:] {
+		var err error
+
+		if p, err = p.MatrixMultiply(m); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return p
+}
+
+// diagonal returns the values as a diagonal matrix.
+func diagonal(values []float64) immutabilitybenchmarking.Matrix[float64] {
+	d, err := structured.NewDiagonal(values)
+	if err != nil {
+		panic(err)
+	}
+
+	return d
+}
+
+// checkOrthonormalColumns checks that the columns of m are orthonormal.
+func checkOrthonormalColumns(t *testing.T, name string, m immutabilitybenchmarking.Matrix[float64]) {
+	t.Helper()
+
+	identity, _ := sliceimmutable.Factory[float64]{}.Identity(m.Width())
+
+	if e := maxError(t, multiply(t, m.Transpose(), m), identity); e > tolerance {
+		t.Errorf("expected the columns of %s to be orthonormal but the error was %g", name, e)
+	}
+}
+
+// checkDescending checks that values are sorted from largest to smallest.
+func checkDescending(t *testing.T, values []float64) {
+	t.Helper()
+
+	for i := 1; i < len(values); i++ {
+		if values[i] > values[i-1] {
+			t.Errorf("expected values in descending order but got %v", values)
+			return
+		}
+	}
+}
+
+func TestLU(t *testing.T) {
+	for _, b := range backends {
+		t.Run(b.name, func(t *testing.T) {
+			m := random(t, b.factory, 12, 12)
+			original := rows(m)
+
+			d, err := NewLU(m)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if e := maxError(t, multiply(t, d.P(), m), multiply(t, d.L(), d.U())); e > tolerance {
+				t.Errorf("expected P A = L U but the error was %g", e)
+			}
+
+			for r := 0; r < 12; r++ {
+				for c := r + 1; c < 12; c++ {
+					if d.L().Get(r, c) != 0 || d.U().Get(c, r) != 0 {
+						t.Fatal("expected triangular factors")
+					}
+				}
+			}
+
+			if e := maxError(t, m, matrix(original)); e != 0 {
+				t.Error("expected decomposing to leave the matrix unchanged")
+			}
+		})
+	}
+}
+
+func TestLUSolve(t *testing.T) {
+	a := matrix([][]float64{{0, 2, 1}, {1, 1, 1}, {2, 1, 0}})
+	b := matrix([][]float64{{1}, {2}, {3}})
+
+	d, err := NewLU[float64](a)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if math.Abs(d.Determinant()-3) > tolerance {
+		t.Errorf("expected a determinant of 3 but got %v", d.Determinant())
+	}
+
+	x, err := d.Solve(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if e := maxError(t, multiply(t, a, x), b); e > tolerance {
+		t.Errorf("expected the solution to satisfy the system but the error was %g", e)
+	}
+}
+
+func TestQR(t *testing.T) {
+	for _, b := range backends {
+		for _, shape := range shapes {
+			t.Run(fmt.Sprintf("%s/%dx%d", b.name, shape[0], shape[1]), func(t *testing.T) {
+				m := random(t, b.factory, shape[0], shape[1])
+				original := rows(m)
+
+				d := NewQR(m)
+
+				if e := maxError(t, m, multiply(t, d.Q(), d.R())); e > tolerance {
+					t.Errorf("expected A = Q R but the error was %g", e)
+				}
+
+				checkOrthonormalColumns(t, "Q", d.Q())
+
+				for r := 0; r < shape[0]; r++ {
+					for c := 0; c < r && c < shape[1]; c++ {
+						if d.R().Get(r, c) != 0 {
+							t.Fatalf("expected R to be upper triangular but %d,%d is %v", r, c, d.R().Get(r, c))
+						}
+					}
+				}
+
+				if e := maxError(t, m, matrix(original)); e != 0 {
+					t.Error("expected decomposing to leave the matrix unchanged")
+				}
+			})
+		}
+	}
+}
+
+func TestCholesky(t *testing.T) {
+	for _, b := range backends {
+		t.Run(b.name, func(t *testing.T) {
+			m := symmetricRandom(t, b.factory, 12)
+
+			d, err := NewCholesky(m)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if e := maxError(t, m, multiply(t, d.L(), d.L().Transpose())); e > tolerance {
+				t.Errorf("expected A = L Lᵀ but the error was %g", e)
+			}
+
+			for r := 0; r < 12; r++ {
+				if d.L().Get(r, r) <= 0 {
+					t.Errorf("expected a positive diagonal but %d,%d is %v", r, r, d.L().Get(r, r))
+				}
+
+				for c := r + 1; c < 12; c++ {
+					if d.L().Get(r, c) != 0 {
+						t.Fatal("expected L to be lower triangular")
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestCholeskyErrors(t *testing.T) {
+	var structure *immutabilitybenchmarking.StructureError
+
+	_, err := NewCholesky[float64](matrix([][]float64{{1, 2}, {3, 4}}))
+	if !errors.As(err, &structure) || structure.Structure != "symmetric" {
+		t.Errorf("expected a symmetric StructureError but got %v", err)
+	}
+
+	_, err = NewCholesky[float64](matrix([][]float64{{1, 2}, {2, 1}}))
+	if !errors.As(err, &structure) || structure.Structure != "positive definite" || structure.Row != 1 {
+		t.Errorf("expected a positive definite StructureError at row 1 but got %v", err)
+	}
+
+	var notSquare *immutabilitybenchmarking.NotSquareError
+	if _, err := NewCholesky[float64](matrix([][]float64{{1, 2}})); !errors.As(err, &notSquare) {
+		t.Errorf("expected a NotSquareError but got %v", err)
+	}
+}
+
+func TestEigen(t *testing.T) {
+	for _, b := range backends {
+		t.Run(b.name, func(t *testing.T) {
+			m := symmetricRandom(t, b.factory, 12)
+
+			d, err := NewEigen(m)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			v := d.Vectors()
+
+			if e := maxError(t, multiply(t, m, v), multiply(t, v, diagonal(d.Values()))); e > tolerance {
+				t.Errorf("expected A V = V Λ but the error was %g", e)
+			}
+
+			checkOrthonormalColumns(t, "V", v)
+			checkDescending(t, d.Values())
+		})
+	}
+}
+
+func TestEigenSymmetricStructure(t *testing.T) {
+	s, err := structured.NewSymmetric([][]float64{{2, 1, 0}, {1, 2, 1}, {0, 1, 2}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d, err := NewEigen[float64](s)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The eigenvalues of this tridiagonal matrix are 2 + √2, 2 and 2 - √2.
+	expected := []float64{2 + math.Sqrt2, 2, 2 - math.Sqrt2}
+
+	for i, v := range d.Values() {
+		if math.Abs(v-expected[i]) > tolerance {
+			t.Errorf("expected eigenvalues %v but got %v", expected, d.Values())
+			break
+		}
+	}
+
+	var structure *immutabilitybenchmarking.StructureError
+	if _, err := NewEigen[float64](matrix([][]float64{{1, 2}, {3, 4}})); !errors.As(err, &structure) {
+		t.Errorf("expected a StructureError but got %v", err)
+	}
+}
+
+func TestSVD(t *testing.T) {
+	for _, b := range backends {
+		for _, shape := range shapes {
+			t.Run(fmt.Sprintf("%s/%dx%d", b.name, shape[0], shape[1]), func(t *testing.T) {
+				m := random(t, b.factory, shape[0], shape[1])
+				original := rows(m)
+
+				d := NewSVD(m)
+
+				if e := maxError(t, m, multiply(t, d.U(), diagonal(d.Values()), d.V().Transpose())); e > tolerance {
+					t.Errorf("expected A = U Σ Vᵀ but the error was %g", e)
+				}
+
+				checkOrthonormalColumns(t, "U", d.U())
+				checkOrthonormalColumns(t, "V", d.V())
+				checkDescending(t, d.Values())
+
+				if e := maxError(t, m, matrix(original)); e != 0 {
+					t.Error("expected decomposing to leave the matrix unchanged")
+				}
+			})
+		}
+	}
+}
+
+func TestSVDRankDeficient(t *testing.T) {
+	m := matrix([][]float64{{3, 0}, {0, 4}, {0, 0}})
+
+	d := NewSVD[float64](m)
+
+	if values := d.Values(); math.Abs(values[0]-4) > tolerance || math.Abs(values[1]-3) > tolerance {
+		t.Errorf("expected singular values 4 and 3 but got %v", values)
+	}
+
+	zero := matrix([][]float64{{1, 2}, {2, 4}})
+	d = NewSVD[float64](zero)
+
+	if e := maxError(t, zero, multiply(t, d.U(), diagonal(d.Values()), d.V().Transpose())); e > tolerance {
+		t.Errorf("expected A = U Σ Vᵀ but the error was %g", e)
+	}
+
+	if values := d.Values(); math.Abs(values[1]) > tolerance {
+		t.Errorf("expected a singular value of zero but got %v", values)
+	}
+}
+
+func TestFloat32(t *testing.T) {
+	m, err := sliceimmutable.New([][]float32{{4, 1, 2}, {1, 3, 0}, {2, 0, 5}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d := NewSVD[float32](m)
+	u, v := d.U(), d.V()
+
+	for r := 0; r < 3; r++ {
+		for c := 0; c < 3; c++ {
+			var e float32
+
+			for k := 0; k < 3; k++ {
+				e += u.Get(r, k) * d.Values()[k] * v.Get(c, k)
+			}
+
+			if math.Abs(float64(e-m.Get(r, c))) > 1e-5 {
+				t.Fatalf("expected A = U Σ Vᵀ at %d,%d but got %v", r, c, e)
+			}
+		}
+	}
+}
+
+var benchmarkSizes = []int{10, 30, 90}
+
+// BenchmarkLU compares the in-place LU factorization of slice/mutable with the decomposition here, which
+// copies its input and returns immutable factors.
+func BenchmarkLU(b *testing.B) {
+	for _, size := range benchmarkSizes {
+		a := rows(random(b, sliceimmutable.Factory[float64]{}, size, size))
+
+		b.Run(fmt.Sprintf("InPlace/%dx%d", size, size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				m, _ := slicemutable.New(rows(matrix(a)))
+				b.StartTimer()
+
+				slicemutable.Factorize(m)
+			}
+		})
+
+		b.Run(fmt.Sprintf("Immutable/%dx%d", size, size), func(b *testing.B) {
+			m := matrix(a)
+
+			for i := 0; i < b.N; i++ {
+				NewLU[float64](m)
+			}
+		})
+	}
+}
+
+func BenchmarkDecompositions(b *testing.B) {
+	decompositions := []struct {
+		name      string
+		decompose func(immutabilitybenchmarking.Matrix[float64])
+	}{
+		{name: "LU", decompose: func(m immutabilitybenchmarking.Matrix[float64]) { NewLU(m) }},
+		{name: "QR", decompose: func(m immutabilitybenchmarking.Matrix[float64]) { NewQR(m) }},
+		{name: "Cholesky", decompose: func(m immutabilitybenchmarking.Matrix[float64]) { NewCholesky(m) }},
+		{name: "Eigen", decompose: func(m immutabilitybenchmarking.Matrix[float64]) { NewEigen(m) }},
+		{name: "SVD", decompose: func(m immutabilitybenchmarking.Matrix[float64]) { NewSVD(m) }},
+	}
+
+	for _, d := range decompositions {
+		for _, size := range benchmarkSizes {
+			m := symmetricRandom(b, sliceimmutable.Factory[float64]{}, size)
+
+			b.Run(fmt.Sprintf("%s/%dx%d", d.name, size, size), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					d.decompose(m)
+				}
+			})
+		}
+	}
+}
+
+func TestLUSingular(t *testing.T) {
+	// The last pivot of this matrix is left at about 1e-16 by rounding rather than exactly zero.
+	d, err := NewLU[float64](matrix([][]float64{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if d.Determinant() != 0 {
+		t.Errorf("expected a determinant of 0 but got %v", d.Determinant())
+	}
+
+	var singular *immutabilitybenchmarking.SingularMatrixError
+	if _, err := d.Solve(matrix([][]float64{{1}, {2}, {3}})); !errors.As(err, &singular) || singular.Col != 2 {
+		t.Errorf("expected a SingularMatrixError for column 2 but got %v", err)
+	}
+}
@@ -0,0 +1,106 @@
+package decomp
+
+import (
+	"github.com/chris-tomich/immutability-benchmarking"
+	"github.com/chris-tomich/immutability-benchmarking/internal/numeric"
+)
+
+// Eigen is the eigendecomposition of a symmetric matrix, A = V Λ Vᵀ, where the columns of the orthogonal
+// matrix V are the eigenvectors and Λ is the diagonal matrix of eigenvalues.
+type Eigen[T immutabilitybenchmarking.Float] struct {
+	values  []T
+	vectors [][]T
+}
+
+// NewEigen returns the eigendecomposition of a symmetric matrix, computed with the cyclic Jacobi method. A
+// matrix that is not symmetric returns a StructureError for the first pair of elements that differ.
+func NewEigen[T immutabilitybenchmarking.Float](m immutabilitybenchmarking.Matrix[T]) (Eigen[T], error) {
+	if err := square(m); err != nil {
+		return Eigen[T]{}, err
+	}
+
+	if err := symmetric(m); err != nil {
+		return Eigen[T]{}, err
+	}
+
+	n := m.Height()
+	a := rows(m)
+	v := identity[T](n)
+
+	var total T
+
+	for r := 0; r < n; r++ {
+		for c := 0; c < n; c++ {
+			total += a[r][c] * a[r][c]
+		}
+	}
+
+	tolerance := numeric.Epsilon[T]() * numeric.Epsilon[T]() * total
+
+	for sweep := 0; sweep < maxSweeps; sweep++ {
+		var off T
+
+		for r := 0; r < n; r++ {
+			for c := r + 1; c < n; c++ {
+				off += a[r][c] * a[r][c]
+			}
+		}
+
+		if off <= tolerance {
+			break
+		}
+
+		for p := 0; p < n; p++ {
+			for q := p + 1; q < n; q++ {
+				if a[p][q] == 0 {
+					continue
+				}
+
+				c, s := rotation(a[p][p], a[q][q], a[p][q])
+
+				// A = Jᵀ A J and V = V J, where J rotates in the p, q plane.
+				for k := 0; k < n; k++ {
+					akp, akq := a[k][p], a[k][q]
+					a[k][p] = c*akp - s*akq
+					a[k][q] = s*akp + c*akq
+				}
+
+				for k := 0; k < n; k++ {
+					apk, aqk := a[p][k], a[q][k]
+					a[p][k] = c*apk - s*aqk
+					a[q][k] = s*apk + c*aqk
+				}
+
+				for k := 0; k < n; k++ {
+					vkp, vkq := v[k][p], v[k][q]
+					v[k][p] = c*vkp - s*vkq
+					v[k][q] = s*vkp + c*vkq
+				}
+
+				// The rotation is chosen to zero this pair, which rounding would otherwise leave slightly
+				// off.
+				a[p][q], a[q][p] = 0, 0
+			}
+		}
+	}
+
+	values := make([]T, n)
+
+	for i := 0; i < n; i++ {
+		values[i] = a[i][i]
+	}
+
+	sortDescending(values, v)
+
+	return Eigen[T]{values: values, vectors: v}, nil
+}
+
+// Values returns the eigenvalues from largest to smallest.
+func (d Eigen[T]) Values() []T {
+	return append([]T(nil), d.values...)
+}
+
+// Vectors returns the matrix whose columns are the eigenvectors, in the same order as Values.
+func (d Eigen[T]) Vectors() immutabilitybenchmarking.Matrix[T] {
+	return matrix(d.vectors)
+}
@@ -0,0 +1,65 @@
+package decomp
+
+import (
+	"github.com/chris-tomich/immutability-benchmarking"
+	sliceimmutable "github.com/chris-tomich/immutability-benchmarking/slice/immutable"
+)
+
+// LU is the LU decomposition with partial pivoting of a square matrix, P A = L U, where L is lower
+// triangular with a diagonal of ones and U is upper triangular.
+type LU[T immutabilitybenchmarking.Float] struct {
+	lu sliceimmutable.LU[T]
+}
+
+// NewLU returns the LU decomposition of a square matrix. Singular matrices can be decomposed, but their
+// factors cannot be used to solve systems or find an inverse.
+func NewLU[T immutabilitybenchmarking.Float](m immutabilitybenchmarking.Matrix[T]) (LU[T], error) {
+	if err := square(m); err != nil {
+		return LU[T]{}, err
+	}
+
+	lu, err := sliceimmutable.Factorize(matrix(rows(m)))
+	if err != nil {
+		return LU[T]{}, err
+	}
+
+	return LU[T]{lu: lu}, nil
+}
+
+// L returns the lower triangular factor, whose diagonal is all ones.
+func (d LU[T]) L() immutabilitybenchmarking.Matrix[T] {
+	return d.lu.L()
+}
+
+// U returns the upper triangular factor.
+func (d LU[T]) U() immutabilitybenchmarking.Matrix[T] {
+	return d.lu.U()
+}
+
+// P returns the permutation matrix that reorders the rows of A to match the factors.
+func (d LU[T]) P() immutabilitybenchmarking.Matrix[T] {
+	pivots := d.lu.Pivots()
+	p := make([][]T, len(pivots))
+
+	for r := 0; r < len(pivots); r++ {
+		p[r] = make([]T, len(pivots))
+		p[r][pivots[r]] = 1
+	}
+
+	return matrix(p)
+}
+
+// Determinant returns the determinant of the decomposed matrix.
+func (d LU[T]) Determinant() T {
+	return d.lu.Determinant()
+}
+
+// Solve returns X where A X = B and A is the decomposed matrix.
+func (d LU[T]) Solve(b immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	x, err := d.lu.Solve(b)
+	if err != nil {
+		return nil, err
+	}
+
+	return x, nil
+}
@@ -0,0 +1,99 @@
+package decomp
+
+import (
+	"github.com/chris-tomich/immutability-benchmarking"
+	"github.com/chris-tomich/immutability-benchmarking/internal/numeric"
+)
+
+// QR is the QR decomposition of a matrix, A = Q R, where Q is orthogonal and R is upper triangular.
+type QR[T immutabilitybenchmarking.Float] struct {
+	q [][]T
+	r [][]T
+}
+
+// NewQR returns the QR decomposition of an m x n matrix computed with Householder reflections. Q is m x m
+// and R is m x n.
+func NewQR[T immutabilitybenchmarking.Float](m immutabilitybenchmarking.Matrix[T]) QR[T] {
+	r := rows(m)
+	q := identity[T](m.Height())
+	v := make([]T, m.Height())
+
+	for k := 0; k < m.Height()-1 && k < m.Width(); k++ {
+		var norm T
+
+		for i := k; i < m.Height(); i++ {
+			norm += r[i][k] * r[i][k]
+		}
+
+		if norm == 0 {
+			continue
+		}
+
+		// Reflecting onto the opposite sign of the diagonal avoids cancellation when forming v.
+		alpha := numeric.Sqrt(norm)
+
+		if r[k][k] > 0 {
+			alpha = -alpha
+		}
+
+		var vv T
+
+		for i := k; i < m.Height(); i++ {
+			v[i] = r[i][k]
+
+			if i == k {
+				v[i] -= alpha
+			}
+
+			vv += v[i] * v[i]
+		}
+
+		// R = H R and Q = Q H where H = I - 2 v vᵀ / vᵀv.
+		for c := k; c < m.Width(); c++ {
+			var dot T
+
+			for i := k; i < m.Height(); i++ {
+				dot += v[i] * r[i][c]
+			}
+
+			f := 2 * dot / vv
+
+			for i := k; i < m.Height(); i++ {
+				r[i][c] -= f * v[i]
+			}
+		}
+
+		for row := 0; row < m.Height(); row++ {
+			var dot T
+
+			for i := k; i < m.Height(); i++ {
+				dot += q[row][i] * v[i]
+			}
+
+			f := 2 * dot / vv
+
+			for i := k; i < m.Height(); i++ {
+				q[row][i] -= f * v[i]
+			}
+		}
+
+		// The reflection leaves exact zeros below the diagonal, which rounding would otherwise disturb.
+		r[k][k] = alpha
+
+		for i := k + 1; i < m.Height(); i++ {
+			r[i][k] = 0
+		}
+	}
+
+	return QR[T]{q: q, r: r}
+}
+
+// Q returns the orthogonal factor.
+func (d QR[T]) Q() immutabilitybenchmarking.Matrix[T] {
+	return matrix(d.q)
+}
+
+// R returns the upper triangular factor.
+func (d QR[T]) R() immutabilitybenchmarking.Matrix[T] {
+	return matrix(d.r)
+}
@@ -0,0 +1,115 @@
+package decomp
+
+import (
+	"github.com/chris-tomich/immutability-benchmarking"
+	"github.com/chris-tomich/immutability-benchmarking/internal/numeric"
+)
+
+// SVD is the thin singular value decomposition of an m x n matrix, A = U Σ Vᵀ, where U is m x k and V is
+// n x k with orthonormal columns, Σ is the k x k diagonal matrix of singular values and k is the smaller of
+// m and n.
+type SVD[T immutabilitybenchmarking.Float] struct {
+	u      [][]T
+	values []T
+	v      [][]T
+}
+
+// NewSVD returns the singular value decomposition of a matrix, computed with the one-sided Jacobi method.
+// The columns of U for singular values of zero are left as zeros.
+func NewSVD[T immutabilitybenchmarking.Float](m immutabilitybenchmarking.Matrix[T]) SVD[T] {
+	if m.Height() < m.Width() {
+		// A = U Σ Vᵀ is found from Aᵀ = V Σ Uᵀ so the columns being orthogonalized are never longer than
+		// the matrix is tall.
+		d := svd(transpose(rows(m)))
+
+		return SVD[T]{u: d.v, values: d.values, v: d.u}
+	}
+
+	return svd(rows(m))
+}
+
+// svd decomposes the rows of a matrix that is at least as tall as it is wide, overwriting them with U.
+func svd[T immutabilitybenchmarking.Float](u [][]T) SVD[T] {
+	n := len(u[0])
+	v := identity[T](n)
+	eps := numeric.Epsilon[T]()
+
+	for sweep := 0; sweep < maxSweeps; sweep++ {
+		rotated := false
+
+		for p := 0; p < n; p++ {
+			for q := p + 1; q < n; q++ {
+				var alpha, beta, gamma T
+
+				for k := 0; k < len(u); k++ {
+					alpha += u[k][p] * u[k][p]
+					beta += u[k][q] * u[k][q]
+					gamma += u[k][p] * u[k][q]
+				}
+
+				if gamma == 0 || numeric.Abs(gamma) <= eps*numeric.Sqrt(alpha*beta) {
+					continue
+				}
+
+				rotated = true
+				c, s := rotation(alpha, beta, gamma)
+
+				// Rotating columns p and q of U makes them orthogonal, and V records the rotation.
+				for k := 0; k < len(u); k++ {
+					ukp, ukq := u[k][p], u[k][q]
+					u[k][p] = c*ukp - s*ukq
+					u[k][q] = s*ukp + c*ukq
+				}
+
+				for k := 0; k < n; k++ {
+					vkp, vkq := v[k][p], v[k][q]
+					v[k][p] = c*vkp - s*vkq
+					v[k][q] = s*vkp + c*vkq
+				}
+			}
+		}
+
+		if !rotated {
+			break
+		}
+	}
+
+	values := make([]T, n)
+
+	for c := 0; c < n; c++ {
+		var norm T
+
+		for k := 0; k < len(u); k++ {
+			norm += u[k][c] * u[k][c]
+		}
+
+		values[c] = numeric.Sqrt(norm)
+
+		if values[c] == 0 {
+			continue
+		}
+
+		for k := 0; k < len(u); k++ {
+			u[k][c] /= values[c]
+		}
+	}
+
+	sortDescending(values, u, v)
+
+	return SVD[T]{u: u, values: values, v: v}
+}
+
+// U returns the matrix whose columns are the left singular vectors.
+func (d SVD[T]) U() immutabilitybenchmarking.Matrix[T] {
+	return matrix(d.u)
+}
+
+// Values returns the singular values from largest to smallest.
+func (d SVD[T]) Values() []T {
+	return append([]T(nil), d.values...)
+}
+
+// V returns the matrix whose columns are the right singular vectors.
+func (d SVD[T]) V() immutabilitybenchmarking.Matrix[T] {
+	return matrix(d.v)
+}
@@ -0,0 +1,671 @@
+package differential
+
+import (
+	"flag"
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/chris-tomich/immutability-benchmarking"
+	arrayimmutable "github.com/chris-tomich/immutability-benchmarking/array/immutable"
+	arraymutable "github.com/chris-tomich/immutability-benchmarking/array/mutable"
+	flatimmutable "github.com/chris-tomich/immutability-benchmarking/flat/immutable"
+	flatmutable "github.com/chris-tomich/immutability-benchmarking/flat/mutable"
+	"github.com/chris-tomich/immutability-benchmarking/persistent"
+	sliceimmutable "github.com/chris-tomich/immutability-benchmarking/slice/immutable"
+	slicemutable "github.com/chris-tomich/immutability-benchmarking/slice/mutable"
+	cooimmutable "github.com/chris-tomich/immutability-benchmarking/sparse/coo/immutable"
+	coomutable "github.com/chris-tomich/immutability-benchmarking/sparse/coo/mutable"
+	csrimmutable "github.com/chris-tomich/immutability-benchmarking/sparse/csr/immutable"
+	csrmutable "github.com/chris-tomich/immutability-benchmarking/sparse/csr/mutable"
+)
+
+var (
+	seed       = flag.Int64("seed", 0, "seed for the generated cases, a time based seed is used when zero")
+	iterations = flag.Int("iterations", 200, "number of cases generated for each property")
+)
+
+type backend struct {
+	name    string
+	factory immutabilitybenchmarking.Factory[int]
+}
+
+var backends = []backend{
+	{name: "slice/mutable", factory: slicemutable.Factory[int]{}},
+	{name: "slice/immutable", factory: sliceimmutable.Factory[int]{}},
+	{name: "array/mutable", factory: arraymutable.Factory[int]{}},
+	{name: "array/immutable", factory: arrayimmutable.Factory[int]{}},
+	{name: "flat/mutable", factory: flatmutable.Factory[int]{}},
+	{name: "flat/immutable", factory: flatimmutable.Factory[int]{}},
+	{name: "persistent", factory: persistent.Factory[int]{}},
+	{name: "sparse/csr/mutable", factory: csrmutable.Factory[int]{}},
+	{name: "sparse/csr/immutable", factory: csrimmutable.Factory[int]{}},
+	{name: "sparse/coo/mutable", factory: coomutable.Factory[int]{}},
+	{name: "sparse/coo/immutable", factory: cooimmutable.Factory[int]{}},
+}
+
+// testCase holds the matrices a property is checked against. A and B are rows x inner so they can be
+// added together and C is inner x cols so it can be multiplied by A.
+type testCase struct {
+	a [][]int
+	b [][]int
+	c [][]int
+}
+
+func (tc testCase) String() string {
+	return fmt.Sprintf("A = %v\nB = %v\nC = %v", tc.a, tc.b, tc.c)
+}
+
+// property returns an error describing how the case breaks it or nil if the property holds.
+type property func(tc testCase) error
+
+func randomDimension(r *rand.Rand) int {
+	// Favour single rows and columns so 1xN and Nx1 matrices come up often.
+	if r.Intn(3) == 0 {
+		return 1
+	}
+
+	return 1 + r.Intn(8)
+}
+
+func randomRows(r *rand.Rand, rows int, cols int) [][]int {
+	m := make([][]int, rows)
+
+	for i := 0; i < rows; i++ {
+		m[i] = make([]int, cols)
+
+		for j := 0; j < cols; j++ {
+			m[i][j] = r.Intn(201) - 100
+		}
+	}
+
+	return m
+}
+
+func randomCase(r *rand.Rand) testCase {
+	rows, inner, cols := randomDimension(r), randomDimension(r), randomDimension(r)
+
+	return testCase{
+		a: randomRows(r, rows, inner),
+		b: randomRows(r, rows, inner),
+		c: randomRows(r, inner, cols),
+	}
+}
+
+func copyRows(m [][]int) [][]int {
+	copied := make([][]int, len(m))
+
+	for i := 0; i < len(m); i++ {
+		copied[i] = append([]int(nil), m[i]...)
+	}
+
+	return copied
+}
+
+func (tc testCase) copy() testCase {
+	return testCase{a: copyRows(tc.a), b: copyRows(tc.b), c: copyRows(tc.c)}
+}
+
+// corner returns a copy of at most the top left n x n elements of m.
+func corner(m [][]int, n int) [][]int {
+	c := copyRows(m[:min(n, len(m))])
+
+	for i := range c {
+		c[i] = c[i][:min(n, len(c[i]))]
+	}
+
+	return c
+}
+
+// without returns a copy of s with the element at i removed.
+func without[S any](s []S, i int) []S {
+	return append(append([]S(nil), s[:i]...), s[i+1:]...)
+}
+
+// shrinks returns simpler variations of a case, removing rows and columns first then simplifying values.
+func (tc testCase) shrinks() []testCase {
+	var candidates []testCase
+
+	if len(tc.a) > 1 {
+		for i := range tc.a {
+			s := tc.copy()
+			s.a, s.b = without(s.a, i), without(s.b, i)
+			candidates = append(candidates, s)
+		}
+	}
+
+	if len(tc.c) > 1 {
+		for k := range tc.c {
+			s := tc.copy()
+			for i := range s.a {
+				s.a[i], s.b[i] = without(s.a[i], k), without(s.b[i], k)
+			}
+			s.c = without(s.c, k)
+			candidates = append(candidates, s)
+		}
+	}
+
+	if len(tc.c[0]) > 1 {
+		for j := range tc.c[0] {
+			s := tc.copy()
+			for k := range s.c {
+				s.c[k] = without(s.c[k], j)
+			}
+			candidates = append(candidates, s)
+		}
+	}
+
+	for _, pick := range []func(testCase) [][]int{
+		func(s testCase) [][]int { return s.a },
+		func(s testCase) [][]int { return s.b },
+		func(s testCase) [][]int { return s.c },
+	} {
+		m := pick(tc)
+		for i := range m {
+			for j := range m[i] {
+				if m[i][j] == 0 {
+					continue
+				}
+
+				zeroed := tc.copy()
+				pick(zeroed)[i][j] = 0
+				candidates = append(candidates, zeroed)
+
+				if m[i][j]/2 != 0 {
+					halved := tc.copy()
+					pick(halved)[i][j] = m[i][j] / 2
+					candidates = append(candidates, halved)
+				}
+			}
+		}
+	}
+
+	return candidates
+}
+
+// shrink repeatedly replaces a failing case with the first simpler case that still fails.
+func shrink(tc testCase, p property, err error) (testCase, error) {
+	for {
+		shrunk := false
+
+		for _, candidate := range tc.shrinks() {
+			if candidateErr := p(candidate); candidateErr != nil {
+				tc, err, shrunk = candidate, candidateErr, true
+				break
+			}
+		}
+
+		if !shrunk {
+			return tc, err
+		}
+	}
+}
+
+// check runs a property against randomly generated cases, shrinking and reporting the first failure.
+func check(t *testing.T, p property) {
+	t.Helper()
+
+	s := *seed
+	if s == 0 {
+		s = time.Now().UnixNano()
+	}
+
+	r := rand.New(rand.NewSource(s))
+
+	for i := 0; i < *iterations; i++ {
+		tc := randomCase(r)
+
+		if err := p(tc); err != nil {
+			tc, err = shrink(tc, p, err)
+			t.Fatalf("property failed with -seed=%d: %v\n%v", s, err, tc)
+		}
+	}
+}
+
+func toRows(m immutabilitybenchmarking.Matrix[int]) [][]int {
+	rows := make([][]int, m.Height())
+
+	for r := 0; r < m.Height(); r++ {
+		rows[r] = make([]int, m.Width())
+
+		for c := 0; c < m.Width(); c++ {
+			rows[r][c] = m.Get(r, c)
+		}
+	}
+
+	return rows
+}
+
+func sameRows(m1 [][]int, m2 [][]int) bool {
+	return fmt.Sprint(m1) == fmt.Sprint(m2)
+}
+
+func fromRows(f immutabilitybenchmarking.Factory[int], rows [][]int) immutabilitybenchmarking.Matrix[int] {
+	m, err := f.FromRows(rows)
+	if err != nil {
+		panic(err)
+	}
+
+	return m
+}
+
+// agree returns a property that checks every backend produces the same result for op.
+func agree(op func(f immutabilitybenchmarking.Factory[int], tc testCase) (immutabilitybenchmarking.Matrix[int], error)) property {
+	return func(tc testCase) error {
+		var expected [][]int
+		var results []string
+
+		for i, b := range backends {
+			m, err := op(b.factory, tc)
+			if err != nil {
+				return fmt.Errorf("%s: %v", b.name, err)
+			}
+
+			rows := toRows(m)
+			results = append(results, fmt.Sprintf("%s = %v", b.name, rows))
+
+			if i == 0 {
+				expected = rows
+			} else if !sameRows(expected, rows) {
+				return fmt.Errorf("backends disagree:\n%s", strings.Join(results, "\n"))
+			}
+		}
+
+		return nil
+	}
+}
+
+// law returns a property that checks the given law holds for every backend.
+func law(holds func(f immutabilitybenchmarking.Factory[int], tc testCase) (bool, error)) property {
+	return func(tc testCase) error {
+		for _, b := range backends {
+			ok, err := holds(b.factory, tc)
+			if err != nil {
+				return fmt.Errorf("%s: %v", b.name, err)
+			}
+
+			if !ok {
+				return fmt.Errorf("%s does not obey the law", b.name)
+			}
+		}
+
+		return nil
+	}
+}
+
+func TestBackendsAgreeOnAdd(t *testing.T) {
+	check(t, agree(func(f immutabilitybenchmarking.Factory[int], tc testCase) (immutabilitybenchmarking.Matrix[int], error) {
+		return fromRows(f, tc.a).Add(fromRows(f, tc.b))
+	}))
+}
+
+func TestBackendsAgreeOnSubtract(t *testing.T) {
+	check(t, agree(func(f immutabilitybenchmarking.Factory[int], tc testCase) (immutabilitybenchmarking.Matrix[int], error) {
+		return fromRows(f, tc.a).Subtract(fromRows(f, tc.b))
+	}))
+}
+
+func TestBackendsAgreeOnScalarMultiply(t *testing.T) {
+	check(t, agree(func(f immutabilitybenchmarking.Factory[int], tc testCase) (immutabilitybenchmarking.Matrix[int], error) {
+		return fromRows(f, tc.a).ScalarMultiply(tc.b[0][0]), nil
+	}))
+}
+
+func TestBackendsAgreeOnTranspose(t *testing.T) {
+	check(t, agree(func(f immutabilitybenchmarking.Factory[int], tc testCase) (immutabilitybenchmarking.Matrix[int], error) {
+		return fromRows(f, tc.a).Transpose(), nil
+	}))
+}
+
+func TestBackendsAgreeOnMatrixMultiply(t *testing.T) {
+	check(t, agree(func(f immutabilitybenchmarking.Factory[int], tc testCase) (immutabilitybenchmarking.Matrix[int], error) {
+		return fromRows(f, tc.a).MatrixMultiply(fromRows(f, tc.c))
+	}))
+}
+
+func TestBackendsAgreeOnMap(t *testing.T) {
+	check(t, agree(func(f immutabilitybenchmarking.Factory[int], tc testCase) (immutabilitybenchmarking.Matrix[int], error) {
+		return fromRows(f, tc.a).Map(func(v int) int { return v*v - tc.b[0][0] }), nil
+	}))
+}
+
+func TestBackendsAgreeOnZipWith(t *testing.T) {
+	check(t, agree(func(f immutabilitybenchmarking.Factory[int], tc testCase) (immutabilitybenchmarking.Matrix[int], error) {
+		return fromRows(f, tc.a).ZipWith(fromRows(f, tc.b), func(a int, b int) int { return a*b - tc.c[0][0] })
+	}))
+}
+
+func TestBackendsAgreeOnHadamardProduct(t *testing.T) {
+	check(t, agree(func(f immutabilitybenchmarking.Factory[int], tc testCase) (immutabilitybenchmarking.Matrix[int], error) {
+		return fromRows(f, tc.a).HadamardProduct(fromRows(f, tc.b))
+	}))
+}
+
+func TestBackendsAgreeOnElementwiseDivide(t *testing.T) {
+	check(t, agree(func(f immutabilitybenchmarking.Factory[int], tc testCase) (immutabilitybenchmarking.Matrix[int], error) {
+		divisor := fromRows(f, tc.b).Map(func(v int) int {
+			if v == 0 {
+				return 1
+			}
+
+			return v
+		})
+
+		return fromRows(f, tc.a).ElementwiseDivide(divisor)
+	}))
+}
+
+func TestBackendsAgreeOnKroneckerProduct(t *testing.T) {
+	check(t, agree(func(f immutabilitybenchmarking.Factory[int], tc testCase) (immutabilitybenchmarking.Matrix[int], error) {
+		// 4x4 corners keep the products small while still outgrowing the smallest array backed matrices.
+		return fromRows(f, corner(tc.a, 4)).KroneckerProduct(fromRows(f, corner(tc.c, 4)))
+	}))
+}
+
+// square returns a copy of the largest square in the top left of m.
+func square(m [][]int) [][]int {
+	return corner(m, min(len(m), len(m[0])))
+}
+
+// exponent returns a power between 0 and 11 picked by a generated value.
+func exponent(v int) int {
+	return (v + 100) % 12
+}
+
+func TestBackendsAgreeOnPower(t *testing.T) {
+	check(t, agree(func(f immutabilitybenchmarking.Factory[int], tc testCase) (immutabilitybenchmarking.Matrix[int], error) {
+		return fromRows(f, square(tc.a)).Power(exponent(tc.b[0][0]))
+	}))
+}
+
+func TestBackendsAgreeOnReshape(t *testing.T) {
+	check(t, agree(func(f immutabilitybenchmarking.Factory[int], tc testCase) (immutabilitybenchmarking.Matrix[int], error) {
+		return fromRows(f, tc.a).Reshape(len(tc.a[0]), len(tc.a))
+	}))
+}
+
+func TestBackendsAgreeOnHConcat(t *testing.T) {
+	check(t, agree(func(f immutabilitybenchmarking.Factory[int], tc testCase) (immutabilitybenchmarking.Matrix[int], error) {
+		return fromRows(f, tc.a).HConcat(fromRows(f, tc.b))
+	}))
+}
+
+func TestBackendsAgreeOnStack(t *testing.T) {
+	check(t, agree(func(f immutabilitybenchmarking.Factory[int], tc testCase) (immutabilitybenchmarking.Matrix[int], error) {
+		return fromRows(f, tc.a).Stack(fromRows(f, tc.b), fromRows(f, tc.a))
+	}))
+}
+
+// halves returns A stacked on B, so it always has at least two rows to split, and the row to split it at
+// picked by a generated value.
+func halves(f immutabilitybenchmarking.Factory[int], tc testCase) (immutabilitybenchmarking.Matrix[int], int, error) {
+	m, err := fromRows(f, corner(tc.a, 5)).VConcat(fromRows(f, corner(tc.b, 5)))
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return m, 1 + (tc.c[0][0]+100)%(m.Height()-1), nil
+}
+
+func TestBackendsAgreeOnSplitRows(t *testing.T) {
+	check(t, agree(func(f immutabilitybenchmarking.Factory[int], tc testCase) (immutabilitybenchmarking.Matrix[int], error) {
+		m, at, err := halves(f, tc)
+		if err != nil {
+			return nil, err
+		}
+
+		top, bottom, err := m.SplitRows(at)
+		if err != nil {
+			return nil, err
+		}
+
+		return bottom.VConcat(top)
+	}))
+}
+
+func TestBackendsAgreeOnSplitCols(t *testing.T) {
+	check(t, agree(func(f immutabilitybenchmarking.Factory[int], tc testCase) (immutabilitybenchmarking.Matrix[int], error) {
+		m, at, err := halves(f, tc)
+		if err != nil {
+			return nil, err
+		}
+
+		left, right, err := m.Transpose().SplitCols(at)
+		if err != nil {
+			return nil, err
+		}
+
+		return right.HConcat(left)
+	}))
+}
+
+func TestBackendsAgreeOnPad(t *testing.T) {
+	check(t, agree(func(f immutabilitybenchmarking.Factory[int], tc testCase) (immutabilitybenchmarking.Matrix[int], error) {
+		b := tc.b[0]
+		amount := func(i int) int { return (b[i%len(b)] + 100) % 3 }
+
+		return fromRows(f, tc.a).Pad(amount(0), amount(1), amount(2), amount(3))
+	}))
+}
+
+func TestBackendsAgreeOnFlip(t *testing.T) {
+	check(t, agree(func(f immutabilitybenchmarking.Factory[int], tc testCase) (immutabilitybenchmarking.Matrix[int], error) {
+		axis := immutabilitybenchmarking.Axis((tc.b[0][0] + 100) % 2)
+
+		return fromRows(f, tc.a).Flip(axis), nil
+	}))
+}
+
+func TestBackendsAgreeOnRotate90(t *testing.T) {
+	check(t, agree(func(f immutabilitybenchmarking.Factory[int], tc testCase) (immutabilitybenchmarking.Matrix[int], error) {
+		return fromRows(f, tc.a).Rotate90(), nil
+	}))
+}
+
+func TestBackendsAgreeOnRowReduce(t *testing.T) {
+	check(t, agree(func(f immutabilitybenchmarking.Factory[int], tc testCase) (immutabilitybenchmarking.Matrix[int], error) {
+		return fromRows(f, tc.a).RowReduce(tc.b[0][0], func(acc int, v int) int { return acc*3 + v }), nil
+	}))
+}
+
+func TestBackendsAgreeOnColReduce(t *testing.T) {
+	check(t, agree(func(f immutabilitybenchmarking.Factory[int], tc testCase) (immutabilitybenchmarking.Matrix[int], error) {
+		return fromRows(f, tc.a).ColReduce(tc.b[0][0], func(acc int, v int) int { return acc*3 + v }), nil
+	}))
+}
+
+func TestBackendsAgreeOnReductions(t *testing.T) {
+	check(t, func(tc testCase) error {
+		var expected string
+
+		for i, b := range backends {
+			m := fromRows(b.factory, tc.a)
+			trace, err := m.Trace()
+			minRow, minCol := m.ArgMin()
+			maxRow, maxCol := m.ArgMax()
+
+			got := fmt.Sprintf("trace %v %v, sum %v, min %v at %d,%d, max %v at %d,%d, norms %v %v %v, rank %d",
+				trace, err, m.Sum(), m.Min(), minRow, minCol, m.Max(), maxRow, maxCol,
+				m.FrobeniusNorm(), m.OneNorm(), m.InfNorm(), m.Rank())
+
+			if i == 0 {
+				expected = got
+			} else if got != expected {
+				return fmt.Errorf("backends disagree:\n%s = %s\n%s = %s", backends[0].name, expected, b.name, got)
+			}
+		}
+
+		return nil
+	})
+}
+
+func TestBackendsAgreeOnEquals(t *testing.T) {
+	check(t, func(tc testCase) error {
+		for _, other := range []testCase{tc, {a: tc.b}, {a: tc.c}} {
+			expected := sameRows(tc.a, other.a)
+
+			for _, b := range backends {
+				if fromRows(b.factory, tc.a).Equals(fromRows(b.factory, other.a)) != expected {
+					return fmt.Errorf("%s: expected Equals to return %v comparing with %v", b.name, expected, other.a)
+				}
+			}
+		}
+
+		return nil
+	})
+}
+
+func TestAdditionIsCommutative(t *testing.T) {
+	check(t, law(func(f immutabilitybenchmarking.Factory[int], tc testCase) (bool, error) {
+		ab, err := fromRows(f, tc.a).Add(fromRows(f, tc.b))
+		if err != nil {
+			return false, err
+		}
+
+		ba, err := fromRows(f, tc.b).Add(fromRows(f, tc.a))
+		if err != nil {
+			return false, err
+		}
+
+		return sameRows(toRows(ab), toRows(ba)), nil
+	}))
+}
+
+func TestTransposeOfProduct(t *testing.T) {
+	check(t, law(func(f immutabilitybenchmarking.Factory[int], tc testCase) (bool, error) {
+		ac, err := fromRows(f, tc.a).MatrixMultiply(fromRows(f, tc.c))
+		if err != nil {
+			return false, err
+		}
+
+		ctat, err := fromRows(f, tc.c).Transpose().MatrixMultiply(fromRows(f, tc.a).Transpose())
+		if err != nil {
+			return false, err
+		}
+
+		return sameRows(toRows(ac.Transpose()), toRows(ctat)), nil
+	}))
+}
+
+func TestSubtractingSelfIsZero(t *testing.T) {
+	check(t, law(func(f immutabilitybenchmarking.Factory[int], tc testCase) (bool, error) {
+		aa, err := fromRows(f, tc.a).Subtract(fromRows(f, tc.a))
+		if err != nil {
+			return false, err
+		}
+
+		zeros, err := f.Zeros(len(tc.a), len(tc.a[0]))
+		if err != nil {
+			return false, err
+		}
+
+		return aa.Equals(zeros), nil
+	}))
+}
+
+func TestRankOfTranspose(t *testing.T) {
+	check(t, law(func(f immutabilitybenchmarking.Factory[int], tc testCase) (bool, error) {
+		a := fromRows(f, tc.a)
+		rank := a.Rank()
+
+		return rank == a.Transpose().Rank(), nil
+	}))
+}
+
+func TestPowersAddExponents(t *testing.T) {
+	check(t, law(func(f immutabilitybenchmarking.Factory[int], tc testCase) (bool, error) {
+		j, k := exponent(tc.b[0][0]), exponent(tc.c[0][0])
+
+		// Each power gets its own matrix as the mutable backends raise the receiver in place.
+		aj, err := fromRows(f, square(tc.a)).Power(j)
+		if err != nil {
+			return false, err
+		}
+
+		ak, err := fromRows(f, square(tc.a)).Power(k)
+		if err != nil {
+			return false, err
+		}
+
+		product, err := aj.MatrixMultiply(ak)
+		if err != nil {
+			return false, err
+		}
+
+		sum, err := fromRows(f, square(tc.a)).Power(j + k)
+		if err != nil {
+			return false, err
+		}
+
+		return product.Equals(sum), nil
+	}))
+}
+
+func TestRotatingFourTimesIsIdentity(t *testing.T) {
+	check(t, law(func(f immutabilitybenchmarking.Factory[int], tc testCase) (bool, error) {
+		m := fromRows(f, tc.a)
+
+		for i := 0; i < 4; i++ {
+			m = m.Rotate90()
+		}
+
+		return sameRows(toRows(m), tc.a), nil
+	}))
+}
+
+func TestSplitRowsThenVConcatRestores(t *testing.T) {
+	check(t, law(func(f immutabilitybenchmarking.Factory[int], tc testCase) (bool, error) {
+		m, at, err := halves(f, tc)
+		if err != nil {
+			return false, err
+		}
+
+		// The mutable backends split the receiver in place, so the expected rows are taken first.
+		expected := toRows(m)
+
+		top, bottom, err := m.SplitRows(at)
+		if err != nil {
+			return false, err
+		}
+
+		restored, err := top.VConcat(bottom)
+		if err != nil {
+			return false, err
+		}
+
+		return sameRows(toRows(restored), expected), nil
+	}))
+}
+
+func TestShrinkFindsMinimalCase(t *testing.T) {
+	// A property that fails whenever A holds a value above 10 should shrink to a 1x1 A holding 11 to 20.
+	p := func(tc testCase) error {
+		for _, row := range tc.a {
+			for _, v := range row {
+				if v > 10 {
+					return fmt.Errorf("found %d", v)
+				}
+			}
+		}
+
+		return nil
+	}
+
+	tc := testCase{
+		a: [][]int{{1, 2, 3}, {4, 50, 6}},
+		b: [][]int{{7, 8, 9}, {1, 2, 3}},
+		c: [][]int{{1, 2}, {3, 4}, {5, 6}},
+	}
+
+	shrunk, _ := shrink(tc, p, p(tc))
+
+	if len(shrunk.a) != 1 || len(shrunk.a[0]) != 1 || len(shrunk.c[0]) != 1 {
+		t.Fatalf("expected a minimal case but got\n%v", shrunk)
+	}
+
+	if shrunk.a[0][0] <= 10 || shrunk.a[0][0] > 20 {
+		t.Errorf("expected the failing value to shrink to between 11 and 20 but got %d", shrunk.a[0][0])
+	}
+}
@@ -0,0 +1,3 @@
+// Package differential holds property based tests that check every backend computes the same results and
+// obeys the same algebraic laws.
+package differential
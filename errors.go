@@ -0,0 +1,153 @@
+package immutabilitybenchmarking
+
+import "fmt"
+
+// DimensionMismatchError is returned when an operation is given two matrices whose dimensions are not
+// compatible with each other.
+type DimensionMismatchError struct {
+	Op        string
+	LeftRows  int
+	LeftCols  int
+	RightRows int
+	RightCols int
+}
+
+func (e *DimensionMismatchError) Error() string {
+	return fmt.Sprintf("%s: the dimensions of a %dx%d matrix and a %dx%d matrix are incompatible", e.Op, e.LeftRows, e.LeftCols, e.RightRows, e.RightCols)
+}
+
+// InvalidDimensionsError is returned when a matrix is requested with a number of rows or columns that is not
+// positive.
+type InvalidDimensionsError struct {
+	Rows int
+	Cols int
+}
+
+func (e *InvalidDimensionsError) Error() string {
+	return fmt.Sprintf("a %dx%d matrix is invalid, width and height must both be positive", e.Rows, e.Cols)
+}
+
+// JaggedRowsError is returned when the rows given for a matrix do not all have the same width.
+type JaggedRowsError struct {
+	Row      int
+	Width    int
+	Expected int
+}
+
+func (e *JaggedRowsError) Error() string {
+	return fmt.Sprintf("row %d has %d values but the matrix is %d wide", e.Row, e.Width, e.Expected)
+}
+
+// SizeLimitError is returned when a matrix is larger than a backend is able to hold.
+type SizeLimitError struct {
+	Rows  int
+	Cols  int
+	Limit int
+}
+
+func (e *SizeLimitError) Error() string {
+	return fmt.Sprintf("a %dx%d matrix is larger than the limit of %dx%d", e.Rows, e.Cols, e.Limit, e.Limit)
+}
+
+// OutOfBoundsError is returned when an element is requested from outside the dimensions of a matrix.
+type OutOfBoundsError struct {
+	Row  int
+	Col  int
+	Rows int
+	Cols int
+}
+
+func (e *OutOfBoundsError) Error() string {
+	return fmt.Sprintf("%d,%d is outside of a %dx%d matrix", e.Row, e.Col, e.Rows, e.Cols)
+}
+
+// OverflowError is returned when an integer operation produces a value that cannot be represented in the
+// element type of a matrix.
+type OverflowError struct {
+	Op  string
+	Row int
+	Col int
+}
+
+func (e *OverflowError) Error() string {
+	return fmt.Sprintf("%s: the result at %d,%d overflows", e.Op, e.Row, e.Col)
+}
+
+// NotSquareError is returned when an operation that needs a square matrix is given one that is not square.
+type NotSquareError struct {
+	Rows int
+	Cols int
+}
+
+func (e *NotSquareError) Error() string {
+	return fmt.Sprintf("a %dx%d matrix is not square", e.Rows, e.Cols)
+}
+
+// StructureError is returned when values given for a structured matrix, such as a triangular or symmetric
+// one, do not have that structure.
+type StructureError struct {
+	Structure string
+	Row       int
+	Col       int
+}
+
+func (e *StructureError) Error() string {
+	return fmt.Sprintf("the value at %d,%d does not fit a %s matrix", e.Row, e.Col, e.Structure)
+}
+
+// SingularMatrixError is returned when an operation needs the inverse of a matrix that has none, reporting
+// the column of the first zero pivot found while factorizing it.
+type SingularMatrixError struct {
+	Col int
+}
+
+func (e *SingularMatrixError) Error() string {
+	return fmt.Sprintf("the matrix is singular: column %d has no non-zero pivot", e.Col)
+}
+
+// DivisionByZeroError is returned when an elementwise division is given a divisor with a zero element,
+// reporting where the first one is.
+type DivisionByZeroError struct {
+	Op  string
+	Row int
+	Col int
+}
+
+func (e *DivisionByZeroError) Error() string {
+	return fmt.Sprintf("%s: the divisor at %d,%d is zero", e.Op, e.Row, e.Col)
+}
+
+// NegativeExponentError is returned when a matrix is raised to a negative power, which would need its
+// inverse.
+type NegativeExponentError struct {
+	Exponent int
+}
+
+func (e *NegativeExponentError) Error() string {
+	return fmt.Sprintf("a matrix cannot be raised to the negative power %d", e.Exponent)
+}
+
+// ReshapeError is returned when a matrix is reshaped to dimensions that do not hold the same number of
+// elements.
+type ReshapeError struct {
+	Rows    int
+	Cols    int
+	NewRows int
+	NewCols int
+}
+
+func (e *ReshapeError) Error() string {
+	return fmt.Sprintf("a %dx%d matrix cannot be reshaped to %dx%d", e.Rows, e.Cols, e.NewRows, e.NewCols)
+}
+
+// PaddingError is returned when a matrix is padded by a negative number of rows or columns.
+type PaddingError struct {
+	Top    int
+	Bottom int
+	Left   int
+	Right  int
+}
+
+func (e *PaddingError) Error() string {
+	return fmt.Sprintf("the padding of %d above, %d below, %d to the left and %d to the right must not be negative", e.Top, e.Bottom, e.Left, e.Right)
+}
@@ -0,0 +1,670 @@
+package flat
+
+import (
+	"flag"
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/chris-tomich/immutability-benchmarking"
+	"github.com/chris-tomich/immutability-benchmarking/flat/immutable"
+	"github.com/chris-tomich/immutability-benchmarking/flat/mutable"
+	"github.com/chris-tomich/immutability-benchmarking/immutabilitycheck"
+	"github.com/chris-tomich/immutability-benchmarking/overflow"
+)
+
+var checkImmutability = flag.Bool("immutabilitycheck", false, "verify immutable matrices are never modified by the benchmarked operations")
+
+type MatrixGenerator[T immutabilitybenchmarking.Number] interface {
+	Size() int
+	Immutable() bool
+	GenerateMatrix() (immutabilitybenchmarking.Matrix[T], immutabilitybenchmarking.Matrix[T])
+}
+
+type MutableMatrixGenerator[T immutabilitybenchmarking.Number] struct {
+	MatrixSize int
+	Random     func() T
+}
+
+func (m MutableMatrixGenerator[T]) Size() int {
+	return m.MatrixSize
+}
+
+func (m MutableMatrixGenerator[T]) Immutable() bool {
+	return false
+}
+
+func (m MutableMatrixGenerator[T]) GenerateMatrix() (immutabilitybenchmarking.Matrix[T], immutabilitybenchmarking.Matrix[T]) {
+	m1 := make([][]T, m.Size())
+	m2 := make([][]T, m.Size())
+
+	for i := 0; i < m.Size(); i++ {
+		m1[i] = make([]T, m.Size())
+		m2[i] = make([]T, m.Size())
+
+		for j := 0; j < m.Size(); j++ {
+			m1[i][j] = m.Random()
+			m2[i][j] = m.Random()
+		}
+	}
+
+	mm1, err := mutable.New(m1)
+	if err != nil {
+		panic(err)
+	}
+
+	mm2, err := mutable.New(m2)
+	if err != nil {
+		panic(err)
+	}
+
+	return mm1, mm2
+}
+
+type ImmutableMatrixGenerator[T immutabilitybenchmarking.Number] struct {
+	MatrixSize int
+	Random     func() T
+}
+
+func (m ImmutableMatrixGenerator[T]) Size() int {
+	return m.MatrixSize
+}
+
+func (m ImmutableMatrixGenerator[T]) Immutable() bool {
+	return true
+}
+
+func (m ImmutableMatrixGenerator[T]) GenerateMatrix() (immutabilitybenchmarking.Matrix[T], immutabilitybenchmarking.Matrix[T]) {
+	m1 := make([][]T, m.Size())
+	m2 := make([][]T, m.Size())
+
+	for i := 0; i < m.Size(); i++ {
+		m1[i] = make([]T, m.Size())
+		m2[i] = make([]T, m.Size())
+
+		for j := 0; j < m.Size(); j++ {
+			m1[i][j] = m.Random()
+			m2[i][j] = m.Random()
+		}
+	}
+
+	mm1, err := immutable.New(m1)
+	if err != nil {
+		panic(err)
+	}
+
+	mm2, err := immutable.New(m2)
+	if err != nil {
+		panic(err)
+	}
+
+	return mm1, mm2
+}
+
+// generateMatrix generates a pair of matrices, wrapping immutable ones in an immutability check when
+// -immutabilitycheck is set.
+func generateMatrix[T immutabilitybenchmarking.Number](b *testing.B, g MatrixGenerator[T]) (immutabilitybenchmarking.Matrix[T], immutabilitybenchmarking.Matrix[T]) {
+	m1, m2 := g.GenerateMatrix()
+
+	if *checkImmutability && g.Immutable() {
+		return immutabilitycheck.Wrap(b, m1), immutabilitycheck.Wrap(b, m2)
+	}
+
+	return m1, m2
+}
+
+func MatrixAddRunner[T immutabilitybenchmarking.Number](b *testing.B, g MatrixGenerator[T], totalMatrices int) {
+	mm1 := make([]immutabilitybenchmarking.Matrix[T], totalMatrices)
+	mm2 := make([]immutabilitybenchmarking.Matrix[T], totalMatrices)
+
+	for i := 0; i < totalMatrices; i++ {
+		mm1[i], mm2[i] = generateMatrix(b, g)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for j := 0; j < totalMatrices; j++ {
+			mm1[j], _ = mm1[j].Add(mm2[j])
+		}
+	}
+}
+
+func MatrixScalarRunner[T immutabilitybenchmarking.Number](b *testing.B, g MatrixGenerator[T], totalMatrices int) {
+	mm1 := make([]immutabilitybenchmarking.Matrix[T], totalMatrices)
+	mm2 := make([]immutabilitybenchmarking.Matrix[T], totalMatrices)
+
+	for i := 0; i < totalMatrices; i++ {
+		mm1[i], mm2[i] = generateMatrix(b, g)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for j := 0; j < totalMatrices; j++ {
+			mm1[j] = mm1[j].ScalarMultiply(3)
+		}
+	}
+}
+
+func MatrixMultiplyRunner[T immutabilitybenchmarking.Number](b *testing.B, g MatrixGenerator[T], totalMatrices int) {
+	mm1 := make([]immutabilitybenchmarking.Matrix[T], totalMatrices)
+	mm2 := make([]immutabilitybenchmarking.Matrix[T], totalMatrices)
+
+	for i := 0; i < totalMatrices; i++ {
+		mm1[i], mm2[i] = generateMatrix(b, g)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for j := 0; j < totalMatrices; j++ {
+			mm1[j], _ = mm1[j].MatrixMultiply(mm2[j])
+		}
+	}
+}
+
+func MatrixSubtractRunner[T immutabilitybenchmarking.Number](b *testing.B, g MatrixGenerator[T], totalMatrices int) {
+	mm1 := make([]immutabilitybenchmarking.Matrix[T], totalMatrices)
+	mm2 := make([]immutabilitybenchmarking.Matrix[T], totalMatrices)
+
+	for i := 0; i < totalMatrices; i++ {
+		mm1[i], mm2[i] = generateMatrix(b, g)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for j := 0; j < totalMatrices; j++ {
+			mm1[j], _ = mm1[j].Subtract(mm2[j])
+		}
+	}
+}
+
+// OverflowPolicyRunner benchmarks every policy on the same inputs each iteration, so Checked never stops early.
+func OverflowPolicyRunner[T overflow.Integer](b *testing.B, g MatrixGenerator[T], f immutabilitybenchmarking.Factory[T], totalMatrices int) {
+	mm1 := make([]immutabilitybenchmarking.Matrix[T], totalMatrices)
+	mm2 := make([]immutabilitybenchmarking.Matrix[T], totalMatrices)
+
+	for i := 0; i < totalMatrices; i++ {
+		mm1[i], mm2[i] = generateMatrix(b, g)
+	}
+
+	ops := []struct {
+		name string
+		op   func(a overflow.Arithmetic[T], m1 immutabilitybenchmarking.Matrix[T], m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error)
+	}{
+		{name: "Add", op: overflow.Arithmetic[T].Add},
+		{name: "Scalar", op: func(a overflow.Arithmetic[T], m1 immutabilitybenchmarking.Matrix[T], _ immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+			return a.ScalarMultiply(m1, 3)
+		}},
+		{name: "Multiply", op: overflow.Arithmetic[T].MatrixMultiply},
+		{name: "Subtract", op: overflow.Arithmetic[T].Subtract},
+	}
+
+	for _, op := range ops {
+		for _, p := range []overflow.Policy{overflow.Wrapping, overflow.Checked, overflow.Saturating} {
+			a := overflow.New(f, p)
+
+			b.Run(fmt.Sprintf("%s/%s", op.name, p), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					for j := 0; j < totalMatrices; j++ {
+						op.op(a, mm1[j], mm2[j])
+					}
+				}
+			})
+		}
+	}
+}
+
+// smallInt returns values small enough that the benchmarked operations do not overflow, so the checked
+// policy does the full amount of work.
+func smallInt() int {
+	return rand.Intn(1 << 16)
+}
+
+func BenchmarkMutableMatrix10x10Add(b *testing.B) {
+	g := MutableMatrixGenerator[int]{MatrixSize: 10, Random: rand.Int}
+	MatrixAddRunner(b, g, 10)
+}
+
+func BenchmarkImmutableMatrix10x10Add(b *testing.B) {
+	g := ImmutableMatrixGenerator[int]{MatrixSize: 10, Random: rand.Int}
+	MatrixAddRunner(b, g, 10)
+}
+
+func BenchmarkMutableMatrix10x10Scalar(b *testing.B) {
+	g := MutableMatrixGenerator[int]{MatrixSize: 10, Random: rand.Int}
+	MatrixScalarRunner(b, g, 10)
+}
+
+func BenchmarkImmutableMatrix10x10Scalar(b *testing.B) {
+	g := ImmutableMatrixGenerator[int]{MatrixSize: 10, Random: rand.Int}
+	MatrixScalarRunner(b, g, 10)
+}
+
+func BenchmarkMutableMatrix10x10Multiply(b *testing.B) {
+	g := MutableMatrixGenerator[int]{MatrixSize: 10, Random: rand.Int}
+	MatrixMultiplyRunner(b, g, 10)
+}
+
+func BenchmarkImmutableMatrix10x10Multiply(b *testing.B) {
+	g := ImmutableMatrixGenerator[int]{MatrixSize: 10, Random: rand.Int}
+	MatrixMultiplyRunner(b, g, 10)
+}
+
+func BenchmarkMutableMatrix10x10Subtract(b *testing.B) {
+	g := MutableMatrixGenerator[int]{MatrixSize: 10, Random: rand.Int}
+	MatrixSubtractRunner(b, g, 10)
+}
+
+func BenchmarkImmutableMatrix10x10Subtract(b *testing.B) {
+	g := ImmutableMatrixGenerator[int]{MatrixSize: 10, Random: rand.Int}
+	MatrixSubtractRunner(b, g, 10)
+}
+
+func BenchmarkMutableMatrix30x30Add(b *testing.B) {
+	g := MutableMatrixGenerator[int]{MatrixSize: 30, Random: rand.Int}
+	MatrixAddRunner(b, g, 10)
+}
+
+func BenchmarkImmutableMatrix30x30Add(b *testing.B) {
+	g := ImmutableMatrixGenerator[int]{MatrixSize: 30, Random: rand.Int}
+	MatrixAddRunner(b, g, 10)
+}
+
+func BenchmarkMutableMatrix30x30Scalar(b *testing.B) {
+	g := MutableMatrixGenerator[int]{MatrixSize: 30, Random: rand.Int}
+	MatrixScalarRunner(b, g, 10)
+}
+
+func BenchmarkImmutableMatrix30x30Scalar(b *testing.B) {
+	g := ImmutableMatrixGenerator[int]{MatrixSize: 30, Random: rand.Int}
+	MatrixScalarRunner(b, g, 10)
+}
+
+func BenchmarkMutableMatrix30x30Multiply(b *testing.B) {
+	g := MutableMatrixGenerator[int]{MatrixSize: 30, Random: rand.Int}
+	MatrixMultiplyRunner(b, g, 10)
+}
+
+func BenchmarkImmutableMatrix30x30Multiply(b *testing.B) {
+	g := ImmutableMatrixGenerator[int]{MatrixSize: 30, Random: rand.Int}
+	MatrixMultiplyRunner(b, g, 10)
+}
+
+func BenchmarkMutableMatrix30x30Subtract(b *testing.B) {
+	g := MutableMatrixGenerator[int]{MatrixSize: 30, Random: rand.Int}
+	MatrixSubtractRunner(b, g, 10)
+}
+
+func BenchmarkImmutableMatrix30x30Subtract(b *testing.B) {
+	g := ImmutableMatrixGenerator[int]{MatrixSize: 30, Random: rand.Int}
+	MatrixSubtractRunner(b, g, 10)
+}
+
+func BenchmarkMutableMatrix90x90Add(b *testing.B) {
+	g := MutableMatrixGenerator[int]{MatrixSize: 90, Random: rand.Int}
+	MatrixAddRunner(b, g, 10)
+}
+
+func BenchmarkImmutableMatrix90x90Add(b *testing.B) {
+	g := ImmutableMatrixGenerator[int]{MatrixSize: 90, Random: rand.Int}
+	MatrixAddRunner(b, g, 10)
+}
+
+func BenchmarkMutableMatrix90x90Scalar(b *testing.B) {
+	g := MutableMatrixGenerator[int]{MatrixSize: 90, Random: rand.Int}
+	MatrixScalarRunner(b, g, 10)
+}
+
+func BenchmarkImmutableMatrix90x90Scalar(b *testing.B) {
+	g := ImmutableMatrixGenerator[int]{MatrixSize: 90, Random: rand.Int}
+	MatrixScalarRunner(b, g, 10)
+}
+
+func BenchmarkMutableMatrix90x90Multiply(b *testing.B) {
+	g := MutableMatrixGenerator[int]{MatrixSize: 90, Random: rand.Int}
+	MatrixMultiplyRunner(b, g, 10)
+}
+
+func BenchmarkImmutableMatrix90x90Multiply(b *testing.B) {
+	g := ImmutableMatrixGenerator[int]{MatrixSize: 90, Random: rand.Int}
+	MatrixMultiplyRunner(b, g, 10)
+}
+
+func BenchmarkMutableMatrix90x90Subtract(b *testing.B) {
+	g := MutableMatrixGenerator[int]{MatrixSize: 90, Random: rand.Int}
+	MatrixSubtractRunner(b, g, 10)
+}
+
+func BenchmarkImmutableMatrix90x90Subtract(b *testing.B) {
+	g := ImmutableMatrixGenerator[int]{MatrixSize: 90, Random: rand.Int}
+	MatrixSubtractRunner(b, g, 10)
+}
+
+func BenchmarkMutableMatrix270x270Add(b *testing.B) {
+	g := MutableMatrixGenerator[int]{MatrixSize: 270, Random: rand.Int}
+	MatrixAddRunner(b, g, 10)
+}
+
+func BenchmarkImmutableMatrix270x270Add(b *testing.B) {
+	g := ImmutableMatrixGenerator[int]{MatrixSize: 270, Random: rand.Int}
+	MatrixAddRunner(b, g, 10)
+}
+
+func BenchmarkMutableMatrix270x270Scalar(b *testing.B) {
+	g := MutableMatrixGenerator[int]{MatrixSize: 270, Random: rand.Int}
+	MatrixScalarRunner(b, g, 10)
+}
+
+func BenchmarkImmutableMatrix270x270Scalar(b *testing.B) {
+	g := ImmutableMatrixGenerator[int]{MatrixSize: 270, Random: rand.Int}
+	MatrixScalarRunner(b, g, 10)
+}
+
+func BenchmarkMutableMatrix270x270Multiply(b *testing.B) {
+	g := MutableMatrixGenerator[int]{MatrixSize: 270, Random: rand.Int}
+	MatrixMultiplyRunner(b, g, 10)
+}
+
+func BenchmarkImmutableMatrix270x270Multiply(b *testing.B) {
+	g := ImmutableMatrixGenerator[int]{MatrixSize: 270, Random: rand.Int}
+	MatrixMultiplyRunner(b, g, 10)
+}
+
+func BenchmarkMutableMatrix270x270Subtract(b *testing.B) {
+	g := MutableMatrixGenerator[int]{MatrixSize: 270, Random: rand.Int}
+	MatrixSubtractRunner(b, g, 10)
+}
+
+func BenchmarkImmutableMatrix270x270Subtract(b *testing.B) {
+	g := ImmutableMatrixGenerator[int]{MatrixSize: 270, Random: rand.Int}
+	MatrixSubtractRunner(b, g, 10)
+}
+
+func BenchmarkMutableMatrix810x810Add(b *testing.B) {
+	g := MutableMatrixGenerator[int]{MatrixSize: 810, Random: rand.Int}
+	MatrixAddRunner(b, g, 10)
+}
+
+func BenchmarkImmutableMatrix810x810Add(b *testing.B) {
+	g := ImmutableMatrixGenerator[int]{MatrixSize: 810, Random: rand.Int}
+	MatrixAddRunner(b, g, 10)
+}
+
+func BenchmarkMutableMatrix810x810Scalar(b *testing.B) {
+	g := MutableMatrixGenerator[int]{MatrixSize: 810, Random: rand.Int}
+	MatrixScalarRunner(b, g, 10)
+}
+
+func BenchmarkImmutableMatrix810x810Scalar(b *testing.B) {
+	g := ImmutableMatrixGenerator[int]{MatrixSize: 810, Random: rand.Int}
+	MatrixScalarRunner(b, g, 10)
+}
+
+func BenchmarkMutableMatrix810x810Multiply(b *testing.B) {
+	g := MutableMatrixGenerator[int]{MatrixSize: 810, Random: rand.Int}
+	MatrixMultiplyRunner(b, g, 10)
+}
+
+func BenchmarkImmutableMatrix810x810Multiply(b *testing.B) {
+	g := ImmutableMatrixGenerator[int]{MatrixSize: 810, Random: rand.Int}
+	MatrixMultiplyRunner(b, g, 10)
+}
+
+func BenchmarkMutableMatrix810x810Subtract(b *testing.B) {
+	g := MutableMatrixGenerator[int]{MatrixSize: 810, Random: rand.Int}
+	MatrixSubtractRunner(b, g, 10)
+}
+
+func BenchmarkImmutableMatrix810x810Subtract(b *testing.B) {
+	g := ImmutableMatrixGenerator[int]{MatrixSize: 810, Random: rand.Int}
+	MatrixSubtractRunner(b, g, 10)
+}
+
+func BenchmarkMutableFloat64Matrix10x10Add(b *testing.B) {
+	g := MutableMatrixGenerator[float64]{MatrixSize: 10, Random: rand.Float64}
+	MatrixAddRunner(b, g, 10)
+}
+
+func BenchmarkImmutableFloat64Matrix10x10Add(b *testing.B) {
+	g := ImmutableMatrixGenerator[float64]{MatrixSize: 10, Random: rand.Float64}
+	MatrixAddRunner(b, g, 10)
+}
+
+func BenchmarkMutableFloat64Matrix10x10Scalar(b *testing.B) {
+	g := MutableMatrixGenerator[float64]{MatrixSize: 10, Random: rand.Float64}
+	MatrixScalarRunner(b, g, 10)
+}
+
+func BenchmarkImmutableFloat64Matrix10x10Scalar(b *testing.B) {
+	g := ImmutableMatrixGenerator[float64]{MatrixSize: 10, Random: rand.Float64}
+	MatrixScalarRunner(b, g, 10)
+}
+
+func BenchmarkMutableFloat64Matrix10x10Multiply(b *testing.B) {
+	g := MutableMatrixGenerator[float64]{MatrixSize: 10, Random: rand.Float64}
+	MatrixMultiplyRunner(b, g, 10)
+}
+
+func BenchmarkImmutableFloat64Matrix10x10Multiply(b *testing.B) {
+	g := ImmutableMatrixGenerator[float64]{MatrixSize: 10, Random: rand.Float64}
+	MatrixMultiplyRunner(b, g, 10)
+}
+
+func BenchmarkMutableFloat64Matrix10x10Subtract(b *testing.B) {
+	g := MutableMatrixGenerator[float64]{MatrixSize: 10, Random: rand.Float64}
+	MatrixSubtractRunner(b, g, 10)
+}
+
+func BenchmarkImmutableFloat64Matrix10x10Subtract(b *testing.B) {
+	g := ImmutableMatrixGenerator[float64]{MatrixSize: 10, Random: rand.Float64}
+	MatrixSubtractRunner(b, g, 10)
+}
+
+func BenchmarkMutableFloat64Matrix30x30Add(b *testing.B) {
+	g := MutableMatrixGenerator[float64]{MatrixSize: 30, Random: rand.Float64}
+	MatrixAddRunner(b, g, 10)
+}
+
+func BenchmarkImmutableFloat64Matrix30x30Add(b *testing.B) {
+	g := ImmutableMatrixGenerator[float64]{MatrixSize: 30, Random: rand.Float64}
+	MatrixAddRunner(b, g, 10)
+}
+
+func BenchmarkMutableFloat64Matrix30x30Scalar(b *testing.B) {
+	g := MutableMatrixGenerator[float64]{MatrixSize: 30, Random: rand.Float64}
+	MatrixScalarRunner(b, g, 10)
+}
+
+func BenchmarkImmutableFloat64Matrix30x30Scalar(b *testing.B) {
+	g := ImmutableMatrixGenerator[float64]{MatrixSize: 30, Random: rand.Float64}
+	MatrixScalarRunner(b, g, 10)
+}
+
+func BenchmarkMutableFloat64Matrix30x30Multiply(b *testing.B) {
+	g := MutableMatrixGenerator[float64]{MatrixSize: 30, Random: rand.Float64}
+	MatrixMultiplyRunner(b, g, 10)
+}
+
+func BenchmarkImmutableFloat64Matrix30x30Multiply(b *testing.B) {
+	g := ImmutableMatrixGenerator[float64]{MatrixSize: 30, Random: rand.Float64}
+	MatrixMultiplyRunner(b, g, 10)
+}
+
+func BenchmarkMutableFloat64Matrix30x30Subtract(b *testing.B) {
+	g := MutableMatrixGenerator[float64]{MatrixSize: 30, Random: rand.Float64}
+	MatrixSubtractRunner(b, g, 10)
+}
+
+func BenchmarkImmutableFloat64Matrix30x30Subtract(b *testing.B) {
+	g := ImmutableMatrixGenerator[float64]{MatrixSize: 30, Random: rand.Float64}
+	MatrixSubtractRunner(b, g, 10)
+}
+
+func BenchmarkMutableFloat64Matrix90x90Add(b *testing.B) {
+	g := MutableMatrixGenerator[float64]{MatrixSize: 90, Random: rand.Float64}
+	MatrixAddRunner(b, g, 10)
+}
+
+func BenchmarkImmutableFloat64Matrix90x90Add(b *testing.B) {
+	g := ImmutableMatrixGenerator[float64]{MatrixSize: 90, Random: rand.Float64}
+	MatrixAddRunner(b, g, 10)
+}
+
+func BenchmarkMutableFloat64Matrix90x90Scalar(b *testing.B) {
+	g := MutableMatrixGenerator[float64]{MatrixSize: 90, Random: rand.Float64}
+	MatrixScalarRunner(b, g, 10)
+}
+
+func BenchmarkImmutableFloat64Matrix90x90Scalar(b *testing.B) {
+	g := ImmutableMatrixGenerator[float64]{MatrixSize: 90, Random: rand.Float64}
+	MatrixScalarRunner(b, g, 10)
+}
+
+func BenchmarkMutableFloat64Matrix90x90Multiply(b *testing.B) {
+	g := MutableMatrixGenerator[float64]{MatrixSize: 90, Random: rand.Float64}
+	MatrixMultiplyRunner(b, g, 10)
+}
+
+func BenchmarkImmutableFloat64Matrix90x90Multiply(b *testing.B) {
+	g := ImmutableMatrixGenerator[float64]{MatrixSize: 90, Random: rand.Float64}
+	MatrixMultiplyRunner(b, g, 10)
+}
+
+func BenchmarkMutableFloat64Matrix90x90Subtract(b *testing.B) {
+	g := MutableMatrixGenerator[float64]{MatrixSize: 90, Random: rand.Float64}
+	MatrixSubtractRunner(b, g, 10)
+}
+
+func BenchmarkImmutableFloat64Matrix90x90Subtract(b *testing.B) {
+	g := ImmutableMatrixGenerator[float64]{MatrixSize: 90, Random: rand.Float64}
+	MatrixSubtractRunner(b, g, 10)
+}
+
+func BenchmarkMutableFloat64Matrix270x270Add(b *testing.B) {
+	g := MutableMatrixGenerator[float64]{MatrixSize: 270, Random: rand.Float64}
+	MatrixAddRunner(b, g, 10)
+}
+
+func BenchmarkImmutableFloat64Matrix270x270Add(b *testing.B) {
+	g := ImmutableMatrixGenerator[float64]{MatrixSize: 270, Random: rand.Float64}
+	MatrixAddRunner(b, g, 10)
+}
+
+func BenchmarkMutableFloat64Matrix270x270Scalar(b *testing.B) {
+	g := MutableMatrixGenerator[float64]{MatrixSize: 270, Random: rand.Float64}
+	MatrixScalarRunner(b, g, 10)
+}
+
+func BenchmarkImmutableFloat64Matrix270x270Scalar(b *testing.B) {
+	g := ImmutableMatrixGenerator[float64]{MatrixSize: 270, Random: rand.Float64}
+	MatrixScalarRunner(b, g, 10)
+}
+
+func BenchmarkMutableFloat64Matrix270x270Multiply(b *testing.B) {
+	g := MutableMatrixGenerator[float64]{MatrixSize: 270, Random: rand.Float64}
+	MatrixMultiplyRunner(b, g, 10)
+}
+
+func BenchmarkImmutableFloat64Matrix270x270Multiply(b *testing.B) {
+	g := ImmutableMatrixGenerator[float64]{MatrixSize: 270, Random: rand.Float64}
+	MatrixMultiplyRunner(b, g, 10)
+}
+
+func BenchmarkMutableFloat64Matrix270x270Subtract(b *testing.B) {
+	g := MutableMatrixGenerator[float64]{MatrixSize: 270, Random: rand.Float64}
+	MatrixSubtractRunner(b, g, 10)
+}
+
+func BenchmarkImmutableFloat64Matrix270x270Subtract(b *testing.B) {
+	g := ImmutableMatrixGenerator[float64]{MatrixSize: 270, Random: rand.Float64}
+	MatrixSubtractRunner(b, g, 10)
+}
+
+func BenchmarkMutableFloat64Matrix810x810Add(b *testing.B) {
+	g := MutableMatrixGenerator[float64]{MatrixSize: 810, Random: rand.Float64}
+	MatrixAddRunner(b, g, 10)
+}
+
+func BenchmarkImmutableFloat64Matrix810x810Add(b *testing.B) {
+	g := ImmutableMatrixGenerator[float64]{MatrixSize: 810, Random: rand.Float64}
+	MatrixAddRunner(b, g, 10)
+}
+
+func BenchmarkMutableFloat64Matrix810x810Scalar(b *testing.B) {
+	g := MutableMatrixGenerator[float64]{MatrixSize: 810, Random: rand.Float64}
+	MatrixScalarRunner(b, g, 10)
+}
+
+func BenchmarkImmutableFloat64Matrix810x810Scalar(b *testing.B) {
+	g := ImmutableMatrixGenerator[float64]{MatrixSize: 810, Random: rand.Float64}
+	MatrixScalarRunner(b, g, 10)
+}
+
+func BenchmarkMutableFloat64Matrix810x810Multiply(b *testing.B) {
+	g := MutableMatrixGenerator[float64]{MatrixSize: 810, Random: rand.Float64}
+	MatrixMultiplyRunner(b, g, 10)
+}
+
+func BenchmarkImmutableFloat64Matrix810x810Multiply(b *testing.B) {
+	g := ImmutableMatrixGenerator[float64]{MatrixSize: 810, Random: rand.Float64}
+	MatrixMultiplyRunner(b, g, 10)
+}
+
+func BenchmarkMutableFloat64Matrix810x810Subtract(b *testing.B) {
+	g := MutableMatrixGenerator[float64]{MatrixSize: 810, Random: rand.Float64}
+	MatrixSubtractRunner(b, g, 10)
+}
+
+func BenchmarkImmutableFloat64Matrix810x810Subtract(b *testing.B) {
+	g := ImmutableMatrixGenerator[float64]{MatrixSize: 810, Random: rand.Float64}
+	MatrixSubtractRunner(b, g, 10)
+}
+
+func BenchmarkMutableFactoryMatrix10x10OverflowPolicies(b *testing.B) {
+	g := MutableMatrixGenerator[int]{MatrixSize: 10, Random: smallInt}
+	OverflowPolicyRunner(b, g, mutable.Factory[int]{}, 10)
+}
+
+func BenchmarkImmutableFactoryMatrix10x10OverflowPolicies(b *testing.B) {
+	g := ImmutableMatrixGenerator[int]{MatrixSize: 10, Random: smallInt}
+	OverflowPolicyRunner(b, g, immutable.Factory[int]{}, 10)
+}
+
+func BenchmarkMutableFactoryMatrix30x30OverflowPolicies(b *testing.B) {
+	g := MutableMatrixGenerator[int]{MatrixSize: 30, Random: smallInt}
+	OverflowPolicyRunner(b, g, mutable.Factory[int]{}, 10)
+}
+
+func BenchmarkImmutableFactoryMatrix30x30OverflowPolicies(b *testing.B) {
+	g := ImmutableMatrixGenerator[int]{MatrixSize: 30, Random: smallInt}
+	OverflowPolicyRunner(b, g, immutable.Factory[int]{}, 10)
+}
+
+func BenchmarkMutableFactoryMatrix90x90OverflowPolicies(b *testing.B) {
+	g := MutableMatrixGenerator[int]{MatrixSize: 90, Random: smallInt}
+	OverflowPolicyRunner(b, g, mutable.Factory[int]{}, 10)
+}
+
+func BenchmarkImmutableFactoryMatrix90x90OverflowPolicies(b *testing.B) {
+	g := ImmutableMatrixGenerator[int]{MatrixSize: 90, Random: smallInt}
+	OverflowPolicyRunner(b, g, immutable.Factory[int]{}, 10)
+}
+
+func BenchmarkMutableFactoryMatrix270x270OverflowPolicies(b *testing.B) {
+	g := MutableMatrixGenerator[int]{MatrixSize: 270, Random: smallInt}
+	OverflowPolicyRunner(b, g, mutable.Factory[int]{}, 10)
+}
+
+func BenchmarkImmutableFactoryMatrix270x270OverflowPolicies(b *testing.B) {
+	g := ImmutableMatrixGenerator[int]{MatrixSize: 270, Random: smallInt}
+	OverflowPolicyRunner(b, g, immutable.Factory[int]{}, 10)
+}
+
+func BenchmarkMutableFactoryMatrix810x810OverflowPolicies(b *testing.B) {
+	g := MutableMatrixGenerator[int]{MatrixSize: 810, Random: smallInt}
+	OverflowPolicyRunner(b, g, mutable.Factory[int]{}, 10)
+}
+
+func BenchmarkImmutableFactoryMatrix810x810OverflowPolicies(b *testing.B) {
+	g := ImmutableMatrixGenerator[int]{MatrixSize: 810, Random: smallInt}
+	OverflowPolicyRunner(b, g, immutable.Factory[int]{}, 10)
+}
@@ -0,0 +1,53 @@
+package immutable
+
+import "github.com/chris-tomich/immutability-benchmarking"
+
+// Factory creates immutable matrices backed by a single row-major slice.
+type Factory[T immutabilitybenchmarking.Number] struct{}
+
+// Zeros creates a rows x cols matrix with every element set to zero.
+func (Factory[T]) Zeros(rows int, cols int) (immutabilitybenchmarking.Matrix[T], error) {
+	if rows <= 0 || cols <= 0 {
+		return nil, &immutabilitybenchmarking.InvalidDimensionsError{Rows: rows, Cols: cols}
+	}
+
+	return NewEmpty[T](cols, rows), nil
+}
+
+// Identity creates an n x n identity matrix.
+func (f Factory[T]) Identity(n int) (immutabilitybenchmarking.Matrix[T], error) {
+	return f.FromFunc(n, n, func(row int, col int) T {
+		if row == col {
+			return 1
+		}
+
+		return 0
+	})
+}
+
+// FromRows creates a matrix holding a copy of the given rectangular values.
+func (Factory[T]) FromRows(rows [][]T) (immutabilitybenchmarking.Matrix[T], error) {
+	m, err := New(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// FromFunc creates a rows x cols matrix with each element set to the result of f.
+func (Factory[T]) FromFunc(rows int, cols int, f func(row int, col int) T) (immutabilitybenchmarking.Matrix[T], error) {
+	if rows <= 0 || cols <= 0 {
+		return nil, &immutabilitybenchmarking.InvalidDimensionsError{Rows: rows, Cols: cols}
+	}
+
+	m := NewEmpty[T](cols, rows)
+
+	for r := 0; r < rows; r++ {
+		for c := 0; c < cols; c++ {
+			m.matrix[r*cols+c] = f(r, c)
+		}
+	}
+
+	return m, nil
+}
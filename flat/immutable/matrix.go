@@ -0,0 +1,617 @@
+package immutable
+
+import (
+	"github.com/chris-tomich/immutability-benchmarking"
+	"github.com/chris-tomich/immutability-benchmarking/internal/numeric"
+	"github.com/chris-tomich/immutability-benchmarking/internal/power"
+	"github.com/chris-tomich/immutability-benchmarking/internal/shape"
+)
+
+// Matrix is an immutable matrix with non-mutating operations backed by a single row-major slice.
+type Matrix[T immutabilitybenchmarking.Number] struct {
+	rows   int
+	cols   int
+	matrix []T
+}
+
+// New creates a new immutable matrix with the given initial values.
+func New[T immutabilitybenchmarking.Number](matrix [][]T) (Matrix[T], error) {
+	if len(matrix) == 0 || len(matrix[0]) == 0 {
+		return Matrix[T]{}, &immutabilitybenchmarking.InvalidDimensionsError{Rows: len(matrix)}
+	}
+
+	m := NewEmpty[T](len(matrix[0]), len(matrix))
+
+	for r := 0; r < len(matrix); r++ {
+		if len(matrix[r]) != m.cols {
+			return Matrix[T]{}, &immutabilitybenchmarking.JaggedRowsError{Row: r, Width: len(matrix[r]), Expected: len(matrix[0])}
+		}
+
+		copy(m.matrix[r*m.cols:(r+1)*m.cols], matrix[r])
+	}
+
+	return m, nil
+}
+
+// NewEmpty createas a new empty matrix with the given dimensions.
+func NewEmpty[T immutabilitybenchmarking.Number](width int, height int) Matrix[T] {
+	if width <= 0 || height <= 0 {
+		panic(&immutabilitybenchmarking.InvalidDimensionsError{Rows: height, Cols: width})
+	}
+
+	m := Matrix[T]{
+		rows:   height,
+		cols:   width,
+		matrix: make([]T, width*height),
+	}
+
+	return m
+}
+
+// Width returns the number of columns in the matrix.
+func (m1 Matrix[T]) Width() int {
+	return m1.cols
+}
+
+// Height returns the number of rows in the matrix.
+func (m1 Matrix[T]) Height() int {
+	return m1.rows
+}
+
+// Get returns the element at the provided coordinates. It panics with an OutOfBoundsError if they are
+// outside the matrix, as a column past the last would otherwise read the start of the next row.
+func (m1 Matrix[T]) Get(row int, col int) T {
+	if row < 0 || row >= m1.rows || col < 0 || col >= m1.cols {
+		panic(&immutabilitybenchmarking.OutOfBoundsError{Row: row, Col: col, Rows: m1.rows, Cols: m1.cols})
+	}
+
+	return m1.matrix[row*m1.cols+col]
+}
+
+// At returns the element at the provided coordinates or an OutOfBoundsError if they are outside the matrix.
+func (m1 Matrix[T]) At(row int, col int) (T, error) {
+	if row < 0 || row >= m1.Height() || col < 0 || col >= m1.Width() {
+		var zero T
+		return zero, &immutabilitybenchmarking.OutOfBoundsError{Row: row, Col: col, Rows: m1.Height(), Cols: m1.Width()}
+	}
+
+	return m1.matrix[row*m1.cols+col], nil
+}
+
+// MustAt returns the element at the provided coordinates and panics with an OutOfBoundsError if they are
+// outside the matrix.
+func (m1 Matrix[T]) MustAt(row int, col int) T {
+	v, err := m1.At(row, col)
+	if err != nil {
+		panic(err)
+	}
+
+	return v
+}
+
+// Equals will compare a matrix against this matrix and return if they are equal.
+func (m1 Matrix[T]) Equals(m2 immutabilitybenchmarking.Matrix[T]) bool {
+	if m1.Height() != m2.Height() {
+		return false
+	}
+
+	if m1.Width() != m2.Width() {
+		return false
+	}
+
+	for r := 0; r < m1.rows; r++ {
+		row := m1.matrix[r*m1.cols : (r+1)*m1.cols]
+		for c := 0; c < len(row); c++ {
+			if row[c] != m2.Get(r, c) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// Add will add the values of a matrix to this matrix.
+func (m1 Matrix[T]) Add(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Height() != m2.Height() || m1.Width() != m2.Width() {
+		return Matrix[T]{}, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "Add",
+			LeftRows:  m1.Height(),
+			LeftCols:  m1.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	m := NewEmpty[T](m1.Width(), m1.Height())
+
+	for r := 0; r < m.rows; r++ {
+		for c := 0; c < m.cols; c++ {
+			m.matrix[r*m.cols+c] = m1.matrix[r*m1.cols+c] + m2.Get(r, c)
+		}
+	}
+
+	return m, nil
+}
+
+// Subtract will subtract the values of a matrix from this matrix.
+func (m1 Matrix[T]) Subtract(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Height() != m2.Height() || m1.Width() != m2.Width() {
+		return Matrix[T]{}, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "Subtract",
+			LeftRows:  m1.Height(),
+			LeftCols:  m1.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	m := NewEmpty[T](m1.Width(), m1.Height())
+
+	for r := 0; r < m.rows; r++ {
+		for c := 0; c < m.cols; c++ {
+			m.matrix[r*m.cols+c] = m1.matrix[r*m1.cols+c] - m2.Get(r, c)
+		}
+	}
+
+	return m, nil
+}
+
+// ScalarMultiply will multiply this matrix by a given scalar value.
+func (m1 Matrix[T]) ScalarMultiply(s T) immutabilitybenchmarking.Matrix[T] {
+	m := NewEmpty[T](m1.Width(), m1.Height())
+
+	for i := 0; i < len(m1.matrix); i++ {
+		m.matrix[i] = m1.matrix[i] * s
+	}
+
+	return m
+}
+
+// Transpose will transpose this matrix.
+func (m1 Matrix[T]) Transpose() immutabilitybenchmarking.Matrix[T] {
+	m := NewEmpty[T](m1.Height(), m1.Width())
+
+	for rt := 0; rt < m.rows; rt++ {
+		for ct := 0; ct < m.cols; ct++ {
+			m.matrix[rt*m.cols+ct] = m1.matrix[ct*m1.cols+rt]
+		}
+	}
+
+	return m
+}
+
+// MatrixMultiply will multiple the given matrix against this matrix.
+func (m1 Matrix[T]) MatrixMultiply(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Width() != m2.Height() {
+		return Matrix[T]{}, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "MatrixMultiply",
+			LeftRows:  m1.Height(),
+			LeftCols:  m1.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	m := NewEmpty[T](m2.Width(), m1.Height())
+
+	for rm := 0; rm < m1.rows; rm++ {
+		row := m1.matrix[rm*m1.cols : (rm+1)*m1.cols]
+		for cm2 := 0; cm2 < m.cols; cm2++ {
+			var product T
+			for cm := 0; cm < len(row); cm++ {
+				product = product + row[cm]*m2.Get(cm, cm2)
+			}
+			m.matrix[rm*m.cols+cm2] = product
+		}
+	}
+
+	return m, nil
+}
+
+// Map will apply f to every element of this matrix.
+func (m1 Matrix[T]) Map(f func(v T) T) immutabilitybenchmarking.Matrix[T] {
+	m := NewEmpty[T](m1.Width(), m1.Height())
+
+	for i := 0; i < len(m1.matrix); i++ {
+		m.matrix[i] = f(m1.matrix[i])
+	}
+
+	return m
+}
+
+// MapIndexed will apply f to every element of this matrix along with its coordinates.
+func (m1 Matrix[T]) MapIndexed(f func(row int, col int, v T) T) immutabilitybenchmarking.Matrix[T] {
+	m := NewEmpty[T](m1.Width(), m1.Height())
+
+	for r := 0; r < m.rows; r++ {
+		for c := 0; c < m.cols; c++ {
+			m.matrix[r*m.cols+c] = f(r, c, m1.matrix[r*m1.cols+c])
+		}
+	}
+
+	return m
+}
+
+// ZipWith will combine each element of this matrix with the element at the same coordinates of the given
+// matrix using f.
+func (m1 Matrix[T]) ZipWith(m2 immutabilitybenchmarking.Matrix[T], f func(a T, b T) T) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Height() != m2.Height() || m1.Width() != m2.Width() {
+		return Matrix[T]{}, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "ZipWith",
+			LeftRows:  m1.Height(),
+			LeftCols:  m1.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	m := NewEmpty[T](m1.Width(), m1.Height())
+
+	for r := 0; r < m.rows; r++ {
+		for c := 0; c < m.cols; c++ {
+			m.matrix[r*m.cols+c] = f(m1.matrix[r*m1.cols+c], m2.Get(r, c))
+		}
+	}
+
+	return m, nil
+}
+
+// Fold will combine every element of this matrix into a single value, starting from initial and visiting
+// the elements in row-major order.
+func (m1 Matrix[T]) Fold(initial T, f func(acc T, v T) T) T {
+	acc := initial
+
+	for i := 0; i < len(m1.matrix); i++ {
+		acc = f(acc, m1.matrix[i])
+	}
+
+	return acc
+}
+
+// RowReduce will fold each row of this matrix into a single value starting from initial, returning a
+// column of the results.
+func (m1 Matrix[T]) RowReduce(initial T, f func(acc T, v T) T) immutabilitybenchmarking.Matrix[T] {
+	m := NewEmpty[T](1, m1.Height())
+
+	for r := 0; r < m1.rows; r++ {
+		acc := initial
+
+		for _, v := range m1.matrix[r*m1.cols : (r+1)*m1.cols] {
+			acc = f(acc, v)
+		}
+
+		m.matrix[r] = acc
+	}
+
+	return m
+}
+
+// ColReduce will fold each column of this matrix into a single value starting from initial, returning a
+// row of the results.
+func (m1 Matrix[T]) ColReduce(initial T, f func(acc T, v T) T) immutabilitybenchmarking.Matrix[T] {
+	m := NewEmpty[T](m1.Width(), 1)
+
+	for c := 0; c < m.cols; c++ {
+		m.matrix[c] = initial
+	}
+
+	for r := 0; r < m1.rows; r++ {
+		for c, v := range m1.matrix[r*m1.cols : (r+1)*m1.cols] {
+			m.matrix[c] = f(m.matrix[c], v)
+		}
+	}
+
+	return m
+}
+
+// HadamardProduct will multiply each element of this matrix by the element at the same coordinates of the
+// given matrix.
+func (m1 Matrix[T]) HadamardProduct(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Height() != m2.Height() || m1.Width() != m2.Width() {
+		return Matrix[T]{}, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "HadamardProduct",
+			LeftRows:  m1.Height(),
+			LeftCols:  m1.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	m := NewEmpty[T](m1.Width(), m1.Height())
+
+	for r := 0; r < m.rows; r++ {
+		for c := 0; c < m.cols; c++ {
+			m.matrix[r*m.cols+c] = m1.matrix[r*m1.cols+c] * m2.Get(r, c)
+		}
+	}
+
+	return m, nil
+}
+
+// ElementwiseDivide will divide each element of this matrix by the element at the same coordinates of the
+// given matrix, returning a DivisionByZeroError if any of them is zero.
+func (m1 Matrix[T]) ElementwiseDivide(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Height() != m2.Height() || m1.Width() != m2.Width() {
+		return Matrix[T]{}, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "ElementwiseDivide",
+			LeftRows:  m1.Height(),
+			LeftCols:  m1.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	m := NewEmpty[T](m1.Width(), m1.Height())
+
+	for r := 0; r < m.rows; r++ {
+		for c := 0; c < m.cols; c++ {
+			d := m2.Get(r, c)
+			if d == 0 {
+				return Matrix[T]{}, &immutabilitybenchmarking.DivisionByZeroError{Op: "ElementwiseDivide", Row: r, Col: c}
+			}
+
+			m.matrix[r*m.cols+c] = m1.matrix[r*m1.cols+c] / d
+		}
+	}
+
+	return m, nil
+}
+
+// KroneckerProduct will multiply every element of this matrix by the whole of the given matrix, returning
+// the blocks of products as a matrix whose dimensions are the products of both matrices' dimensions.
+func (m1 Matrix[T]) KroneckerProduct(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	m := NewEmpty[T](m1.cols*m2.Width(), m1.rows*m2.Height())
+
+	for r1 := 0; r1 < m1.rows; r1++ {
+		for r2 := 0; r2 < m2.Height(); r2++ {
+			row := m.matrix[(r1*m2.Height()+r2)*m.cols : (r1*m2.Height()+r2+1)*m.cols]
+
+			for c1 := 0; c1 < m1.cols; c1++ {
+				for c2 := 0; c2 < m2.Width(); c2++ {
+					row[c1*m2.Width()+c2] = m1.matrix[r1*m1.cols+c1] * m2.Get(r2, c2)
+				}
+			}
+		}
+	}
+
+	return m, nil
+}
+
+// row returns row r of this matrix without copying it.
+func (m1 Matrix[T]) row(r int) []T {
+	return m1.matrix[r*m1.cols : (r+1)*m1.cols]
+}
+
+// Trace will sum the elements on the diagonal of this matrix, returning a NotSquareError if it is not square.
+func (m1 Matrix[T]) Trace() (T, error) {
+	if m1.rows != m1.cols {
+		var zero T
+		return zero, &immutabilitybenchmarking.NotSquareError{Rows: m1.rows, Cols: m1.cols}
+	}
+
+	var trace T
+
+	for i := 0; i < m1.rows; i++ {
+		trace += m1.matrix[i*m1.cols+i]
+	}
+
+	return trace, nil
+}
+
+// Sum will add together every element of this matrix.
+func (m1 Matrix[T]) Sum() T {
+	return numeric.Sum(m1.rows, m1.row)
+}
+
+// Min will return the smallest element of this matrix. Complex elements are ordered by their real parts and
+// then by their imaginary parts.
+func (m1 Matrix[T]) Min() T {
+	r, c := numeric.ArgMin(m1.rows, m1.row)
+
+	return m1.matrix[r*m1.cols+c]
+}
+
+// Max will return the largest element of this matrix. Complex elements are ordered by their real parts and
+// then by their imaginary parts.
+func (m1 Matrix[T]) Max() T {
+	r, c := numeric.ArgMax(m1.rows, m1.row)
+
+	return m1.matrix[r*m1.cols+c]
+}
+
+// ArgMin will return the coordinates of the first smallest element of this matrix in row-major order.
+func (m1 Matrix[T]) ArgMin() (int, int) {
+	return numeric.ArgMin(m1.rows, m1.row)
+}
+
+// ArgMax will return the coordinates of the first largest element of this matrix in row-major order.
+func (m1 Matrix[T]) ArgMax() (int, int) {
+	return numeric.ArgMax(m1.rows, m1.row)
+}
+
+// FrobeniusNorm will return the square root of the sum of the squared absolute values of the elements of
+// this matrix.
+func (m1 Matrix[T]) FrobeniusNorm() float64 {
+	return numeric.FrobeniusNorm(m1.rows, m1.row)
+}
+
+// OneNorm will return the largest sum of the absolute values in a column of this matrix.
+func (m1 Matrix[T]) OneNorm() float64 {
+	return numeric.OneNorm(m1.rows, m1.cols, m1.row)
+}
+
+// InfNorm will return the largest sum of the absolute values in a row of this matrix.
+func (m1 Matrix[T]) InfNorm() float64 {
+	return numeric.InfNorm(m1.rows, m1.row)
+}
+
+// Rank will return the number of linearly independent rows of this matrix, treating values within rounding
+// error of zero as zero.
+func (m1 Matrix[T]) Rank() int {
+	return numeric.Rank(m1.rows, m1.cols, m1.row)
+}
+
+// Power will raise this matrix to the power n by repeated squaring, returning a NotSquareError if it is not
+// square or a NegativeExponentError if n is negative. The zeroth power is the identity matrix.
+func (m1 Matrix[T]) Power(n int) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Height() != m1.Width() {
+		return Matrix[T]{}, &immutabilitybenchmarking.NotSquareError{Rows: m1.Height(), Cols: m1.Width()}
+	}
+
+	if n < 0 {
+		return Matrix[T]{}, &immutabilitybenchmarking.NegativeExponentError{Exponent: n}
+	}
+
+	return power.BySquaring[immutabilitybenchmarking.Matrix[T]](m1, n, func() (immutabilitybenchmarking.Matrix[T], error) {
+		return Factory[T]{}.Identity(m1.Height())
+	}, nil)
+}
+
+// fromResult creates a matrix holding a copy of the elements a shape operation describes.
+func fromResult[T immutabilitybenchmarking.Number](res shape.Result[T]) Matrix[T] {
+	m := NewEmpty[T](res.Cols, res.Rows)
+
+	for r := 0; r < res.Rows; r++ {
+		for c := 0; c < res.Cols; c++ {
+			m.matrix[r*m.cols+c] = res.At(r, c)
+		}
+	}
+
+	return m
+}
+
+// Reshape will read the elements of this matrix in row-major order into a matrix with the given dimensions,
+// returning a ReshapeError if it would not hold the same number of elements. Row-major order is the order of
+// the backing slice, so the result shares it with this matrix.
+func (m1 Matrix[T]) Reshape(rows int, cols int) (immutabilitybenchmarking.Matrix[T], error) {
+	if err := shape.CheckReshape(m1, rows, cols); err != nil {
+		return Matrix[T]{}, err
+	}
+
+	return Matrix[T]{rows: rows, cols: cols, matrix: m1.matrix}, nil
+}
+
+// HConcat will place the given matrix to the right of this matrix, returning a DimensionMismatchError if
+// they do not have the same number of rows.
+func (m1 Matrix[T]) HConcat(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	res, err := shape.HConcat[T](m1, m2)
+	if err != nil {
+		return Matrix[T]{}, err
+	}
+
+	m := NewEmpty[T](res.Cols, res.Rows)
+
+	for r := 0; r < m.rows; r++ {
+		copy(m.matrix[r*m.cols:], m1.matrix[r*m1.cols:(r+1)*m1.cols])
+
+		for c := m1.cols; c < m.cols; c++ {
+			m.matrix[r*m.cols+c] = m2.Get(r, c-m1.cols)
+		}
+	}
+
+	return m, nil
+}
+
+// VConcat will place the given matrix below this matrix, returning a DimensionMismatchError if they do not
+// have the same number of columns.
+func (m1 Matrix[T]) VConcat(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	return m1.stack("VConcat", m2)
+}
+
+// Stack will place the given matrices below this matrix in order, returning a DimensionMismatchError for
+// the first that does not have the same number of columns.
+func (m1 Matrix[T]) Stack(ms ...immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	return m1.stack("Stack", ms...)
+}
+
+func (m1 Matrix[T]) stack(op string, ms ...immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if err := shape.CheckStack(op, m1, ms...); err != nil {
+		return Matrix[T]{}, err
+	}
+
+	rows := m1.rows
+
+	for _, m2 := range ms {
+		rows += m2.Height()
+	}
+
+	m := Matrix[T]{rows: rows, cols: m1.cols, matrix: append(make([]T, 0, rows*m1.cols), m1.matrix...)}
+
+	for _, m2 := range ms {
+		if o, ok := m2.(Matrix[T]); ok {
+			m.matrix = append(m.matrix, o.matrix...)
+			continue
+		}
+
+		for r := 0; r < m2.Height(); r++ {
+			for c := 0; c < m2.Width(); c++ {
+				m.matrix = append(m.matrix, m2.Get(r, c))
+			}
+		}
+	}
+
+	return m, nil
+}
+
+// SplitRows will split this matrix into its rows before at and its rows from at onwards, returning an
+// InvalidDimensionsError if either part would be empty. The rows of each part are contiguous in the backing
+// slice, so both share it with this matrix.
+func (m1 Matrix[T]) SplitRows(at int) (immutabilitybenchmarking.Matrix[T], immutabilitybenchmarking.Matrix[T], error) {
+	if err := shape.CheckSplitRows(m1, at); err != nil {
+		return Matrix[T]{}, Matrix[T]{}, err
+	}
+
+	i := at * m1.cols
+	top := Matrix[T]{rows: at, cols: m1.cols, matrix: m1.matrix[:i:i]}
+	bottom := Matrix[T]{rows: m1.rows - at, cols: m1.cols, matrix: m1.matrix[i:]}
+
+	return top, bottom, nil
+}
+
+// SplitCols will split this matrix into its columns before at and its columns from at onwards, returning an
+// InvalidDimensionsError if either part would be empty.
+func (m1 Matrix[T]) SplitCols(at int) (immutabilitybenchmarking.Matrix[T], immutabilitybenchmarking.Matrix[T], error) {
+	left, right, err := shape.SplitCols[T](m1, at)
+	if err != nil {
+		return Matrix[T]{}, Matrix[T]{}, err
+	}
+
+	return fromResult(left), fromResult(right), nil
+}
+
+// Pad will surround this matrix with the given number of rows and columns of zeros on each side, returning
+// a PaddingError if any of them is negative.
+func (m1 Matrix[T]) Pad(top int, bottom int, left int, right int) (immutabilitybenchmarking.Matrix[T], error) {
+	if err := shape.CheckPad(top, bottom, left, right); err != nil {
+		return Matrix[T]{}, err
+	}
+
+	m := NewEmpty[T](left+m1.cols+right, top+m1.rows+bottom)
+
+	for r := 0; r < m1.rows; r++ {
+		copy(m.matrix[(top+r)*m.cols+left:], m1.matrix[r*m1.cols:(r+1)*m1.cols])
+	}
+
+	return m, nil
+}
+
+// Flip will reverse the order of the rows or the columns of this matrix.
+func (m1 Matrix[T]) Flip(axis immutabilitybenchmarking.Axis) immutabilitybenchmarking.Matrix[T] {
+	if axis == immutabilitybenchmarking.RowAxis {
+		m := NewEmpty[T](m1.cols, m1.rows)
+
+		for r := 0; r < m1.rows; r++ {
+			copy(m.matrix[(m1.rows-1-r)*m1.cols:], m1.matrix[r*m1.cols:(r+1)*m1.cols])
+		}
+
+		return m
+	}
+
+	return fromResult(shape.Flip[T](m1, axis))
+}
+
+// Rotate90 will turn this matrix a quarter turn clockwise.
+func (m1 Matrix[T]) Rotate90() immutabilitybenchmarking.Matrix[T] {
+	return fromResult(shape.Rotate90[T](m1))
+}
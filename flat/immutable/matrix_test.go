@@ -0,0 +1,192 @@
+package immutable
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/chris-tomich/immutability-benchmarking"
+	"github.com/chris-tomich/immutability-benchmarking/matrixtest"
+)
+
+func TestImmutableMatrixMultiplication(t *testing.T) {
+	m1 := mustNew(
+		[][]int{
+			{2, 3, 4},
+			{1, 0, 0},
+		},
+	)
+
+	m2 := mustNew(
+		[][]int{
+			{0, 1000},
+			{1, 100},
+			{0, 10},
+		},
+	)
+
+	m3, _ := m1.MatrixMultiply(m2)
+	isCorrect := m3.Equals(mustNew(
+		[][]int{
+			{3, 2340},
+			{0, 1000},
+		},
+	))
+
+	if !isCorrect {
+		t.Fail()
+	}
+
+	m4 := mustNew(
+		[][]int{
+			{2, 3, 4},
+		},
+	)
+
+	m5 := mustNew(
+		[][]int{
+			{0, 1000},
+			{1, 100},
+			{0, 10},
+		},
+	)
+
+	m6, _ := m4.MatrixMultiply(m5)
+	isCorrect = m6.Equals(mustNew(
+		[][]int{
+			{3, 2340},
+		},
+	))
+
+	if !isCorrect {
+		t.Fail()
+	}
+
+	m7 := mustNew(
+		[][]int{
+			{2, 3, 4},
+			{1, 0, 0},
+		},
+	)
+
+	m8 := mustNew(
+		[][]int{
+			{0},
+			{1},
+			{0},
+		},
+	)
+
+	m9, _ := m7.MatrixMultiply(m8)
+	isCorrect = m9.Equals(mustNew(
+		[][]int{
+			{3},
+			{0},
+		},
+	))
+
+	if !isCorrect {
+		t.Fail()
+	}
+}
+
+func TestImmutableFloat64MatrixMultiplication(t *testing.T) {
+	m1 := mustNew(
+		[][]float64{
+			{0.5, 1.5},
+			{2, -1},
+		},
+	)
+
+	m2 := mustNew(
+		[][]float64{
+			{4, 0},
+			{0.25, 2},
+		},
+	)
+
+	m3, _ := m1.MatrixMultiply(m2)
+	isCorrect := m3.Equals(mustNew(
+		[][]float64{
+			{2.375, 3},
+			{7.75, -2},
+		},
+	))
+
+	if !isCorrect {
+		t.Fail()
+	}
+}
+
+func mustNew[T immutabilitybenchmarking.Number](matrix [][]T) Matrix[T] {
+	m, err := New(matrix)
+	if err != nil {
+		panic(err)
+	}
+
+	return m
+}
+
+func TestImmutableMatrixConformance(t *testing.T) {
+	t.Run("int", func(t *testing.T) {
+		matrixtest.RunConformance[int](t, Factory[int]{})
+	})
+
+	t.Run("uint8", func(t *testing.T) {
+		matrixtest.RunConformance[uint8](t, Factory[uint8]{})
+	})
+
+	t.Run("float64", func(t *testing.T) {
+		matrixtest.RunConformance[float64](t, Factory[float64]{})
+	})
+
+	t.Run("complex128", func(t *testing.T) {
+		matrixtest.RunConformance[complex128](t, Factory[complex128]{})
+	})
+}
+
+func TestImmutableMatrixShapeSharesBacking(t *testing.T) {
+	m1 := mustNew([][]int{
+		{1, 2, 3},
+		{4, 5, 6},
+	})
+
+	reshaped, err := m1.Reshape(3, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	top, bottom, err := m1.SplitRows(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if &reshaped.(Matrix[int]).matrix[0] != &m1.matrix[0] {
+		t.Error("expected Reshape to share the backing slice")
+	}
+
+	if &top.(Matrix[int]).matrix[0] != &m1.matrix[0] || &bottom.(Matrix[int]).matrix[0] != &m1.matrix[3] {
+		t.Error("expected SplitRows to share the backing slice")
+	}
+
+	if cap(top.(Matrix[int]).matrix) != 3 {
+		t.Error("expected the top part to be unable to grow into the bottom part")
+	}
+}
+
+func TestImmutableMatrixGetOutOfBounds(t *testing.T) {
+	m := mustNew([][]int{{1, 2}, {3, 4}})
+
+	// 0,2 would otherwise read the element at 1,0 from the backing slice.
+	for _, c := range [][2]int{{0, 2}, {-1, 1}, {2, 0}, {1, -1}} {
+		func() {
+			defer func() {
+				var outOfBounds *immutabilitybenchmarking.OutOfBoundsError
+				if err, _ := recover().(error); !errors.As(err, &outOfBounds) {
+					t.Errorf("%d,%d: expected a panic with an OutOfBoundsError but got %v", c[0], c[1], err)
+				}
+			}()
+
+			m.Get(c[0], c[1])
+		}()
+	}
+}
@@ -0,0 +1,700 @@
+package mutable
+
+import (
+	"github.com/chris-tomich/immutability-benchmarking"
+	"github.com/chris-tomich/immutability-benchmarking/internal/numeric"
+	"github.com/chris-tomich/immutability-benchmarking/internal/power"
+	"github.com/chris-tomich/immutability-benchmarking/internal/shape"
+)
+
+// Matrix is a matrix with mutating operations backed by a single row-major slice.
+type Matrix[T immutabilitybenchmarking.Number] struct {
+	rows   int
+	cols   int
+	matrix []T
+}
+
+// New creates a new matrix with the given initial values.
+func New[T immutabilitybenchmarking.Number](matrix [][]T) (*Matrix[T], error) {
+	if len(matrix) == 0 || len(matrix[0]) == 0 {
+		return nil, &immutabilitybenchmarking.InvalidDimensionsError{Rows: len(matrix)}
+	}
+
+	m, _ := NewEmpty[T](len(matrix[0]), len(matrix))
+
+	for r := 0; r < len(matrix); r++ {
+		if len(matrix[r]) != m.cols {
+			return nil, &immutabilitybenchmarking.JaggedRowsError{Row: r, Width: len(matrix[r]), Expected: len(matrix[0])}
+		}
+
+		copy(m.matrix[r*m.cols:(r+1)*m.cols], matrix[r])
+	}
+
+	return m, nil
+}
+
+// NewEmpty createas a new empty matrix with the given dimensions.
+func NewEmpty[T immutabilitybenchmarking.Number](width int, height int) (*Matrix[T], error) {
+	if width <= 0 || height <= 0 {
+		return nil, &immutabilitybenchmarking.InvalidDimensionsError{Rows: height, Cols: width}
+	}
+
+	m := &Matrix[T]{
+		rows:   height,
+		cols:   width,
+		matrix: make([]T, width*height),
+	}
+
+	return m, nil
+}
+
+// Width returns the number of columns in the matrix.
+func (m *Matrix[T]) Width() int {
+	return m.cols
+}
+
+// Height returns the number of rows in the matrix.
+func (m *Matrix[T]) Height() int {
+	return m.rows
+}
+
+// Get returns the element at the provided coordinates. It panics with an OutOfBoundsError if they are
+// outside the matrix, as a column past the last would otherwise read the start of the next row.
+func (m *Matrix[T]) Get(row int, col int) T {
+	if row < 0 || row >= m.rows || col < 0 || col >= m.cols {
+		panic(&immutabilitybenchmarking.OutOfBoundsError{Row: row, Col: col, Rows: m.rows, Cols: m.cols})
+	}
+
+	return m.matrix[row*m.cols+col]
+}
+
+// At returns the element at the provided coordinates or an OutOfBoundsError if they are outside the matrix.
+func (m *Matrix[T]) At(row int, col int) (T, error) {
+	if row < 0 || row >= m.Height() || col < 0 || col >= m.Width() {
+		var zero T
+		return zero, &immutabilitybenchmarking.OutOfBoundsError{Row: row, Col: col, Rows: m.Height(), Cols: m.Width()}
+	}
+
+	return m.matrix[row*m.cols+col], nil
+}
+
+// MustAt returns the element at the provided coordinates and panics with an OutOfBoundsError if they are
+// outside the matrix.
+func (m *Matrix[T]) MustAt(row int, col int) T {
+	v, err := m.At(row, col)
+	if err != nil {
+		panic(err)
+	}
+
+	return v
+}
+
+// Equals will compare a matrix against this matrix and return if they are equal.
+func (m *Matrix[T]) Equals(m2 immutabilitybenchmarking.Matrix[T]) bool {
+	if m.Height() != m2.Height() {
+		return false
+	}
+
+	if m.Width() != m2.Width() {
+		return false
+	}
+
+	for r := 0; r < m.rows; r++ {
+		row := m.matrix[r*m.cols : (r+1)*m.cols]
+		for c := 0; c < len(row); c++ {
+			if row[c] != m2.Get(r, c) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// Add will add the values of a matrix to this matrix.
+func (m *Matrix[T]) Add(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m.Height() != m2.Height() || m.Width() != m2.Width() {
+		return nil, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "Add",
+			LeftRows:  m.Height(),
+			LeftCols:  m.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	for r := 0; r < m.rows; r++ {
+		row := m.matrix[r*m.cols : (r+1)*m.cols]
+		for c := 0; c < len(row); c++ {
+			row[c] = row[c] + m2.Get(r, c)
+		}
+	}
+
+	return m, nil
+}
+
+// Subtract will subtract the values of a matrix from this matrix.
+func (m *Matrix[T]) Subtract(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m.Height() != m2.Height() || m.Width() != m2.Width() {
+		return nil, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "Subtract",
+			LeftRows:  m.Height(),
+			LeftCols:  m.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	for r := 0; r < m.rows; r++ {
+		row := m.matrix[r*m.cols : (r+1)*m.cols]
+		for c := 0; c < len(row); c++ {
+			row[c] = row[c] - m2.Get(r, c)
+		}
+	}
+
+	return m, nil
+}
+
+// ScalarMultiply will multiply this matrix by a given scalar value.
+func (m *Matrix[T]) ScalarMultiply(s T) immutabilitybenchmarking.Matrix[T] {
+	for i := 0; i < len(m.matrix); i++ {
+		m.matrix[i] = m.matrix[i] * s
+	}
+
+	return m
+}
+
+// Transpose will transpose this matrix.
+func (m *Matrix[T]) Transpose() immutabilitybenchmarking.Matrix[T] {
+	t := make([]T, len(m.matrix))
+
+	for rt := 0; rt < m.cols; rt++ {
+		for ct := 0; ct < m.rows; ct++ {
+			t[rt*m.rows+ct] = m.matrix[ct*m.cols+rt]
+		}
+	}
+
+	m.matrix = t
+	m.rows, m.cols = m.cols, m.rows
+
+	return m
+}
+
+// MatrixMultiply will multiple the given matrix against this matrix.
+func (m *Matrix[T]) MatrixMultiply(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m.Width() != m2.Height() {
+		return nil, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "MatrixMultiply",
+			LeftRows:  m.Height(),
+			LeftCols:  m.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	cols := m2.Width()
+	n := make([]T, m.rows*cols)
+
+	for rm := 0; rm < m.rows; rm++ {
+		row := m.matrix[rm*m.cols : (rm+1)*m.cols]
+		for cm2 := 0; cm2 < cols; cm2++ {
+			var product T
+			for cm := 0; cm < len(row); cm++ {
+				product = product + row[cm]*m2.Get(cm, cm2)
+			}
+			n[rm*cols+cm2] = product
+		}
+	}
+
+	m.matrix = n
+	m.cols = cols
+
+	return m, nil
+}
+
+// Map will apply f to every element of this matrix.
+func (m *Matrix[T]) Map(f func(v T) T) immutabilitybenchmarking.Matrix[T] {
+	for i := 0; i < len(m.matrix); i++ {
+		m.matrix[i] = f(m.matrix[i])
+	}
+
+	return m
+}
+
+// MapIndexed will apply f to every element of this matrix along with its coordinates.
+func (m *Matrix[T]) MapIndexed(f func(row int, col int, v T) T) immutabilitybenchmarking.Matrix[T] {
+	for r := 0; r < m.rows; r++ {
+		for c := 0; c < m.cols; c++ {
+			m.matrix[r*m.cols+c] = f(r, c, m.matrix[r*m.cols+c])
+		}
+	}
+
+	return m
+}
+
+// ZipWith will combine each element of this matrix with the element at the same coordinates of the given
+// matrix using f.
+func (m *Matrix[T]) ZipWith(m2 immutabilitybenchmarking.Matrix[T], f func(a T, b T) T) (immutabilitybenchmarking.Matrix[T], error) {
+	if m.Height() != m2.Height() || m.Width() != m2.Width() {
+		return nil, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "ZipWith",
+			LeftRows:  m.Height(),
+			LeftCols:  m.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	for r := 0; r < m.rows; r++ {
+		for c := 0; c < m.cols; c++ {
+			m.matrix[r*m.cols+c] = f(m.matrix[r*m.cols+c], m2.Get(r, c))
+		}
+	}
+
+	return m, nil
+}
+
+// Fold will combine every element of this matrix into a single value, starting from initial and visiting
+// the elements in row-major order.
+func (m *Matrix[T]) Fold(initial T, f func(acc T, v T) T) T {
+	acc := initial
+
+	for i := 0; i < len(m.matrix); i++ {
+		acc = f(acc, m.matrix[i])
+	}
+
+	return acc
+}
+
+// RowReduce will fold each row of this matrix into a single value starting from initial, replacing this
+// matrix with a column of the results.
+func (m *Matrix[T]) RowReduce(initial T, f func(acc T, v T) T) immutabilitybenchmarking.Matrix[T] {
+	for r := 0; r < m.rows; r++ {
+		acc := initial
+
+		for _, v := range m.matrix[r*m.cols : (r+1)*m.cols] {
+			acc = f(acc, v)
+		}
+
+		// Row r has been read by the time its result is written, as r <= r*m.cols.
+		m.matrix[r] = acc
+	}
+
+	m.matrix = m.matrix[:m.rows]
+	m.cols = 1
+
+	return m
+}
+
+// ColReduce will fold each column of this matrix into a single value starting from initial, replacing this
+// matrix with a row of the results.
+func (m *Matrix[T]) ColReduce(initial T, f func(acc T, v T) T) immutabilitybenchmarking.Matrix[T] {
+	n := make([]T, m.cols)
+
+	for c := 0; c < len(n); c++ {
+		n[c] = initial
+	}
+
+	for r := 0; r < m.rows; r++ {
+		for c, v := range m.matrix[r*m.cols : (r+1)*m.cols] {
+			n[c] = f(n[c], v)
+		}
+	}
+
+	m.matrix = n
+	m.rows = 1
+
+	return m
+}
+
+// HadamardProduct will multiply each element of this matrix by the element at the same coordinates of the
+// given matrix.
+func (m *Matrix[T]) HadamardProduct(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m.Height() != m2.Height() || m.Width() != m2.Width() {
+		return nil, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "HadamardProduct",
+			LeftRows:  m.Height(),
+			LeftCols:  m.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	for r := 0; r < m.rows; r++ {
+		for c := 0; c < m.cols; c++ {
+			m.matrix[r*m.cols+c] = m.matrix[r*m.cols+c] * m2.Get(r, c)
+		}
+	}
+
+	return m, nil
+}
+
+// ElementwiseDivide will divide each element of this matrix by the element at the same coordinates of the
+// given matrix. The divisor is checked for zeros before any element is changed, so a DivisionByZeroError
+// leaves this matrix as it was.
+func (m *Matrix[T]) ElementwiseDivide(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m.Height() != m2.Height() || m.Width() != m2.Width() {
+		return nil, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "ElementwiseDivide",
+			LeftRows:  m.Height(),
+			LeftCols:  m.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	for r := 0; r < m.rows; r++ {
+		for c := 0; c < m.cols; c++ {
+			if m2.Get(r, c) == 0 {
+				return nil, &immutabilitybenchmarking.DivisionByZeroError{Op: "ElementwiseDivide", Row: r, Col: c}
+			}
+		}
+	}
+
+	for r := 0; r < m.rows; r++ {
+		for c := 0; c < m.cols; c++ {
+			m.matrix[r*m.cols+c] = m.matrix[r*m.cols+c] / m2.Get(r, c)
+		}
+	}
+
+	return m, nil
+}
+
+// KroneckerProduct will multiply every element of this matrix by the whole of the given matrix, replacing
+// this matrix with the blocks of products.
+func (m *Matrix[T]) KroneckerProduct(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	rows, cols := m.rows*m2.Height(), m.cols*m2.Width()
+	n := make([]T, rows*cols)
+
+	for r1 := 0; r1 < m.rows; r1++ {
+		for r2 := 0; r2 < m2.Height(); r2++ {
+			row := n[(r1*m2.Height()+r2)*cols : (r1*m2.Height()+r2+1)*cols]
+
+			for c1 := 0; c1 < m.cols; c1++ {
+				for c2 := 0; c2 < m2.Width(); c2++ {
+					row[c1*m2.Width()+c2] = m.matrix[r1*m.cols+c1] * m2.Get(r2, c2)
+				}
+			}
+		}
+	}
+
+	m.matrix = n
+	m.rows, m.cols = rows, cols
+
+	return m, nil
+}
+
+// row returns row r of this matrix without copying it.
+func (m *Matrix[T]) row(r int) []T {
+	return m.matrix[r*m.cols : (r+1)*m.cols]
+}
+
+// Trace will sum the elements on the diagonal of this matrix, returning a NotSquareError if it is not square.
+func (m *Matrix[T]) Trace() (T, error) {
+	if m.rows != m.cols {
+		var zero T
+		return zero, &immutabilitybenchmarking.NotSquareError{Rows: m.rows, Cols: m.cols}
+	}
+
+	var trace T
+
+	for i := 0; i < m.rows; i++ {
+		trace += m.matrix[i*m.cols+i]
+	}
+
+	return trace, nil
+}
+
+// Sum will add together every element of this matrix.
+func (m *Matrix[T]) Sum() T {
+	return numeric.Sum(m.rows, m.row)
+}
+
+// Min will return the smallest element of this matrix. Complex elements are ordered by their real parts and
+// then by their imaginary parts.
+func (m *Matrix[T]) Min() T {
+	r, c := numeric.ArgMin(m.rows, m.row)
+
+	return m.matrix[r*m.cols+c]
+}
+
+// Max will return the largest element of this matrix. Complex elements are ordered by their real parts and
+// then by their imaginary parts.
+func (m *Matrix[T]) Max() T {
+	r, c := numeric.ArgMax(m.rows, m.row)
+
+	return m.matrix[r*m.cols+c]
+}
+
+// ArgMin will return the coordinates of the first smallest element of this matrix in row-major order.
+func (m *Matrix[T]) ArgMin() (int, int) {
+	return numeric.ArgMin(m.rows, m.row)
+}
+
+// ArgMax will return the coordinates of the first largest element of this matrix in row-major order.
+func (m *Matrix[T]) ArgMax() (int, int) {
+	return numeric.ArgMax(m.rows, m.row)
+}
+
+// FrobeniusNorm will return the square root of the sum of the squared absolute values of the elements of
+// this matrix.
+func (m *Matrix[T]) FrobeniusNorm() float64 {
+	return numeric.FrobeniusNorm(m.rows, m.row)
+}
+
+// OneNorm will return the largest sum of the absolute values in a column of this matrix.
+func (m *Matrix[T]) OneNorm() float64 {
+	return numeric.OneNorm(m.rows, m.cols, m.row)
+}
+
+// InfNorm will return the largest sum of the absolute values in a row of this matrix.
+func (m *Matrix[T]) InfNorm() float64 {
+	return numeric.InfNorm(m.rows, m.row)
+}
+
+// Rank will return the number of linearly independent rows of this matrix, treating values within rounding
+// error of zero as zero.
+func (m *Matrix[T]) Rank() int {
+	return numeric.Rank(m.rows, m.cols, m.row)
+}
+
+// clone returns a copy of this matrix that does not share its elements.
+func (m *Matrix[T]) clone() *Matrix[T] {
+	return &Matrix[T]{rows: m.rows, cols: m.cols, matrix: append([]T(nil), m.matrix...)}
+}
+
+// Power will raise this matrix to the power n in place by repeated squaring, returning a NotSquareError if it
+// is not square or a NegativeExponentError if n is negative. The zeroth power is the identity matrix.
+func (m *Matrix[T]) Power(n int) (immutabilitybenchmarking.Matrix[T], error) {
+	if m.Height() != m.Width() {
+		return nil, &immutabilitybenchmarking.NotSquareError{Rows: m.Height(), Cols: m.Width()}
+	}
+
+	if n < 0 {
+		return nil, &immutabilitybenchmarking.NegativeExponentError{Exponent: n}
+	}
+
+	// Squaring replaces the elements of m, so the first power kept for the result has to be a copy.
+	p, err := power.BySquaring[immutabilitybenchmarking.Matrix[T]](m, n, func() (immutabilitybenchmarking.Matrix[T], error) {
+		return Factory[T]{}.Identity(m.Height())
+	}, func(b immutabilitybenchmarking.Matrix[T]) immutabilitybenchmarking.Matrix[T] {
+		return b.(*Matrix[T]).clone()
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	m.matrix = p.(*Matrix[T]).matrix
+
+	return m, nil
+}
+
+// resize will change the length of the backing slice to n, reusing its capacity when there is enough.
+func (m *Matrix[T]) resize(n int) {
+	if n <= cap(m.matrix) {
+		m.matrix = m.matrix[:n]
+		return
+	}
+
+	m.matrix = append(m.matrix, make([]T, n-len(m.matrix))...)
+}
+
+// Reshape will read the elements of this matrix in row-major order into the given dimensions, returning a
+// ReshapeError if they would not hold the same number of elements. Row-major order is the order of the
+// backing slice, so only the dimensions change.
+func (m *Matrix[T]) Reshape(rows int, cols int) (immutabilitybenchmarking.Matrix[T], error) {
+	if err := shape.CheckReshape(m, rows, cols); err != nil {
+		return nil, err
+	}
+
+	m.rows, m.cols = rows, cols
+
+	return m, nil
+}
+
+// HConcat will append the columns of the given matrix to the rows of this matrix, returning a
+// DimensionMismatchError if they do not have the same number of rows. The rows are moved apart within the
+// backing slice, starting from the last so none is overwritten before it has moved.
+func (m *Matrix[T]) HConcat(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if err := shape.CheckHConcat(m, m2); err != nil {
+		return nil, err
+	}
+
+	if o, ok := m2.(*Matrix[T]); ok && o == m {
+		m2 = m.clone()
+	}
+
+	cols := m.cols + m2.Width()
+	m.resize(m.rows * cols)
+
+	for r := m.rows - 1; r >= 0; r-- {
+		copy(m.matrix[r*cols:], m.matrix[r*m.cols:(r+1)*m.cols])
+
+		for c := m.cols; c < cols; c++ {
+			m.matrix[r*cols+c] = m2.Get(r, c-m.cols)
+		}
+	}
+
+	m.cols = cols
+
+	return m, nil
+}
+
+// VConcat will append the rows of the given matrix to this matrix, returning a DimensionMismatchError if they
+// do not have the same number of columns.
+func (m *Matrix[T]) VConcat(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	return m.stack("VConcat", m2)
+}
+
+// Stack will append the rows of each of the given matrices to this matrix in order, returning a
+// DimensionMismatchError for the first that does not have the same number of columns.
+func (m *Matrix[T]) Stack(ms ...immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	return m.stack("Stack", ms...)
+}
+
+func (m *Matrix[T]) stack(op string, ms ...immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if err := shape.CheckStack(op, m, ms...); err != nil {
+		return nil, err
+	}
+
+	// Any of ms can be m itself, so their heights are taken before the first row is appended.
+	heights := make([]int, len(ms))
+
+	for i, m2 := range ms {
+		heights[i] = m2.Height()
+	}
+
+	for i, m2 := range ms {
+		if o, ok := m2.(*Matrix[T]); ok {
+			m.matrix = append(m.matrix, o.matrix[:heights[i]*m.cols]...)
+			continue
+		}
+
+		for r := 0; r < heights[i]; r++ {
+			for c := 0; c < m.cols; c++ {
+				m.matrix = append(m.matrix, m2.Get(r, c))
+			}
+		}
+	}
+
+	m.rows = len(m.matrix) / m.cols
+
+	return m, nil
+}
+
+// SplitRows will keep the rows of this matrix before at and return them along with a new matrix holding the
+// rows from at onwards, returning an InvalidDimensionsError if either part would be empty. The new matrix
+// takes the end of the backing slice without copying it, and this matrix loses the capacity to grow into it.
+func (m *Matrix[T]) SplitRows(at int) (immutabilitybenchmarking.Matrix[T], immutabilitybenchmarking.Matrix[T], error) {
+	if err := shape.CheckSplitRows(m, at); err != nil {
+		return nil, nil, err
+	}
+
+	i := at * m.cols
+	rest := &Matrix[T]{rows: m.rows - at, cols: m.cols, matrix: m.matrix[i:]}
+	m.rows, m.matrix = at, m.matrix[:i:i]
+
+	return m, rest, nil
+}
+
+// SplitCols will keep the columns of this matrix before at and return them along with a new matrix holding
+// copies of the columns from at onwards, returning an InvalidDimensionsError if either part would be empty.
+// The kept columns are moved together at the front of the backing slice.
+func (m *Matrix[T]) SplitCols(at int) (immutabilitybenchmarking.Matrix[T], immutabilitybenchmarking.Matrix[T], error) {
+	if err := shape.CheckSplitCols(m, at); err != nil {
+		return nil, nil, err
+	}
+
+	rest := &Matrix[T]{rows: m.rows, cols: m.cols - at, matrix: make([]T, 0, m.rows*(m.cols-at))}
+
+	for r := 0; r < m.rows; r++ {
+		rest.matrix = append(rest.matrix, m.matrix[r*m.cols+at:(r+1)*m.cols]...)
+		copy(m.matrix[r*at:], m.matrix[r*m.cols:r*m.cols+at])
+	}
+
+	m.cols, m.matrix = at, m.matrix[:m.rows*at]
+
+	return m, rest, nil
+}
+
+// Pad will surround this matrix with the given number of rows and columns of zeros on each side, returning
+// a PaddingError if any of them is negative. The rows are moved apart within the backing slice, starting
+// from the last so none is overwritten before it has moved, and the space left around them is cleared.
+func (m *Matrix[T]) Pad(top int, bottom int, left int, right int) (immutabilitybenchmarking.Matrix[T], error) {
+	if err := shape.CheckPad(top, bottom, left, right); err != nil {
+		return nil, err
+	}
+
+	rows, cols := top+m.rows+bottom, left+m.cols+right
+	m.resize(rows * cols)
+
+	for r := m.rows - 1; r >= 0; r-- {
+		copy(m.matrix[(top+r)*cols+left:], m.matrix[r*m.cols:(r+1)*m.cols])
+	}
+
+	clear(m.matrix[:top*cols+left])
+
+	for r := top; r < top+m.rows-1; r++ {
+		clear(m.matrix[r*cols+left+m.cols : (r+1)*cols+left])
+	}
+
+	clear(m.matrix[(top+m.rows-1)*cols+left+m.cols:])
+
+	m.rows, m.cols = rows, cols
+
+	return m, nil
+}
+
+// Flip will reverse the order of the rows or the columns of this matrix in place.
+func (m *Matrix[T]) Flip(axis immutabilitybenchmarking.Axis) immutabilitybenchmarking.Matrix[T] {
+	if axis == immutabilitybenchmarking.RowAxis {
+		for i, j := 0, m.rows-1; i < j; i, j = i+1, j-1 {
+			for c := 0; c < m.cols; c++ {
+				m.matrix[i*m.cols+c], m.matrix[j*m.cols+c] = m.matrix[j*m.cols+c], m.matrix[i*m.cols+c]
+			}
+		}
+
+		return m
+	}
+
+	for r := 0; r < m.rows; r++ {
+		row := m.matrix[r*m.cols : (r+1)*m.cols]
+
+		for i, j := 0, len(row)-1; i < j; i, j = i+1, j-1 {
+			row[i], row[j] = row[j], row[i]
+		}
+	}
+
+	return m
+}
+
+// Rotate90 will turn this matrix a quarter turn clockwise. Square matrices are rotated in place, a ring of
+// four elements at a time.
+func (m *Matrix[T]) Rotate90() immutabilitybenchmarking.Matrix[T] {
+	h, w := m.rows, m.cols
+
+	if h == w {
+		a := m.matrix
+
+		for r := 0; r < h/2; r++ {
+			for c := r; c < h-1-r; c++ {
+				a[r*h+c], a[c*h+h-1-r], a[(h-1-r)*h+h-1-c], a[(h-1-c)*h+r] = a[(h-1-c)*h+r], a[r*h+c], a[c*h+h-1-r], a[(h-1-r)*h+h-1-c]
+			}
+		}
+
+		return m
+	}
+
+	t := make([]T, len(m.matrix))
+
+	for r := 0; r < w; r++ {
+		for c := 0; c < h; c++ {
+			t[r*h+c] = m.matrix[(h-1-c)*w+r]
+		}
+	}
+
+	m.matrix = t
+	m.rows, m.cols = w, h
+
+	return m
+}
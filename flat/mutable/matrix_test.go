@@ -0,0 +1,166 @@
+package mutable
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/chris-tomich/immutability-benchmarking"
+	"github.com/chris-tomich/immutability-benchmarking/matrixtest"
+)
+
+func TestMutableMatrixMultiplication(t *testing.T) {
+	m1 := mustNew(
+		[][]int{
+			{2, 3, 4},
+			{1, 0, 0},
+		},
+	)
+
+	m2 := mustNew(
+		[][]int{
+			{0, 1000},
+			{1, 100},
+			{0, 10},
+		},
+	)
+
+	m1.MatrixMultiply(m2)
+	m1EqualsM2 := m1.Equals(mustNew(
+		[][]int{
+			{3, 2340},
+			{0, 1000},
+		},
+	))
+
+	if !m1EqualsM2 {
+		t.Fail()
+	}
+
+	m3 := mustNew(
+		[][]int{
+			{2, 3, 4},
+		},
+	)
+
+	m4 := mustNew(
+		[][]int{
+			{0, 1000},
+			{1, 100},
+			{0, 10},
+		},
+	)
+
+	m3.MatrixMultiply(m4)
+	m3EqualsM4 := m3.Equals(mustNew(
+		[][]int{
+			{3, 2340},
+		},
+	))
+
+	if !m3EqualsM4 {
+		t.Fail()
+	}
+
+	m5 := mustNew(
+		[][]int{
+			{2, 3, 4},
+			{1, 0, 0},
+		},
+	)
+
+	m6 := mustNew(
+		[][]int{
+			{0},
+			{1},
+			{0},
+		},
+	)
+
+	m5.MatrixMultiply(m6)
+	m5EqualsM6 := m5.Equals(mustNew(
+		[][]int{
+			{3},
+			{0},
+		},
+	))
+
+	if !m5EqualsM6 {
+		fmt.Println(m5)
+		t.Fail()
+	}
+}
+
+func TestMutableComplexMatrixMultiplication(t *testing.T) {
+	m1 := mustNew(
+		[][]complex128{
+			{1i, 2},
+			{0, 1 - 1i},
+		},
+	)
+
+	m2 := mustNew(
+		[][]complex128{
+			{1i},
+			{3},
+		},
+	)
+
+	m1.MatrixMultiply(m2)
+	m1EqualsM2 := m1.Equals(mustNew(
+		[][]complex128{
+			{5},
+			{3 - 3i},
+		},
+	))
+
+	if !m1EqualsM2 {
+		fmt.Println(m1)
+		t.Fail()
+	}
+}
+
+func mustNew[T immutabilitybenchmarking.Number](matrix [][]T) *Matrix[T] {
+	m, err := New(matrix)
+	if err != nil {
+		panic(err)
+	}
+
+	return m
+}
+
+func TestMutableMatrixConformance(t *testing.T) {
+	t.Run("int", func(t *testing.T) {
+		matrixtest.RunConformance[int](t, Factory[int]{})
+	})
+
+	t.Run("uint8", func(t *testing.T) {
+		matrixtest.RunConformance[uint8](t, Factory[uint8]{})
+	})
+
+	t.Run("float64", func(t *testing.T) {
+		matrixtest.RunConformance[float64](t, Factory[float64]{})
+	})
+
+	t.Run("complex128", func(t *testing.T) {
+		matrixtest.RunConformance[complex128](t, Factory[complex128]{})
+	})
+}
+
+func TestMutableMatrixGetOutOfBounds(t *testing.T) {
+	m := mustNew([][]int{{1, 2}, {3, 4}})
+
+	// 0,2 would otherwise read the element at 1,0 from the backing slice.
+	for _, c := range [][2]int{{0, 2}, {-1, 1}, {2, 0}, {1, -1}} {
+		func() {
+			defer func() {
+				var outOfBounds *immutabilitybenchmarking.OutOfBoundsError
+				if err, _ := recover().(error); !errors.As(err, &outOfBounds) {
+					t.Errorf("%d,%d: expected a panic with an OutOfBoundsError but got %v", c[0], c[1], err)
+				}
+			}()
+
+			m.Get(c[0], c[1])
+		}()
+	}
+}
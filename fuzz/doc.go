@@ -0,0 +1,3 @@
+// Package fuzz holds the native Go fuzz targets that run every backend against decoded matrix shapes and
+// operation sequences.
+package fuzz
@@ -0,0 +1,308 @@
+package fuzz
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/chris-tomich/immutability-benchmarking"
+	arrayimmutable "github.com/chris-tomich/immutability-benchmarking/array/immutable"
+	arraymutable "github.com/chris-tomich/immutability-benchmarking/array/mutable"
+	flatimmutable "github.com/chris-tomich/immutability-benchmarking/flat/immutable"
+	flatmutable "github.com/chris-tomich/immutability-benchmarking/flat/mutable"
+	"github.com/chris-tomich/immutability-benchmarking/persistent"
+	sliceimmutable "github.com/chris-tomich/immutability-benchmarking/slice/immutable"
+	slicemutable "github.com/chris-tomich/immutability-benchmarking/slice/mutable"
+	cooimmutable "github.com/chris-tomich/immutability-benchmarking/sparse/coo/immutable"
+	coomutable "github.com/chris-tomich/immutability-benchmarking/sparse/coo/mutable"
+	csrimmutable "github.com/chris-tomich/immutability-benchmarking/sparse/csr/immutable"
+	csrmutable "github.com/chris-tomich/immutability-benchmarking/sparse/csr/mutable"
+)
+
+type backend struct {
+	name    string
+	factory immutabilitybenchmarking.Factory[int]
+}
+
+var backends = []backend{
+	{name: "slice/mutable", factory: slicemutable.Factory[int]{}},
+	{name: "slice/immutable", factory: sliceimmutable.Factory[int]{}},
+	{name: "array/mutable", factory: arraymutable.Factory[int]{}},
+	{name: "array/immutable", factory: arrayimmutable.Factory[int]{}},
+	{name: "flat/mutable", factory: flatmutable.Factory[int]{}},
+	{name: "flat/immutable", factory: flatimmutable.Factory[int]{}},
+	{name: "persistent", factory: persistent.Factory[int]{}},
+	{name: "sparse/csr/mutable", factory: csrmutable.Factory[int]{}},
+	{name: "sparse/csr/immutable", factory: csrimmutable.Factory[int]{}},
+	{name: "sparse/coo/mutable", factory: coomutable.Factory[int]{}},
+	{name: "sparse/coo/immutable", factory: cooimmutable.Factory[int]{}},
+}
+
+// constructors lists every way of building a matrix from rows, including the package level New functions
+// that take their input without going through a factory.
+var constructors = map[string]func(rows [][]int) (immutabilitybenchmarking.Matrix[int], error){
+	"slice/mutable.New": func(rows [][]int) (immutabilitybenchmarking.Matrix[int], error) {
+		m, err := slicemutable.New(rows)
+		if err != nil {
+			return nil, err
+		}
+		return m, nil
+	},
+	"slice/immutable.New": func(rows [][]int) (immutabilitybenchmarking.Matrix[int], error) {
+		m, err := sliceimmutable.New(rows)
+		if err != nil {
+			return nil, err
+		}
+		return m, nil
+	},
+	"slice/immutable.NewUnsafeNoCopy": func(rows [][]int) (immutabilitybenchmarking.Matrix[int], error) {
+		m, err := sliceimmutable.NewUnsafeNoCopy(rows)
+		if err != nil {
+			return nil, err
+		}
+		return m, nil
+	},
+}
+
+func init() {
+	for _, b := range backends {
+		constructors[b.name+".FromRows"] = b.factory.FromRows
+	}
+}
+
+// decoder turns fuzz input into matrix shapes, values and operations, returning zero once the input has
+// been used up.
+type decoder struct {
+	data []byte
+}
+
+func (d *decoder) next() byte {
+	if len(d.data) == 0 {
+		return 0
+	}
+
+	b := d.data[0]
+	d.data = d.data[1:]
+
+	return b
+}
+
+func (d *decoder) dimension() int {
+	return int(d.next() % 6)
+}
+
+// rows decodes a possibly empty or jagged set of rows.
+func (d *decoder) rows() [][]int {
+	rows, cols := d.dimension(), d.dimension()
+	m := make([][]int, rows)
+
+	for r := 0; r < rows; r++ {
+		width := cols
+		if d.next()%8 == 7 {
+			width = d.dimension()
+		}
+
+		m[r] = make([]int, width)
+
+		for c := 0; c < width; c++ {
+			m[r][c] = int(int8(d.next()))
+		}
+	}
+
+	return m
+}
+
+// checkError fails the test unless err is one of the typed errors returned by the backends.
+func checkError(t *testing.T, name string, err error) {
+	t.Helper()
+
+	var dimensionMismatch *immutabilitybenchmarking.DimensionMismatchError
+	var invalidDimensions *immutabilitybenchmarking.InvalidDimensionsError
+	var jaggedRows *immutabilitybenchmarking.JaggedRowsError
+	var sizeLimit *immutabilitybenchmarking.SizeLimitError
+
+	switch {
+	case errors.As(err, &dimensionMismatch):
+	case errors.As(err, &invalidDimensions):
+	case errors.As(err, &jaggedRows):
+	case errors.As(err, &sizeLimit):
+	default:
+		t.Fatalf("%s: returned an untyped error %v", name, err)
+	}
+}
+
+func isValid(rows [][]int) bool {
+	if len(rows) == 0 || len(rows[0]) == 0 {
+		return false
+	}
+
+	for r := 1; r < len(rows); r++ {
+		if len(rows[r]) != len(rows[0]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// checkValues reads every element of m through Get and compares it against the expected values.
+func checkValues(t *testing.T, name string, m immutabilitybenchmarking.Matrix[int], expected [][]int) {
+	t.Helper()
+
+	if m.Height() != len(expected) || m.Width() != len(expected[0]) {
+		t.Fatalf("%s: expected a %dx%d matrix but got %dx%d", name, len(expected), len(expected[0]), m.Height(), m.Width())
+	}
+
+	for r := 0; r < m.Height(); r++ {
+		for c := 0; c < m.Width(); c++ {
+			if m.Get(r, c) != expected[r][c] {
+				t.Fatalf("%s: expected %d at %d,%d but got %d", name, expected[r][c], r, c, m.Get(r, c))
+			}
+		}
+	}
+}
+
+func toRows(m immutabilitybenchmarking.Matrix[int]) [][]int {
+	rows := make([][]int, m.Height())
+
+	for r := 0; r < m.Height(); r++ {
+		rows[r] = make([]int, m.Width())
+
+		for c := 0; c < m.Width(); c++ {
+			rows[r][c] = m.Get(r, c)
+		}
+	}
+
+	return rows
+}
+
+func FuzzNew(f *testing.F) {
+	f.Add([]byte{2, 3, 0, 1, 2, 3, 0, 4, 5, 6})
+	f.Add([]byte{0, 3})
+	f.Add([]byte{3, 0})
+	f.Add([]byte{2, 2, 0, 1, 2, 7, 3, 1, 2, 3})
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		d := &decoder{data: data}
+		rows := d.rows()
+
+		for name, construct := range constructors {
+			m, err := construct(rows)
+
+			if !isValid(rows) {
+				if err == nil {
+					t.Fatalf("%s: expected %v to be rejected", name, rows)
+				}
+
+				checkError(t, name, err)
+				continue
+			}
+
+			if err != nil {
+				t.Fatalf("%s: unexpected error %v", name, err)
+			}
+
+			checkValues(t, name, m, rows)
+		}
+	})
+}
+
+func FuzzOperations(f *testing.F) {
+	f.Add([]byte{2, 3, 0, 1, 2, 3, 0, 4, 5, 6, 3, 4, 3, 2, 0, 1, 0, 1, 0, 1, 0, 1})
+	f.Add([]byte{1, 1, 0, 5, 0, 1, 1, 0, 2, 2, 255, 5, 1, 1, 0, 5})
+	f.Add([]byte{3, 2, 0, 1, 2, 0, 3, 4, 0, 5, 6, 4, 3, 3, 0, 1, 0, 0, 0, 1, 0, 0, 0, 1, 1, 2, 3})
+	f.Add([]byte{2, 2, 0, 1, 2, 0, 3, 4, 0, 3, 3, 3, 0, 1, 2, 3})
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		d := &decoder{data: data}
+
+		rows := d.rows()
+		if !isValid(rows) {
+			return
+		}
+
+		current := make([]immutabilitybenchmarking.Matrix[int], len(backends))
+
+		for i, b := range backends {
+			m, err := b.factory.FromRows(rows)
+			if err != nil {
+				t.Fatalf("%s: unexpected error %v", b.name, err)
+			}
+
+			current[i] = m
+		}
+
+		for step := 0; step < 16 && len(d.data) > 0; step++ {
+			op := d.next() % 6
+
+			var other [][]int
+			var scalar int
+
+			switch op {
+			case 0, 1, 4, 5:
+				other = d.rows()
+				if !isValid(other) {
+					continue
+				}
+			case 2:
+				scalar = int(int8(d.next()))
+			}
+
+			var expected [][]int
+			var expectedErr error
+
+			for i, b := range backends {
+				name := fmt.Sprintf("%s step %d", b.name, step)
+
+				var arg immutabilitybenchmarking.Matrix[int]
+				if other != nil {
+					var err error
+					if arg, err = b.factory.FromRows(other); err != nil {
+						t.Fatalf("%s: unexpected error %v", name, err)
+					}
+				}
+
+				var result immutabilitybenchmarking.Matrix[int]
+				var err error
+
+				switch op {
+				case 0:
+					result, err = current[i].Add(arg)
+				case 1:
+					result, err = current[i].Subtract(arg)
+				case 2:
+					result = current[i].ScalarMultiply(scalar)
+				case 3:
+					result = current[i].Transpose()
+				case 4:
+					result, err = current[i].MatrixMultiply(arg)
+				case 5:
+					equals := current[i].Equals(arg)
+					if equals != (fmt.Sprint(toRows(current[i])) == fmt.Sprint(other)) {
+						t.Fatalf("%s: Equals returned %v comparing %v with %v", name, equals, toRows(current[i]), other)
+					}
+					continue
+				}
+
+				if i == 0 {
+					expectedErr = err
+				} else if (err == nil) != (expectedErr == nil) {
+					t.Fatalf("%s: returned error %v but %s returned %v", name, err, backends[0].name, expectedErr)
+				}
+
+				if err != nil {
+					checkError(t, name, err)
+					continue
+				}
+
+				if i == 0 {
+					expected = toRows(result)
+				} else {
+					checkValues(t, name, result, expected)
+				}
+
+				current[i] = result
+			}
+		}
+	})
+}
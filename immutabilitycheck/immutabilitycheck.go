@@ -0,0 +1,615 @@
+// Package immutabilitycheck verifies at test time that matrix operations leave their receiver and argument
+// untouched.
+package immutabilitycheck
+
+import (
+	"hash/fnv"
+	"testing"
+	"unsafe"
+
+	"github.com/chris-tomich/immutability-benchmarking"
+)
+
+// Matrix wraps a matrix and reports a test failure whenever one of its operations changes the contents of
+// the wrapped matrix or of the matrix passed to it. Matrices returned by operations are wrapped as well so
+// chained operations stay checked.
+type Matrix[T immutabilitybenchmarking.Number] struct {
+	tb     testing.TB
+	matrix immutabilitybenchmarking.Matrix[T]
+}
+
+// Wrap returns m wrapped so that every operation on it is checked for modifications.
+func Wrap[T immutabilitybenchmarking.Number](tb testing.TB, m immutabilitybenchmarking.Matrix[T]) Matrix[T] {
+	return Matrix[T]{tb: tb, matrix: unwrap(m)}
+}
+
+// Hash returns a hash of the dimensions and contents of a matrix.
+func Hash[T immutabilitybenchmarking.Number](m immutabilitybenchmarking.Matrix[T]) uint64 {
+	h := fnv.New64a()
+
+	dimensions := [2]int{m.Height(), m.Width()}
+	h.Write(unsafe.Slice((*byte)(unsafe.Pointer(&dimensions)), unsafe.Sizeof(dimensions)))
+
+	for r := 0; r < m.Height(); r++ {
+		for c := 0; c < m.Width(); c++ {
+			// Hashing the raw bytes keeps values such as NaN, which never equal themselves, stable.
+			v := m.Get(r, c)
+			h.Write(unsafe.Slice((*byte)(unsafe.Pointer(&v)), unsafe.Sizeof(v)))
+		}
+	}
+
+	return h.Sum64()
+}
+
+func unwrap[T immutabilitybenchmarking.Number](m immutabilitybenchmarking.Matrix[T]) immutabilitybenchmarking.Matrix[T] {
+	if w, ok := m.(Matrix[T]); ok {
+		return w.matrix
+	}
+
+	return m
+}
+
+// check runs op and reports if it changed the receiver or, when given, the argument.
+func (w Matrix[T]) check(name string, m2 immutabilitybenchmarking.Matrix[T], op func()) {
+	w.tb.Helper()
+
+	before := Hash(w.matrix)
+
+	var before2 uint64
+	if m2 != nil {
+		before2 = Hash(m2)
+	}
+
+	op()
+
+	if Hash(w.matrix) != before {
+		w.tb.Errorf("%s modified its receiver", name)
+	}
+
+	if m2 != nil && Hash(m2) != before2 {
+		w.tb.Errorf("%s modified its argument", name)
+	}
+}
+
+func (w Matrix[T]) wrap(m immutabilitybenchmarking.Matrix[T], err error) (immutabilitybenchmarking.Matrix[T], error) {
+	if err != nil {
+		return m, err
+	}
+
+	return Matrix[T]{tb: w.tb, matrix: m}, nil
+}
+
+// Unwrap returns the matrix being checked.
+func (w Matrix[T]) Unwrap() immutabilitybenchmarking.Matrix[T] {
+	return w.matrix
+}
+
+// Width returns the number of columns in the matrix.
+func (w Matrix[T]) Width() int {
+	return w.matrix.Width()
+}
+
+// Height returns the number of rows in the matrix.
+func (w Matrix[T]) Height() int {
+	return w.matrix.Height()
+}
+
+// Get returns the element at the provided coordinates.
+func (w Matrix[T]) Get(row int, col int) T {
+	return w.matrix.Get(row, col)
+}
+
+// At returns the element at the provided coordinates or an OutOfBoundsError if they are outside the matrix.
+func (w Matrix[T]) At(row int, col int) (T, error) {
+	return w.matrix.At(row, col)
+}
+
+// MustAt returns the element at the provided coordinates and panics with an OutOfBoundsError if they are
+// outside the matrix.
+func (w Matrix[T]) MustAt(row int, col int) T {
+	return w.matrix.MustAt(row, col)
+}
+
+// Equals will compare a matrix against this matrix and return if they are equal.
+func (w Matrix[T]) Equals(m2 immutabilitybenchmarking.Matrix[T]) bool {
+	w.tb.Helper()
+
+	m2 = unwrap(m2)
+
+	var equals bool
+	w.check("Equals", m2, func() {
+		equals = w.matrix.Equals(m2)
+	})
+
+	return equals
+}
+
+// Add will add the values of a matrix to this matrix.
+func (w Matrix[T]) Add(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	w.tb.Helper()
+
+	m2 = unwrap(m2)
+
+	var m immutabilitybenchmarking.Matrix[T]
+	var err error
+	w.check("Add", m2, func() {
+		m, err = w.matrix.Add(m2)
+	})
+
+	return w.wrap(m, err)
+}
+
+// Subtract will subtract the values of a matrix from this matrix.
+func (w Matrix[T]) Subtract(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	w.tb.Helper()
+
+	m2 = unwrap(m2)
+
+	var m immutabilitybenchmarking.Matrix[T]
+	var err error
+	w.check("Subtract", m2, func() {
+		m, err = w.matrix.Subtract(m2)
+	})
+
+	return w.wrap(m, err)
+}
+
+// ScalarMultiply will multiply this matrix by a given scalar value.
+func (w Matrix[T]) ScalarMultiply(s T) immutabilitybenchmarking.Matrix[T] {
+	w.tb.Helper()
+
+	var m immutabilitybenchmarking.Matrix[T]
+	w.check("ScalarMultiply", nil, func() {
+		m = w.matrix.ScalarMultiply(s)
+	})
+
+	m, _ = w.wrap(m, nil)
+
+	return m
+}
+
+// Transpose will transpose this matrix.
+func (w Matrix[T]) Transpose() immutabilitybenchmarking.Matrix[T] {
+	w.tb.Helper()
+
+	var m immutabilitybenchmarking.Matrix[T]
+	w.check("Transpose", nil, func() {
+		m = w.matrix.Transpose()
+	})
+
+	m, _ = w.wrap(m, nil)
+
+	return m
+}
+
+// MatrixMultiply will multiple the given matrix against this matrix.
+func (w Matrix[T]) MatrixMultiply(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	w.tb.Helper()
+
+	m2 = unwrap(m2)
+
+	var m immutabilitybenchmarking.Matrix[T]
+	var err error
+	w.check("MatrixMultiply", m2, func() {
+		m, err = w.matrix.MatrixMultiply(m2)
+	})
+
+	return w.wrap(m, err)
+}
+
+// Map will apply f to every element of this matrix.
+func (w Matrix[T]) Map(f func(v T) T) immutabilitybenchmarking.Matrix[T] {
+	w.tb.Helper()
+
+	var m immutabilitybenchmarking.Matrix[T]
+	w.check("Map", nil, func() {
+		m = w.matrix.Map(f)
+	})
+
+	m, _ = w.wrap(m, nil)
+
+	return m
+}
+
+// MapIndexed will apply f to every element of this matrix along with its coordinates.
+func (w Matrix[T]) MapIndexed(f func(row int, col int, v T) T) immutabilitybenchmarking.Matrix[T] {
+	w.tb.Helper()
+
+	var m immutabilitybenchmarking.Matrix[T]
+	w.check("MapIndexed", nil, func() {
+		m = w.matrix.MapIndexed(f)
+	})
+
+	m, _ = w.wrap(m, nil)
+
+	return m
+}
+
+// ZipWith will combine each element of this matrix with the element at the same coordinates of the given
+// matrix using f.
+func (w Matrix[T]) ZipWith(m2 immutabilitybenchmarking.Matrix[T], f func(a T, b T) T) (immutabilitybenchmarking.Matrix[T], error) {
+	w.tb.Helper()
+
+	m2 = unwrap(m2)
+
+	var m immutabilitybenchmarking.Matrix[T]
+	var err error
+	w.check("ZipWith", m2, func() {
+		m, err = w.matrix.ZipWith(m2, f)
+	})
+
+	return w.wrap(m, err)
+}
+
+// Fold will combine every element of this matrix into a single value, starting from initial and visiting
+// the elements in row-major order.
+func (w Matrix[T]) Fold(initial T, f func(acc T, v T) T) T {
+	w.tb.Helper()
+
+	var v T
+	w.check("Fold", nil, func() {
+		v = w.matrix.Fold(initial, f)
+	})
+
+	return v
+}
+
+// RowReduce will fold each row of this matrix into a single value starting from initial, returning a
+// column of the results.
+func (w Matrix[T]) RowReduce(initial T, f func(acc T, v T) T) immutabilitybenchmarking.Matrix[T] {
+	w.tb.Helper()
+
+	var m immutabilitybenchmarking.Matrix[T]
+	w.check("RowReduce", nil, func() {
+		m = w.matrix.RowReduce(initial, f)
+	})
+
+	m, _ = w.wrap(m, nil)
+
+	return m
+}
+
+// ColReduce will fold each column of this matrix into a single value starting from initial, returning a
+// row of the results.
+func (w Matrix[T]) ColReduce(initial T, f func(acc T, v T) T) immutabilitybenchmarking.Matrix[T] {
+	w.tb.Helper()
+
+	var m immutabilitybenchmarking.Matrix[T]
+	w.check("ColReduce", nil, func() {
+		m = w.matrix.ColReduce(initial, f)
+	})
+
+	m, _ = w.wrap(m, nil)
+
+	return m
+}
+
+// HadamardProduct will multiply each element of this matrix by the element at the same coordinates of the
+// given matrix.
+func (w Matrix[T]) HadamardProduct(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	w.tb.Helper()
+
+	m2 = unwrap(m2)
+
+	var m immutabilitybenchmarking.Matrix[T]
+	var err error
+	w.check("HadamardProduct", m2, func() {
+		m, err = w.matrix.HadamardProduct(m2)
+	})
+
+	return w.wrap(m, err)
+}
+
+// ElementwiseDivide will divide each element of this matrix by the element at the same coordinates of the
+// given matrix, returning a DivisionByZeroError if any of them is zero.
+func (w Matrix[T]) ElementwiseDivide(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	w.tb.Helper()
+
+	m2 = unwrap(m2)
+
+	var m immutabilitybenchmarking.Matrix[T]
+	var err error
+	w.check("ElementwiseDivide", m2, func() {
+		m, err = w.matrix.ElementwiseDivide(m2)
+	})
+
+	return w.wrap(m, err)
+}
+
+// KroneckerProduct will multiply every element of this matrix by the whole of the given matrix.
+func (w Matrix[T]) KroneckerProduct(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	w.tb.Helper()
+
+	m2 = unwrap(m2)
+
+	var m immutabilitybenchmarking.Matrix[T]
+	var err error
+	w.check("KroneckerProduct", m2, func() {
+		m, err = w.matrix.KroneckerProduct(m2)
+	})
+
+	return w.wrap(m, err)
+}
+
+// Trace will sum the elements on the diagonal of this matrix, returning a NotSquareError if it is not square.
+func (w Matrix[T]) Trace() (T, error) {
+	w.tb.Helper()
+
+	var v T
+	var err error
+	w.check("Trace", nil, func() {
+		v, err = w.matrix.Trace()
+	})
+
+	return v, err
+}
+
+// Sum will add together every element of this matrix.
+func (w Matrix[T]) Sum() T {
+	w.tb.Helper()
+
+	var v T
+	w.check("Sum", nil, func() {
+		v = w.matrix.Sum()
+	})
+
+	return v
+}
+
+// Min will return the smallest element of this matrix.
+func (w Matrix[T]) Min() T {
+	w.tb.Helper()
+
+	var v T
+	w.check("Min", nil, func() {
+		v = w.matrix.Min()
+	})
+
+	return v
+}
+
+// Max will return the largest element of this matrix.
+func (w Matrix[T]) Max() T {
+	w.tb.Helper()
+
+	var v T
+	w.check("Max", nil, func() {
+		v = w.matrix.Max()
+	})
+
+	return v
+}
+
+// ArgMin will return the coordinates of the first smallest element of this matrix in row-major order.
+func (w Matrix[T]) ArgMin() (int, int) {
+	w.tb.Helper()
+
+	var row, col int
+	w.check("ArgMin", nil, func() {
+		row, col = w.matrix.ArgMin()
+	})
+
+	return row, col
+}
+
+// ArgMax will return the coordinates of the first largest element of this matrix in row-major order.
+func (w Matrix[T]) ArgMax() (int, int) {
+	w.tb.Helper()
+
+	var row, col int
+	w.check("ArgMax", nil, func() {
+		row, col = w.matrix.ArgMax()
+	})
+
+	return row, col
+}
+
+// FrobeniusNorm will return the square root of the sum of the squared absolute values of the elements of
+// this matrix.
+func (w Matrix[T]) FrobeniusNorm() float64 {
+	w.tb.Helper()
+
+	var norm float64
+	w.check("FrobeniusNorm", nil, func() {
+		norm = w.matrix.FrobeniusNorm()
+	})
+
+	return norm
+}
+
+// OneNorm will return the largest sum of the absolute values in a column of this matrix.
+func (w Matrix[T]) OneNorm() float64 {
+	w.tb.Helper()
+
+	var norm float64
+	w.check("OneNorm", nil, func() {
+		norm = w.matrix.OneNorm()
+	})
+
+	return norm
+}
+
+// InfNorm will return the largest sum of the absolute values in a row of this matrix.
+func (w Matrix[T]) InfNorm() float64 {
+	w.tb.Helper()
+
+	var norm float64
+	w.check("InfNorm", nil, func() {
+		norm = w.matrix.InfNorm()
+	})
+
+	return norm
+}
+
+// Rank will return the number of linearly independent rows of this matrix.
+func (w Matrix[T]) Rank() int {
+	w.tb.Helper()
+
+	var rank int
+	w.check("Rank", nil, func() {
+		rank = w.matrix.Rank()
+	})
+
+	return rank
+}
+
+// Power will raise this matrix to the power n, returning a NotSquareError if it is not square or a
+// NegativeExponentError if n is negative.
+func (w Matrix[T]) Power(n int) (immutabilitybenchmarking.Matrix[T], error) {
+	w.tb.Helper()
+
+	var m immutabilitybenchmarking.Matrix[T]
+	var err error
+	w.check("Power", nil, func() {
+		m, err = w.matrix.Power(n)
+	})
+
+	return w.wrap(m, err)
+}
+
+// Reshape will read the elements of this matrix in row-major order into a matrix with the given dimensions,
+// returning a ReshapeError if it would not hold the same number of elements.
+func (w Matrix[T]) Reshape(rows int, cols int) (immutabilitybenchmarking.Matrix[T], error) {
+	w.tb.Helper()
+
+	var m immutabilitybenchmarking.Matrix[T]
+	var err error
+	w.check("Reshape", nil, func() {
+		m, err = w.matrix.Reshape(rows, cols)
+	})
+
+	return w.wrap(m, err)
+}
+
+// HConcat will place the given matrix to the right of this matrix.
+func (w Matrix[T]) HConcat(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	w.tb.Helper()
+
+	m2 = unwrap(m2)
+
+	var m immutabilitybenchmarking.Matrix[T]
+	var err error
+	w.check("HConcat", m2, func() {
+		m, err = w.matrix.HConcat(m2)
+	})
+
+	return w.wrap(m, err)
+}
+
+// VConcat will place the given matrix below this matrix.
+func (w Matrix[T]) VConcat(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	w.tb.Helper()
+
+	m2 = unwrap(m2)
+
+	var m immutabilitybenchmarking.Matrix[T]
+	var err error
+	w.check("VConcat", m2, func() {
+		m, err = w.matrix.VConcat(m2)
+	})
+
+	return w.wrap(m, err)
+}
+
+// Stack will place the given matrices below this matrix in order. Every one of the given matrices is
+// checked, not just the first.
+func (w Matrix[T]) Stack(ms ...immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	w.tb.Helper()
+
+	unwrapped := make([]immutabilitybenchmarking.Matrix[T], len(ms))
+	before := make([]uint64, len(ms))
+
+	for i, m2 := range ms {
+		unwrapped[i] = unwrap(m2)
+		before[i] = Hash(unwrapped[i])
+	}
+
+	var m immutabilitybenchmarking.Matrix[T]
+	var err error
+	w.check("Stack", nil, func() {
+		m, err = w.matrix.Stack(unwrapped...)
+	})
+
+	for i, m2 := range unwrapped {
+		if Hash(m2) != before[i] {
+			w.tb.Errorf("Stack modified its argument %d", i)
+		}
+	}
+
+	return w.wrap(m, err)
+}
+
+// SplitRows will split this matrix into its rows before at and its rows from at onwards.
+func (w Matrix[T]) SplitRows(at int) (immutabilitybenchmarking.Matrix[T], immutabilitybenchmarking.Matrix[T], error) {
+	w.tb.Helper()
+
+	var m1, m2 immutabilitybenchmarking.Matrix[T]
+	var err error
+	w.check("SplitRows", nil, func() {
+		m1, m2, err = w.matrix.SplitRows(at)
+	})
+
+	return w.wrapSplit(m1, m2, err)
+}
+
+// SplitCols will split this matrix into its columns before at and its columns from at onwards.
+func (w Matrix[T]) SplitCols(at int) (immutabilitybenchmarking.Matrix[T], immutabilitybenchmarking.Matrix[T], error) {
+	w.tb.Helper()
+
+	var m1, m2 immutabilitybenchmarking.Matrix[T]
+	var err error
+	w.check("SplitCols", nil, func() {
+		m1, m2, err = w.matrix.SplitCols(at)
+	})
+
+	return w.wrapSplit(m1, m2, err)
+}
+
+func (w Matrix[T]) wrapSplit(m1 immutabilitybenchmarking.Matrix[T], m2 immutabilitybenchmarking.Matrix[T], err error) (immutabilitybenchmarking.Matrix[T], immutabilitybenchmarking.Matrix[T], error) {
+	if err != nil {
+		return m1, m2, err
+	}
+
+	return Matrix[T]{tb: w.tb, matrix: m1}, Matrix[T]{tb: w.tb, matrix: m2}, nil
+}
+
+// Pad will surround this matrix with the given number of rows and columns of zeros on each side.
+func (w Matrix[T]) Pad(top int, bottom int, left int, right int) (immutabilitybenchmarking.Matrix[T], error) {
+	w.tb.Helper()
+
+	var m immutabilitybenchmarking.Matrix[T]
+	var err error
+	w.check("Pad", nil, func() {
+		m, err = w.matrix.Pad(top, bottom, left, right)
+	})
+
+	return w.wrap(m, err)
+}
+
+// Flip will reverse the order of the rows or the columns of this matrix.
+func (w Matrix[T]) Flip(axis immutabilitybenchmarking.Axis) immutabilitybenchmarking.Matrix[T] {
+	w.tb.Helper()
+
+	var m immutabilitybenchmarking.Matrix[T]
+	w.check("Flip", nil, func() {
+		m = w.matrix.Flip(axis)
+	})
+
+	m, _ = w.wrap(m, nil)
+
+	return m
+}
+
+// Rotate90 will turn this matrix a quarter turn clockwise.
+func (w Matrix[T]) Rotate90() immutabilitybenchmarking.Matrix[T] {
+	w.tb.Helper()
+
+	var m immutabilitybenchmarking.Matrix[T]
+	w.check("Rotate90", nil, func() {
+		m = w.matrix.Rotate90()
+	})
+
+	m, _ = w.wrap(m, nil)
+
+	return m
+}
@@ -0,0 +1,71 @@
+package immutabilitycheck
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/chris-tomich/immutability-benchmarking"
+	"github.com/chris-tomich/immutability-benchmarking/slice/immutable"
+	"github.com/chris-tomich/immutability-benchmarking/slice/mutable"
+)
+
+// recorder collects the failures reported through it instead of failing the test.
+type recorder struct {
+	testing.TB
+	failures []string
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Errorf(format string, args ...any) {
+	r.failures = append(r.failures, fmt.Sprintf(format, args...))
+}
+
+func values() [][]int {
+	return [][]int{
+		{1, 2},
+		{3, 4},
+	}
+}
+
+func TestImmutableMatrixPassesCheck(t *testing.T) {
+	r := &recorder{TB: t}
+
+	m1, _ := immutable.New(values())
+	m2, _ := immutable.New(values())
+
+	var m immutabilitybenchmarking.Matrix[int] = Wrap[int](r, m1)
+	m, _ = m.Add(m2)
+	m, _ = m.MatrixMultiply(m2)
+	m = m.ScalarMultiply(2).Transpose()
+	m, _ = m.Subtract(m2)
+	m.Equals(m2)
+
+	if len(r.failures) != 0 {
+		t.Errorf("expected no failures but got %v", r.failures)
+	}
+}
+
+func TestMutableMatrixFailsCheck(t *testing.T) {
+	r := &recorder{TB: t}
+
+	m1, _ := mutable.New(values())
+	m2, _ := mutable.New(values())
+
+	m := Wrap[int](r, m1)
+	m.Add(m2)
+
+	if len(r.failures) != 1 || r.failures[0] != "Add modified its receiver" {
+		t.Errorf("expected Add to be reported but got %v", r.failures)
+	}
+}
+
+func TestHashIsStableForNaN(t *testing.T) {
+	var zero float64
+
+	m, _ := immutable.New([][]float64{{zero / zero}})
+
+	if Hash[float64](m) != Hash[float64](m) {
+		t.Error("expected the hash of a matrix holding NaN to be stable")
+	}
+}
@@ -0,0 +1,324 @@
+// Package numeric provides the element comparisons, absolute values and whole-matrix reductions shared by
+// the backends. The comparisons and absolute values are chosen once for each element type and returned as
+// functions, so the reductions do not decide how to treat every element they visit. The reductions read a
+// matrix one row at a time through a function returning each row as a slice, so backends with contiguous
+// rows can hand them over without copying.
+package numeric
+
+import (
+	"math"
+	"math/cmplx"
+	"reflect"
+	"unsafe"
+
+	"github.com/chris-tomich/immutability-benchmarking"
+)
+
+// LessFunc returns a function reporting whether a is ordered before b. Complex numbers have no natural
+// order, so they are ordered by their real parts and then by their imaginary parts.
+func LessFunc[T immutabilitybenchmarking.Number]() func(a T, b T) bool {
+	switch kind[T]() {
+	case reflect.Int:
+		return less[T, int]
+	case reflect.Int8:
+		return less[T, int8]
+	case reflect.Int16:
+		return less[T, int16]
+	case reflect.Int32:
+		return less[T, int32]
+	case reflect.Int64:
+		return less[T, int64]
+	case reflect.Uint:
+		return less[T, uint]
+	case reflect.Uint8:
+		return less[T, uint8]
+	case reflect.Uint16:
+		return less[T, uint16]
+	case reflect.Uint32:
+		return less[T, uint32]
+	case reflect.Uint64:
+		return less[T, uint64]
+	case reflect.Float32:
+		return less[T, float32]
+	case reflect.Float64:
+		return less[T, float64]
+	}
+
+	toComplex := ComplexFunc[T]()
+
+	return func(a T, b T) bool {
+		ca, cb := toComplex(a), toComplex(b)
+		return real(ca) < real(cb) || (real(ca) == real(cb) && imag(ca) < imag(cb))
+	}
+}
+
+// ComplexFunc returns a function converting an element to a complex128.
+func ComplexFunc[T immutabilitybenchmarking.Number]() func(v T) complex128 {
+	switch kind[T]() {
+	case reflect.Complex64:
+		return func(v T) complex128 { return complex128(as[complex64](v)) }
+	case reflect.Complex128:
+		return as[complex128, T]
+	}
+
+	toFloat := floatFunc[T]()
+
+	return func(v T) complex128 { return complex(toFloat(v), 0) }
+}
+
+// AbsFunc returns a function returning the absolute value of an element, which is the modulus for complex
+// numbers.
+func AbsFunc[T immutabilitybenchmarking.Number]() func(v T) float64 {
+	switch kind[T]() {
+	case reflect.Complex64, reflect.Complex128:
+		toComplex := ComplexFunc[T]()
+		return func(v T) float64 { return cmplx.Abs(toComplex(v)) }
+	}
+
+	toFloat := floatFunc[T]()
+
+	return func(v T) float64 { return math.Abs(toFloat(v)) }
+}
+
+// floatFunc returns a function converting an element that is not complex to a float64.
+func floatFunc[T immutabilitybenchmarking.Number]() func(v T) float64 {
+	switch kind[T]() {
+	case reflect.Int:
+		return floatOf[T, int]
+	case reflect.Int8:
+		return floatOf[T, int8]
+	case reflect.Int16:
+		return floatOf[T, int16]
+	case reflect.Int32:
+		return floatOf[T, int32]
+	case reflect.Int64:
+		return floatOf[T, int64]
+	case reflect.Uint:
+		return floatOf[T, uint]
+	case reflect.Uint8:
+		return floatOf[T, uint8]
+	case reflect.Uint16:
+		return floatOf[T, uint16]
+	case reflect.Uint32:
+		return floatOf[T, uint32]
+	case reflect.Uint64:
+		return floatOf[T, uint64]
+	case reflect.Float32:
+		return floatOf[T, float32]
+	}
+
+	return floatOf[T, float64]
+}
+
+// kind returns the kind of T. Named element types such as a float64 with units have their own reflect.Type
+// but share the kind, and so the implementation, of their underlying type. It is read once for each call
+// of the functions above rather than once for each element.
+func kind[T immutabilitybenchmarking.Number]() reflect.Kind {
+	return reflect.TypeFor[T]().Kind()
+}
+
+// as reinterprets v as U, which must be the underlying type of T.
+func as[U any, T any](v T) U {
+	return *(*U)(unsafe.Pointer(&v))
+}
+
+func less[T any, U ordered](a T, b T) bool {
+	return as[U](a) < as[U](b)
+}
+
+func floatOf[T any, U ordered](v T) float64 {
+	return float64(as[U](v))
+}
+
+// ordered is the set of element types that are not complex.
+type ordered interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 | ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~float32 | ~float64
+}
+
+// Abs returns the magnitude of a floating point value.
+func Abs[T immutabilitybenchmarking.Float](v T) T {
+	if v < 0 {
+		return -v
+	}
+
+	return v
+}
+
+// Sqrt returns the square root of a floating point value.
+func Sqrt[T immutabilitybenchmarking.Float](v T) T {
+	return T(math.Sqrt(float64(v)))
+}
+
+// Epsilon returns the difference between one and the next larger value of T.
+func Epsilon[T immutabilitybenchmarking.Float]() T {
+	e := T(1)
+
+	for T(1)+e/2 != 1 {
+		e /= 2
+	}
+
+	return e
+}
+
+// RowsOf returns a function reading each row of m through Get. The returned slice is reused, so it is only
+// valid until the next call.
+func RowsOf[T immutabilitybenchmarking.Number](m immutabilitybenchmarking.Matrix[T]) func(r int) []T {
+	row := make([]T, m.Width())
+
+	return func(r int) []T {
+		for c := range row {
+			row[c] = m.Get(r, c)
+		}
+
+		return row
+	}
+}
+
+// Sum adds together every element of a matrix with the given number of rows.
+func Sum[T immutabilitybenchmarking.Number](rows int, row func(r int) []T) T {
+	var sum T
+
+	for r := 0; r < rows; r++ {
+		for _, v := range row(r) {
+			sum += v
+		}
+	}
+
+	return sum
+}
+
+// ArgMin returns the coordinates of the first smallest element in row-major order.
+func ArgMin[T immutabilitybenchmarking.Number](rows int, row func(r int) []T) (int, int) {
+	return extreme(rows, row, LessFunc[T]())
+}
+
+// ArgMax returns the coordinates of the first largest element in row-major order.
+func ArgMax[T immutabilitybenchmarking.Number](rows int, row func(r int) []T) (int, int) {
+	less := LessFunc[T]()
+
+	return extreme(rows, row, func(v T, best T) bool { return less(best, v) })
+}
+
+func extreme[T immutabilitybenchmarking.Number](rows int, row func(r int) []T, better func(v T, best T) bool) (int, int) {
+	var best T
+	bestRow, bestCol := -1, -1
+
+	for r := 0; r < rows; r++ {
+		for c, v := range row(r) {
+			if bestRow < 0 || better(v, best) {
+				best, bestRow, bestCol = v, r, c
+			}
+		}
+	}
+
+	return bestRow, bestCol
+}
+
+// FrobeniusNorm returns the square root of the sum of the squared absolute values of every element.
+func FrobeniusNorm[T immutabilitybenchmarking.Number](rows int, row func(r int) []T) float64 {
+	abs := AbsFunc[T]()
+	var sum float64
+
+	for r := 0; r < rows; r++ {
+		for _, v := range row(r) {
+			a := abs(v)
+			sum += a * a
+		}
+	}
+
+	return math.Sqrt(sum)
+}
+
+// OneNorm returns the largest sum of the absolute values in a column.
+func OneNorm[T immutabilitybenchmarking.Number](rows int, cols int, row func(r int) []T) float64 {
+	abs := AbsFunc[T]()
+	sums := make([]float64, cols)
+
+	for r := 0; r < rows; r++ {
+		for c, v := range row(r) {
+			sums[c] += abs(v)
+		}
+	}
+
+	return largest(sums)
+}
+
+// InfNorm returns the largest sum of the absolute values in a row.
+func InfNorm[T immutabilitybenchmarking.Number](rows int, row func(r int) []T) float64 {
+	abs := AbsFunc[T]()
+	var norm float64
+
+	for r := 0; r < rows; r++ {
+		var sum float64
+
+		for _, v := range row(r) {
+			sum += abs(v)
+		}
+
+		norm = math.Max(norm, sum)
+	}
+
+	return norm
+}
+
+func largest(values []float64) float64 {
+	var l float64
+
+	for _, v := range values {
+		l = math.Max(l, v)
+	}
+
+	return l
+}
+
+// Rank returns the number of linearly independent rows of a matrix. The rows are copied and reduced to row
+// echelon form with partial pivoting, treating a pivot as zero when it is within rounding error of the
+// largest element.
+func Rank[T immutabilitybenchmarking.Number](rows int, cols int, row func(r int) []T) int {
+	toComplex := ComplexFunc[T]()
+	a := make([][]complex128, rows)
+	var scale float64
+
+	for r := 0; r < rows; r++ {
+		a[r] = make([]complex128, cols)
+
+		for c, v := range row(r) {
+			a[r][c] = toComplex(v)
+			scale = math.Max(scale, cmplx.Abs(a[r][c]))
+		}
+	}
+
+	tolerance := float64(max(rows, cols)) * scale * 0x1p-52
+	rank := 0
+
+	for c := 0; c < cols && rank < rows; c++ {
+		pivot, size := rank, cmplx.Abs(a[rank][c])
+
+		for r := rank + 1; r < rows; r++ {
+			if s := cmplx.Abs(a[r][c]); s > size {
+				pivot, size = r, s
+			}
+		}
+
+		if size <= tolerance {
+			continue
+		}
+
+		a[rank], a[pivot] = a[pivot], a[rank]
+
+		for r := rank + 1; r < rows; r++ {
+			f := a[r][c] / a[rank][c]
+			if f == 0 {
+				continue
+			}
+
+			for k := c; k < cols; k++ {
+				a[r][k] -= f * a[rank][k]
+			}
+		}
+
+		rank++
+	}
+
+	return rank
+}
@@ -0,0 +1,95 @@
+package numeric
+
+import (
+	"testing"
+)
+
+type celsius float64
+
+type level uint8
+
+type phase complex64
+
+func TestLessFunc(t *testing.T) {
+	cases := []struct {
+		name string
+		less bool
+		got  bool
+	}{
+		{name: "ints", less: true, got: LessFunc[int]()(-3, 2)},
+		{name: "equal ints", less: false, got: LessFunc[int]()(2, 2)},
+		{name: "unsigned", less: false, got: LessFunc[uint16]()(7, 3)},
+		{name: "floats", less: true, got: LessFunc[float64]()(0.25, 0.5)},
+		{name: "complex real parts", less: true, got: LessFunc[complex128]()(1+5i, 2+0i)},
+		{name: "complex imaginary parts", less: true, got: LessFunc[complex128]()(1+1i, 1+2i)},
+		{name: "equal complex", less: false, got: LessFunc[complex128]()(1+2i, 1+2i)},
+		{name: "named float", less: true, got: LessFunc[celsius]()(-1.5, 0)},
+		{name: "named unsigned", less: false, got: LessFunc[level]()(200, 100)},
+		{name: "named complex", less: true, got: LessFunc[phase]()(2+1i, 2+3i)},
+		{name: "large int64", less: true, got: LessFunc[int64]()(1<<62, 1<<62+1)},
+	}
+
+	for _, c := range cases {
+		if c.got != c.less {
+			t.Errorf("%s: expected %v but got %v", c.name, c.less, c.got)
+		}
+	}
+}
+
+func TestAbsFunc(t *testing.T) {
+	cases := []struct {
+		name string
+		abs  float64
+		got  float64
+	}{
+		{name: "negative int", abs: 3, got: AbsFunc[int]()(-3)},
+		{name: "int8", abs: 128, got: AbsFunc[int8]()(-128)},
+		{name: "float", abs: 0.5, got: AbsFunc[float64]()(-0.5)},
+		{name: "complex", abs: 5, got: AbsFunc[complex128]()(3 - 4i)},
+		{name: "named float", abs: 1.5, got: AbsFunc[celsius]()(-1.5)},
+		{name: "named complex", abs: 5, got: AbsFunc[phase]()(-3 + 4i)},
+	}
+
+	for _, c := range cases {
+		if c.got != c.abs {
+			t.Errorf("%s: expected %v but got %v", c.name, c.abs, c.got)
+		}
+	}
+}
+
+func rows[T any](values [][]T) func(r int) []T {
+	return func(r int) []T { return values[r] }
+}
+
+func TestRank(t *testing.T) {
+	// The third row is twice the second minus the first, which only cancels to within rounding error.
+	rounded := [][]float64{{0.1, 0.2, 0.3}, {0.4, 0.5, 0.6}, {0.7, 0.8, 0.9}}
+	if rank := Rank(3, 3, rows(rounded)); rank != 2 {
+		t.Errorf("expected a rank of 2 but got %d", rank)
+	}
+
+	// The second row is i times the first.
+	dependent := [][]complex128{{1, 1i}, {1i, -1}}
+	if rank := Rank(2, 2, rows(dependent)); rank != 1 {
+		t.Errorf("expected a rank of 1 but got %d", rank)
+	}
+
+	small := [][]float64{{1e-20, 0}, {0, 1e-20}}
+	if rank := Rank(2, 2, rows(small)); rank != 2 {
+		t.Errorf("expected the tolerance to scale with the elements, giving a rank of 2, but got %d", rank)
+	}
+}
+
+func TestEpsilon(t *testing.T) {
+	if e := Epsilon[float64](); e != 0x1p-52 {
+		t.Errorf("expected 2^-52 for float64 but got %v", e)
+	}
+
+	if e := Epsilon[float32](); e != 0x1p-23 {
+		t.Errorf("expected 2^-23 for float32 but got %v", e)
+	}
+
+	if e := Epsilon[celsius](); e != 0x1p-52 {
+		t.Errorf("expected 2^-52 for a named float64 but got %v", e)
+	}
+}
@@ -0,0 +1,58 @@
+// Package power raises square matrices to non-negative integer powers by repeated squaring, multiplying
+// through each backend's own MatrixMultiply so its cost is what gets measured.
+package power
+
+// Multiplier is a matrix that can be multiplied by another of the same type, which is all BySquaring needs.
+// It is satisfied by immutabilitybenchmarking.Matrix and by the arbitrary precision matrices in
+// slice/bignum, whose elements do not satisfy immutabilitybenchmarking.Number.
+type Multiplier[M any] interface {
+	MatrixMultiply(M) (M, error)
+}
+
+// BySquaring returns m raised to the power n with at most 2*log2(n) calls to MatrixMultiply. m must be square
+// and n must not be negative. identity is only called when n is zero.
+//
+// The powers of m are formed by squaring m itself, which a mutable backend does in place, so keep is given
+// the power that becomes the first factor of the result and must return a copy of it that later squaring
+// cannot change. Immutable backends can pass nil.
+func BySquaring[M Multiplier[M]](m M, n int, identity func() (M, error), keep func(m M) M) (M, error) {
+	var zero M
+
+	if n == 0 {
+		return identity()
+	}
+
+	var result M
+	var err error
+	started := false
+	base := m
+
+	for {
+		if n&1 == 1 {
+			switch {
+			case started:
+				result, err = result.MatrixMultiply(base)
+			case keep != nil:
+				result = keep(base)
+			default:
+				result = base
+			}
+
+			if err != nil {
+				return zero, err
+			}
+
+			started = true
+		}
+
+		n >>= 1
+		if n == 0 {
+			return result, nil
+		}
+
+		base, err = base.MatrixMultiply(base)
+		if err != nil {
+			return zero, err
+		}
+	}
+}
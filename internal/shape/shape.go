@@ -0,0 +1,218 @@
+// Package shape checks the dimensions given to the operations that rearrange matrices and describes their
+// results as a size and a function reading each element back from the matrices they came from. Backends
+// that cannot share storage build their results from these descriptions, and the ones that can share
+// storage still use the checks so every backend reports the same errors.
+package shape
+
+import "github.com/chris-tomich/immutability-benchmarking"
+
+// Sized is all the checks need from a matrix, so they can also check the arbitrary precision matrices in
+// slice/bignum, whose elements do not satisfy immutabilitybenchmarking.Number.
+type Sized interface {
+	Height() int
+	Width() int
+}
+
+// Result describes a rearranged matrix as its dimensions and a function returning the element at each of
+// its coordinates.
+type Result[T immutabilitybenchmarking.Number] struct {
+	Rows int
+	Cols int
+	At   func(row int, col int) T
+}
+
+// CheckReshape returns an InvalidDimensionsError if rows or cols is not positive, or a ReshapeError if a
+// rows x cols matrix would not hold the same number of elements as m.
+func CheckReshape(m Sized, rows int, cols int) error {
+	if rows <= 0 || cols <= 0 {
+		return &immutabilitybenchmarking.InvalidDimensionsError{Rows: rows, Cols: cols}
+	}
+
+	if rows*cols != m.Height()*m.Width() {
+		return &immutabilitybenchmarking.ReshapeError{Rows: m.Height(), Cols: m.Width(), NewRows: rows, NewCols: cols}
+	}
+
+	return nil
+}
+
+// Reshape describes m read in row-major order into a rows x cols matrix.
+func Reshape[T immutabilitybenchmarking.Number](m immutabilitybenchmarking.Matrix[T], rows int, cols int) (Result[T], error) {
+	if err := CheckReshape(m, rows, cols); err != nil {
+		return Result[T]{}, err
+	}
+
+	return Result[T]{Rows: rows, Cols: cols, At: func(row int, col int) T {
+		i := row*cols + col
+		return m.Get(i/m.Width(), i%m.Width())
+	}}, nil
+}
+
+// CheckHConcat returns a DimensionMismatchError if m1 and m2 do not have the same number of rows.
+func CheckHConcat(m1 Sized, m2 Sized) error {
+	if m1.Height() != m2.Height() {
+		return mismatch("HConcat", m1, m2)
+	}
+
+	return nil
+}
+
+// HConcat describes m2 placed to the right of m1.
+func HConcat[T immutabilitybenchmarking.Number](m1 immutabilitybenchmarking.Matrix[T], m2 immutabilitybenchmarking.Matrix[T]) (Result[T], error) {
+	if err := CheckHConcat(m1, m2); err != nil {
+		return Result[T]{}, err
+	}
+
+	return Result[T]{Rows: m1.Height(), Cols: m1.Width() + m2.Width(), At: func(row int, col int) T {
+		if col < m1.Width() {
+			return m1.Get(row, col)
+		}
+
+		return m2.Get(row, col-m1.Width())
+	}}, nil
+}
+
+// CheckStack returns a DimensionMismatchError for the first of ms that does not have the same number of
+// columns as m1, naming op as the operation.
+func CheckStack[M Sized](op string, m1 Sized, ms ...M) error {
+	for _, m2 := range ms {
+		if m1.Width() != m2.Width() {
+			return mismatch(op, m1, m2)
+		}
+	}
+
+	return nil
+}
+
+// Stack describes ms placed below m1 in order, naming op as the operation in any error.
+func Stack[T immutabilitybenchmarking.Number](op string, m1 immutabilitybenchmarking.Matrix[T], ms ...immutabilitybenchmarking.Matrix[T]) (Result[T], error) {
+	if err := CheckStack(op, m1, ms...); err != nil {
+		return Result[T]{}, err
+	}
+
+	all := append([]immutabilitybenchmarking.Matrix[T]{m1}, ms...)
+	// starts holds the first row of each matrix in the result, followed by the total number of rows.
+	starts := make([]int, len(all)+1)
+
+	for i, m := range all {
+		starts[i+1] = starts[i] + m.Height()
+	}
+
+	return Result[T]{Rows: starts[len(all)], Cols: m1.Width(), At: func(row int, col int) T {
+		i := 0
+		for starts[i+1] <= row {
+			i++
+		}
+
+		return all[i].Get(row-starts[i], col)
+	}}, nil
+}
+
+// CheckSplitRows returns an InvalidDimensionsError with the dimensions of the empty part if splitting the
+// rows of m at at would leave either part without any rows.
+func CheckSplitRows(m Sized, at int) error {
+	switch {
+	case at <= 0:
+		return &immutabilitybenchmarking.InvalidDimensionsError{Rows: at, Cols: m.Width()}
+	case at >= m.Height():
+		return &immutabilitybenchmarking.InvalidDimensionsError{Rows: m.Height() - at, Cols: m.Width()}
+	}
+
+	return nil
+}
+
+// CheckSplitCols returns an InvalidDimensionsError with the dimensions of the empty part if splitting the
+// columns of m at at would leave either part without any columns.
+func CheckSplitCols(m Sized, at int) error {
+	switch {
+	case at <= 0:
+		return &immutabilitybenchmarking.InvalidDimensionsError{Rows: m.Height(), Cols: at}
+	case at >= m.Width():
+		return &immutabilitybenchmarking.InvalidDimensionsError{Rows: m.Height(), Cols: m.Width() - at}
+	}
+
+	return nil
+}
+
+// SplitRows describes the rows of m before at and the rows from at onwards.
+func SplitRows[T immutabilitybenchmarking.Number](m immutabilitybenchmarking.Matrix[T], at int) (Result[T], Result[T], error) {
+	if err := CheckSplitRows(m, at); err != nil {
+		return Result[T]{}, Result[T]{}, err
+	}
+
+	top := Result[T]{Rows: at, Cols: m.Width(), At: m.Get}
+	bottom := Result[T]{Rows: m.Height() - at, Cols: m.Width(), At: func(row int, col int) T {
+		return m.Get(row+at, col)
+	}}
+
+	return top, bottom, nil
+}
+
+// SplitCols describes the columns of m before at and the columns from at onwards.
+func SplitCols[T immutabilitybenchmarking.Number](m immutabilitybenchmarking.Matrix[T], at int) (Result[T], Result[T], error) {
+	if err := CheckSplitCols(m, at); err != nil {
+		return Result[T]{}, Result[T]{}, err
+	}
+
+	left := Result[T]{Rows: m.Height(), Cols: at, At: m.Get}
+	right := Result[T]{Rows: m.Height(), Cols: m.Width() - at, At: func(row int, col int) T {
+		return m.Get(row, col+at)
+	}}
+
+	return left, right, nil
+}
+
+// CheckPad returns a PaddingError if any of the amounts of padding is negative.
+func CheckPad(top int, bottom int, left int, right int) error {
+	if top < 0 || bottom < 0 || left < 0 || right < 0 {
+		return &immutabilitybenchmarking.PaddingError{Top: top, Bottom: bottom, Left: left, Right: right}
+	}
+
+	return nil
+}
+
+// Pad describes m surrounded by the given number of rows and columns of zeros on each side.
+func Pad[T immutabilitybenchmarking.Number](m immutabilitybenchmarking.Matrix[T], top int, bottom int, left int, right int) (Result[T], error) {
+	if err := CheckPad(top, bottom, left, right); err != nil {
+		return Result[T]{}, err
+	}
+
+	return Result[T]{Rows: top + m.Height() + bottom, Cols: left + m.Width() + right, At: func(row int, col int) T {
+		row, col = row-top, col-left
+		if row < 0 || row >= m.Height() || col < 0 || col >= m.Width() {
+			return 0
+		}
+
+		return m.Get(row, col)
+	}}, nil
+}
+
+// Flip describes m with the order of its rows or columns reversed.
+func Flip[T immutabilitybenchmarking.Number](m immutabilitybenchmarking.Matrix[T], axis immutabilitybenchmarking.Axis) Result[T] {
+	if axis == immutabilitybenchmarking.RowAxis {
+		return Result[T]{Rows: m.Height(), Cols: m.Width(), At: func(row int, col int) T {
+			return m.Get(m.Height()-1-row, col)
+		}}
+	}
+
+	return Result[T]{Rows: m.Height(), Cols: m.Width(), At: func(row int, col int) T {
+		return m.Get(row, m.Width()-1-col)
+	}}
+}
+
+// Rotate90 describes m turned a quarter turn clockwise, so its first column becomes the first row read
+// from the bottom up.
+func Rotate90[T immutabilitybenchmarking.Number](m immutabilitybenchmarking.Matrix[T]) Result[T] {
+	return Result[T]{Rows: m.Width(), Cols: m.Height(), At: func(row int, col int) T {
+		return m.Get(m.Height()-1-col, row)
+	}}
+}
+
+func mismatch(op string, m1 Sized, m2 Sized) error {
+	return &immutabilitybenchmarking.DimensionMismatchError{
+		Op:        op,
+		LeftRows:  m1.Height(),
+		LeftCols:  m1.Width(),
+		RightRows: m2.Height(),
+		RightCols: m2.Width(),
+	}
+}
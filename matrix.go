@@ -1,24 +1,81 @@
 package immutabilitybenchmarking
 
-//const MatrixWidth int = 10
-//const MatrixHeight int = 10
-//const MatrixWidth int = 30
-//const MatrixHeight int = 30
-//const MatrixWidth int = 90
-//const MatrixHeight int = 90
-//const MatrixWidth int = 270
-//const MatrixHeight int = 270
-const MatrixWidth int = 810
-const MatrixHeight int = 810
+// Number is the set of element types a Matrix can hold.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 |
+		~float32 | ~float64 |
+		~complex64 | ~complex128
+}
+
+// Float is the set of floating point element types, for operations such as factorization that need division
+// and an ordering to choose pivots.
+type Float interface {
+	~float32 | ~float64
+}
+
+// Axis selects the direction an operation such as Flip works along.
+type Axis int
 
-type Matrix interface {
+const (
+	// RowAxis runs down a matrix, so flipping along it reverses the order of the rows.
+	RowAxis Axis = iota
+	// ColAxis runs across a matrix, so flipping along it reverses the order of the columns.
+	ColAxis
+)
+
+type Matrix[T Number] interface {
 	Width() int
 	Height() int
-	Get(int, int) int
-	Equals(Matrix) bool
-	Add(Matrix) (Matrix, error)
-	Subtract(Matrix) (Matrix, error)
-	ScalarMultiply(s int) Matrix
-	Transpose() Matrix
-	MatrixMultiply(Matrix) (Matrix, error)
+	Get(int, int) T
+	At(int, int) (T, error)
+	MustAt(int, int) T
+	Equals(Matrix[T]) bool
+	Add(Matrix[T]) (Matrix[T], error)
+	Subtract(Matrix[T]) (Matrix[T], error)
+	ScalarMultiply(s T) Matrix[T]
+	Transpose() Matrix[T]
+	MatrixMultiply(Matrix[T]) (Matrix[T], error)
+	Map(f func(v T) T) Matrix[T]
+	MapIndexed(f func(row int, col int, v T) T) Matrix[T]
+	ZipWith(m2 Matrix[T], f func(a T, b T) T) (Matrix[T], error)
+	Fold(initial T, f func(acc T, v T) T) T
+	RowReduce(initial T, f func(acc T, v T) T) Matrix[T]
+	ColReduce(initial T, f func(acc T, v T) T) Matrix[T]
+	HadamardProduct(Matrix[T]) (Matrix[T], error)
+	ElementwiseDivide(Matrix[T]) (Matrix[T], error)
+	KroneckerProduct(Matrix[T]) (Matrix[T], error)
+	Trace() (T, error)
+	Sum() T
+	Min() T
+	Max() T
+	ArgMin() (int, int)
+	ArgMax() (int, int)
+	FrobeniusNorm() float64
+	OneNorm() float64
+	InfNorm() float64
+	Rank() int
+	Power(n int) (Matrix[T], error)
+	Reshape(rows int, cols int) (Matrix[T], error)
+	HConcat(Matrix[T]) (Matrix[T], error)
+	VConcat(Matrix[T]) (Matrix[T], error)
+	Stack(...Matrix[T]) (Matrix[T], error)
+	SplitRows(at int) (Matrix[T], Matrix[T], error)
+	SplitCols(at int) (Matrix[T], Matrix[T], error)
+	Pad(top int, bottom int, left int, right int) (Matrix[T], error)
+	Flip(axis Axis) Matrix[T]
+	Rotate90() Matrix[T]
+}
+
+// Factory creates matrices for a particular backend so code can construct matrices without knowing which
+// package they come from.
+type Factory[T Number] interface {
+	// Zeros creates a rows x cols matrix with every element set to zero.
+	Zeros(rows int, cols int) (Matrix[T], error)
+	// Identity creates an n x n identity matrix.
+	Identity(n int) (Matrix[T], error)
+	// FromRows creates a matrix holding a copy of the given rectangular values.
+	FromRows(rows [][]T) (Matrix[T], error)
+	// FromFunc creates a rows x cols matrix with each element set to the result of f.
+	FromFunc(rows int, cols int, f func(row int, col int) T) (Matrix[T], error)
 }
@@ -0,0 +1,85 @@
+package matrixtest
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/chris-tomich/immutability-benchmarking"
+)
+
+// Builder adapts the builder of a backend for RunBuilder. Each builder returns its own type from its
+// methods, so they are wrapped in functions rather than described by an interface.
+type Builder[T immutabilitybenchmarking.Number] struct {
+	Set    func(row int, col int, v T)
+	SetRow func(row int, values []T)
+	Fill   func(v T)
+	Freeze func() immutabilitybenchmarking.Matrix[T]
+}
+
+// RunBuilder checks the builders created by newBuilder construct the expected matrix, panic with typed
+// errors when given coordinates or rows that do not fit, and cannot be used once frozen.
+func RunBuilder[T immutabilitybenchmarking.Number](t *testing.T, newBuilder func(width int, height int) Builder[T]) {
+	t.Run("Build", func(t *testing.T) {
+		b := newBuilder(3, 2)
+		b.Fill(7)
+		b.SetRow(1, []T{1, 0, 3})
+		b.Set(1, 1, 2)
+
+		assertValues(t, b.Freeze(), [][]T{
+			{7, 7, 7},
+			{1, 2, 3},
+		})
+	})
+
+	cases := []struct {
+		name     string
+		use      func(b Builder[T])
+		expected error
+	}{
+		{
+			name:     "SetOutsideColumns",
+			use:      func(b Builder[T]) { b.Set(0, 3, 1) },
+			expected: &immutabilitybenchmarking.OutOfBoundsError{Row: 0, Col: 3, Rows: 2, Cols: 3},
+		},
+		{
+			name:     "SetOutsideRows",
+			use:      func(b Builder[T]) { b.Set(-1, 0, 1) },
+			expected: &immutabilitybenchmarking.OutOfBoundsError{Row: -1, Col: 0, Rows: 2, Cols: 3},
+		},
+		{
+			name:     "SetRowOutside",
+			use:      func(b Builder[T]) { b.SetRow(2, []T{1, 2, 3}) },
+			expected: &immutabilitybenchmarking.OutOfBoundsError{Row: 2, Col: 0, Rows: 2, Cols: 3},
+		},
+		{
+			name:     "SetRowWidth",
+			use:      func(b Builder[T]) { b.SetRow(0, []T{1, 2}) },
+			expected: &immutabilitybenchmarking.DimensionMismatchError{Op: "SetRow", LeftRows: 1, LeftCols: 3, RightRows: 1, RightCols: 2},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); fmt.Sprintf("%T %v", r, r) != fmt.Sprintf("%T %v", c.expected, c.expected) {
+					t.Errorf("expected a panic with %T %v but got %T %v", c.expected, c.expected, r, r)
+				}
+			}()
+
+			c.use(newBuilder(3, 2))
+		})
+	}
+
+	t.Run("Frozen", func(t *testing.T) {
+		b := newBuilder(3, 2)
+		b.Freeze()
+
+		defer func() {
+			if recover() == nil {
+				t.Error("expected using a frozen builder to panic")
+			}
+		}()
+
+		b.Set(0, 0, 1)
+	})
+}
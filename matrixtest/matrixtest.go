@@ -0,0 +1,1057 @@
+// Package matrixtest provides a conformance suite that checks an implementation of
+// immutabilitybenchmarking.Matrix against the behaviour expected of every backend, and a suite shared by
+// the builders of the immutable backends.
+package matrixtest
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/chris-tomich/immutability-benchmarking"
+)
+
+// RunConformance checks every method of the matrices created by the given factory. Each check creates its
+// own matrices so mutable and immutable backends can both be run through the suite.
+func RunConformance[T immutabilitybenchmarking.Number](t *testing.T, f immutabilitybenchmarking.Factory[T]) {
+	t.Run("FromRows", func(t *testing.T) {
+		m := mustFromRows(t, f, [][]T{
+			{1, 2, 3},
+			{4, 5, 6},
+		})
+
+		if m.Height() != 2 || m.Width() != 3 {
+			t.Fatalf("expected a 2x3 matrix but got %dx%d", m.Height(), m.Width())
+		}
+
+		assertValues(t, m, [][]T{
+			{1, 2, 3},
+			{4, 5, 6},
+		})
+	})
+
+	t.Run("FromRowsCopies", func(t *testing.T) {
+		rows := [][]T{
+			{1, 2},
+			{3, 4},
+		}
+
+		m := mustFromRows(t, f, rows)
+		rows[0][0] = 9
+
+		if m.Get(0, 0) != 1 {
+			t.Error("expected FromRows to copy its input")
+		}
+	})
+
+	t.Run("FromRowsInvalid", func(t *testing.T) {
+		var invalidDimensions *immutabilitybenchmarking.InvalidDimensionsError
+		var jaggedRows *immutabilitybenchmarking.JaggedRowsError
+
+		if _, err := f.FromRows([][]T{}); !errors.As(err, &invalidDimensions) {
+			t.Errorf("expected an empty matrix to be rejected with an InvalidDimensionsError but got %v", err)
+		}
+
+		if _, err := f.FromRows([][]T{{}}); !errors.As(err, &invalidDimensions) {
+			t.Errorf("expected a matrix with empty rows to be rejected with an InvalidDimensionsError but got %v", err)
+		}
+
+		if _, err := f.FromRows([][]T{{1, 2}, {3}}); !errors.As(err, &jaggedRows) {
+			t.Errorf("expected a jagged matrix to be rejected with a JaggedRowsError but got %v", err)
+		} else if jaggedRows.Row != 1 || jaggedRows.Width != 1 || jaggedRows.Expected != 2 {
+			t.Errorf("unexpected error details %+v", jaggedRows)
+		}
+	})
+
+	t.Run("FromFunc", func(t *testing.T) {
+		values := [][]T{
+			{0, 1},
+			{2, 3},
+			{4, 5},
+		}
+
+		m, err := f.FromFunc(3, 2, func(row int, col int) T {
+			return values[row][col]
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assertValues(t, m, [][]T{
+			{0, 1},
+			{2, 3},
+			{4, 5},
+		})
+	})
+
+	t.Run("Zeros", func(t *testing.T) {
+		m, err := f.Zeros(2, 3)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assertValues(t, m, [][]T{
+			{0, 0, 0},
+			{0, 0, 0},
+		})
+
+		var invalidDimensions *immutabilitybenchmarking.InvalidDimensionsError
+
+		if _, err := f.Zeros(0, 3); !errors.As(err, &invalidDimensions) {
+			t.Errorf("expected a matrix with no rows to be rejected with an InvalidDimensionsError but got %v", err)
+		}
+
+		if _, err := f.Zeros(3, -1); !errors.As(err, &invalidDimensions) {
+			t.Errorf("expected a matrix with negative columns to be rejected with an InvalidDimensionsError but got %v", err)
+		} else if invalidDimensions.Rows != 3 || invalidDimensions.Cols != -1 {
+			t.Errorf("unexpected error details %+v", invalidDimensions)
+		}
+	})
+
+	t.Run("Identity", func(t *testing.T) {
+		m, err := f.Identity(3)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assertValues(t, m, [][]T{
+			{1, 0, 0},
+			{0, 1, 0},
+			{0, 0, 1},
+		})
+	})
+
+	t.Run("At", func(t *testing.T) {
+		m := mustFromRows(t, f, [][]T{{1, 2, 3}, {4, 5, 6}})
+
+		if v, err := m.At(1, 2); err != nil || v != 6 {
+			t.Errorf("expected 6 at 1,2 but got %v, %v", v, err)
+		}
+
+		if v := m.MustAt(0, 1); v != 2 {
+			t.Errorf("expected 2 at 0,1 but got %v", v)
+		}
+
+		for _, coordinates := range [][2]int{{-1, 0}, {0, -1}, {2, 0}, {0, 3}, {2, 3}} {
+			var e *immutabilitybenchmarking.OutOfBoundsError
+
+			_, err := m.At(coordinates[0], coordinates[1])
+			if !errors.As(err, &e) {
+				t.Errorf("expected an OutOfBoundsError at %d,%d but got %v", coordinates[0], coordinates[1], err)
+				continue
+			}
+
+			expected := immutabilitybenchmarking.OutOfBoundsError{Row: coordinates[0], Col: coordinates[1], Rows: 2, Cols: 3}
+			if *e != expected {
+				t.Errorf("expected %+v but got %+v", expected, *e)
+			}
+		}
+	})
+
+	t.Run("MustAtPanics", func(t *testing.T) {
+		m := mustFromRows(t, f, [][]T{{1, 2, 3}, {4, 5, 6}})
+
+		defer func() {
+			var e *immutabilitybenchmarking.OutOfBoundsError
+			if err, ok := recover().(error); !ok || !errors.As(err, &e) {
+				t.Errorf("expected MustAt to panic with an OutOfBoundsError")
+			}
+		}()
+
+		m.MustAt(2, 0)
+	})
+
+	t.Run("Equals", func(t *testing.T) {
+		m := mustFromRows(t, f, [][]T{{1, 2, 3}, {4, 5, 6}})
+
+		if !m.Equals(mustFromRows(t, f, [][]T{{1, 2, 3}, {4, 5, 6}})) {
+			t.Error("expected matrices with the same values to be equal")
+		}
+
+		if !m.Equals(reference(t, [][]T{{1, 2, 3}, {4, 5, 6}})) {
+			t.Error("expected a matrix to equal another implementation with the same values")
+		}
+
+		if m.Equals(mustFromRows(t, f, [][]T{{1, 2, 3}, {4, 5, 7}})) {
+			t.Error("expected matrices with different values not to be equal")
+		}
+
+		if m.Equals(mustFromRows(t, f, [][]T{{1, 2}, {3, 4}, {5, 6}})) {
+			t.Error("expected matrices with different dimensions not to be equal")
+		}
+
+		if m.Equals(mustFromRows(t, f, [][]T{{1, 2, 3}})) {
+			t.Error("expected matrices with different heights not to be equal")
+		}
+	})
+
+	t.Run("Add", func(t *testing.T) {
+		for name, m2 := range operands(t, f, [][]T{{6, 5, 4}, {3, 2, 1}}) {
+			m1 := mustFromRows(t, f, [][]T{{1, 2, 3}, {4, 5, 6}})
+
+			m3, err := m1.Add(m2)
+			if err != nil {
+				t.Fatalf("%s: %v", name, err)
+			}
+
+			assertValues(t, m3, [][]T{
+				{7, 7, 7},
+				{7, 7, 7},
+			})
+		}
+	})
+
+	t.Run("Subtract", func(t *testing.T) {
+		for name, m2 := range operands(t, f, [][]T{{1, 2, 3}, {1, 1, 1}}) {
+			m1 := mustFromRows(t, f, [][]T{{9, 8, 7}, {6, 5, 4}})
+
+			m3, err := m1.Subtract(m2)
+			if err != nil {
+				t.Fatalf("%s: %v", name, err)
+			}
+
+			assertValues(t, m3, [][]T{
+				{8, 6, 4},
+				{5, 4, 3},
+			})
+		}
+	})
+
+	t.Run("SubtractSelf", func(t *testing.T) {
+		m1 := mustFromRows(t, f, [][]T{{9, 8, 7}, {6, 5, 4}})
+
+		m2, err := m1.Subtract(mustFromRows(t, f, [][]T{{9, 8, 7}, {6, 5, 4}}))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assertValues(t, m2, [][]T{
+			{0, 0, 0},
+			{0, 0, 0},
+		})
+	})
+
+	t.Run("ScalarMultiply", func(t *testing.T) {
+		m := mustFromRows(t, f, [][]T{{1, 2, 3}, {4, 5, 6}})
+
+		assertValues(t, m.ScalarMultiply(3), [][]T{
+			{3, 6, 9},
+			{12, 15, 18},
+		})
+	})
+
+	t.Run("Transpose", func(t *testing.T) {
+		m := mustFromRows(t, f, [][]T{{1, 2, 3}, {4, 5, 6}})
+
+		assertValues(t, m.Transpose(), [][]T{
+			{1, 4},
+			{2, 5},
+			{3, 6},
+		})
+	})
+
+	t.Run("TransposeTwice", func(t *testing.T) {
+		m := mustFromRows(t, f, [][]T{{1, 2, 3}, {4, 5, 6}})
+
+		assertValues(t, m.Transpose().Transpose(), [][]T{
+			{1, 2, 3},
+			{4, 5, 6},
+		})
+	})
+
+	t.Run("Map", func(t *testing.T) {
+		m := mustFromRows(t, f, [][]T{{1, 0, 3}, {0, 5, 6}})
+
+		assertValues(t, m.Map(func(v T) T { return v * 2 }), [][]T{
+			{2, 0, 6},
+			{0, 10, 12},
+		})
+	})
+
+	t.Run("MapZeros", func(t *testing.T) {
+		m := mustFromRows(t, f, [][]T{{1, 0, 3}, {0, 5, 6}})
+
+		assertValues(t, m.Map(func(v T) T { return v + 1 }), [][]T{
+			{2, 1, 4},
+			{1, 6, 7},
+		})
+	})
+
+	t.Run("MapIndexed", func(t *testing.T) {
+		m := mustFromRows(t, f, [][]T{{1, 0, 3}, {0, 5, 6}})
+		offsets := [][]T{{0, 1, 2}, {10, 11, 12}}
+
+		assertValues(t, m.MapIndexed(func(row int, col int, v T) T { return v + offsets[row][col] }), [][]T{
+			{1, 1, 5},
+			{10, 16, 18},
+		})
+	})
+
+	t.Run("ZipWith", func(t *testing.T) {
+		for name, m2 := range operands(t, f, [][]T{{6, 0, 4}, {3, 2, 0}}) {
+			m1 := mustFromRows(t, f, [][]T{{1, 0, 3}, {0, 5, 6}})
+
+			m3, err := m1.ZipWith(m2, func(a T, b T) T { return a*2 + b })
+			if err != nil {
+				t.Fatalf("%s: %v", name, err)
+			}
+
+			assertValues(t, m3, [][]T{
+				{8, 0, 10},
+				{3, 12, 12},
+			})
+		}
+	})
+
+	t.Run("ZipWithZeros", func(t *testing.T) {
+		for name, m2 := range operands(t, f, [][]T{{6, 0, 4}, {3, 2, 0}}) {
+			m1 := mustFromRows(t, f, [][]T{{1, 0, 3}, {0, 5, 6}})
+
+			m3, err := m1.ZipWith(m2, func(a T, b T) T { return a + b + 1 })
+			if err != nil {
+				t.Fatalf("%s: %v", name, err)
+			}
+
+			assertValues(t, m3, [][]T{
+				{8, 1, 8},
+				{4, 8, 7},
+			})
+		}
+	})
+
+	t.Run("Fold", func(t *testing.T) {
+		m := mustFromRows(t, f, [][]T{{1, 2, 3}, {4, 5, 6}})
+
+		// Doubling the accumulator makes the result depend on the order the elements are visited in.
+		if v := m.Fold(0, func(acc T, v T) T { return acc*2 + v }); v != 120 {
+			t.Errorf("expected the fold to be 120 but got %v", v)
+		}
+	})
+
+	t.Run("RowReduce", func(t *testing.T) {
+		m := mustFromRows(t, f, [][]T{{1, 2, 3}, {4, 5, 6}})
+
+		assertValues(t, m.RowReduce(1, func(acc T, v T) T { return acc + v }), [][]T{
+			{7},
+			{16},
+		})
+	})
+
+	t.Run("ColReduce", func(t *testing.T) {
+		m := mustFromRows(t, f, [][]T{{1, 2, 3}, {4, 5, 6}})
+
+		assertValues(t, m.ColReduce(1, func(acc T, v T) T { return acc + v }), [][]T{
+			{6, 8, 10},
+		})
+	})
+
+	t.Run("HadamardProduct", func(t *testing.T) {
+		for name, m2 := range operands(t, f, [][]T{{6, 5, 0}, {3, 2, 1}}) {
+			m1 := mustFromRows(t, f, [][]T{{1, 0, 3}, {4, 5, 6}})
+
+			m3, err := m1.HadamardProduct(m2)
+			if err != nil {
+				t.Fatalf("%s: %v", name, err)
+			}
+
+			assertValues(t, m3, [][]T{
+				{6, 0, 0},
+				{12, 10, 6},
+			})
+		}
+	})
+
+	t.Run("ElementwiseDivide", func(t *testing.T) {
+		for name, m2 := range operands(t, f, [][]T{{3, 2, 3}, {4, 5, 6}}) {
+			m1 := mustFromRows(t, f, [][]T{{6, 0, 9}, {4, 10, 12}})
+
+			m3, err := m1.ElementwiseDivide(m2)
+			if err != nil {
+				t.Fatalf("%s: %v", name, err)
+			}
+
+			assertValues(t, m3, [][]T{
+				{2, 0, 3},
+				{1, 2, 2},
+			})
+		}
+	})
+
+	t.Run("ElementwiseDivideByZero", func(t *testing.T) {
+		for name, m2 := range operands(t, f, [][]T{{1, 2, 3}, {4, 0, 0}}) {
+			m1 := mustFromRows(t, f, [][]T{{1, 2, 3}, {4, 5, 6}})
+
+			var e *immutabilitybenchmarking.DivisionByZeroError
+			if _, err := m1.ElementwiseDivide(m2); !errors.As(err, &e) {
+				t.Fatalf("%s: expected a DivisionByZeroError but got %v", name, err)
+			}
+
+			expected := immutabilitybenchmarking.DivisionByZeroError{Op: "ElementwiseDivide", Row: 1, Col: 1}
+			if *e != expected {
+				t.Errorf("%s: expected %+v but got %+v", name, expected, *e)
+			}
+
+			// A failed division must not leave a mutable matrix partly divided.
+			assertValues(t, m1, [][]T{
+				{1, 2, 3},
+				{4, 5, 6},
+			})
+		}
+	})
+
+	t.Run("KroneckerProduct", func(t *testing.T) {
+		for name, m2 := range operands(t, f, [][]T{{1, 0, 2}, {3, 1, 0}}) {
+			m1 := mustFromRows(t, f, [][]T{{1, 2}, {0, 3}})
+
+			m3, err := m1.KroneckerProduct(m2)
+			if err != nil {
+				t.Fatalf("%s: %v", name, err)
+			}
+
+			assertValues(t, m3, [][]T{
+				{1, 0, 2, 2, 0, 4},
+				{3, 1, 0, 6, 2, 0},
+				{0, 0, 0, 3, 0, 6},
+				{0, 0, 0, 9, 3, 0},
+			})
+		}
+	})
+
+	t.Run("Trace", func(t *testing.T) {
+		m := mustFromRows(t, f, [][]T{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}})
+
+		trace, err := m.Trace()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if trace != 15 {
+			t.Errorf("expected the trace to be 15 but got %v", trace)
+		}
+	})
+
+	t.Run("TraceNotSquare", func(t *testing.T) {
+		m := mustFromRows(t, f, [][]T{{1, 2, 3}, {4, 5, 6}})
+
+		var e *immutabilitybenchmarking.NotSquareError
+		if _, err := m.Trace(); !errors.As(err, &e) {
+			t.Fatalf("expected a NotSquareError but got %v", err)
+		}
+
+		if *e != (immutabilitybenchmarking.NotSquareError{Rows: 2, Cols: 3}) {
+			t.Errorf("expected a 2x3 NotSquareError but got %+v", *e)
+		}
+	})
+
+	t.Run("Sum", func(t *testing.T) {
+		m := mustFromRows(t, f, [][]T{{1, 0, 3}, {4, 5, 0}})
+
+		if sum := m.Sum(); sum != 13 {
+			t.Errorf("expected the sum to be 13 but got %v", sum)
+		}
+	})
+
+	t.Run("MinMax", func(t *testing.T) {
+		cases := []struct {
+			name   string
+			rows   [][]T
+			min    T
+			argMin [2]int
+			max    T
+			argMax [2]int
+		}{
+			{
+				name:   "first of repeated extremes",
+				rows:   [][]T{{4, 2, 9}, {2, 9, 5}},
+				min:    2,
+				argMin: [2]int{0, 1},
+				max:    9,
+				argMax: [2]int{0, 2},
+			},
+			{
+				name:   "zero before a smaller stored value",
+				rows:   [][]T{{3, 0, 7}, {7, 1, 0}},
+				min:    0,
+				argMin: [2]int{0, 1},
+				max:    7,
+				argMax: [2]int{0, 2},
+			},
+			{
+				name:   "zero after the largest value",
+				rows:   [][]T{{9, 3}, {0, 9}},
+				min:    0,
+				argMin: [2]int{1, 0},
+				max:    9,
+				argMax: [2]int{0, 0},
+			},
+			{
+				name:   "all zeros",
+				rows:   [][]T{{0, 0}, {0, 0}},
+				min:    0,
+				argMin: [2]int{0, 0},
+				max:    0,
+				argMax: [2]int{0, 0},
+			},
+		}
+
+		for _, c := range cases {
+			m := mustFromRows(t, f, c.rows)
+
+			if v := m.Min(); v != c.min {
+				t.Errorf("%s: expected the minimum to be %v but got %v", c.name, c.min, v)
+			}
+
+			if r, col := m.ArgMin(); [2]int{r, col} != c.argMin {
+				t.Errorf("%s: expected the minimum at %v but got %v", c.name, c.argMin, [2]int{r, col})
+			}
+
+			if v := m.Max(); v != c.max {
+				t.Errorf("%s: expected the maximum to be %v but got %v", c.name, c.max, v)
+			}
+
+			if r, col := m.ArgMax(); [2]int{r, col} != c.argMax {
+				t.Errorf("%s: expected the maximum at %v but got %v", c.name, c.argMax, [2]int{r, col})
+			}
+		}
+	})
+
+	t.Run("Norms", func(t *testing.T) {
+		m := mustFromRows(t, f, [][]T{{1, 0, 5}, {3, 4, 0}})
+
+		if n := m.FrobeniusNorm(); n != math.Sqrt(51) {
+			t.Errorf("expected the Frobenius norm to be %v but got %v", math.Sqrt(51), n)
+		}
+
+		if n := m.OneNorm(); n != 5 {
+			t.Errorf("expected the one norm to be 5 but got %v", n)
+		}
+
+		if n := m.InfNorm(); n != 7 {
+			t.Errorf("expected the infinity norm to be 7 but got %v", n)
+		}
+	})
+
+	t.Run("Rank", func(t *testing.T) {
+		cases := []struct {
+			name string
+			rows [][]T
+			rank int
+		}{
+			{name: "full rank", rows: [][]T{{1, 2}, {3, 4}}, rank: 2},
+			{name: "repeated row", rows: [][]T{{1, 2, 3}, {2, 4, 6}}, rank: 1},
+			{name: "wide", rows: [][]T{{1, 0, 0}, {0, 0, 1}}, rank: 2},
+			{name: "tall", rows: [][]T{{1, 2}, {2, 3}, {3, 4}}, rank: 2},
+			{name: "zeros", rows: [][]T{{0, 0}, {0, 0}}, rank: 0},
+		}
+
+		for _, c := range cases {
+			if rank := mustFromRows(t, f, c.rows).Rank(); rank != c.rank {
+				t.Errorf("%s: expected a rank of %d but got %d", c.name, c.rank, rank)
+			}
+		}
+	})
+
+	t.Run("Power", func(t *testing.T) {
+		cases := []struct {
+			n        int
+			expected [][]T
+		}{
+			{n: 0, expected: [][]T{{1, 0, 0}, {0, 1, 0}, {0, 0, 1}}},
+			{n: 1, expected: [][]T{{1, 2, 0}, {0, 1, 1}, {1, 0, 1}}},
+			{n: 2, expected: [][]T{{1, 4, 2}, {1, 1, 2}, {2, 2, 1}}},
+			{n: 5, expected: [][]T{{21, 30, 24}, {12, 21, 15}, {15, 24, 21}}},
+			{n: 6, expected: [][]T{{45, 72, 54}, {27, 45, 36}, {36, 54, 45}}},
+		}
+
+		for _, c := range cases {
+			m := mustFromRows(t, f, [][]T{{1, 2, 0}, {0, 1, 1}, {1, 0, 1}})
+
+			p, err := m.Power(c.n)
+			if err != nil {
+				t.Fatalf("power %d: %v", c.n, err)
+			}
+
+			assertValues(t, p, c.expected)
+		}
+	})
+
+	t.Run("PowerNotSquare", func(t *testing.T) {
+		m := mustFromRows(t, f, [][]T{{1, 2, 3}, {4, 5, 6}})
+
+		var e *immutabilitybenchmarking.NotSquareError
+		if _, err := m.Power(2); !errors.As(err, &e) {
+			t.Fatalf("expected a NotSquareError but got %v", err)
+		}
+
+		if *e != (immutabilitybenchmarking.NotSquareError{Rows: 2, Cols: 3}) {
+			t.Errorf("expected a 2x3 NotSquareError but got %+v", *e)
+		}
+	})
+
+	t.Run("PowerNegativeExponent", func(t *testing.T) {
+		m := mustFromRows(t, f, [][]T{{1, 2}, {3, 4}})
+
+		var e *immutabilitybenchmarking.NegativeExponentError
+		if _, err := m.Power(-1); !errors.As(err, &e) {
+			t.Fatalf("expected a NegativeExponentError but got %v", err)
+		}
+
+		if e.Exponent != -1 {
+			t.Errorf("expected the exponent -1 but got %d", e.Exponent)
+		}
+	})
+
+	t.Run("Reshape", func(t *testing.T) {
+		cases := []struct {
+			rows     int
+			cols     int
+			expected [][]T
+		}{
+			{rows: 2, cols: 3, expected: [][]T{{1, 2, 3}, {4, 5, 6}}},
+			{rows: 3, cols: 2, expected: [][]T{{1, 2}, {3, 4}, {5, 6}}},
+			{rows: 1, cols: 6, expected: [][]T{{1, 2, 3, 4, 5, 6}}},
+			{rows: 6, cols: 1, expected: [][]T{{1}, {2}, {3}, {4}, {5}, {6}}},
+		}
+
+		for _, c := range cases {
+			m := mustFromRows(t, f, [][]T{{1, 2, 3}, {4, 5, 6}})
+
+			m2, err := m.Reshape(c.rows, c.cols)
+			if err != nil {
+				t.Fatalf("%dx%d: %v", c.rows, c.cols, err)
+			}
+
+			assertValues(t, m2, c.expected)
+		}
+	})
+
+	t.Run("ReshapeInvalid", func(t *testing.T) {
+		m := mustFromRows(t, f, [][]T{{1, 2, 3}, {4, 5, 6}})
+
+		var reshape *immutabilitybenchmarking.ReshapeError
+		if _, err := m.Reshape(4, 2); !errors.As(err, &reshape) {
+			t.Errorf("expected a ReshapeError but got %v", err)
+		} else if *reshape != (immutabilitybenchmarking.ReshapeError{Rows: 2, Cols: 3, NewRows: 4, NewCols: 2}) {
+			t.Errorf("expected a ReshapeError from 2x3 to 4x2 but got %+v", *reshape)
+		}
+
+		var invalidDimensions *immutabilitybenchmarking.InvalidDimensionsError
+		if _, err := m.Reshape(0, 6); !errors.As(err, &invalidDimensions) {
+			t.Errorf("expected an InvalidDimensionsError but got %v", err)
+		}
+	})
+
+	t.Run("HConcat", func(t *testing.T) {
+		for name, m2 := range operands(t, f, [][]T{{7}, {8}}) {
+			m1 := mustFromRows(t, f, [][]T{{1, 2, 3}, {4, 5, 6}})
+
+			m3, err := m1.HConcat(m2)
+			if err != nil {
+				t.Fatalf("%s: %v", name, err)
+			}
+
+			assertValues(t, m3, [][]T{{1, 2, 3, 7}, {4, 5, 6, 8}})
+		}
+	})
+
+	t.Run("VConcat", func(t *testing.T) {
+		for name, m2 := range operands(t, f, [][]T{{7, 8, 9}}) {
+			m1 := mustFromRows(t, f, [][]T{{1, 2, 3}, {4, 5, 6}})
+
+			m3, err := m1.VConcat(m2)
+			if err != nil {
+				t.Fatalf("%s: %v", name, err)
+			}
+
+			assertValues(t, m3, [][]T{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}})
+		}
+	})
+
+	t.Run("Stack", func(t *testing.T) {
+		m1 := mustFromRows(t, f, [][]T{{1, 2}})
+
+		m2, err := m1.Stack(mustFromRows(t, f, [][]T{{3, 4}, {5, 6}}), reference(t, [][]T{{7, 8}}), mustFromRows(t, f, [][]T{{9, 0}}))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assertValues(t, m2, [][]T{{1, 2}, {3, 4}, {5, 6}, {7, 8}, {9, 0}})
+
+		m3, err := mustFromRows(t, f, [][]T{{1, 2}}).Stack()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assertValues(t, m3, [][]T{{1, 2}})
+	})
+
+	t.Run("ConcatSelf", func(t *testing.T) {
+		m := mustFromRows(t, f, [][]T{{1, 2}, {3, 4}})
+
+		h, err := m.HConcat(m)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assertValues(t, h, [][]T{{1, 2, 1, 2}, {3, 4, 3, 4}})
+
+		m = mustFromRows(t, f, [][]T{{1, 2}, {3, 4}})
+
+		v, err := m.Stack(m, m)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assertValues(t, v, [][]T{{1, 2}, {3, 4}, {1, 2}, {3, 4}, {1, 2}, {3, 4}})
+	})
+
+	t.Run("SplitRows", func(t *testing.T) {
+		m := mustFromRows(t, f, [][]T{{1, 2}, {3, 4}, {5, 6}})
+
+		top, bottom, err := m.SplitRows(1)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assertValues(t, top, [][]T{{1, 2}})
+		assertValues(t, bottom, [][]T{{3, 4}, {5, 6}})
+	})
+
+	t.Run("SplitCols", func(t *testing.T) {
+		m := mustFromRows(t, f, [][]T{{1, 2, 3}, {4, 5, 6}})
+
+		left, right, err := m.SplitCols(2)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assertValues(t, left, [][]T{{1, 2}, {4, 5}})
+		assertValues(t, right, [][]T{{3}, {6}})
+	})
+
+	t.Run("SplitEmpty", func(t *testing.T) {
+		cases := []struct {
+			name     string
+			split    func(m immutabilitybenchmarking.Matrix[T]) error
+			expected immutabilitybenchmarking.InvalidDimensionsError
+		}{
+			{
+				name: "rows at 0",
+				split: func(m immutabilitybenchmarking.Matrix[T]) error {
+					_, _, err := m.SplitRows(0)
+					return err
+				},
+				expected: immutabilitybenchmarking.InvalidDimensionsError{Rows: 0, Cols: 3},
+			},
+			{
+				name: "rows at 2",
+				split: func(m immutabilitybenchmarking.Matrix[T]) error {
+					_, _, err := m.SplitRows(2)
+					return err
+				},
+				expected: immutabilitybenchmarking.InvalidDimensionsError{Rows: 0, Cols: 3},
+			},
+			{
+				name: "cols at 0",
+				split: func(m immutabilitybenchmarking.Matrix[T]) error {
+					_, _, err := m.SplitCols(0)
+					return err
+				},
+				expected: immutabilitybenchmarking.InvalidDimensionsError{Rows: 2, Cols: 0},
+			},
+			{
+				name: "cols at 3",
+				split: func(m immutabilitybenchmarking.Matrix[T]) error {
+					_, _, err := m.SplitCols(3)
+					return err
+				},
+				expected: immutabilitybenchmarking.InvalidDimensionsError{Rows: 2, Cols: 0},
+			},
+		}
+
+		for _, c := range cases {
+			m := mustFromRows(t, f, [][]T{{1, 2, 3}, {4, 5, 6}})
+
+			var e *immutabilitybenchmarking.InvalidDimensionsError
+			if err := c.split(m); !errors.As(err, &e) {
+				t.Errorf("%s: expected an InvalidDimensionsError but got %v", c.name, err)
+				continue
+			}
+
+			if *e != c.expected {
+				t.Errorf("%s: expected %+v but got %+v", c.name, c.expected, *e)
+			}
+
+			assertValues(t, m, [][]T{{1, 2, 3}, {4, 5, 6}})
+		}
+	})
+
+	t.Run("Pad", func(t *testing.T) {
+		m := mustFromRows(t, f, [][]T{{1, 2}, {3, 4}})
+
+		p, err := m.Pad(1, 0, 2, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assertValues(t, p, [][]T{
+			{0, 0, 0, 0, 0},
+			{0, 0, 1, 2, 0},
+			{0, 0, 3, 4, 0},
+		})
+	})
+
+	t.Run("PadNegative", func(t *testing.T) {
+		m := mustFromRows(t, f, [][]T{{1, 2}, {3, 4}})
+
+		var padding *immutabilitybenchmarking.PaddingError
+		if _, err := m.Pad(0, 2, -1, 0); !errors.As(err, &padding) {
+			t.Errorf("expected a PaddingError but got %v", err)
+		} else if *padding != (immutabilitybenchmarking.PaddingError{Top: 0, Bottom: 2, Left: -1, Right: 0}) {
+			t.Errorf("expected a PaddingError for 0, 2, -1 and 0 but got %+v", *padding)
+		}
+
+		assertValues(t, m, [][]T{{1, 2}, {3, 4}})
+	})
+
+	t.Run("Flip", func(t *testing.T) {
+		rows := mustFromRows(t, f, [][]T{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}}).Flip(immutabilitybenchmarking.RowAxis)
+		assertValues(t, rows, [][]T{{7, 8, 9}, {4, 5, 6}, {1, 2, 3}})
+
+		cols := mustFromRows(t, f, [][]T{{1, 2, 3}, {4, 5, 6}}).Flip(immutabilitybenchmarking.ColAxis)
+		assertValues(t, cols, [][]T{{3, 2, 1}, {6, 5, 4}})
+	})
+
+	t.Run("Rotate90", func(t *testing.T) {
+		rect := mustFromRows(t, f, [][]T{{1, 2, 3}, {4, 5, 6}}).Rotate90()
+		assertValues(t, rect, [][]T{{4, 1}, {5, 2}, {6, 3}})
+
+		square := mustFromRows(t, f, [][]T{{1, 2, 3, 4}, {5, 6, 7, 8}, {9, 10, 11, 12}, {13, 14, 15, 16}}).Rotate90()
+		assertValues(t, square, [][]T{{13, 9, 5, 1}, {14, 10, 6, 2}, {15, 11, 7, 3}, {16, 12, 8, 4}})
+	})
+
+	t.Run("Rotate90FourTimes", func(t *testing.T) {
+		for _, rows := range [][][]T{{{1, 2, 3}, {4, 5, 6}}, {{1, 2, 3}, {4, 5, 6}, {7, 8, 9}}} {
+			m := mustFromRows(t, f, rows)
+
+			for i := 0; i < 4; i++ {
+				m = m.Rotate90()
+			}
+
+			assertValues(t, m, rows)
+		}
+	})
+
+	t.Run("MatrixMultiply", func(t *testing.T) {
+		cases := []struct {
+			name     string
+			left     [][]T
+			right    [][]T
+			expected [][]T
+		}{
+			{
+				name:     "2x3 by 3x2",
+				left:     [][]T{{2, 3, 4}, {1, 0, 0}},
+				right:    [][]T{{0, 10}, {1, 2}, {0, 1}},
+				expected: [][]T{{3, 30}, {0, 10}},
+			},
+			{
+				name:     "1x3 by 3x1",
+				left:     [][]T{{2, 3, 4}},
+				right:    [][]T{{1}, {2}, {3}},
+				expected: [][]T{{20}},
+			},
+			{
+				name:     "3x1 by 1x3",
+				left:     [][]T{{1}, {2}, {3}},
+				right:    [][]T{{2, 3, 4}},
+				expected: [][]T{{2, 3, 4}, {4, 6, 8}, {6, 9, 12}},
+			},
+			{
+				name:     "2x2 by 2x2",
+				left:     [][]T{{1, 2}, {3, 4}},
+				right:    [][]T{{5, 6}, {7, 8}},
+				expected: [][]T{{19, 22}, {43, 50}},
+			},
+			{
+				name:     "2x3 by 3x3",
+				left:     [][]T{{1, 0, 2}, {0, 1, 0}},
+				right:    [][]T{{1, 2, 3}, {4, 5, 6}, {1, 1, 1}},
+				expected: [][]T{{3, 4, 5}, {4, 5, 6}},
+			},
+		}
+
+		for _, c := range cases {
+			for name, m2 := range operands(t, f, c.right) {
+				m1 := mustFromRows(t, f, c.left)
+
+				m3, err := m1.MatrixMultiply(m2)
+				if err != nil {
+					t.Fatalf("%s with %s: %v", c.name, name, err)
+				}
+
+				assertValues(t, m3, c.expected)
+			}
+		}
+	})
+
+	t.Run("MatrixMultiplyIdentity", func(t *testing.T) {
+		m1 := mustFromRows(t, f, [][]T{{1, 2, 3}, {4, 5, 6}})
+
+		id, err := f.Identity(3)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		m2, err := m1.MatrixMultiply(id)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assertValues(t, m2, [][]T{
+			{1, 2, 3},
+			{4, 5, 6},
+		})
+	})
+
+	t.Run("DimensionMismatch", func(t *testing.T) {
+		cases := []struct {
+			op    string
+			right [][]T
+			apply func(m1 immutabilitybenchmarking.Matrix[T], m2 immutabilitybenchmarking.Matrix[T]) error
+		}{
+			{
+				op:    "Add",
+				right: [][]T{{1, 2}, {3, 4}, {5, 6}},
+				apply: func(m1 immutabilitybenchmarking.Matrix[T], m2 immutabilitybenchmarking.Matrix[T]) error {
+					_, err := m1.Add(m2)
+					return err
+				},
+			},
+			{
+				op:    "Subtract",
+				right: [][]T{{1, 2, 3}},
+				apply: func(m1 immutabilitybenchmarking.Matrix[T], m2 immutabilitybenchmarking.Matrix[T]) error {
+					_, err := m1.Subtract(m2)
+					return err
+				},
+			},
+			{
+				op:    "ZipWith",
+				right: [][]T{{1, 2}, {3, 4}},
+				apply: func(m1 immutabilitybenchmarking.Matrix[T], m2 immutabilitybenchmarking.Matrix[T]) error {
+					_, err := m1.ZipWith(m2, func(a T, b T) T { return a + b })
+					return err
+				},
+			},
+			{
+				op:    "HadamardProduct",
+				right: [][]T{{1, 2}, {3, 4}},
+				apply: func(m1 immutabilitybenchmarking.Matrix[T], m2 immutabilitybenchmarking.Matrix[T]) error {
+					_, err := m1.HadamardProduct(m2)
+					return err
+				},
+			},
+			{
+				op:    "ElementwiseDivide",
+				right: [][]T{{1, 2, 3}},
+				apply: func(m1 immutabilitybenchmarking.Matrix[T], m2 immutabilitybenchmarking.Matrix[T]) error {
+					_, err := m1.ElementwiseDivide(m2)
+					return err
+				},
+			},
+			{
+				op:    "HConcat",
+				right: [][]T{{1}, {2}, {3}},
+				apply: func(m1 immutabilitybenchmarking.Matrix[T], m2 immutabilitybenchmarking.Matrix[T]) error {
+					_, err := m1.HConcat(m2)
+					return err
+				},
+			},
+			{
+				op:    "VConcat",
+				right: [][]T{{1, 2}},
+				apply: func(m1 immutabilitybenchmarking.Matrix[T], m2 immutabilitybenchmarking.Matrix[T]) error {
+					_, err := m1.VConcat(m2)
+					return err
+				},
+			},
+			{
+				op:    "Stack",
+				right: [][]T{{1, 2, 3, 4}},
+				apply: func(m1 immutabilitybenchmarking.Matrix[T], m2 immutabilitybenchmarking.Matrix[T]) error {
+					_, err := m1.Stack(m1, m2)
+					return err
+				},
+			},
+			{
+				op:    "MatrixMultiply",
+				right: [][]T{{1, 2, 3}, {4, 5, 6}},
+				apply: func(m1 immutabilitybenchmarking.Matrix[T], m2 immutabilitybenchmarking.Matrix[T]) error {
+					_, err := m1.MatrixMultiply(m2)
+					return err
+				},
+			},
+		}
+
+		for _, c := range cases {
+			m1 := mustFromRows(t, f, [][]T{{1, 2, 3}, {4, 5, 6}})
+			m2 := mustFromRows(t, f, c.right)
+
+			var e *immutabilitybenchmarking.DimensionMismatchError
+			if err := c.apply(m1, m2); !errors.As(err, &e) {
+				t.Errorf("%s: expected a DimensionMismatchError but got %v", c.op, err)
+				continue
+			}
+
+			expected := immutabilitybenchmarking.DimensionMismatchError{
+				Op:        c.op,
+				LeftRows:  2,
+				LeftCols:  3,
+				RightRows: len(c.right),
+				RightCols: len(c.right[0]),
+			}
+
+			if *e != expected {
+				t.Errorf("%s: expected %+v but got %+v", c.op, expected, *e)
+			}
+		}
+	})
+}
+
+// operands returns the given values as both a matrix from the factory and a matrix from the reference
+// implementation so every operation is checked against an argument of a different type.
+func operands[T immutabilitybenchmarking.Number](t *testing.T, f immutabilitybenchmarking.Factory[T], rows [][]T) map[string]immutabilitybenchmarking.Matrix[T] {
+	return map[string]immutabilitybenchmarking.Matrix[T]{
+		"factory":   mustFromRows(t, f, rows),
+		"reference": reference(t, rows),
+	}
+}
+
+func mustFromRows[T immutabilitybenchmarking.Number](t *testing.T, f immutabilitybenchmarking.Factory[T], rows [][]T) immutabilitybenchmarking.Matrix[T] {
+	t.Helper()
+
+	m, err := f.FromRows(rows)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return m
+}
+
+// assertValues checks the dimensions and every element of a matrix without relying on its Equals method.
+func assertValues[T immutabilitybenchmarking.Number](t *testing.T, m immutabilitybenchmarking.Matrix[T], expected [][]T) {
+	t.Helper()
+
+	if m.Height() != len(expected) || m.Width() != len(expected[0]) {
+		t.Fatalf("expected a %dx%d matrix but got %dx%d", len(expected), len(expected[0]), m.Height(), m.Width())
+	}
+
+	for r := 0; r < len(expected); r++ {
+		for c := 0; c < len(expected[r]); c++ {
+			if m.Get(r, c) != expected[r][c] {
+				t.Fatalf("expected %v at %d,%d but got %v", expected[r][c], r, c, m.Get(r, c))
+			}
+		}
+	}
+}
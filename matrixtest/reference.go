@@ -0,0 +1,445 @@
+package matrixtest
+
+import (
+	"math"
+	"testing"
+
+	"github.com/chris-tomich/immutability-benchmarking"
+	"github.com/chris-tomich/immutability-benchmarking/internal/numeric"
+)
+
+// referenceMatrix is a deliberately simple implementation of the Matrix interface used as the argument to
+// operations so backends are checked against a matrix type other than their own.
+type referenceMatrix[T immutabilitybenchmarking.Number] struct {
+	rows [][]T
+}
+
+func reference[T immutabilitybenchmarking.Number](t *testing.T, rows [][]T) referenceMatrix[T] {
+	t.Helper()
+
+	copied := make([][]T, len(rows))
+
+	for r := 0; r < len(rows); r++ {
+		copied[r] = append([]T(nil), rows[r]...)
+	}
+
+	return referenceMatrix[T]{rows: copied}
+}
+
+func newReference[T immutabilitybenchmarking.Number](rows int, cols int, f func(r int, c int) T) referenceMatrix[T] {
+	m := referenceMatrix[T]{rows: make([][]T, rows)}
+
+	for r := 0; r < rows; r++ {
+		m.rows[r] = make([]T, cols)
+		for c := 0; c < cols; c++ {
+			m.rows[r][c] = f(r, c)
+		}
+	}
+
+	return m
+}
+
+func (m1 referenceMatrix[T]) Width() int {
+	return len(m1.rows[0])
+}
+
+func (m1 referenceMatrix[T]) Height() int {
+	return len(m1.rows)
+}
+
+func (m1 referenceMatrix[T]) Get(row int, col int) T {
+	return m1.rows[row][col]
+}
+
+func (m1 referenceMatrix[T]) At(row int, col int) (T, error) {
+	if row < 0 || row >= m1.Height() || col < 0 || col >= m1.Width() {
+		var zero T
+		return zero, &immutabilitybenchmarking.OutOfBoundsError{Row: row, Col: col, Rows: m1.Height(), Cols: m1.Width()}
+	}
+
+	return m1.rows[row][col], nil
+}
+
+func (m1 referenceMatrix[T]) MustAt(row int, col int) T {
+	v, err := m1.At(row, col)
+	if err != nil {
+		panic(err)
+	}
+
+	return v
+}
+
+func (m1 referenceMatrix[T]) Equals(m2 immutabilitybenchmarking.Matrix[T]) bool {
+	if m1.Height() != m2.Height() || m1.Width() != m2.Width() {
+		return false
+	}
+
+	for r := 0; r < m1.Height(); r++ {
+		for c := 0; c < m1.Width(); c++ {
+			if m1.Get(r, c) != m2.Get(r, c) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func (m1 referenceMatrix[T]) mismatch(op string, m2 immutabilitybenchmarking.Matrix[T]) error {
+	return &immutabilitybenchmarking.DimensionMismatchError{
+		Op:        op,
+		LeftRows:  m1.Height(),
+		LeftCols:  m1.Width(),
+		RightRows: m2.Height(),
+		RightCols: m2.Width(),
+	}
+}
+
+func (m1 referenceMatrix[T]) Add(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Height() != m2.Height() || m1.Width() != m2.Width() {
+		return nil, m1.mismatch("Add", m2)
+	}
+
+	return newReference(m1.Height(), m1.Width(), func(r int, c int) T {
+		return m1.Get(r, c) + m2.Get(r, c)
+	}), nil
+}
+
+func (m1 referenceMatrix[T]) Subtract(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Height() != m2.Height() || m1.Width() != m2.Width() {
+		return nil, m1.mismatch("Subtract", m2)
+	}
+
+	return newReference(m1.Height(), m1.Width(), func(r int, c int) T {
+		return m1.Get(r, c) - m2.Get(r, c)
+	}), nil
+}
+
+func (m1 referenceMatrix[T]) ScalarMultiply(s T) immutabilitybenchmarking.Matrix[T] {
+	return newReference(m1.Height(), m1.Width(), func(r int, c int) T {
+		return m1.Get(r, c) * s
+	})
+}
+
+func (m1 referenceMatrix[T]) Transpose() immutabilitybenchmarking.Matrix[T] {
+	return newReference(m1.Width(), m1.Height(), func(r int, c int) T {
+		return m1.Get(c, r)
+	})
+}
+
+func (m1 referenceMatrix[T]) MatrixMultiply(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Width() != m2.Height() {
+		return nil, m1.mismatch("MatrixMultiply", m2)
+	}
+
+	return newReference(m1.Height(), m2.Width(), func(r int, c int) T {
+		var product T
+		for k := 0; k < m1.Width(); k++ {
+			product = product + m1.Get(r, k)*m2.Get(k, c)
+		}
+		return product
+	}), nil
+}
+
+func (m1 referenceMatrix[T]) Map(f func(v T) T) immutabilitybenchmarking.Matrix[T] {
+	return newReference(m1.Height(), m1.Width(), func(r int, c int) T {
+		return f(m1.Get(r, c))
+	})
+}
+
+func (m1 referenceMatrix[T]) MapIndexed(f func(row int, col int, v T) T) immutabilitybenchmarking.Matrix[T] {
+	return newReference(m1.Height(), m1.Width(), func(r int, c int) T {
+		return f(r, c, m1.Get(r, c))
+	})
+}
+
+func (m1 referenceMatrix[T]) ZipWith(m2 immutabilitybenchmarking.Matrix[T], f func(a T, b T) T) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Height() != m2.Height() || m1.Width() != m2.Width() {
+		return nil, m1.mismatch("ZipWith", m2)
+	}
+
+	return newReference(m1.Height(), m1.Width(), func(r int, c int) T {
+		return f(m1.Get(r, c), m2.Get(r, c))
+	}), nil
+}
+
+func (m1 referenceMatrix[T]) Fold(initial T, f func(acc T, v T) T) T {
+	acc := initial
+	for r := 0; r < m1.Height(); r++ {
+		for c := 0; c < m1.Width(); c++ {
+			acc = f(acc, m1.Get(r, c))
+		}
+	}
+
+	return acc
+}
+
+func (m1 referenceMatrix[T]) RowReduce(initial T, f func(acc T, v T) T) immutabilitybenchmarking.Matrix[T] {
+	return newReference(m1.Height(), 1, func(r int, _ int) T {
+		acc := initial
+		for c := 0; c < m1.Width(); c++ {
+			acc = f(acc, m1.Get(r, c))
+		}
+		return acc
+	})
+}
+
+func (m1 referenceMatrix[T]) ColReduce(initial T, f func(acc T, v T) T) immutabilitybenchmarking.Matrix[T] {
+	return newReference(1, m1.Width(), func(_ int, c int) T {
+		acc := initial
+		for r := 0; r < m1.Height(); r++ {
+			acc = f(acc, m1.Get(r, c))
+		}
+		return acc
+	})
+}
+
+func (m1 referenceMatrix[T]) HadamardProduct(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Height() != m2.Height() || m1.Width() != m2.Width() {
+		return nil, m1.mismatch("HadamardProduct", m2)
+	}
+
+	return newReference(m1.Height(), m1.Width(), func(r int, c int) T {
+		return m1.Get(r, c) * m2.Get(r, c)
+	}), nil
+}
+
+func (m1 referenceMatrix[T]) ElementwiseDivide(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Height() != m2.Height() || m1.Width() != m2.Width() {
+		return nil, m1.mismatch("ElementwiseDivide", m2)
+	}
+
+	for r := 0; r < m2.Height(); r++ {
+		for c := 0; c < m2.Width(); c++ {
+			if m2.Get(r, c) == 0 {
+				return nil, &immutabilitybenchmarking.DivisionByZeroError{Op: "ElementwiseDivide", Row: r, Col: c}
+			}
+		}
+	}
+
+	return newReference(m1.Height(), m1.Width(), func(r int, c int) T {
+		return m1.Get(r, c) / m2.Get(r, c)
+	}), nil
+}
+
+func (m1 referenceMatrix[T]) KroneckerProduct(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	return newReference(m1.Height()*m2.Height(), m1.Width()*m2.Width(), func(r int, c int) T {
+		return m1.Get(r/m2.Height(), c/m2.Width()) * m2.Get(r%m2.Height(), c%m2.Width())
+	}), nil
+}
+
+func (m1 referenceMatrix[T]) Trace() (T, error) {
+	if m1.Height() != m1.Width() {
+		var zero T
+		return zero, &immutabilitybenchmarking.NotSquareError{Rows: m1.Height(), Cols: m1.Width()}
+	}
+
+	var trace T
+	for i := 0; i < m1.Height(); i++ {
+		trace += m1.rows[i][i]
+	}
+
+	return trace, nil
+}
+
+func (m1 referenceMatrix[T]) Sum() T {
+	return m1.Fold(0, func(acc T, v T) T { return acc + v })
+}
+
+func (m1 referenceMatrix[T]) Min() T {
+	return m1.Get(m1.ArgMin())
+}
+
+func (m1 referenceMatrix[T]) Max() T {
+	return m1.Get(m1.ArgMax())
+}
+
+func (m1 referenceMatrix[T]) ArgMin() (int, int) {
+	less := numeric.LessFunc[T]()
+	row, col := 0, 0
+	for r := 0; r < m1.Height(); r++ {
+		for c := 0; c < m1.Width(); c++ {
+			if less(m1.rows[r][c], m1.rows[row][col]) {
+				row, col = r, c
+			}
+		}
+	}
+
+	return row, col
+}
+
+func (m1 referenceMatrix[T]) ArgMax() (int, int) {
+	less := numeric.LessFunc[T]()
+	row, col := 0, 0
+	for r := 0; r < m1.Height(); r++ {
+		for c := 0; c < m1.Width(); c++ {
+			if less(m1.rows[row][col], m1.rows[r][c]) {
+				row, col = r, c
+			}
+		}
+	}
+
+	return row, col
+}
+
+func (m1 referenceMatrix[T]) FrobeniusNorm() float64 {
+	abs := numeric.AbsFunc[T]()
+	var sum float64
+	for r := 0; r < m1.Height(); r++ {
+		for c := 0; c < m1.Width(); c++ {
+			sum += abs(m1.rows[r][c]) * abs(m1.rows[r][c])
+		}
+	}
+
+	return math.Sqrt(sum)
+}
+
+func (m1 referenceMatrix[T]) OneNorm() float64 {
+	return m1.Transpose().InfNorm()
+}
+
+func (m1 referenceMatrix[T]) InfNorm() float64 {
+	abs := numeric.AbsFunc[T]()
+	var norm float64
+	for r := 0; r < m1.Height(); r++ {
+		var sum float64
+		for c := 0; c < m1.Width(); c++ {
+			sum += abs(m1.rows[r][c])
+		}
+		norm = math.Max(norm, sum)
+	}
+
+	return norm
+}
+
+func (m1 referenceMatrix[T]) Rank() int {
+	return numeric.Rank(m1.Height(), m1.Width(), func(r int) []T { return m1.rows[r] })
+}
+
+func (m1 referenceMatrix[T]) Power(n int) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Height() != m1.Width() {
+		return nil, &immutabilitybenchmarking.NotSquareError{Rows: m1.Height(), Cols: m1.Width()}
+	}
+
+	if n < 0 {
+		return nil, &immutabilitybenchmarking.NegativeExponentError{Exponent: n}
+	}
+
+	var p immutabilitybenchmarking.Matrix[T] = newReference(m1.Height(), m1.Width(), func(r int, c int) T {
+		if r == c {
+			return 1
+		}
+		return 0
+	})
+
+	for i := 0; i < n; i++ {
+		p, _ = p.MatrixMultiply(m1)
+	}
+
+	return p, nil
+}
+
+func (m1 referenceMatrix[T]) Reshape(rows int, cols int) (immutabilitybenchmarking.Matrix[T], error) {
+	if rows <= 0 || cols <= 0 {
+		return nil, &immutabilitybenchmarking.InvalidDimensionsError{Rows: rows, Cols: cols}
+	}
+
+	if rows*cols != m1.Height()*m1.Width() {
+		return nil, &immutabilitybenchmarking.ReshapeError{Rows: m1.Height(), Cols: m1.Width(), NewRows: rows, NewCols: cols}
+	}
+
+	return newReference(rows, cols, func(r int, c int) T {
+		i := r*cols + c
+		return m1.rows[i/m1.Width()][i%m1.Width()]
+	}), nil
+}
+
+func (m1 referenceMatrix[T]) HConcat(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Height() != m2.Height() {
+		return nil, m1.mismatch("HConcat", m2)
+	}
+
+	return newReference(m1.Height(), m1.Width()+m2.Width(), func(r int, c int) T {
+		if c < m1.Width() {
+			return m1.rows[r][c]
+		}
+		return m2.Get(r, c-m1.Width())
+	}), nil
+}
+
+func (m1 referenceMatrix[T]) VConcat(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Width() != m2.Width() {
+		return nil, m1.mismatch("VConcat", m2)
+	}
+
+	return newReference(m1.Height()+m2.Height(), m1.Width(), func(r int, c int) T {
+		if r < m1.Height() {
+			return m1.rows[r][c]
+		}
+		return m2.Get(r-m1.Height(), c)
+	}), nil
+}
+
+func (m1 referenceMatrix[T]) Stack(ms ...immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	var m immutabilitybenchmarking.Matrix[T] = m1
+
+	for _, m2 := range ms {
+		if m1.Width() != m2.Width() {
+			return nil, m1.mismatch("Stack", m2)
+		}
+
+		m, _ = m.VConcat(m2)
+	}
+
+	return m, nil
+}
+
+func (m1 referenceMatrix[T]) SplitRows(at int) (immutabilitybenchmarking.Matrix[T], immutabilitybenchmarking.Matrix[T], error) {
+	if at <= 0 || at >= m1.Height() {
+		return nil, nil, &immutabilitybenchmarking.InvalidDimensionsError{Rows: min(at, m1.Height()-at), Cols: m1.Width()}
+	}
+
+	top := newReference(at, m1.Width(), func(r int, c int) T { return m1.rows[r][c] })
+	bottom := newReference(m1.Height()-at, m1.Width(), func(r int, c int) T { return m1.rows[at+r][c] })
+
+	return top, bottom, nil
+}
+
+func (m1 referenceMatrix[T]) SplitCols(at int) (immutabilitybenchmarking.Matrix[T], immutabilitybenchmarking.Matrix[T], error) {
+	if at <= 0 || at >= m1.Width() {
+		return nil, nil, &immutabilitybenchmarking.InvalidDimensionsError{Rows: m1.Height(), Cols: min(at, m1.Width()-at)}
+	}
+
+	left := newReference(m1.Height(), at, func(r int, c int) T { return m1.rows[r][c] })
+	right := newReference(m1.Height(), m1.Width()-at, func(r int, c int) T { return m1.rows[r][at+c] })
+
+	return left, right, nil
+}
+
+func (m1 referenceMatrix[T]) Pad(top int, bottom int, left int, right int) (immutabilitybenchmarking.Matrix[T], error) {
+	if top < 0 || bottom < 0 || left < 0 || right < 0 {
+		return nil, &immutabilitybenchmarking.PaddingError{Top: top, Bottom: bottom, Left: left, Right: right}
+	}
+
+	return newReference(top+m1.Height()+bottom, left+m1.Width()+right, func(r int, c int) T {
+		if r < top || r >= top+m1.Height() || c < left || c >= left+m1.Width() {
+			return 0
+		}
+		return m1.rows[r-top][c-left]
+	}), nil
+}
+
+func (m1 referenceMatrix[T]) Flip(axis immutabilitybenchmarking.Axis) immutabilitybenchmarking.Matrix[T] {
+	return newReference(m1.Height(), m1.Width(), func(r int, c int) T {
+		if axis == immutabilitybenchmarking.RowAxis {
+			return m1.rows[m1.Height()-1-r][c]
+		}
+		return m1.rows[r][m1.Width()-1-c]
+	})
+}
+
+func (m1 referenceMatrix[T]) Rotate90() immutabilitybenchmarking.Matrix[T] {
+	return newReference(m1.Width(), m1.Height(), func(r int, c int) T {
+		return m1.rows[m1.Height()-1-c][r]
+	})
+}
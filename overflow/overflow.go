@@ -0,0 +1,234 @@
+// Package overflow performs integer matrix arithmetic with a selectable policy for results that cannot be
+// represented in the element type. It works with any backend through that backend's Factory, reading the
+// operands through Get and building each result as a new matrix, so mutable backends are never updated in
+// place under a policy. Benchmarks through a mutable backend's Factory therefore do the same allocating work
+// as through an immutable one and differ only in the storage each Factory builds.
+package overflow
+
+import (
+	"unsafe"
+
+	"github.com/chris-tomich/immutability-benchmarking"
+)
+
+// Integer is the set of element types an overflow policy applies to.
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64
+}
+
+// Policy selects what happens when an operation produces a value that cannot be represented.
+type Policy int
+
+const (
+	// Wrapping lets results wrap around as Go's built-in integer arithmetic does.
+	Wrapping Policy = iota
+	// Checked stops at the first cell that overflows and returns an OverflowError for it.
+	Checked
+	// Saturating clamps results to the smallest or largest value the element type can hold.
+	Saturating
+)
+
+func (p Policy) String() string {
+	switch p {
+	case Wrapping:
+		return "Wrapping"
+	case Checked:
+		return "Checked"
+	case Saturating:
+		return "Saturating"
+	}
+
+	return "Unknown"
+}
+
+// Arithmetic performs matrix operations under a Policy, creating results with a Factory.
+type Arithmetic[T Integer] struct {
+	factory immutabilitybenchmarking.Factory[T]
+	policy  Policy
+	signed  bool
+	min     T
+	max     T
+}
+
+// New creates an Arithmetic that applies policy p to matrices created by f.
+func New[T Integer](f immutabilitybenchmarking.Factory[T], p Policy) Arithmetic[T] {
+	var zero T
+
+	a := Arithmetic[T]{factory: f, policy: p}
+
+	if ^zero < zero {
+		a.signed = true
+		a.max = T(uint64(1)<<(unsafe.Sizeof(zero)*8-1) - 1)
+		a.min = ^a.max
+	} else {
+		a.max = ^zero
+	}
+
+	return a
+}
+
+// Policy returns the policy a applies.
+func (a Arithmetic[T]) Policy() Policy {
+	return a.policy
+}
+
+// Add adds m1 and m2.
+func (a Arithmetic[T]) Add(m1 immutabilitybenchmarking.Matrix[T], m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Height() != m2.Height() || m1.Width() != m2.Width() {
+		return nil, &immutabilitybenchmarking.DimensionMismatchError{
+			Op: "Add", LeftRows: m1.Height(), LeftCols: m1.Width(), RightRows: m2.Height(), RightCols: m2.Width(),
+		}
+	}
+
+	return a.build("Add", m1.Height(), m1.Width(), func(row int, col int) (T, bool) {
+		return a.add(m1.Get(row, col), m2.Get(row, col))
+	})
+}
+
+// Subtract subtracts m2 from m1.
+func (a Arithmetic[T]) Subtract(m1 immutabilitybenchmarking.Matrix[T], m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Height() != m2.Height() || m1.Width() != m2.Width() {
+		return nil, &immutabilitybenchmarking.DimensionMismatchError{
+			Op: "Subtract", LeftRows: m1.Height(), LeftCols: m1.Width(), RightRows: m2.Height(), RightCols: m2.Width(),
+		}
+	}
+
+	return a.build("Subtract", m1.Height(), m1.Width(), func(row int, col int) (T, bool) {
+		return a.subtract(m1.Get(row, col), m2.Get(row, col))
+	})
+}
+
+// ScalarMultiply multiplies every element of m by s.
+func (a Arithmetic[T]) ScalarMultiply(m immutabilitybenchmarking.Matrix[T], s T) (immutabilitybenchmarking.Matrix[T], error) {
+	return a.build("ScalarMultiply", m.Height(), m.Width(), func(row int, col int) (T, bool) {
+		return a.multiply(m.Get(row, col), s)
+	})
+}
+
+// MatrixMultiply multiplies m1 by m2. Under the Saturating policy each product and each partial sum is
+// clamped as it is calculated.
+func (a Arithmetic[T]) MatrixMultiply(m1 immutabilitybenchmarking.Matrix[T], m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Width() != m2.Height() {
+		return nil, &immutabilitybenchmarking.DimensionMismatchError{
+			Op: "MatrixMultiply", LeftRows: m1.Height(), LeftCols: m1.Width(), RightRows: m2.Height(), RightCols: m2.Width(),
+		}
+	}
+
+	return a.build("MatrixMultiply", m1.Height(), m2.Width(), func(row int, col int) (T, bool) {
+		var sum T
+
+		for k := 0; k < m1.Width(); k++ {
+			product, ok := a.multiply(m1.Get(row, k), m2.Get(k, col))
+			if !ok {
+				return product, false
+			}
+
+			if sum, ok = a.add(sum, product); !ok {
+				return sum, false
+			}
+		}
+
+		return sum, true
+	})
+}
+
+// build creates a rows x cols matrix from f, returning an OverflowError for the first cell f reports as
+// overflowing. Once a cell has overflowed the remaining cells are left as zero.
+func (a Arithmetic[T]) build(op string, rows int, cols int, f func(row int, col int) (T, bool)) (immutabilitybenchmarking.Matrix[T], error) {
+	var overflow *immutabilitybenchmarking.OverflowError
+
+	m, err := a.factory.FromFunc(rows, cols, func(row int, col int) T {
+		if overflow != nil {
+			return 0
+		}
+
+		v, ok := f(row, col)
+		if !ok {
+			overflow = &immutabilitybenchmarking.OverflowError{Op: op, Row: row, Col: col}
+		}
+
+		return v
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if overflow != nil {
+		return nil, overflow
+	}
+
+	return m, nil
+}
+
+// overflowed returns the value to use for a result that has overflowed and whether the policy allows it.
+func (a Arithmetic[T]) overflowed(saturated T) (T, bool) {
+	if a.policy == Saturating {
+		return saturated, true
+	}
+
+	return 0, false
+}
+
+func (a Arithmetic[T]) add(x T, y T) (T, bool) {
+	r := x + y
+
+	if a.policy == Wrapping {
+		return r, true
+	}
+
+	if a.signed {
+		if x > 0 && y > 0 && r < 0 {
+			return a.overflowed(a.max)
+		}
+
+		if x < 0 && y < 0 && r >= 0 {
+			return a.overflowed(a.min)
+		}
+	} else if r < x {
+		return a.overflowed(a.max)
+	}
+
+	return r, true
+}
+
+func (a Arithmetic[T]) subtract(x T, y T) (T, bool) {
+	r := x - y
+
+	if a.policy == Wrapping {
+		return r, true
+	}
+
+	if a.signed {
+		if y < 0 && r < x {
+			return a.overflowed(a.max)
+		}
+
+		if y > 0 && r > x {
+			return a.overflowed(a.min)
+		}
+	} else if y > x {
+		return a.overflowed(a.min)
+	}
+
+	return r, true
+}
+
+func (a Arithmetic[T]) multiply(x T, y T) (T, bool) {
+	r := x * y
+
+	if a.policy == Wrapping || x == 0 || y == 0 {
+		return r, true
+	}
+
+	// Dividing the most negative value by -1 wraps back to itself, so that case has to be caught directly.
+	if r/y != x || (a.signed && y == ^T(0) && x == a.min) {
+		if a.signed && (x < 0) != (y < 0) {
+			return a.overflowed(a.min)
+		}
+
+		return a.overflowed(a.max)
+	}
+
+	return r, true
+}
@@ -0,0 +1,266 @@
+package overflow
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/chris-tomich/immutability-benchmarking"
+	arrayimmutable "github.com/chris-tomich/immutability-benchmarking/array/immutable"
+	arraymutable "github.com/chris-tomich/immutability-benchmarking/array/mutable"
+	flatimmutable "github.com/chris-tomich/immutability-benchmarking/flat/immutable"
+	flatmutable "github.com/chris-tomich/immutability-benchmarking/flat/mutable"
+	"github.com/chris-tomich/immutability-benchmarking/persistent"
+	sliceimmutable "github.com/chris-tomich/immutability-benchmarking/slice/immutable"
+	slicemutable "github.com/chris-tomich/immutability-benchmarking/slice/mutable"
+)
+
+var factories = map[string]immutabilitybenchmarking.Factory[int8]{
+	"slice/mutable":   slicemutable.Factory[int8]{},
+	"slice/immutable": sliceimmutable.Factory[int8]{},
+	"array/mutable":   arraymutable.Factory[int8]{},
+	"array/immutable": arrayimmutable.Factory[int8]{},
+	"flat/mutable":    flatmutable.Factory[int8]{},
+	"flat/immutable":  flatimmutable.Factory[int8]{},
+	"persistent":      persistent.Factory[int8]{},
+}
+
+// expect returns what a policy should produce for an exact result, clamped to the range [min, max].
+func expect(p Policy, exact int, wrapped int, min int, max int) (int, bool) {
+	switch {
+	case exact >= min && exact <= max:
+		return exact, true
+	case p == Wrapping:
+		return wrapped, true
+	case p == Checked:
+		return 0, false
+	case exact < min:
+		return min, true
+	default:
+		return max, true
+	}
+}
+
+func TestInt8Exhaustive(t *testing.T) {
+	for _, p := range []Policy{Wrapping, Checked, Saturating} {
+		a := New[int8](sliceimmutable.Factory[int8]{}, p)
+
+		for x := math.MinInt8; x <= math.MaxInt8; x++ {
+			for y := math.MinInt8; y <= math.MaxInt8; y++ {
+				checks := []struct {
+					op    string
+					exact int
+					f     func(int8, int8) (int8, bool)
+				}{
+					{op: "add", exact: x + y, f: a.add},
+					{op: "subtract", exact: x - y, f: a.subtract},
+					{op: "multiply", exact: x * y, f: a.multiply},
+				}
+
+				for _, c := range checks {
+					expected, expectedOk := expect(p, c.exact, int(int8(c.exact)), math.MinInt8, math.MaxInt8)
+
+					v, ok := c.f(int8(x), int8(y))
+					if ok != expectedOk || (ok && int(v) != expected) {
+						t.Fatalf("%s %s %d, %d: expected %d, %v but got %d, %v", p, c.op, x, y, expected, expectedOk, v, ok)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestUint8Exhaustive(t *testing.T) {
+	for _, p := range []Policy{Wrapping, Checked, Saturating} {
+		a := New[uint8](sliceimmutable.Factory[uint8]{}, p)
+
+		for x := 0; x <= math.MaxUint8; x++ {
+			for y := 0; y <= math.MaxUint8; y++ {
+				checks := []struct {
+					op    string
+					exact int
+					f     func(uint8, uint8) (uint8, bool)
+				}{
+					{op: "add", exact: x + y, f: a.add},
+					{op: "subtract", exact: x - y, f: a.subtract},
+					{op: "multiply", exact: x * y, f: a.multiply},
+				}
+
+				for _, c := range checks {
+					expected, expectedOk := expect(p, c.exact, int(uint8(c.exact)), 0, math.MaxUint8)
+
+					v, ok := c.f(uint8(x), uint8(y))
+					if ok != expectedOk || (ok && int(v) != expected) {
+						t.Fatalf("%s %s %d, %d: expected %d, %v but got %d, %v", p, c.op, x, y, expected, expectedOk, v, ok)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestLimits(t *testing.T) {
+	if a := New[int64](sliceimmutable.Factory[int64]{}, Checked); a.min != math.MinInt64 || a.max != math.MaxInt64 {
+		t.Errorf("expected int64 limits but got %d, %d", a.min, a.max)
+	}
+
+	if a := New[uint32](sliceimmutable.Factory[uint32]{}, Checked); a.min != 0 || a.max != math.MaxUint32 {
+		t.Errorf("expected uint32 limits but got %d, %d", a.min, a.max)
+	}
+}
+
+func TestCheckedReportsCell(t *testing.T) {
+	for name, f := range factories {
+		t.Run(name, func(t *testing.T) {
+			a := New(f, Checked)
+
+			m1, _ := f.FromRows([][]int8{{1, 2}, {30, 100}})
+			m2, _ := f.FromRows([][]int8{{1, 2}, {100, 1}})
+			negated, _ := f.FromRows([][]int8{{-1, -2}, {-100, -1}})
+
+			cases := []struct {
+				op     string
+				result func() (immutabilitybenchmarking.Matrix[int8], error)
+				row    int
+				col    int
+			}{
+				{op: "Add", result: func() (immutabilitybenchmarking.Matrix[int8], error) { return a.Add(m1, m2) }, row: 1, col: 0},
+				{op: "Subtract", result: func() (immutabilitybenchmarking.Matrix[int8], error) { return a.Subtract(m1, negated) }, row: 1, col: 0},
+				{op: "ScalarMultiply", result: func() (immutabilitybenchmarking.Matrix[int8], error) { return a.ScalarMultiply(m1, 2) }, row: 1, col: 1},
+				{op: "MatrixMultiply", result: func() (immutabilitybenchmarking.Matrix[int8], error) { return a.MatrixMultiply(m1, m2) }, row: 0, col: 0},
+			}
+
+			for _, c := range cases {
+				var e *immutabilitybenchmarking.OverflowError
+
+				m, err := c.result()
+				if !errors.As(err, &e) {
+					t.Errorf("%s: expected an OverflowError but got %v, %v", c.op, m, err)
+					continue
+				}
+
+				expected := immutabilitybenchmarking.OverflowError{Op: c.op, Row: c.row, Col: c.col}
+				if *e != expected {
+					t.Errorf("expected %+v but got %+v", expected, *e)
+				}
+			}
+		})
+	}
+}
+
+func TestSaturatingMatrixMultiply(t *testing.T) {
+	for name, f := range factories {
+		t.Run(name, func(t *testing.T) {
+			m1, _ := f.FromRows([][]int8{{100, 100}, {-100, 1}})
+			m2, _ := f.FromRows([][]int8{{2, 0}, {1, 1}})
+
+			m, err := New(f, Saturating).MatrixMultiply(m1, m2)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			expected, _ := f.FromRows([][]int8{{127, 100}, {-127, 1}})
+			if !m.Equals(expected) {
+				t.Errorf("expected %v but got %v", expected, m)
+			}
+		})
+	}
+}
+
+func TestWrappingMatchesBackend(t *testing.T) {
+	for name, f := range factories {
+		t.Run(name, func(t *testing.T) {
+			a := New(f, Wrapping)
+
+			// The mutable backends update their receiver in place so every operation is given fresh matrices.
+			matrices := func() (immutabilitybenchmarking.Matrix[int8], immutabilitybenchmarking.Matrix[int8], immutabilitybenchmarking.Matrix[int8]) {
+				m1, _ := f.FromRows([][]int8{{1, 2, 3}, {100, 120, -128}})
+				m2, _ := f.FromRows([][]int8{{100, 127, -1}, {100, 8, -1}})
+				m3, _ := f.FromRows([][]int8{{100, 2}, {127, 1}, {-3, -128}})
+
+				return m1, m2, m3
+			}
+
+			ops := []struct {
+				op       string
+				expected func(m1, m2, m3 immutabilitybenchmarking.Matrix[int8]) (immutabilitybenchmarking.Matrix[int8], error)
+				actual   func(m1, m2, m3 immutabilitybenchmarking.Matrix[int8]) (immutabilitybenchmarking.Matrix[int8], error)
+			}{
+				{
+					op: "Add",
+					expected: func(m1, m2, _ immutabilitybenchmarking.Matrix[int8]) (immutabilitybenchmarking.Matrix[int8], error) {
+						return m1.Add(m2)
+					},
+					actual: func(m1, m2, _ immutabilitybenchmarking.Matrix[int8]) (immutabilitybenchmarking.Matrix[int8], error) {
+						return a.Add(m1, m2)
+					},
+				},
+				{
+					op: "Subtract",
+					expected: func(m1, m2, _ immutabilitybenchmarking.Matrix[int8]) (immutabilitybenchmarking.Matrix[int8], error) {
+						return m1.Subtract(m2)
+					},
+					actual: func(m1, m2, _ immutabilitybenchmarking.Matrix[int8]) (immutabilitybenchmarking.Matrix[int8], error) {
+						return a.Subtract(m1, m2)
+					},
+				},
+				{
+					op: "ScalarMultiply",
+					expected: func(m1, _, _ immutabilitybenchmarking.Matrix[int8]) (immutabilitybenchmarking.Matrix[int8], error) {
+						return m1.ScalarMultiply(3), nil
+					},
+					actual: func(m1, _, _ immutabilitybenchmarking.Matrix[int8]) (immutabilitybenchmarking.Matrix[int8], error) {
+						return a.ScalarMultiply(m1, 3)
+					},
+				},
+				{
+					op: "MatrixMultiply",
+					expected: func(m1, _, m3 immutabilitybenchmarking.Matrix[int8]) (immutabilitybenchmarking.Matrix[int8], error) {
+						return m1.MatrixMultiply(m3)
+					},
+					actual: func(m1, _, m3 immutabilitybenchmarking.Matrix[int8]) (immutabilitybenchmarking.Matrix[int8], error) {
+						return a.MatrixMultiply(m1, m3)
+					},
+				},
+			}
+
+			for _, o := range ops {
+				actual, err := o.actual(matrices())
+				if err != nil {
+					t.Fatalf("%s: %v", o.op, err)
+				}
+
+				expected, err := o.expected(matrices())
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if !actual.Equals(expected) {
+					t.Errorf("%s: expected %v but got %v", o.op, expected, actual)
+				}
+			}
+		})
+	}
+}
+
+func TestDimensionMismatch(t *testing.T) {
+	f := sliceimmutable.Factory[int8]{}
+	a := New[int8](f, Checked)
+
+	m1, _ := f.FromRows([][]int8{{1, 2}})
+	m2, _ := f.FromRows([][]int8{{1, 2}, {3, 4}, {5, 6}})
+
+	var e *immutabilitybenchmarking.DimensionMismatchError
+
+	if _, err := a.Add(m1, m2); !errors.As(err, &e) {
+		t.Errorf("expected a DimensionMismatchError from Add but got %v", err)
+	}
+
+	if _, err := a.Subtract(m1, m2); !errors.As(err, &e) {
+		t.Errorf("expected a DimensionMismatchError from Subtract but got %v", err)
+	}
+
+	if _, err := a.MatrixMultiply(m1, m2); !errors.As(err, &e) {
+		t.Errorf("expected a DimensionMismatchError from MatrixMultiply but got %v", err)
+	}
+}
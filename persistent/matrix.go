@@ -0,0 +1,699 @@
+package persistent
+
+import (
+	"github.com/chris-tomich/immutability-benchmarking"
+	"github.com/chris-tomich/immutability-benchmarking/internal/numeric"
+	"github.com/chris-tomich/immutability-benchmarking/internal/power"
+	"github.com/chris-tomich/immutability-benchmarking/internal/shape"
+)
+
+const (
+	bits      = 5
+	chunkSize = 1 << bits
+	mask      = chunkSize - 1
+)
+
+// node is either a branch holding up to chunkSize children or a leaf holding chunkSize values.
+// Nodes are never modified once built so they can be shared freely between matrices.
+type node[T immutabilitybenchmarking.Number] struct {
+	children []*node[T]
+	values   []T
+}
+
+// with returns a copy of the path from this node to the value at index i with that value replaced by v.
+func (n *node[T]) with(shift uint, i int, v T) *node[T] {
+	if shift == 0 {
+		values := make([]T, len(n.values))
+		copy(values, n.values)
+		values[i&mask] = v
+
+		return &node[T]{values: values}
+	}
+
+	children := make([]*node[T], len(n.children))
+	copy(children, n.children)
+
+	j := (i >> shift) & mask
+	children[j] = n.children[j].with(shift-bits, i, v)
+
+	return &node[T]{children: children}
+}
+
+// Matrix is a persistent immutable matrix stored as a tree of fixed size chunks in row-major order.
+// Updating a single element with With only copies the chunks along the path to that element, all other
+// chunks are shared with the original matrix.
+type Matrix[T immutabilitybenchmarking.Number] struct {
+	rows  int
+	cols  int
+	shift uint
+	root  *node[T]
+}
+
+// New creates a new persistent matrix with the given initial values.
+func New[T immutabilitybenchmarking.Number](matrix [][]T) (Matrix[T], error) {
+	if len(matrix) == 0 || len(matrix[0]) == 0 {
+		return Matrix[T]{}, &immutabilitybenchmarking.InvalidDimensionsError{Rows: len(matrix)}
+	}
+
+	cols := len(matrix[0])
+
+	for r := 1; r < len(matrix); r++ {
+		if len(matrix[r]) != cols {
+			return Matrix[T]{}, &immutabilitybenchmarking.JaggedRowsError{Row: r, Width: len(matrix[r]), Expected: len(matrix[0])}
+		}
+	}
+
+	return build(len(matrix), cols, func(r int, c int) T {
+		return matrix[r][c]
+	}), nil
+}
+
+// NewEmpty createas a new empty matrix with the given dimensions.
+func NewEmpty[T immutabilitybenchmarking.Number](width int, height int) Matrix[T] {
+	if width <= 0 || height <= 0 {
+		panic(&immutabilitybenchmarking.InvalidDimensionsError{Rows: height, Cols: width})
+	}
+
+	return build(height, width, func(int, int) T {
+		var zero T
+		return zero
+	})
+}
+
+// build creates a matrix of the given dimensions with each element set to the result of f.
+func build[T immutabilitybenchmarking.Number](rows int, cols int, f func(r int, c int) T) Matrix[T] {
+	total := rows * cols
+	level := make([]*node[T], 0, (total+mask)/chunkSize)
+
+	for i := 0; i < total; i += chunkSize {
+		values := make([]T, chunkSize)
+		for j := 0; j < chunkSize && i+j < total; j++ {
+			values[j] = f((i+j)/cols, (i+j)%cols)
+		}
+		level = append(level, &node[T]{values: values})
+	}
+
+	return fromLeaves(rows, cols, level)
+}
+
+// fromLeaves creates a matrix of the given dimensions from its chunks in row-major order, building the
+// branches above them. Every chunk but the last must be full.
+func fromLeaves[T immutabilitybenchmarking.Number](rows int, cols int, level []*node[T]) Matrix[T] {
+	shift := uint(0)
+
+	for len(level) > 1 {
+		parents := make([]*node[T], 0, (len(level)+mask)/chunkSize)
+		for i := 0; i < len(level); i += chunkSize {
+			end := i + chunkSize
+			if end > len(level) {
+				end = len(level)
+			}
+			parents = append(parents, &node[T]{children: level[i:end:end]})
+		}
+		level = parents
+		shift += bits
+	}
+
+	return Matrix[T]{rows: rows, cols: cols, shift: shift, root: level[0]}
+}
+
+// mapValues creates a matrix of the same dimensions with each element set to the result of f, walking the
+// chunks of this matrix directly rather than looking up every element from the root.
+func (m1 Matrix[T]) mapValues(f func(r int, c int, v T) T) Matrix[T] {
+	total := m1.rows * m1.cols
+	i := 0
+
+	var walk func(n *node[T], shift uint) *node[T]
+	walk = func(n *node[T], shift uint) *node[T] {
+		if shift == 0 {
+			values := make([]T, chunkSize)
+			for j := 0; j < chunkSize && i < total; j++ {
+				values[j] = f(i/m1.cols, i%m1.cols, n.values[j])
+				i++
+			}
+			return &node[T]{values: values}
+		}
+
+		children := make([]*node[T], len(n.children))
+		for j := 0; j < len(n.children); j++ {
+			children[j] = walk(n.children[j], shift-bits)
+		}
+		return &node[T]{children: children}
+	}
+
+	return Matrix[T]{rows: m1.rows, cols: m1.cols, shift: m1.shift, root: walk(m1.root, m1.shift)}
+}
+
+// each calls f with every element in row-major order, walking the chunks of this matrix directly rather
+// than looking up every element from the root.
+func (m1 Matrix[T]) each(f func(r int, c int, v T)) {
+	total := m1.rows * m1.cols
+	i := 0
+
+	var walk func(n *node[T], shift uint)
+	walk = func(n *node[T], shift uint) {
+		if shift == 0 {
+			for j := 0; j < chunkSize && i < total; j++ {
+				f(i/m1.cols, i%m1.cols, n.values[j])
+				i++
+			}
+			return
+		}
+
+		for j := 0; j < len(n.children); j++ {
+			walk(n.children[j], shift-bits)
+		}
+	}
+
+	walk(m1.root, m1.shift)
+}
+
+// leaf returns the chunk holding the element at index i.
+func (m1 Matrix[T]) leaf(i int) []T {
+	n := m1.root
+
+	for shift := m1.shift; shift > 0; shift -= bits {
+		n = n.children[(i>>shift)&mask]
+	}
+
+	return n.values
+}
+
+// Width returns the number of columns in the matrix.
+func (m1 Matrix[T]) Width() int {
+	return m1.cols
+}
+
+// Height returns the number of rows in the matrix.
+func (m1 Matrix[T]) Height() int {
+	return m1.rows
+}
+
+// Get returns the element at the provided coordinates. It panics with an OutOfBoundsError if they are
+// outside the matrix, as the elements are stored in row-major chunks where a column past the last would
+// otherwise read the next row.
+func (m1 Matrix[T]) Get(row int, col int) T {
+	m1.checkBounds(row, col)
+
+	i := row*m1.cols + col
+
+	return m1.leaf(i)[i&mask]
+}
+
+// At returns the element at the provided coordinates or an OutOfBoundsError if they are outside the matrix.
+func (m1 Matrix[T]) At(row int, col int) (T, error) {
+	if row < 0 || row >= m1.Height() || col < 0 || col >= m1.Width() {
+		var zero T
+		return zero, &immutabilitybenchmarking.OutOfBoundsError{Row: row, Col: col, Rows: m1.Height(), Cols: m1.Width()}
+	}
+
+	return m1.Get(row, col), nil
+}
+
+// MustAt returns the element at the provided coordinates and panics with an OutOfBoundsError if they are
+// outside the matrix.
+func (m1 Matrix[T]) MustAt(row int, col int) T {
+	v, err := m1.At(row, col)
+	if err != nil {
+		panic(err)
+	}
+
+	return v
+}
+
+// With returns a new matrix with the element at the provided coordinates replaced by v. The new matrix
+// shares every chunk with this one apart from those on the path to the updated element. It panics with an
+// OutOfBoundsError if the coordinates are outside the matrix.
+func (m1 Matrix[T]) With(row int, col int, v T) Matrix[T] {
+	m1.checkBounds(row, col)
+
+	m1.root = m1.root.with(m1.shift, row*m1.cols+col, v)
+
+	return m1
+}
+
+func (m1 Matrix[T]) checkBounds(row int, col int) {
+	if row < 0 || row >= m1.rows || col < 0 || col >= m1.cols {
+		panic(&immutabilitybenchmarking.OutOfBoundsError{Row: row, Col: col, Rows: m1.rows, Cols: m1.cols})
+	}
+}
+
+// Equals will compare a matrix against this matrix and return if they are equal.
+func (m1 Matrix[T]) Equals(m2 immutabilitybenchmarking.Matrix[T]) bool {
+	if m1.Height() != m2.Height() {
+		return false
+	}
+
+	if m1.Width() != m2.Width() {
+		return false
+	}
+
+	total := m1.rows * m1.cols
+
+	for i := 0; i < total; i += chunkSize {
+		values := m1.leaf(i)
+		for j := 0; j < chunkSize && i+j < total; j++ {
+			if values[j] != m2.Get((i+j)/m1.cols, (i+j)%m1.cols) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// Add will add the values of a matrix to this matrix.
+func (m1 Matrix[T]) Add(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Height() != m2.Height() || m1.Width() != m2.Width() {
+		return Matrix[T]{}, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "Add",
+			LeftRows:  m1.Height(),
+			LeftCols:  m1.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	return m1.mapValues(func(r int, c int, v T) T {
+		return v + m2.Get(r, c)
+	}), nil
+}
+
+// Subtract will subtract the values of a matrix from this matrix.
+func (m1 Matrix[T]) Subtract(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Height() != m2.Height() || m1.Width() != m2.Width() {
+		return Matrix[T]{}, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "Subtract",
+			LeftRows:  m1.Height(),
+			LeftCols:  m1.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	return m1.mapValues(func(r int, c int, v T) T {
+		return v - m2.Get(r, c)
+	}), nil
+}
+
+// ScalarMultiply will multiply this matrix by a given scalar value.
+func (m1 Matrix[T]) ScalarMultiply(s T) immutabilitybenchmarking.Matrix[T] {
+	return m1.mapValues(func(r int, c int, v T) T {
+		return v * s
+	})
+}
+
+// Transpose will transpose this matrix.
+func (m1 Matrix[T]) Transpose() immutabilitybenchmarking.Matrix[T] {
+	return build(m1.cols, m1.rows, func(r int, c int) T {
+		return m1.Get(c, r)
+	})
+}
+
+// MatrixMultiply will multiple the given matrix against this matrix.
+func (m1 Matrix[T]) MatrixMultiply(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Width() != m2.Height() {
+		return Matrix[T]{}, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "MatrixMultiply",
+			LeftRows:  m1.Height(),
+			LeftCols:  m1.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	return build(m1.rows, m2.Width(), func(r int, c int) T {
+		var product T
+		for cm := 0; cm < m1.cols; cm++ {
+			product = product + m1.Get(r, cm)*m2.Get(cm, c)
+		}
+		return product
+	}), nil
+}
+
+// Map will apply f to every element of this matrix.
+func (m1 Matrix[T]) Map(f func(v T) T) immutabilitybenchmarking.Matrix[T] {
+	return m1.mapValues(func(r int, c int, v T) T {
+		return f(v)
+	})
+}
+
+// MapIndexed will apply f to every element of this matrix along with its coordinates.
+func (m1 Matrix[T]) MapIndexed(f func(row int, col int, v T) T) immutabilitybenchmarking.Matrix[T] {
+	return m1.mapValues(f)
+}
+
+// ZipWith will combine each element of this matrix with the element at the same coordinates of the given
+// matrix using f.
+func (m1 Matrix[T]) ZipWith(m2 immutabilitybenchmarking.Matrix[T], f func(a T, b T) T) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Height() != m2.Height() || m1.Width() != m2.Width() {
+		return Matrix[T]{}, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "ZipWith",
+			LeftRows:  m1.Height(),
+			LeftCols:  m1.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	return m1.mapValues(func(r int, c int, v T) T {
+		return f(v, m2.Get(r, c))
+	}), nil
+}
+
+// Fold will combine every element of this matrix into a single value, starting from initial and visiting
+// the elements in row-major order.
+func (m1 Matrix[T]) Fold(initial T, f func(acc T, v T) T) T {
+	acc := initial
+
+	m1.each(func(r int, c int, v T) {
+		acc = f(acc, v)
+	})
+
+	return acc
+}
+
+// RowReduce will fold each row of this matrix into a single value starting from initial, returning a
+// column of the results.
+func (m1 Matrix[T]) RowReduce(initial T, f func(acc T, v T) T) immutabilitybenchmarking.Matrix[T] {
+	results := make([]T, m1.rows)
+
+	m1.each(func(r int, c int, v T) {
+		if c == 0 {
+			results[r] = initial
+		}
+
+		results[r] = f(results[r], v)
+	})
+
+	return build(m1.rows, 1, func(r int, c int) T {
+		return results[r]
+	})
+}
+
+// ColReduce will fold each column of this matrix into a single value starting from initial, returning a
+// row of the results.
+func (m1 Matrix[T]) ColReduce(initial T, f func(acc T, v T) T) immutabilitybenchmarking.Matrix[T] {
+	results := make([]T, m1.cols)
+
+	for c := 0; c < len(results); c++ {
+		results[c] = initial
+	}
+
+	m1.each(func(r int, c int, v T) {
+		results[c] = f(results[c], v)
+	})
+
+	return build(1, m1.cols, func(r int, c int) T {
+		return results[c]
+	})
+}
+
+// HadamardProduct will multiply each element of this matrix by the element at the same coordinates of the
+// given matrix.
+func (m1 Matrix[T]) HadamardProduct(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Height() != m2.Height() || m1.Width() != m2.Width() {
+		return Matrix[T]{}, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "HadamardProduct",
+			LeftRows:  m1.Height(),
+			LeftCols:  m1.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	return m1.mapValues(func(r int, c int, v T) T {
+		return v * m2.Get(r, c)
+	}), nil
+}
+
+// ElementwiseDivide will divide each element of this matrix by the element at the same coordinates of the
+// given matrix, returning a DivisionByZeroError if any of them is zero.
+func (m1 Matrix[T]) ElementwiseDivide(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Height() != m2.Height() || m1.Width() != m2.Width() {
+		return Matrix[T]{}, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "ElementwiseDivide",
+			LeftRows:  m1.Height(),
+			LeftCols:  m1.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	for r := 0; r < m1.rows; r++ {
+		for c := 0; c < m1.cols; c++ {
+			if m2.Get(r, c) == 0 {
+				return Matrix[T]{}, &immutabilitybenchmarking.DivisionByZeroError{Op: "ElementwiseDivide", Row: r, Col: c}
+			}
+		}
+	}
+
+	return m1.mapValues(func(r int, c int, v T) T {
+		return v / m2.Get(r, c)
+	}), nil
+}
+
+// KroneckerProduct will multiply every element of this matrix by the whole of the given matrix, returning
+// the blocks of products as a matrix whose dimensions are the products of both matrices' dimensions.
+func (m1 Matrix[T]) KroneckerProduct(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	rows, cols := m2.Height(), m2.Width()
+
+	return build(m1.rows*rows, m1.cols*cols, func(r int, c int) T {
+		return m1.Get(r/rows, c/cols) * m2.Get(r%rows, c%cols)
+	}), nil
+}
+
+// rowReader returns a function copying each row of this matrix into a reused slice a chunk at a time rather
+// than looking up every element from the root.
+func (m1 Matrix[T]) rowReader() func(r int) []T {
+	row := make([]T, m1.cols)
+
+	return func(r int) []T {
+		for c := 0; c < m1.cols; {
+			i := r*m1.cols + c
+			c += copy(row[c:], m1.leaf(i)[i&mask:])
+		}
+
+		return row
+	}
+}
+
+// Trace will sum the elements on the diagonal of this matrix, returning a NotSquareError if it is not square.
+func (m1 Matrix[T]) Trace() (T, error) {
+	if m1.rows != m1.cols {
+		var zero T
+		return zero, &immutabilitybenchmarking.NotSquareError{Rows: m1.rows, Cols: m1.cols}
+	}
+
+	var trace T
+
+	for i := 0; i < m1.rows; i++ {
+		trace += m1.Get(i, i)
+	}
+
+	return trace, nil
+}
+
+// Sum will add together every element of this matrix.
+func (m1 Matrix[T]) Sum() T {
+	return numeric.Sum(m1.rows, m1.rowReader())
+}
+
+// Min will return the smallest element of this matrix. Complex elements are ordered by their real parts and
+// then by their imaginary parts.
+func (m1 Matrix[T]) Min() T {
+	r, c := numeric.ArgMin(m1.rows, m1.rowReader())
+
+	return m1.Get(r, c)
+}
+
+// Max will return the largest element of this matrix. Complex elements are ordered by their real parts and
+// then by their imaginary parts.
+func (m1 Matrix[T]) Max() T {
+	r, c := numeric.ArgMax(m1.rows, m1.rowReader())
+
+	return m1.Get(r, c)
+}
+
+// ArgMin will return the coordinates of the first smallest element of this matrix in row-major order.
+func (m1 Matrix[T]) ArgMin() (int, int) {
+	return numeric.ArgMin(m1.rows, m1.rowReader())
+}
+
+// ArgMax will return the coordinates of the first largest element of this matrix in row-major order.
+func (m1 Matrix[T]) ArgMax() (int, int) {
+	return numeric.ArgMax(m1.rows, m1.rowReader())
+}
+
+// FrobeniusNorm will return the square root of the sum of the squared absolute values of the elements of
+// this matrix.
+func (m1 Matrix[T]) FrobeniusNorm() float64 {
+	return numeric.FrobeniusNorm(m1.rows, m1.rowReader())
+}
+
+// OneNorm will return the largest sum of the absolute values in a column of this matrix.
+func (m1 Matrix[T]) OneNorm() float64 {
+	return numeric.OneNorm(m1.rows, m1.cols, m1.rowReader())
+}
+
+// InfNorm will return the largest sum of the absolute values in a row of this matrix.
+func (m1 Matrix[T]) InfNorm() float64 {
+	return numeric.InfNorm(m1.rows, m1.rowReader())
+}
+
+// Rank will return the number of linearly independent rows of this matrix, treating values within rounding
+// error of zero as zero.
+func (m1 Matrix[T]) Rank() int {
+	return numeric.Rank(m1.rows, m1.cols, m1.rowReader())
+}
+
+// Power will raise this matrix to the power n by repeated squaring, returning a NotSquareError if it is not
+// square or a NegativeExponentError if n is negative. The zeroth power is the identity matrix.
+func (m1 Matrix[T]) Power(n int) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Height() != m1.Width() {
+		return Matrix[T]{}, &immutabilitybenchmarking.NotSquareError{Rows: m1.Height(), Cols: m1.Width()}
+	}
+
+	if n < 0 {
+		return Matrix[T]{}, &immutabilitybenchmarking.NegativeExponentError{Exponent: n}
+	}
+
+	return power.BySquaring[immutabilitybenchmarking.Matrix[T]](m1, n, func() (immutabilitybenchmarking.Matrix[T], error) {
+		return Factory[T]{}.Identity(m1.Height())
+	}, nil)
+}
+
+// leaves returns the chunks of this matrix in row-major order.
+func (m1 Matrix[T]) leaves() []*node[T] {
+	level := []*node[T]{m1.root}
+
+	for shift := m1.shift; shift > 0; shift -= bits {
+		var children []*node[T]
+		for _, n := range level {
+			children = append(children, n.children...)
+		}
+		level = children
+	}
+
+	return level
+}
+
+// fromResult creates a matrix holding the elements a shape operation describes.
+func fromResult[T immutabilitybenchmarking.Number](res shape.Result[T]) Matrix[T] {
+	return build(res.Rows, res.Cols, res.At)
+}
+
+// Reshape will read the elements of this matrix in row-major order into a matrix with the given dimensions,
+// returning a ReshapeError if it would not hold the same number of elements. The chunks are already in
+// row-major order, so the result shares the whole tree with this matrix.
+func (m1 Matrix[T]) Reshape(rows int, cols int) (immutabilitybenchmarking.Matrix[T], error) {
+	if err := shape.CheckReshape(m1, rows, cols); err != nil {
+		return Matrix[T]{}, err
+	}
+
+	return Matrix[T]{rows: rows, cols: cols, shift: m1.shift, root: m1.root}, nil
+}
+
+// HConcat will place the given matrix to the right of this matrix, returning a DimensionMismatchError if
+// they do not have the same number of rows.
+func (m1 Matrix[T]) HConcat(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	res, err := shape.HConcat[T](m1, m2)
+	if err != nil {
+		return Matrix[T]{}, err
+	}
+
+	return fromResult(res), nil
+}
+
+// VConcat will place the given matrix below this matrix, returning a DimensionMismatchError if they do not
+// have the same number of columns.
+func (m1 Matrix[T]) VConcat(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	return m1.stack("VConcat", m2)
+}
+
+// Stack will place the given matrices below this matrix in order, returning a DimensionMismatchError for
+// the first that does not have the same number of columns.
+func (m1 Matrix[T]) Stack(ms ...immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	return m1.stack("Stack", ms...)
+}
+
+// stack shares the chunks of every matrix when they are all persistent and each but the last fills its
+// final chunk, as the chunks then line up in the result.
+func (m1 Matrix[T]) stack(op string, ms ...immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	res, err := shape.Stack[T](op, m1, ms...)
+	if err != nil {
+		return Matrix[T]{}, err
+	}
+
+	all := []Matrix[T]{m1}
+
+	for _, m2 := range ms {
+		o, ok := m2.(Matrix[T])
+		if !ok || all[len(all)-1].rows*all[len(all)-1].cols%chunkSize != 0 {
+			return fromResult(res), nil
+		}
+
+		all = append(all, o)
+	}
+
+	var level []*node[T]
+
+	for _, m := range all {
+		level = append(level, m.leaves()...)
+	}
+
+	return fromLeaves(res.Rows, res.Cols, level), nil
+}
+
+// SplitRows will split this matrix into its rows before at and its rows from at onwards, returning an
+// InvalidDimensionsError if either part would be empty. The top part shares the chunks it needs with this
+// matrix, and so does the bottom part when the split falls on the boundary of a chunk.
+func (m1 Matrix[T]) SplitRows(at int) (immutabilitybenchmarking.Matrix[T], immutabilitybenchmarking.Matrix[T], error) {
+	if err := shape.CheckSplitRows(m1, at); err != nil {
+		return Matrix[T]{}, Matrix[T]{}, err
+	}
+
+	level := m1.leaves()
+	i := at * m1.cols
+	top := fromLeaves(at, m1.cols, level[:(i+mask)/chunkSize])
+
+	if i%chunkSize != 0 {
+		return top, build(m1.rows-at, m1.cols, func(r int, c int) T {
+			return m1.Get(r+at, c)
+		}), nil
+	}
+
+	return top, fromLeaves(m1.rows-at, m1.cols, level[i/chunkSize:]), nil
+}
+
+// SplitCols will split this matrix into its columns before at and its columns from at onwards, returning an
+// InvalidDimensionsError if either part would be empty.
+func (m1 Matrix[T]) SplitCols(at int) (immutabilitybenchmarking.Matrix[T], immutabilitybenchmarking.Matrix[T], error) {
+	left, right, err := shape.SplitCols[T](m1, at)
+	if err != nil {
+		return Matrix[T]{}, Matrix[T]{}, err
+	}
+
+	return fromResult(left), fromResult(right), nil
+}
+
+// Pad will surround this matrix with the given number of rows and columns of zeros on each side, returning
+// a PaddingError if any of them is negative.
+func (m1 Matrix[T]) Pad(top int, bottom int, left int, right int) (immutabilitybenchmarking.Matrix[T], error) {
+	res, err := shape.Pad[T](m1, top, bottom, left, right)
+	if err != nil {
+		return Matrix[T]{}, err
+	}
+
+	return fromResult(res), nil
+}
+
+// Flip will reverse the order of the rows or the columns of this matrix.
+func (m1 Matrix[T]) Flip(axis immutabilitybenchmarking.Axis) immutabilitybenchmarking.Matrix[T] {
+	return fromResult(shape.Flip[T](m1, axis))
+}
+
+// Rotate90 will turn this matrix a quarter turn clockwise.
+func (m1 Matrix[T]) Rotate90() immutabilitybenchmarking.Matrix[T] {
+	return fromResult(shape.Rotate90[T](m1))
+}
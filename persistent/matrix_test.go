@@ -0,0 +1,186 @@
+package persistent
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/chris-tomich/immutability-benchmarking"
+	"github.com/chris-tomich/immutability-benchmarking/matrixtest"
+)
+
+func TestPersistentMatrixMultiplication(t *testing.T) {
+	m1 := mustNew(
+		[][]int{
+			{2, 3, 4},
+			{1, 0, 0},
+		},
+	)
+
+	m2 := mustNew(
+		[][]int{
+			{0, 1000},
+			{1, 100},
+			{0, 10},
+		},
+	)
+
+	m3, _ := m1.MatrixMultiply(m2)
+	isCorrect := m3.Equals(mustNew(
+		[][]int{
+			{3, 2340},
+			{0, 1000},
+		},
+	))
+
+	if !isCorrect {
+		t.Fail()
+	}
+}
+
+func TestPersistentMatrixWith(t *testing.T) {
+	// 40x40 needs 50 chunks so the tree has a branch level above the leaves.
+	rows := make([][]int, 40)
+	for r := range rows {
+		rows[r] = make([]int, 40)
+		for c := range rows[r] {
+			rows[r][c] = r*40 + c
+		}
+	}
+
+	m1 := mustNew(rows)
+	m2 := m1.With(0, 1, -2)
+
+	for r := 0; r < 40; r++ {
+		for c := 0; c < 40; c++ {
+			if m1.Get(r, c) != r*40+c {
+				t.Fatalf("original matrix changed at %d,%d", r, c)
+			}
+
+			expected := r*40 + c
+			if r == 0 && c == 1 {
+				expected = -2
+			}
+
+			if m2.Get(r, c) != expected {
+				t.Fatalf("expected %d at %d,%d but got %d", expected, r, c, m2.Get(r, c))
+			}
+		}
+	}
+
+	if m1.root.children[1] != m2.root.children[1] {
+		t.Error("expected chunks untouched by With to be shared")
+	}
+
+	if m1.root.children[0] == m2.root.children[0] {
+		t.Error("expected the updated chunk to be copied")
+	}
+}
+
+func TestPersistentMatrixWithOutOfBounds(t *testing.T) {
+	m := mustNew([][]int{{1, 2}, {3, 4}})
+
+	// 0,2 would otherwise land on the element at 1,0 of the row-major chunks.
+	for _, c := range [][2]int{{0, 2}, {2, 0}, {-1, 0}, {0, -1}, {100, 100}} {
+		func() {
+			defer func() {
+				var outOfBounds *immutabilitybenchmarking.OutOfBoundsError
+				if err, _ := recover().(error); !errors.As(err, &outOfBounds) {
+					t.Errorf("%d,%d: expected a panic with an OutOfBoundsError but got %v", c[0], c[1], err)
+				}
+			}()
+
+			m.With(c[0], c[1], 9)
+		}()
+	}
+}
+
+func TestPersistentMatrixGetOutOfBounds(t *testing.T) {
+	m := mustNew([][]int{{1, 2}, {3, 4}})
+
+	// 0,2 would otherwise read the element at 1,0 of the row-major chunks.
+	for _, c := range [][2]int{{0, 2}, {0, 5}, {-1, 1}, {2, 0}} {
+		func() {
+			defer func() {
+				var outOfBounds *immutabilitybenchmarking.OutOfBoundsError
+				if err, _ := recover().(error); !errors.As(err, &outOfBounds) {
+					t.Errorf("%d,%d: expected a panic with an OutOfBoundsError but got %v", c[0], c[1], err)
+				}
+			}()
+
+			m.Get(c[0], c[1])
+		}()
+	}
+}
+
+func mustNew[T immutabilitybenchmarking.Number](matrix [][]T) Matrix[T] {
+	m, err := New(matrix)
+	if err != nil {
+		panic(err)
+	}
+
+	return m
+}
+
+func TestPersistentMatrixConformance(t *testing.T) {
+	t.Run("int", func(t *testing.T) {
+		matrixtest.RunConformance[int](t, Factory[int]{})
+	})
+
+	t.Run("uint8", func(t *testing.T) {
+		matrixtest.RunConformance[uint8](t, Factory[uint8]{})
+	})
+
+	t.Run("float64", func(t *testing.T) {
+		matrixtest.RunConformance[float64](t, Factory[float64]{})
+	})
+
+	t.Run("complex128", func(t *testing.T) {
+		matrixtest.RunConformance[complex128](t, Factory[complex128]{})
+	})
+}
+
+func TestPersistentMatrixShapeSharesChunks(t *testing.T) {
+	rows := make([][]int, 40)
+	for r := range rows {
+		rows[r] = make([]int, 40)
+		for c := range rows[r] {
+			rows[r][c] = r*40 + c
+		}
+	}
+
+	m1 := mustNew(rows)
+
+	reshaped, err := m1.Reshape(20, 80)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if reshaped.(Matrix[int]).root != m1.root {
+		t.Error("expected Reshape to share the tree")
+	}
+
+	// 4 rows of 40 fill exactly 5 chunks, so both parts line up with the chunks of m1.
+	top, bottom, err := m1.SplitRows(4)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	leaves := m1.leaves()
+
+	if top.(Matrix[int]).leaves()[0] != leaves[0] || bottom.(Matrix[int]).leaves()[0] != leaves[5] {
+		t.Error("expected a split on a chunk boundary to share chunks")
+	}
+
+	stacked, err := top.VConcat(bottom)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !stacked.Equals(m1) {
+		t.Error("expected stacking the parts to restore the matrix")
+	}
+
+	if stacked.(Matrix[int]).leaves()[5] != leaves[5] {
+		t.Error("expected stacking whole chunks to share them")
+	}
+}
@@ -0,0 +1,102 @@
+package persistent
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/chris-tomich/immutability-benchmarking/slice/mutable"
+)
+
+type cellUpdate struct {
+	row   int
+	col   int
+	value int
+}
+
+func generateRows(size int) [][]int {
+	m := make([][]int, size)
+
+	for i := 0; i < size; i++ {
+		m[i] = make([]int, size)
+
+		for j := 0; j < size; j++ {
+			m[i][j] = rand.Int()
+		}
+	}
+
+	return m
+}
+
+func generateUpdates(size int, totalUpdates int) []cellUpdate {
+	updates := make([]cellUpdate, totalUpdates)
+
+	for i := 0; i < totalUpdates; i++ {
+		updates[i] = cellUpdate{row: rand.Intn(size), col: rand.Intn(size), value: rand.Int()}
+	}
+
+	return updates
+}
+
+func PersistentMatrixWithRunner(b *testing.B, size int, totalUpdates int) {
+	m, _ := New(generateRows(size))
+	updates := generateUpdates(size, totalUpdates)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, u := range updates {
+			m = m.With(u.row, u.col, u.value)
+		}
+	}
+}
+
+func MutableMatrixSetRunner(b *testing.B, size int, totalUpdates int) {
+	m, _ := mutable.New(generateRows(size))
+	updates := generateUpdates(size, totalUpdates)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, u := range updates {
+			m.Set(u.row, u.col, u.value)
+		}
+	}
+}
+
+func BenchmarkMutableMatrix10x10Set(b *testing.B) {
+	MutableMatrixSetRunner(b, 10, 100)
+}
+
+func BenchmarkPersistentMatrix10x10With(b *testing.B) {
+	PersistentMatrixWithRunner(b, 10, 100)
+}
+
+func BenchmarkMutableMatrix30x30Set(b *testing.B) {
+	MutableMatrixSetRunner(b, 30, 100)
+}
+
+func BenchmarkPersistentMatrix30x30With(b *testing.B) {
+	PersistentMatrixWithRunner(b, 30, 100)
+}
+
+func BenchmarkMutableMatrix90x90Set(b *testing.B) {
+	MutableMatrixSetRunner(b, 90, 100)
+}
+
+func BenchmarkPersistentMatrix90x90With(b *testing.B) {
+	PersistentMatrixWithRunner(b, 90, 100)
+}
+
+func BenchmarkMutableMatrix270x270Set(b *testing.B) {
+	MutableMatrixSetRunner(b, 270, 100)
+}
+
+func BenchmarkPersistentMatrix270x270With(b *testing.B) {
+	PersistentMatrixWithRunner(b, 270, 100)
+}
+
+func BenchmarkMutableMatrix810x810Set(b *testing.B) {
+	MutableMatrixSetRunner(b, 810, 100)
+}
+
+func BenchmarkPersistentMatrix810x810With(b *testing.B) {
+	PersistentMatrixWithRunner(b, 810, 100)
+}
@@ -0,0 +1,76 @@
+// Package bignum defines the element constraint and matrix interface shared by the arbitrary precision
+// matrices in slice/bignum/mutable and slice/bignum/immutable.
+package bignum
+
+import (
+	"math/big"
+
+	"github.com/chris-tomich/immutability-benchmarking"
+)
+
+// Number is satisfied by *big.Int and *big.Rat, whose elements are pointers and are updated through their
+// methods rather than with operators. Quo truncates for *big.Int, so it is only used where the division is
+// known to be exact.
+type Number[T any] interface {
+	*T
+	Add(x *T, y *T) *T
+	Sub(x *T, y *T) *T
+	Mul(x *T, y *T) *T
+	Quo(x *T, y *T) *T
+	Abs(x *T) *T
+	Set(x *T) *T
+	SetInt64(x int64) *T
+	Cmp(y *T) int
+	Sign() int
+	MarshalText() ([]byte, error)
+}
+
+// Matrix holds arbitrary precision elements. It has the same operations as immutabilitybenchmarking.Matrix,
+// which cannot hold math/big types as they do not satisfy its Number constraint. Its norms are exact except
+// for FrobeniusNorm, whose square root is rounded to a big.Float.
+type Matrix[T any, PT Number[T]] interface {
+	Width() int
+	Height() int
+	Get(int, int) PT
+	At(int, int) (PT, error)
+	MustAt(int, int) PT
+	Equals(Matrix[T, PT]) bool
+	Add(Matrix[T, PT]) (Matrix[T, PT], error)
+	Subtract(Matrix[T, PT]) (Matrix[T, PT], error)
+	ScalarMultiply(s PT) Matrix[T, PT]
+	Transpose() Matrix[T, PT]
+	MatrixMultiply(Matrix[T, PT]) (Matrix[T, PT], error)
+	Map(f func(v PT) PT) Matrix[T, PT]
+	MapIndexed(f func(row int, col int, v PT) PT) Matrix[T, PT]
+	ZipWith(m2 Matrix[T, PT], f func(a PT, b PT) PT) (Matrix[T, PT], error)
+	Fold(initial PT, f func(acc PT, v PT) PT) PT
+	RowReduce(initial PT, f func(acc PT, v PT) PT) Matrix[T, PT]
+	ColReduce(initial PT, f func(acc PT, v PT) PT) Matrix[T, PT]
+	Trace() (PT, error)
+	Sum() PT
+	Min() PT
+	Max() PT
+	ArgMin() (int, int)
+	ArgMax() (int, int)
+	FrobeniusNorm() *big.Float
+	OneNorm() PT
+	InfNorm() PT
+	Rank() int
+	Power(n int) (Matrix[T, PT], error)
+	Reshape(rows int, cols int) (Matrix[T, PT], error)
+	HConcat(Matrix[T, PT]) (Matrix[T, PT], error)
+	VConcat(Matrix[T, PT]) (Matrix[T, PT], error)
+	Stack(...Matrix[T, PT]) (Matrix[T, PT], error)
+	SplitRows(at int) (Matrix[T, PT], Matrix[T, PT], error)
+	SplitCols(at int) (Matrix[T, PT], Matrix[T, PT], error)
+	Pad(top int, bottom int, left int, right int) (Matrix[T, PT], error)
+	Flip(axis immutabilitybenchmarking.Axis) Matrix[T, PT]
+	Rotate90() Matrix[T, PT]
+}
+
+// Field is satisfied by *big.Rat, whose division is exact, so matrices of them can be factorized without
+// rounding. Requiring Inv keeps out *big.Int, whose Quo truncates.
+type Field[T any] interface {
+	Number[T]
+	Inv(x *T) *T
+}
@@ -0,0 +1,269 @@
+package immutable
+
+import (
+	"github.com/chris-tomich/immutability-benchmarking"
+	"github.com/chris-tomich/immutability-benchmarking/slice/bignum"
+)
+
+// LU is the exact LU factorization with partial pivoting of an immutable matrix, P A = L U, where L is
+// lower triangular with a diagonal of ones and U is upper triangular. The matrix it was computed from is
+// left unchanged.
+type LU[T any, PT bignum.Field[T]] struct {
+	l Matrix[T, PT]
+	u Matrix[T, PT]
+	// pivots holds the row of the original matrix that each row of the factors came from.
+	pivots []int
+	sign   int64
+	// singular is the column of the first zero pivot, or -1 if there is none.
+	singular int
+}
+
+// Factorize returns the LU factorization of a square matrix. Pivots are chosen by magnitude even though
+// the arithmetic is exact, which keeps the elements of the factors small. Singular matrices can be
+// factorized, but their factors cannot be used to solve systems or find an inverse.
+func Factorize[T any, PT bignum.Field[T]](m Matrix[T, PT]) (LU[T, PT], error) {
+	if m.Height() != m.Width() {
+		return LU[T, PT]{}, &immutabilitybenchmarking.NotSquareError{Rows: m.Height(), Cols: m.Width()}
+	}
+
+	n := m.Height()
+
+	// The elimination is done on private copies of the elements that become the elements of L and U once
+	// it is finished.
+	a := copyRows(m.matrix)
+
+	f := LU[T, PT]{pivots: make([]int, n), sign: 1, singular: -1}
+
+	for i := 0; i < n; i++ {
+		f.pivots[i] = i
+	}
+
+	best, candidate, inv, term := PT(new(T)), PT(new(T)), PT(new(T)), PT(new(T))
+
+	for k := 0; k < n; k++ {
+		p := k
+		best.Abs(a[k][k])
+
+		for r := k + 1; r < n; r++ {
+			if PT(candidate.Abs(a[r][k])).Cmp(best) > 0 {
+				p = r
+				best, candidate = candidate, best
+			}
+		}
+
+		if p != k {
+			a[p], a[k] = a[k], a[p]
+			f.pivots[p], f.pivots[k] = f.pivots[k], f.pivots[p]
+			f.sign = -f.sign
+		}
+
+		if a[k][k].Sign() == 0 {
+			if f.singular < 0 {
+				f.singular = k
+			}
+
+			continue
+		}
+
+		inv.Inv(a[k][k])
+
+		for r := k + 1; r < n; r++ {
+			l := PT(a[r][k].Mul(a[r][k], inv))
+
+			if l.Sign() == 0 {
+				continue
+			}
+
+			for c := k + 1; c < n; c++ {
+				a[r][c].Sub(a[r][c], term.Mul(l, a[k][c]))
+			}
+		}
+	}
+
+	f.l = newRows[T, PT](n, n)
+	f.u = newRows[T, PT](n, n)
+
+	// Elements are never changed once the factors are returned so the zeros and ones can be shared.
+	zero, one := PT(new(T)), PT(PT(new(T)).SetInt64(1))
+
+	for r := 0; r < n; r++ {
+		for c := 0; c < n; c++ {
+			switch {
+			case c < r:
+				f.l.matrix[r][c] = a[r][c]
+				f.u.matrix[r][c] = zero
+			case c == r:
+				f.l.matrix[r][c] = one
+				f.u.matrix[r][c] = a[r][c]
+			default:
+				f.l.matrix[r][c] = zero
+				f.u.matrix[r][c] = a[r][c]
+			}
+		}
+	}
+
+	return f, nil
+}
+
+// copyRows returns copies of the given elements that can be changed without affecting the originals.
+func copyRows[T any, PT bignum.Number[T]](matrix [][]PT) [][]PT {
+	rows := make([][]PT, len(matrix))
+
+	for r := 0; r < len(matrix); r++ {
+		rows[r] = make([]PT, len(matrix[r]))
+
+		for c := 0; c < len(matrix[r]); c++ {
+			rows[r][c] = PT(new(T)).Set(matrix[r][c])
+		}
+	}
+
+	return rows
+}
+
+// L returns the lower triangular factor, whose diagonal is all ones.
+func (f LU[T, PT]) L() Matrix[T, PT] {
+	return f.l
+}
+
+// U returns the upper triangular factor.
+func (f LU[T, PT]) U() Matrix[T, PT] {
+	return f.u
+}
+
+// Pivots returns the row of the original matrix that each row of the factors came from.
+func (f LU[T, PT]) Pivots() []int {
+	return append([]int(nil), f.pivots...)
+}
+
+// Determinant returns the determinant of the factorized matrix.
+func (f LU[T, PT]) Determinant() PT {
+	if f.singular >= 0 {
+		return PT(new(T))
+	}
+
+	d := PT(PT(new(T)).SetInt64(f.sign))
+
+	for i := 0; i < len(f.u.matrix); i++ {
+		d.Mul(d, f.u.matrix[i][i])
+	}
+
+	return d
+}
+
+// Solve returns X where A X = B and A is the factorized matrix.
+func (f LU[T, PT]) Solve(b bignum.Matrix[T, PT]) (Matrix[T, PT], error) {
+	n := len(f.u.matrix)
+
+	if b.Height() != n {
+		return Matrix[T, PT]{}, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "Solve",
+			LeftRows:  n,
+			LeftCols:  n,
+			RightRows: b.Height(),
+			RightCols: b.Width(),
+		}
+	}
+
+	if f.singular >= 0 {
+		return Matrix[T, PT]{}, &immutabilitybenchmarking.SingularMatrixError{Col: f.singular}
+	}
+
+	x := newRows[T, PT](b.Width(), n)
+
+	for r := 0; r < n; r++ {
+		for c := 0; c < len(x.matrix[r]); c++ {
+			x.matrix[r][c] = PT(new(T)).Set(element(b, f.pivots[r], c))
+		}
+	}
+
+	return f.substitute(x), nil
+}
+
+// substitute solves L U X = Y in place, where x holds distinct elements of the rows of Y already in pivoted
+// order. x must not have been returned to a caller yet.
+func (f LU[T, PT]) substitute(x Matrix[T, PT]) Matrix[T, PT] {
+	n := len(f.u.matrix)
+	l, u, rows := f.l.matrix, f.u.matrix, x.matrix
+	term := PT(new(T))
+
+	for r := 1; r < n; r++ {
+		for k := 0; k < r; k++ {
+			if l[r][k].Sign() == 0 {
+				continue
+			}
+
+			for c := 0; c < len(rows[r]); c++ {
+				rows[r][c].Sub(rows[r][c], term.Mul(l[r][k], rows[k][c]))
+			}
+		}
+	}
+
+	for r := n - 1; r >= 0; r-- {
+		for k := r + 1; k < n; k++ {
+			if u[r][k].Sign() == 0 {
+				continue
+			}
+
+			for c := 0; c < len(rows[r]); c++ {
+				rows[r][c].Sub(rows[r][c], term.Mul(u[r][k], rows[k][c]))
+			}
+		}
+
+		for c := 0; c < len(rows[r]); c++ {
+			rows[r][c].Quo(rows[r][c], u[r][r])
+		}
+	}
+
+	return x
+}
+
+// Inverse returns the inverse of the factorized matrix.
+func (f LU[T, PT]) Inverse() (Matrix[T, PT], error) {
+	n := len(f.u.matrix)
+
+	if f.singular >= 0 {
+		return Matrix[T, PT]{}, &immutabilitybenchmarking.SingularMatrixError{Col: f.singular}
+	}
+
+	x := newRows[T, PT](n, n)
+
+	for r := 0; r < n; r++ {
+		for c := 0; c < n; c++ {
+			x.matrix[r][c] = PT(new(T))
+		}
+
+		x.matrix[r][f.pivots[r]].SetInt64(1)
+	}
+
+	return f.substitute(x), nil
+}
+
+// Determinant returns the determinant of a square matrix.
+func Determinant[T any, PT bignum.Field[T]](m Matrix[T, PT]) (PT, error) {
+	f, err := Factorize(m)
+	if err != nil {
+		return nil, err
+	}
+
+	return f.Determinant(), nil
+}
+
+// Inverse returns the inverse of a square matrix.
+func Inverse[T any, PT bignum.Field[T]](m Matrix[T, PT]) (Matrix[T, PT], error) {
+	f, err := Factorize(m)
+	if err != nil {
+		return Matrix[T, PT]{}, err
+	}
+
+	return f.Inverse()
+}
+
+// Solve returns X where m X = b.
+func Solve[T any, PT bignum.Field[T]](m Matrix[T, PT], b bignum.Matrix[T, PT]) (Matrix[T, PT], error) {
+	f, err := Factorize(m)
+	if err != nil {
+		return Matrix[T, PT]{}, err
+	}
+
+	return f.Solve(b)
+}
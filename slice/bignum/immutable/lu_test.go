@@ -0,0 +1,151 @@
+package immutable
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/chris-tomich/immutability-benchmarking"
+)
+
+func rats(rows ...[]int64) [][]*big.Rat {
+	matrix := make([][]*big.Rat, len(rows))
+
+	for r := range rows {
+		matrix[r] = make([]*big.Rat, len(rows[r]))
+
+		for c := range rows[r] {
+			matrix[r][c] = big.NewRat(rows[r][c], 1)
+		}
+	}
+
+	return matrix
+}
+
+// pivoted needs a row swap before its first column can be eliminated and has a determinant of 3.
+func pivoted() Matrix[big.Rat, *big.Rat] {
+	return mustNew(rats([]int64{0, 2, 1}, []int64{1, 1, 1}, []int64{2, 1, 0}))
+}
+
+// hilbert returns the notoriously ill-conditioned n x n Hilbert matrix, which exact arithmetic inverts
+// without any error.
+func hilbert(n int) Matrix[big.Rat, *big.Rat] {
+	rows := make([][]*big.Rat, n)
+
+	for r := 0; r < n; r++ {
+		rows[r] = make([]*big.Rat, n)
+
+		for c := 0; c < n; c++ {
+			rows[r][c] = big.NewRat(1, int64(r+c+1))
+		}
+	}
+
+	return mustNew(rows)
+}
+
+func TestBigRatDeterminant(t *testing.T) {
+	d, err := Determinant(pivoted())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if d.Cmp(big.NewRat(3, 1)) != 0 {
+		t.Errorf("expected a determinant of 3 but got %v", d)
+	}
+
+	d, err = Determinant(mustNew(rats([]int64{1, 2}, []int64{2, 4})))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if d.Sign() != 0 {
+		t.Errorf("expected a singular matrix to have a determinant of 0 but got %v", d)
+	}
+}
+
+func TestBigRatFactors(t *testing.T) {
+	m := pivoted()
+
+	f, err := Factorize(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !m.Equals(pivoted()) {
+		t.Error("expected factorizing to leave the matrix unchanged")
+	}
+
+	lu, _ := f.L().MatrixMultiply(f.U())
+	pivots := f.Pivots()
+
+	pa := make([][]*big.Rat, 3)
+
+	for r := 0; r < 3; r++ {
+		pa[r] = make([]*big.Rat, 3)
+
+		for c := 0; c < 3; c++ {
+			pa[r][c] = m.Get(pivots[r], c)
+		}
+	}
+
+	if !lu.Equals(mustNew(pa)) {
+		t.Errorf("expected L U to equal P A but got %v", lu)
+	}
+}
+
+func TestBigRatInverse(t *testing.T) {
+	m := hilbert(8)
+
+	inv, err := Inverse(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	product, _ := m.MatrixMultiply(inv)
+	identity := NewEmpty[big.Rat, *big.Rat](8, 8)
+	one := big.NewRat(1, 1)
+
+	for i := 0; i < 8; i++ {
+		identity.matrix[i][i] = one
+	}
+
+	if !product.Equals(identity) {
+		t.Errorf("expected the product with the inverse to be the identity but got %v", product)
+	}
+
+	if !m.Equals(hilbert(8)) {
+		t.Error("expected inverting to leave the matrix unchanged")
+	}
+}
+
+func TestBigRatSolve(t *testing.T) {
+	b := mustNew(rats([]int64{1, 0}, []int64{2, 1}, []int64{3, 0}))
+
+	x, err := Solve(pivoted(), b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ax, _ := pivoted().MatrixMultiply(x)
+
+	if !ax.Equals(b) {
+		t.Errorf("expected the solution to satisfy the system but got %v", x.matrix)
+	}
+}
+
+func TestBigRatLUErrors(t *testing.T) {
+	var notSquare *immutabilitybenchmarking.NotSquareError
+	if _, err := Factorize(mustNew(rats([]int64{1, 2}))); !errors.As(err, &notSquare) {
+		t.Errorf("expected a NotSquareError but got %v", err)
+	}
+
+	var singular *immutabilitybenchmarking.SingularMatrixError
+	if _, err := Inverse(mustNew(rats([]int64{1, 2}, []int64{2, 4}))); !errors.As(err, &singular) || singular.Col != 1 {
+		t.Errorf("expected a SingularMatrixError for column 1 but got %v", err)
+	}
+
+	var mismatch *immutabilitybenchmarking.DimensionMismatchError
+	if _, err := Solve(pivoted(), mustNew(rats([]int64{1}, []int64{2}))); !errors.As(err, &mismatch) {
+		t.Errorf("expected a DimensionMismatchError but got %v", err)
+	}
+}
@@ -0,0 +1,627 @@
+package immutable
+
+import (
+	"math/big"
+
+	"github.com/chris-tomich/immutability-benchmarking"
+	"github.com/chris-tomich/immutability-benchmarking/internal/power"
+	"github.com/chris-tomich/immutability-benchmarking/internal/shape"
+	"github.com/chris-tomich/immutability-benchmarking/slice/bignum"
+	"github.com/chris-tomich/immutability-benchmarking/slice/bignum/internal/numeric"
+)
+
+// Matrix is an immutable arbitrary precision matrix with non-mutating operations. Its elements are never
+// changed once the matrix is created, so they are shared between matrices wherever an operation leaves them
+// as they are.
+type Matrix[T any, PT bignum.Number[T]] struct {
+	matrix [][]PT
+}
+
+// New creates a new immutable matrix with a copy of the given initial values. Any nil elements are treated
+// as zero.
+func New[T any, PT bignum.Number[T]](matrix [][]PT) (Matrix[T, PT], error) {
+	if len(matrix) == 0 || len(matrix[0]) == 0 {
+		return Matrix[T, PT]{}, &immutabilitybenchmarking.InvalidDimensionsError{Rows: len(matrix)}
+	}
+
+	for r := 1; r < len(matrix); r++ {
+		if len(matrix[r]) != len(matrix[0]) {
+			return Matrix[T, PT]{}, &immutabilitybenchmarking.JaggedRowsError{Row: r, Width: len(matrix[r]), Expected: len(matrix[0])}
+		}
+	}
+
+	m := newRows[T, PT](len(matrix[0]), len(matrix))
+
+	for r := 0; r < len(matrix); r++ {
+		for c := 0; c < len(matrix[r]); c++ {
+			m.matrix[r][c] = PT(new(T))
+
+			if matrix[r][c] != nil {
+				m.matrix[r][c].Set(matrix[r][c])
+			}
+		}
+	}
+
+	return m, nil
+}
+
+// NewEmpty createas a new matrix of zeros with the given dimensions.
+func NewEmpty[T any, PT bignum.Number[T]](width int, height int) Matrix[T, PT] {
+	if width <= 0 || height <= 0 {
+		panic(&immutabilitybenchmarking.InvalidDimensionsError{Rows: height, Cols: width})
+	}
+
+	m := newRows[T, PT](width, height)
+
+	// Elements are never changed so every cell can share the same zero.
+	zero := PT(new(T))
+
+	for r := 0; r < height; r++ {
+		for c := 0; c < width; c++ {
+			m.matrix[r][c] = zero
+		}
+	}
+
+	return m
+}
+
+// newRows creates a matrix whose elements are all nil and must be filled in before it is returned.
+func newRows[T any, PT bignum.Number[T]](width int, height int) Matrix[T, PT] {
+	m := Matrix[T, PT]{
+		matrix: make([][]PT, height),
+	}
+
+	for r := 0; r < height; r++ {
+		m.matrix[r] = make([]PT, width)
+	}
+
+	return m
+}
+
+// identity creates an n by n identity matrix, sharing one zero and one one between its cells.
+func identity[T any, PT bignum.Number[T]](n int) Matrix[T, PT] {
+	m := NewEmpty[T, PT](n, n)
+	one := PT(new(T))
+	one.SetInt64(1)
+
+	for i := 0; i < n; i++ {
+		m.matrix[i][i] = one
+	}
+
+	return m
+}
+
+// element returns the element of m at the provided coordinates, reading it directly rather than through
+// Get when m is immutable as its elements can then be used without being copied.
+func element[T any, PT bignum.Number[T]](m bignum.Matrix[T, PT], row int, col int) PT {
+	if m, ok := m.(Matrix[T, PT]); ok {
+		return m.matrix[row][col]
+	}
+
+	return m.Get(row, col)
+}
+
+// shared returns the element of m at the provided coordinates for a matrix of this package to hold. Elements
+// of immutable matrices are never changed so they are used directly, but the elements of other matrices
+// could be changed later and so are copied.
+func shared[T any, PT bignum.Number[T]](m bignum.Matrix[T, PT], row int, col int) PT {
+	if m, ok := m.(Matrix[T, PT]); ok {
+		return m.matrix[row][col]
+	}
+
+	return PT(new(T)).Set(m.Get(row, col))
+}
+
+// Width returns the number of columns in the matrix.
+func (m1 Matrix[T, PT]) Width() int {
+	if len(m1.matrix) == 0 {
+		return 0
+	}
+
+	return len(m1.matrix[0])
+}
+
+// Height returns the number of rows in the matrix.
+func (m1 Matrix[T, PT]) Height() int {
+	return len(m1.matrix)
+}
+
+// Get returns a copy of the element at the provided coordinates.
+func (m1 Matrix[T, PT]) Get(row int, col int) PT {
+	return PT(new(T)).Set(m1.matrix[row][col])
+}
+
+// At returns a copy of the element at the provided coordinates or an OutOfBoundsError if they are outside
+// the matrix.
+func (m1 Matrix[T, PT]) At(row int, col int) (PT, error) {
+	if row < 0 || row >= m1.Height() || col < 0 || col >= m1.Width() {
+		return nil, &immutabilitybenchmarking.OutOfBoundsError{Row: row, Col: col, Rows: m1.Height(), Cols: m1.Width()}
+	}
+
+	return m1.Get(row, col), nil
+}
+
+// MustAt returns a copy of the element at the provided coordinates and panics with an OutOfBoundsError if
+// they are outside the matrix.
+func (m1 Matrix[T, PT]) MustAt(row int, col int) PT {
+	v, err := m1.At(row, col)
+	if err != nil {
+		panic(err)
+	}
+
+	return v
+}
+
+// Equals will compare a matrix against this matrix and return if they are equal.
+func (m1 Matrix[T, PT]) Equals(m2 bignum.Matrix[T, PT]) bool {
+	if m1.Height() != m2.Height() {
+		return false
+	}
+
+	if m1.Width() != m2.Width() {
+		return false
+	}
+
+	for r := 0; r < m1.Height(); r++ {
+		for c := 0; c < len(m1.matrix[r]); c++ {
+			if m1.matrix[r][c].Cmp(element(m2, r, c)) != 0 {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// Add will add the values of a matrix to this matrix.
+func (m1 Matrix[T, PT]) Add(m2 bignum.Matrix[T, PT]) (bignum.Matrix[T, PT], error) {
+	if m1.Height() != m2.Height() || m1.Width() != m2.Width() {
+		return Matrix[T, PT]{}, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "Add",
+			LeftRows:  m1.Height(),
+			LeftCols:  m1.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	m := newRows[T, PT](m1.Width(), m1.Height())
+
+	for r := 0; r < m.Height(); r++ {
+		for c := 0; c < len(m.matrix[r]); c++ {
+			m.matrix[r][c] = PT(new(T)).Add(m1.matrix[r][c], element(m2, r, c))
+		}
+	}
+
+	return m, nil
+}
+
+// Subtract will subtract the values of a matrix from this matrix.
+func (m1 Matrix[T, PT]) Subtract(m2 bignum.Matrix[T, PT]) (bignum.Matrix[T, PT], error) {
+	if m1.Height() != m2.Height() || m1.Width() != m2.Width() {
+		return Matrix[T, PT]{}, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "Subtract",
+			LeftRows:  m1.Height(),
+			LeftCols:  m1.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	m := newRows[T, PT](m1.Width(), m1.Height())
+
+	for r := 0; r < m.Height(); r++ {
+		for c := 0; c < len(m.matrix[r]); c++ {
+			m.matrix[r][c] = PT(new(T)).Sub(m1.matrix[r][c], element(m2, r, c))
+		}
+	}
+
+	return m, nil
+}
+
+// ScalarMultiply will multiply this matrix by a given scalar value.
+func (m1 Matrix[T, PT]) ScalarMultiply(s PT) bignum.Matrix[T, PT] {
+	m := newRows[T, PT](m1.Width(), m1.Height())
+
+	for r := 0; r < m1.Height(); r++ {
+		for c := 0; c < len(m1.matrix[r]); c++ {
+			m.matrix[r][c] = PT(new(T)).Mul(m1.matrix[r][c], s)
+		}
+	}
+
+	return m
+}
+
+// Transpose will transpose this matrix. The transposed matrix shares its elements with this matrix.
+func (m1 Matrix[T, PT]) Transpose() bignum.Matrix[T, PT] {
+	m := newRows[T, PT](m1.Height(), m1.Width())
+
+	for rt := 0; rt < m.Height(); rt++ {
+		for ct := 0; ct < m1.Height(); ct++ {
+			m.matrix[rt][ct] = m1.matrix[ct][rt]
+		}
+	}
+
+	return m
+}
+
+// MatrixMultiply will multiple the given matrix against this matrix.
+func (m1 Matrix[T, PT]) MatrixMultiply(m2 bignum.Matrix[T, PT]) (bignum.Matrix[T, PT], error) {
+	if m1.Width() != m2.Height() {
+		return Matrix[T, PT]{}, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "MatrixMultiply",
+			LeftRows:  m1.Height(),
+			LeftCols:  m1.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	m := newRows[T, PT](m2.Width(), m1.Height())
+	term := PT(new(T))
+
+	for rm := 0; rm < m1.Height(); rm++ {
+		for cm2 := 0; cm2 < m2.Width(); cm2++ {
+			product := PT(new(T))
+			for cm := 0; cm < len(m1.matrix[rm]); cm++ {
+				product.Add(product, term.Mul(m1.matrix[rm][cm], element(m2, cm, cm2)))
+			}
+			m.matrix[rm][cm2] = product
+		}
+	}
+
+	return m, nil
+}
+
+// Map will apply f to every element of this matrix. f is given a copy of each element, which it can update
+// in place and return.
+func (m1 Matrix[T, PT]) Map(f func(v PT) PT) bignum.Matrix[T, PT] {
+	m := newRows[T, PT](m1.Width(), m1.Height())
+
+	for r := 0; r < m1.Height(); r++ {
+		for c := 0; c < len(m1.matrix[r]); c++ {
+			m.matrix[r][c] = f(m1.Get(r, c))
+		}
+	}
+
+	return m
+}
+
+// MapIndexed will apply f to every element of this matrix along with its coordinates, in the same way as
+// Map.
+func (m1 Matrix[T, PT]) MapIndexed(f func(row int, col int, v PT) PT) bignum.Matrix[T, PT] {
+	m := newRows[T, PT](m1.Width(), m1.Height())
+
+	for r := 0; r < m1.Height(); r++ {
+		for c := 0; c < len(m1.matrix[r]); c++ {
+			m.matrix[r][c] = f(r, c, m1.Get(r, c))
+		}
+	}
+
+	return m
+}
+
+// ZipWith will combine each element of this matrix with the element at the same coordinates of the given
+// matrix using f. f is given copies of the elements of this matrix, which it can update in place and return.
+func (m1 Matrix[T, PT]) ZipWith(m2 bignum.Matrix[T, PT], f func(a PT, b PT) PT) (bignum.Matrix[T, PT], error) {
+	if m1.Height() != m2.Height() || m1.Width() != m2.Width() {
+		return Matrix[T, PT]{}, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "ZipWith",
+			LeftRows:  m1.Height(),
+			LeftCols:  m1.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	m := newRows[T, PT](m1.Width(), m1.Height())
+
+	for r := 0; r < m1.Height(); r++ {
+		for c := 0; c < len(m1.matrix[r]); c++ {
+			m.matrix[r][c] = f(m1.Get(r, c), m2.Get(r, c))
+		}
+	}
+
+	return m, nil
+}
+
+// fold combines the elements of a row or column, starting from a copy of initial so f can update the
+// accumulator in place.
+func fold[T any, PT bignum.Number[T]](initial PT, f func(acc PT, v PT) PT, count int, element func(i int) PT) PT {
+	acc := PT(new(T)).Set(initial)
+
+	for i := 0; i < count; i++ {
+		acc = f(acc, element(i))
+	}
+
+	return acc
+}
+
+// Fold will combine every element of this matrix into a single value, starting from a copy of initial and
+// visiting copies of the elements in row-major order.
+func (m1 Matrix[T, PT]) Fold(initial PT, f func(acc PT, v PT) PT) PT {
+	return fold(initial, f, m1.Height()*m1.Width(), func(i int) PT {
+		return m1.Get(i/m1.Width(), i%m1.Width())
+	})
+}
+
+// RowReduce will fold each row of this matrix into a single value starting from a copy of initial,
+// returning a column of the results.
+func (m1 Matrix[T, PT]) RowReduce(initial PT, f func(acc PT, v PT) PT) bignum.Matrix[T, PT] {
+	m := newRows[T, PT](1, m1.Height())
+
+	for r := 0; r < m1.Height(); r++ {
+		m.matrix[r][0] = fold(initial, f, m1.Width(), func(c int) PT { return m1.Get(r, c) })
+	}
+
+	return m
+}
+
+// ColReduce will fold each column of this matrix into a single value starting from a copy of initial,
+// returning a row of the results.
+func (m1 Matrix[T, PT]) ColReduce(initial PT, f func(acc PT, v PT) PT) bignum.Matrix[T, PT] {
+	m := newRows[T, PT](m1.Width(), 1)
+
+	for c := 0; c < m1.Width(); c++ {
+		m.matrix[0][c] = fold(initial, f, m1.Height(), func(r int) PT { return m1.Get(r, c) })
+	}
+
+	return m
+}
+
+// row returns a row of this matrix for the reductions in numeric, which do not change it.
+func (m1 Matrix[T, PT]) row(r int) []PT {
+	return m1.matrix[r]
+}
+
+// Trace will sum the elements on the diagonal of this matrix, returning a NotSquareError if it is not square.
+func (m1 Matrix[T, PT]) Trace() (PT, error) {
+	if m1.Height() != m1.Width() {
+		return nil, &immutabilitybenchmarking.NotSquareError{Rows: m1.Height(), Cols: m1.Width()}
+	}
+
+	trace := PT(new(T))
+
+	for i := 0; i < m1.Height(); i++ {
+		trace.Add(trace, m1.matrix[i][i])
+	}
+
+	return trace, nil
+}
+
+// Sum will add together every element of this matrix.
+func (m1 Matrix[T, PT]) Sum() PT {
+	return numeric.Sum(m1.Height(), m1.row)
+}
+
+// Min will return a copy of the smallest element of this matrix.
+func (m1 Matrix[T, PT]) Min() PT {
+	return m1.Get(numeric.ArgMin(m1.Height(), m1.row))
+}
+
+// Max will return a copy of the largest element of this matrix.
+func (m1 Matrix[T, PT]) Max() PT {
+	return m1.Get(numeric.ArgMax(m1.Height(), m1.row))
+}
+
+// ArgMin will return the coordinates of the first smallest element of this matrix in row-major order.
+func (m1 Matrix[T, PT]) ArgMin() (int, int) {
+	return numeric.ArgMin(m1.Height(), m1.row)
+}
+
+// ArgMax will return the coordinates of the first largest element of this matrix in row-major order.
+func (m1 Matrix[T, PT]) ArgMax() (int, int) {
+	return numeric.ArgMax(m1.Height(), m1.row)
+}
+
+// FrobeniusNorm will return the square root of the sum of the squared elements of this matrix, rounded to a
+// big.Float.
+func (m1 Matrix[T, PT]) FrobeniusNorm() *big.Float {
+	return numeric.FrobeniusNorm(m1.Height(), m1.row)
+}
+
+// OneNorm will return the largest sum of the absolute values in a column of this matrix.
+func (m1 Matrix[T, PT]) OneNorm() PT {
+	return numeric.OneNorm(m1.Height(), m1.Width(), m1.row)
+}
+
+// InfNorm will return the largest sum of the absolute values in a row of this matrix.
+func (m1 Matrix[T, PT]) InfNorm() PT {
+	return numeric.InfNorm(m1.Height(), m1.row)
+}
+
+// Rank will return the exact number of linearly independent rows of this matrix.
+func (m1 Matrix[T, PT]) Rank() int {
+	return numeric.Rank(m1.Height(), m1.Width(), m1.row)
+}
+
+// Power will raise this matrix to the power n by repeated squaring, returning a NotSquareError if it is not
+// square or a NegativeExponentError if n is negative. The zeroth power is the identity matrix.
+func (m1 Matrix[T, PT]) Power(n int) (bignum.Matrix[T, PT], error) {
+	if m1.Height() != m1.Width() {
+		return Matrix[T, PT]{}, &immutabilitybenchmarking.NotSquareError{Rows: m1.Height(), Cols: m1.Width()}
+	}
+
+	if n < 0 {
+		return Matrix[T, PT]{}, &immutabilitybenchmarking.NegativeExponentError{Exponent: n}
+	}
+
+	return power.BySquaring[bignum.Matrix[T, PT]](m1, n, func() (bignum.Matrix[T, PT], error) {
+		return identity[T, PT](m1.Height()), nil
+	}, nil)
+}
+
+// Reshape will read the elements of this matrix in row-major order into a matrix with the given dimensions,
+// returning a ReshapeError if it would not hold the same number of elements. The result shares the
+// elements of this matrix, and its rows too if the dimensions are unchanged.
+func (m1 Matrix[T, PT]) Reshape(rows int, cols int) (bignum.Matrix[T, PT], error) {
+	if err := shape.CheckReshape(m1, rows, cols); err != nil {
+		return Matrix[T, PT]{}, err
+	}
+
+	if rows == m1.Height() {
+		return m1, nil
+	}
+
+	m := newRows[T, PT](cols, rows)
+	w := m1.Width()
+
+	for i := 0; i < rows*cols; i++ {
+		m.matrix[i/cols][i%cols] = m1.matrix[i/w][i%w]
+	}
+
+	return m, nil
+}
+
+// HConcat will place the given matrix to the right of this matrix, returning a DimensionMismatchError if
+// they do not have the same number of rows.
+func (m1 Matrix[T, PT]) HConcat(m2 bignum.Matrix[T, PT]) (bignum.Matrix[T, PT], error) {
+	if err := shape.CheckHConcat(m1, m2); err != nil {
+		return Matrix[T, PT]{}, err
+	}
+
+	m := newRows[T, PT](m1.Width()+m2.Width(), m1.Height())
+
+	for r := 0; r < m.Height(); r++ {
+		copy(m.matrix[r], m1.matrix[r])
+
+		for c := 0; c < m2.Width(); c++ {
+			m.matrix[r][m1.Width()+c] = shared(m2, r, c)
+		}
+	}
+
+	return m, nil
+}
+
+// VConcat will place the given matrix below this matrix, returning a DimensionMismatchError if they do not
+// have the same number of columns. The result shares the rows of both when the given matrix is also from
+// this package.
+func (m1 Matrix[T, PT]) VConcat(m2 bignum.Matrix[T, PT]) (bignum.Matrix[T, PT], error) {
+	return m1.stack("VConcat", m2)
+}
+
+// Stack will place the given matrices below this matrix in order, returning a DimensionMismatchError for
+// the first that does not have the same number of columns. The result shares the rows of this matrix and of
+// any of the given matrices from this package.
+func (m1 Matrix[T, PT]) Stack(ms ...bignum.Matrix[T, PT]) (bignum.Matrix[T, PT], error) {
+	return m1.stack("Stack", ms...)
+}
+
+func (m1 Matrix[T, PT]) stack(op string, ms ...bignum.Matrix[T, PT]) (bignum.Matrix[T, PT], error) {
+	if err := shape.CheckStack(op, m1, ms...); err != nil {
+		return Matrix[T, PT]{}, err
+	}
+
+	height := m1.Height()
+
+	for _, m2 := range ms {
+		height += m2.Height()
+	}
+
+	rows := append(make([][]PT, 0, height), m1.matrix...)
+
+	for _, m2 := range ms {
+		if o, ok := m2.(Matrix[T, PT]); ok {
+			rows = append(rows, o.matrix...)
+			continue
+		}
+
+		for r := 0; r < m2.Height(); r++ {
+			row := make([]PT, m2.Width())
+
+			for c := range row {
+				row[c] = shared(m2, r, c)
+			}
+
+			rows = append(rows, row)
+		}
+	}
+
+	return Matrix[T, PT]{matrix: rows}, nil
+}
+
+// SplitRows will split this matrix into its rows before at and its rows from at onwards, returning an
+// InvalidDimensionsError if either part would be empty. Both parts share the rows of this matrix.
+func (m1 Matrix[T, PT]) SplitRows(at int) (bignum.Matrix[T, PT], bignum.Matrix[T, PT], error) {
+	if err := shape.CheckSplitRows(m1, at); err != nil {
+		return Matrix[T, PT]{}, Matrix[T, PT]{}, err
+	}
+
+	return Matrix[T, PT]{matrix: m1.matrix[:at:at]}, Matrix[T, PT]{matrix: m1.matrix[at:]}, nil
+}
+
+// SplitCols will split this matrix into its columns before at and its columns from at onwards, returning an
+// InvalidDimensionsError if either part would be empty. Each row of both parts is a slice of the same row of
+// this matrix.
+func (m1 Matrix[T, PT]) SplitCols(at int) (bignum.Matrix[T, PT], bignum.Matrix[T, PT], error) {
+	if err := shape.CheckSplitCols(m1, at); err != nil {
+		return Matrix[T, PT]{}, Matrix[T, PT]{}, err
+	}
+
+	left := Matrix[T, PT]{matrix: make([][]PT, m1.Height())}
+	right := Matrix[T, PT]{matrix: make([][]PT, m1.Height())}
+
+	for r, row := range m1.matrix {
+		left.matrix[r] = row[:at:at]
+		right.matrix[r] = row[at:]
+	}
+
+	return left, right, nil
+}
+
+// Pad will surround this matrix with the given number of rows and columns of zeros on each side, returning
+// a PaddingError if any of them is negative. The padding shares a single zero.
+func (m1 Matrix[T, PT]) Pad(top int, bottom int, left int, right int) (bignum.Matrix[T, PT], error) {
+	if err := shape.CheckPad(top, bottom, left, right); err != nil {
+		return Matrix[T, PT]{}, err
+	}
+
+	m := NewEmpty[T, PT](left+m1.Width()+right, top+m1.Height()+bottom)
+
+	for r, row := range m1.matrix {
+		copy(m.matrix[top+r][left:], row)
+	}
+
+	return m, nil
+}
+
+// Flip will reverse the order of the rows or the columns of this matrix. Flipping the rows shares them with
+// this matrix in the opposite order.
+func (m1 Matrix[T, PT]) Flip(axis immutabilitybenchmarking.Axis) bignum.Matrix[T, PT] {
+	h := m1.Height()
+
+	if axis == immutabilitybenchmarking.RowAxis {
+		m := Matrix[T, PT]{matrix: make([][]PT, h)}
+
+		for r, row := range m1.matrix {
+			m.matrix[h-1-r] = row
+		}
+
+		return m
+	}
+
+	m := newRows[T, PT](m1.Width(), h)
+
+	for r, row := range m1.matrix {
+		for c, v := range row {
+			m.matrix[r][len(row)-1-c] = v
+		}
+	}
+
+	return m
+}
+
+// Rotate90 will turn this matrix a quarter turn clockwise. The rotated matrix shares its elements with this
+// matrix.
+func (m1 Matrix[T, PT]) Rotate90() bignum.Matrix[T, PT] {
+	h := m1.Height()
+	m := newRows[T, PT](h, m1.Width())
+
+	for r, row := range m1.matrix {
+		for c, v := range row {
+			m.matrix[c][h-1-r] = v
+		}
+	}
+
+	return m
+}
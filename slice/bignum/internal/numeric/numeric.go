@@ -0,0 +1,169 @@
+// Package numeric provides the whole-matrix reductions shared by the arbitrary precision matrices. They read
+// a matrix one row at a time in the same way as the reductions for the other backends, but every sum and
+// comparison is exact and the results are new elements that can be changed without changing the matrix.
+package numeric
+
+import (
+	"math/big"
+
+	"github.com/chris-tomich/immutability-benchmarking/slice/bignum"
+)
+
+// Sum adds together every element of a matrix with the given number of rows.
+func Sum[T any, PT bignum.Number[T]](rows int, row func(r int) []PT) PT {
+	sum := PT(new(T))
+
+	for r := 0; r < rows; r++ {
+		for _, v := range row(r) {
+			sum.Add(sum, v)
+		}
+	}
+
+	return sum
+}
+
+// ArgMin returns the coordinates of the first smallest element in row-major order.
+func ArgMin[T any, PT bignum.Number[T]](rows int, row func(r int) []PT) (int, int) {
+	return extreme(rows, row, func(v PT, best PT) bool { return v.Cmp(best) < 0 })
+}
+
+// ArgMax returns the coordinates of the first largest element in row-major order.
+func ArgMax[T any, PT bignum.Number[T]](rows int, row func(r int) []PT) (int, int) {
+	return extreme(rows, row, func(v PT, best PT) bool { return v.Cmp(best) > 0 })
+}
+
+func extreme[T any, PT bignum.Number[T]](rows int, row func(r int) []PT, better func(v PT, best PT) bool) (int, int) {
+	var best PT
+	bestRow, bestCol := -1, -1
+
+	for r := 0; r < rows; r++ {
+		for c, v := range row(r) {
+			if bestRow < 0 || better(v, best) {
+				best, bestRow, bestCol = v, r, c
+			}
+		}
+	}
+
+	return bestRow, bestCol
+}
+
+// FrobeniusNorm returns the square root of the sum of the squares of every element. The sum is exact, but
+// its square root is rarely rational, so it is rounded to a big.Float with as many bits as the sum needs.
+func FrobeniusNorm[T any, PT bignum.Number[T]](rows int, row func(r int) []PT) *big.Float {
+	sum, term := PT(new(T)), PT(new(T))
+
+	for r := 0; r < rows; r++ {
+		for _, v := range row(r) {
+			sum.Add(sum, term.Mul(v, v))
+		}
+	}
+
+	// big.Rat reads the text of both big.Int and big.Rat, which have no other conversion in common.
+	text, err := sum.MarshalText()
+	if err != nil {
+		panic(err)
+	}
+
+	exact := new(big.Rat)
+	if err := exact.UnmarshalText(text); err != nil {
+		panic(err)
+	}
+
+	norm := new(big.Float).SetRat(exact)
+
+	return norm.Sqrt(norm)
+}
+
+// OneNorm returns the largest sum of the absolute values in a column.
+func OneNorm[T any, PT bignum.Number[T]](rows int, cols int, row func(r int) []PT) PT {
+	sums := make([]PT, cols)
+	abs := PT(new(T))
+
+	for c := range sums {
+		sums[c] = PT(new(T))
+	}
+
+	for r := 0; r < rows; r++ {
+		for c, v := range row(r) {
+			sums[c].Add(sums[c], abs.Abs(v))
+		}
+	}
+
+	return largest(sums)
+}
+
+// InfNorm returns the largest sum of the absolute values in a row.
+func InfNorm[T any, PT bignum.Number[T]](rows int, row func(r int) []PT) PT {
+	sums := make([]PT, rows)
+	abs := PT(new(T))
+
+	for r := range sums {
+		sums[r] = PT(new(T))
+
+		for _, v := range row(r) {
+			sums[r].Add(sums[r], abs.Abs(v))
+		}
+	}
+
+	return largest(sums)
+}
+
+func largest[T any, PT bignum.Number[T]](values []PT) PT {
+	l := PT(new(T))
+
+	for _, v := range values {
+		if v.Cmp(l) > 0 {
+			l = v
+		}
+	}
+
+	return l
+}
+
+// Rank returns the number of linearly independent rows of a matrix. The rows are copied and reduced to row
+// echelon form by fraction-free elimination, where every division is exact, so it gives the exact rank of
+// big.Int matrices as well as big.Rat matrices and keeps the elements from growing with each step.
+func Rank[T any, PT bignum.Number[T]](rows int, cols int, row func(r int) []PT) int {
+	a := make([][]PT, rows)
+
+	for r := 0; r < rows; r++ {
+		a[r] = make([]PT, cols)
+
+		for c, v := range row(r) {
+			a[r][c] = PT(new(T)).Set(v)
+		}
+	}
+
+	previous, term := PT(new(T)), PT(new(T))
+	previous.SetInt64(1)
+	rank := 0
+
+	for c := 0; c < cols && rank < rows; c++ {
+		pivot := rank
+
+		for pivot < rows && a[pivot][c].Sign() == 0 {
+			pivot++
+		}
+
+		if pivot == rows {
+			continue
+		}
+
+		a[rank], a[pivot] = a[pivot], a[rank]
+
+		// By Sylvester's identity each element below the pivot row becomes a minor of the original matrix, so
+		// dividing it by the previous pivot leaves no remainder.
+		for r := rank + 1; r < rows; r++ {
+			for k := c + 1; k < cols; k++ {
+				a[r][k].Mul(a[r][k], a[rank][c])
+				a[r][k].Sub(a[r][k], term.Mul(a[r][c], a[rank][k]))
+				a[r][k].Quo(a[r][k], previous)
+			}
+		}
+
+		previous = a[rank][c]
+		rank++
+	}
+
+	return rank
+}
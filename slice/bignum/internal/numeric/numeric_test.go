@@ -0,0 +1,84 @@
+package numeric
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+func rows[T any](values [][]T) func(r int) []T {
+	return func(r int) []T { return values[r] }
+}
+
+// product returns the elements of a times b, whose rank is at most the width of a.
+func product(a [][]int64, b [][]int64) ([][]*big.Int, [][]*big.Rat) {
+	ints := make([][]*big.Int, len(a))
+	rats := make([][]*big.Rat, len(a))
+
+	for r := range a {
+		ints[r] = make([]*big.Int, len(b[0]))
+		rats[r] = make([]*big.Rat, len(b[0]))
+
+		for c := range b[0] {
+			var v int64
+			for k := range b {
+				v += a[r][k] * b[k][c]
+			}
+
+			ints[r][c] = big.NewInt(v)
+			rats[r][c] = new(big.Rat).SetInt64(v)
+		}
+	}
+
+	return ints, rats
+}
+
+func random(rng *rand.Rand, height int, width int) [][]int64 {
+	m := make([][]int64, height)
+
+	for r := range m {
+		m[r] = make([]int64, width)
+
+		for c := range m[r] {
+			m[r][c] = rng.Int63n(19) - 9
+		}
+	}
+
+	return m
+}
+
+func TestRank(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+
+	for i := 0; i < 200; i++ {
+		height, width, inner := 1+rng.Intn(6), 1+rng.Intn(6), 1+rng.Intn(6)
+		ints, rats := product(random(rng, height, inner), random(rng, inner, width))
+
+		// The integer elimination truncates any division that is not exact, so it would disagree with the
+		// rational elimination if one was not.
+		intRank, ratRank := Rank(height, width, rows(ints)), Rank(height, width, rows(rats))
+		if intRank != ratRank || intRank > min(height, width, inner) {
+			t.Fatalf("expected the ranks of %v to agree and be at most %d but got %d and %d", ints, min(height, width, inner), intRank, ratRank)
+		}
+	}
+
+	dependent := [][]*big.Rat{
+		{big.NewRat(1, 2), big.NewRat(1, 3)},
+		{big.NewRat(3, 2), big.NewRat(1, 1)},
+	}
+	if rank := Rank(2, 2, rows(dependent)); rank != 1 {
+		t.Errorf("expected the second row to be three times the first, giving a rank of 1, but got %d", rank)
+	}
+}
+
+func TestFrobeniusNorm(t *testing.T) {
+	m := [][]*big.Int{{big.NewInt(3), big.NewInt(-4)}}
+	if norm, _ := FrobeniusNorm(1, rows(m)).Float64(); norm != 5 {
+		t.Errorf("expected 5 but got %v", norm)
+	}
+
+	huge := new(big.Int).Lsh(big.NewInt(1), 2000)
+	if norm := FrobeniusNorm(1, rows([][]*big.Int{{huge}})); norm.Cmp(new(big.Float).SetInt(huge)) != 0 {
+		t.Errorf("expected the norm of 2^2000 to be exact but got %v", norm)
+	}
+}
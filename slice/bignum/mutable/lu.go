@@ -0,0 +1,220 @@
+package mutable
+
+import (
+	"github.com/chris-tomich/immutability-benchmarking"
+	"github.com/chris-tomich/immutability-benchmarking/slice/bignum"
+)
+
+// LU is an exact LU factorization with partial pivoting computed in place, LAPACK style. The factorized
+// matrix holds U on and above its diagonal and the multipliers of L, whose diagonal is all ones, below it.
+type LU[T any, PT bignum.Field[T]] struct {
+	lu *Matrix[T, PT]
+	// pivots holds the row of the original matrix that each row of the factors came from.
+	pivots []int
+	sign   int64
+	// singular is the column of the first zero pivot, or -1 if there is none.
+	singular int
+}
+
+// Factorize computes the LU factorization of a square matrix, overwriting m with its factors. Pivots are
+// chosen by magnitude even though the arithmetic is exact, which keeps the elements of the factors small.
+// Singular matrices can be factorized, but their factors cannot be used to solve systems or find an
+// inverse.
+func Factorize[T any, PT bignum.Field[T]](m *Matrix[T, PT]) (*LU[T, PT], error) {
+	if m.Height() != m.Width() {
+		return nil, &immutabilitybenchmarking.NotSquareError{Rows: m.Height(), Cols: m.Width()}
+	}
+
+	n := m.Height()
+	a := m.matrix
+
+	f := &LU[T, PT]{lu: m, pivots: make([]int, n), sign: 1, singular: -1}
+
+	for i := 0; i < n; i++ {
+		f.pivots[i] = i
+	}
+
+	best, candidate, inv, term := PT(new(T)), PT(new(T)), PT(new(T)), PT(new(T))
+
+	for k := 0; k < n; k++ {
+		p := k
+		best.Abs(a[k][k])
+
+		for r := k + 1; r < n; r++ {
+			if PT(candidate.Abs(a[r][k])).Cmp(best) > 0 {
+				p = r
+				best, candidate = candidate, best
+			}
+		}
+
+		if p != k {
+			a[p], a[k] = a[k], a[p]
+			f.pivots[p], f.pivots[k] = f.pivots[k], f.pivots[p]
+			f.sign = -f.sign
+		}
+
+		if a[k][k].Sign() == 0 {
+			if f.singular < 0 {
+				f.singular = k
+			}
+
+			continue
+		}
+
+		inv.Inv(a[k][k])
+
+		for r := k + 1; r < n; r++ {
+			l := PT(a[r][k].Mul(a[r][k], inv))
+
+			if l.Sign() == 0 {
+				continue
+			}
+
+			for c := k + 1; c < n; c++ {
+				a[r][c].Sub(a[r][c], term.Mul(l, a[k][c]))
+			}
+		}
+	}
+
+	return f, nil
+}
+
+// Determinant returns the determinant of the factorized matrix.
+func (f *LU[T, PT]) Determinant() PT {
+	if f.singular >= 0 {
+		return PT(new(T))
+	}
+
+	d := PT(PT(new(T)).SetInt64(f.sign))
+
+	for i := 0; i < len(f.lu.matrix); i++ {
+		d.Mul(d, f.lu.matrix[i][i])
+	}
+
+	return d
+}
+
+// Solve solves A X = B, where A is the factorized matrix, overwriting b with X.
+func (f *LU[T, PT]) Solve(b *Matrix[T, PT]) (*Matrix[T, PT], error) {
+	n := len(f.lu.matrix)
+
+	if b.Height() != n {
+		return nil, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "Solve",
+			LeftRows:  n,
+			LeftCols:  n,
+			RightRows: b.Height(),
+			RightCols: b.Width(),
+		}
+	}
+
+	if f.singular >= 0 {
+		return nil, &immutabilitybenchmarking.SingularMatrixError{Col: f.singular}
+	}
+
+	a := f.lu.matrix
+
+	// Rows are swapped by reordering the row slices rather than copying their elements.
+	rows := make([][]PT, n)
+
+	for i := 0; i < n; i++ {
+		rows[i] = b.matrix[f.pivots[i]]
+	}
+
+	b.matrix = rows
+	term := PT(new(T))
+
+	// Forward substitution with L, whose diagonal is all ones, then back substitution with U.
+	for r := 1; r < n; r++ {
+		for k := 0; k < r; k++ {
+			if a[r][k].Sign() == 0 {
+				continue
+			}
+
+			for c := 0; c < len(rows[r]); c++ {
+				rows[r][c].Sub(rows[r][c], term.Mul(a[r][k], rows[k][c]))
+			}
+		}
+	}
+
+	for r := n - 1; r >= 0; r-- {
+		for k := r + 1; k < n; k++ {
+			if a[r][k].Sign() == 0 {
+				continue
+			}
+
+			for c := 0; c < len(rows[r]); c++ {
+				rows[r][c].Sub(rows[r][c], term.Mul(a[r][k], rows[k][c]))
+			}
+		}
+
+		for c := 0; c < len(rows[r]); c++ {
+			rows[r][c].Quo(rows[r][c], a[r][r])
+		}
+	}
+
+	return b, nil
+}
+
+// Inverse returns the inverse of the factorized matrix as a new matrix.
+func (f *LU[T, PT]) Inverse() (*Matrix[T, PT], error) {
+	n := len(f.lu.matrix)
+
+	inv, err := NewEmpty[T, PT](n, n)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := 0; i < n; i++ {
+		inv.matrix[i][i].SetInt64(1)
+	}
+
+	return f.Solve(inv)
+}
+
+// Determinant returns the determinant of a square matrix, overwriting m with its LU factors.
+func Determinant[T any, PT bignum.Field[T]](m *Matrix[T, PT]) (PT, error) {
+	f, err := Factorize(m)
+	if err != nil {
+		return nil, err
+	}
+
+	return f.Determinant(), nil
+}
+
+// Inverse replaces a square matrix with its inverse. A singular matrix is left holding its LU factors.
+func Inverse[T any, PT bignum.Field[T]](m *Matrix[T, PT]) (*Matrix[T, PT], error) {
+	f, err := Factorize(m)
+	if err != nil {
+		return nil, err
+	}
+
+	inv, err := f.Inverse()
+	if err != nil {
+		return nil, err
+	}
+
+	m.matrix = inv.matrix
+
+	return m, nil
+}
+
+// Solve solves m X = b, overwriting m with its LU factors and b with X.
+func Solve[T any, PT bignum.Field[T]](m *Matrix[T, PT], b *Matrix[T, PT]) (*Matrix[T, PT], error) {
+	if m.Height() == m.Width() && b.Height() != m.Height() {
+		return nil, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "Solve",
+			LeftRows:  m.Height(),
+			LeftCols:  m.Width(),
+			RightRows: b.Height(),
+			RightCols: b.Width(),
+		}
+	}
+
+	f, err := Factorize(m)
+	if err != nil {
+		return nil, err
+	}
+
+	return f.Solve(b)
+}
@@ -0,0 +1,631 @@
+package mutable
+
+import (
+	"math/big"
+
+	"github.com/chris-tomich/immutability-benchmarking"
+	"github.com/chris-tomich/immutability-benchmarking/internal/power"
+	"github.com/chris-tomich/immutability-benchmarking/internal/shape"
+	"github.com/chris-tomich/immutability-benchmarking/slice/bignum"
+	"github.com/chris-tomich/immutability-benchmarking/slice/bignum/internal/numeric"
+)
+
+// Matrix is an arbitrary precision matrix with mutating operations. Operations update the elements in place
+// so they do not allocate new numbers except where a result cannot be written over its inputs.
+type Matrix[T any, PT bignum.Number[T]] struct {
+	matrix [][]PT
+}
+
+// New creates a new matrix that operates directly on the given initial values. Any nil elements are
+// replaced with zero. Operations update the elements in place, so no element may appear in more than one
+// cell.
+func New[T any, PT bignum.Number[T]](matrix [][]PT) (*Matrix[T, PT], error) {
+	if len(matrix) == 0 || len(matrix[0]) == 0 {
+		return nil, &immutabilitybenchmarking.InvalidDimensionsError{Rows: len(matrix)}
+	}
+
+	for r := 0; r < len(matrix); r++ {
+		if len(matrix[r]) != len(matrix[0]) {
+			return nil, &immutabilitybenchmarking.JaggedRowsError{Row: r, Width: len(matrix[r]), Expected: len(matrix[0])}
+		}
+
+		for c := 0; c < len(matrix[r]); c++ {
+			if matrix[r][c] == nil {
+				matrix[r][c] = new(T)
+			}
+		}
+	}
+
+	return &Matrix[T, PT]{matrix: matrix}, nil
+}
+
+// NewEmpty createas a new matrix of zeros with the given dimensions.
+func NewEmpty[T any, PT bignum.Number[T]](width int, height int) (*Matrix[T, PT], error) {
+	if width <= 0 || height <= 0 {
+		return nil, &immutabilitybenchmarking.InvalidDimensionsError{Rows: height, Cols: width}
+	}
+
+	return &Matrix[T, PT]{matrix: zeros[T, PT](width, height)}, nil
+}
+
+// zeros creates rows of distinct zero elements so each can be updated in place on its own.
+func zeros[T any, PT bignum.Number[T]](width int, height int) [][]PT {
+	matrix := make([][]PT, height)
+
+	for r := 0; r < height; r++ {
+		matrix[r] = make([]PT, width)
+
+		for c := 0; c < width; c++ {
+			matrix[r][c] = new(T)
+		}
+	}
+
+	return matrix
+}
+
+// identity creates an n by n identity matrix of distinct elements.
+func identity[T any, PT bignum.Number[T]](n int) *Matrix[T, PT] {
+	m := &Matrix[T, PT]{matrix: zeros[T, PT](n, n)}
+
+	for i := 0; i < n; i++ {
+		m.matrix[i][i].SetInt64(1)
+	}
+
+	return m
+}
+
+// clone creates a matrix with copies of the elements of this matrix.
+func (m *Matrix[T, PT]) clone() *Matrix[T, PT] {
+	c := &Matrix[T, PT]{matrix: zeros[T, PT](m.Width(), m.Height())}
+
+	for r := range m.matrix {
+		for col, v := range m.matrix[r] {
+			c.matrix[r][col].Set(v)
+		}
+	}
+
+	return c
+}
+
+// Width returns the number of columns in the matrix.
+func (m *Matrix[T, PT]) Width() int {
+	if len(m.matrix) == 0 {
+		return 0
+	}
+
+	return len(m.matrix[0])
+}
+
+// Height returns the number of rows in the matrix.
+func (m *Matrix[T, PT]) Height() int {
+	return len(m.matrix)
+}
+
+// Get returns the element at the provided coordinates. The element is the one held by the matrix so
+// changing it changes the matrix.
+func (m *Matrix[T, PT]) Get(row int, col int) PT {
+	return m.matrix[row][col]
+}
+
+// At returns the element at the provided coordinates or an OutOfBoundsError if they are outside the matrix.
+func (m *Matrix[T, PT]) At(row int, col int) (PT, error) {
+	if row < 0 || row >= m.Height() || col < 0 || col >= m.Width() {
+		return nil, &immutabilitybenchmarking.OutOfBoundsError{Row: row, Col: col, Rows: m.Height(), Cols: m.Width()}
+	}
+
+	return m.matrix[row][col], nil
+}
+
+// MustAt returns the element at the provided coordinates and panics with an OutOfBoundsError if they are
+// outside the matrix.
+func (m *Matrix[T, PT]) MustAt(row int, col int) PT {
+	v, err := m.At(row, col)
+	if err != nil {
+		panic(err)
+	}
+
+	return v
+}
+
+// Set replaces the element at the provided coordinates in place.
+func (m *Matrix[T, PT]) Set(row int, col int, v PT) {
+	m.matrix[row][col].Set(v)
+}
+
+// Equals will compare a matrix against this matrix and return if they are equal.
+func (m *Matrix[T, PT]) Equals(m2 bignum.Matrix[T, PT]) bool {
+	if m.Height() != m2.Height() {
+		return false
+	}
+
+	if m.Width() != m2.Width() {
+		return false
+	}
+
+	for r := 0; r < len(m.matrix); r++ {
+		for c := 0; c < len(m.matrix[r]); c++ {
+			if m.matrix[r][c].Cmp(m2.Get(r, c)) != 0 {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// Add will add the values of a matrix to this matrix.
+func (m *Matrix[T, PT]) Add(m2 bignum.Matrix[T, PT]) (bignum.Matrix[T, PT], error) {
+	if m.Height() != m2.Height() || m.Width() != m2.Width() {
+		return nil, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "Add",
+			LeftRows:  m.Height(),
+			LeftCols:  m.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	for r := 0; r < len(m.matrix); r++ {
+		for c := 0; c < len(m.matrix[r]); c++ {
+			m.matrix[r][c].Add(m.matrix[r][c], m2.Get(r, c))
+		}
+	}
+
+	return m, nil
+}
+
+// Subtract will subtract the values of a matrix from this matrix.
+func (m *Matrix[T, PT]) Subtract(m2 bignum.Matrix[T, PT]) (bignum.Matrix[T, PT], error) {
+	if m.Height() != m2.Height() || m.Width() != m2.Width() {
+		return nil, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "Subtract",
+			LeftRows:  m.Height(),
+			LeftCols:  m.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	for r := 0; r < len(m.matrix); r++ {
+		for c := 0; c < len(m.matrix[r]); c++ {
+			m.matrix[r][c].Sub(m.matrix[r][c], m2.Get(r, c))
+		}
+	}
+
+	return m, nil
+}
+
+// ScalarMultiply will multiply this matrix by a given scalar value.
+func (m *Matrix[T, PT]) ScalarMultiply(s PT) bignum.Matrix[T, PT] {
+	// s may be one of this matrix's own elements, so it is copied before any element is changed.
+	s = PT(new(T)).Set(s)
+
+	for r := 0; r < len(m.matrix); r++ {
+		for c := 0; c < len(m.matrix[r]); c++ {
+			m.matrix[r][c].Mul(m.matrix[r][c], s)
+		}
+	}
+
+	return m
+}
+
+// Transpose will transpose this matrix.
+func (m *Matrix[T, PT]) Transpose() bignum.Matrix[T, PT] {
+	t := make([][]PT, len(m.matrix[0]))
+
+	for rt := 0; rt < len(t); rt++ {
+		t[rt] = make([]PT, len(m.matrix))
+
+		for ct := 0; ct < len(m.matrix); ct++ {
+			t[rt][ct] = m.matrix[ct][rt]
+		}
+	}
+
+	m.matrix = t
+
+	return m
+}
+
+// MatrixMultiply will multiple the given matrix against this matrix.
+func (m *Matrix[T, PT]) MatrixMultiply(m2 bignum.Matrix[T, PT]) (bignum.Matrix[T, PT], error) {
+	if m.Width() != m2.Height() {
+		return nil, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "MatrixMultiply",
+			LeftRows:  m.Height(),
+			LeftCols:  m.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	n := zeros[T, PT](m2.Width(), m.Height())
+	term := PT(new(T))
+
+	for rm := 0; rm < m.Height(); rm++ {
+		for cm2 := 0; cm2 < m2.Width(); cm2++ {
+			for cm := 0; cm < m.Width(); cm++ {
+				n[rm][cm2].Add(n[rm][cm2], term.Mul(m.matrix[rm][cm], m2.Get(cm, cm2)))
+			}
+		}
+	}
+
+	m.matrix = n
+
+	return m, nil
+}
+
+// Map will apply f to every element of this matrix. f is given the element held by the matrix, which it can
+// update in place and return, and whatever it returns is copied into the element.
+func (m *Matrix[T, PT]) Map(f func(v PT) PT) bignum.Matrix[T, PT] {
+	for r := 0; r < len(m.matrix); r++ {
+		for c := 0; c < len(m.matrix[r]); c++ {
+			m.matrix[r][c].Set(f(m.matrix[r][c]))
+		}
+	}
+
+	return m
+}
+
+// MapIndexed will apply f to every element of this matrix along with its coordinates, in the same way as
+// Map.
+func (m *Matrix[T, PT]) MapIndexed(f func(row int, col int, v PT) PT) bignum.Matrix[T, PT] {
+	for r := 0; r < len(m.matrix); r++ {
+		for c := 0; c < len(m.matrix[r]); c++ {
+			m.matrix[r][c].Set(f(r, c, m.matrix[r][c]))
+		}
+	}
+
+	return m
+}
+
+// ZipWith will combine each element of this matrix with the element at the same coordinates of the given
+// matrix using f, in the same way as Map.
+func (m *Matrix[T, PT]) ZipWith(m2 bignum.Matrix[T, PT], f func(a PT, b PT) PT) (bignum.Matrix[T, PT], error) {
+	if m.Height() != m2.Height() || m.Width() != m2.Width() {
+		return nil, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "ZipWith",
+			LeftRows:  m.Height(),
+			LeftCols:  m.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	for r := 0; r < len(m.matrix); r++ {
+		for c := 0; c < len(m.matrix[r]); c++ {
+			m.matrix[r][c].Set(f(m.matrix[r][c], m2.Get(r, c)))
+		}
+	}
+
+	return m, nil
+}
+
+// fold combines the elements of a row or column, starting from a copy of initial so f can update the
+// accumulator in place.
+func fold[T any, PT bignum.Number[T]](initial PT, f func(acc PT, v PT) PT, count int, element func(i int) PT) PT {
+	acc := PT(new(T)).Set(initial)
+
+	for i := 0; i < count; i++ {
+		acc = f(acc, element(i))
+	}
+
+	return acc
+}
+
+// Fold will combine every element of this matrix into a single value, starting from a copy of initial and
+// visiting the elements in row-major order.
+func (m *Matrix[T, PT]) Fold(initial PT, f func(acc PT, v PT) PT) PT {
+	return fold(initial, f, m.Height()*m.Width(), func(i int) PT {
+		return m.matrix[i/m.Width()][i%m.Width()]
+	})
+}
+
+// RowReduce will fold each row of this matrix into a single value starting from a copy of initial,
+// replacing this matrix with a column of the results.
+func (m *Matrix[T, PT]) RowReduce(initial PT, f func(acc PT, v PT) PT) bignum.Matrix[T, PT] {
+	for r := 0; r < len(m.matrix); r++ {
+		row := m.matrix[r]
+		m.matrix[r] = []PT{fold(initial, f, len(row), func(c int) PT { return row[c] })}
+	}
+
+	return m
+}
+
+// ColReduce will fold each column of this matrix into a single value starting from a copy of initial,
+// replacing this matrix with a row of the results.
+func (m *Matrix[T, PT]) ColReduce(initial PT, f func(acc PT, v PT) PT) bignum.Matrix[T, PT] {
+	row := make([]PT, m.Width())
+
+	for c := 0; c < len(row); c++ {
+		row[c] = fold(initial, f, len(m.matrix), func(r int) PT { return m.matrix[r][c] })
+	}
+
+	m.matrix = [][]PT{row}
+
+	return m
+}
+
+// row returns a row of this matrix for the reductions in numeric, which do not change it.
+func (m *Matrix[T, PT]) row(r int) []PT {
+	return m.matrix[r]
+}
+
+// Trace will sum the elements on the diagonal of this matrix into a new element, returning a NotSquareError
+// if it is not square.
+func (m *Matrix[T, PT]) Trace() (PT, error) {
+	if m.Height() != m.Width() {
+		return nil, &immutabilitybenchmarking.NotSquareError{Rows: m.Height(), Cols: m.Width()}
+	}
+
+	trace := PT(new(T))
+
+	for i := 0; i < len(m.matrix); i++ {
+		trace.Add(trace, m.matrix[i][i])
+	}
+
+	return trace, nil
+}
+
+// Sum will add together every element of this matrix into a new element.
+func (m *Matrix[T, PT]) Sum() PT {
+	return numeric.Sum(m.Height(), m.row)
+}
+
+// Min will return the smallest element of this matrix. The element is the one held by the matrix so
+// changing it changes the matrix.
+func (m *Matrix[T, PT]) Min() PT {
+	return m.Get(numeric.ArgMin(m.Height(), m.row))
+}
+
+// Max will return the largest element of this matrix. The element is the one held by the matrix so changing
+// it changes the matrix.
+func (m *Matrix[T, PT]) Max() PT {
+	return m.Get(numeric.ArgMax(m.Height(), m.row))
+}
+
+// ArgMin will return the coordinates of the first smallest element of this matrix in row-major order.
+func (m *Matrix[T, PT]) ArgMin() (int, int) {
+	return numeric.ArgMin(m.Height(), m.row)
+}
+
+// ArgMax will return the coordinates of the first largest element of this matrix in row-major order.
+func (m *Matrix[T, PT]) ArgMax() (int, int) {
+	return numeric.ArgMax(m.Height(), m.row)
+}
+
+// FrobeniusNorm will return the square root of the sum of the squared elements of this matrix, rounded to a
+// big.Float.
+func (m *Matrix[T, PT]) FrobeniusNorm() *big.Float {
+	return numeric.FrobeniusNorm(m.Height(), m.row)
+}
+
+// OneNorm will return the largest sum of the absolute values in a column of this matrix as a new element.
+func (m *Matrix[T, PT]) OneNorm() PT {
+	return numeric.OneNorm(m.Height(), m.Width(), m.row)
+}
+
+// InfNorm will return the largest sum of the absolute values in a row of this matrix as a new element.
+func (m *Matrix[T, PT]) InfNorm() PT {
+	return numeric.InfNorm(m.Height(), m.row)
+}
+
+// Rank will return the exact number of linearly independent rows of this matrix.
+func (m *Matrix[T, PT]) Rank() int {
+	return numeric.Rank(m.Height(), m.Width(), m.row)
+}
+
+// Power will raise this matrix to the power n in place by repeated squaring, returning a NotSquareError if it
+// is not square or a NegativeExponentError if n is negative. The zeroth power is the identity matrix.
+func (m *Matrix[T, PT]) Power(n int) (bignum.Matrix[T, PT], error) {
+	if m.Height() != m.Width() {
+		return nil, &immutabilitybenchmarking.NotSquareError{Rows: m.Height(), Cols: m.Width()}
+	}
+
+	if n < 0 {
+		return nil, &immutabilitybenchmarking.NegativeExponentError{Exponent: n}
+	}
+
+	// Squaring replaces the elements of m, so the first power kept for the result has to be a copy.
+	p, err := power.BySquaring[bignum.Matrix[T, PT]](m, n, func() (bignum.Matrix[T, PT], error) {
+		return identity[T, PT](m.Height()), nil
+	}, func(b bignum.Matrix[T, PT]) bignum.Matrix[T, PT] {
+		return b.(*Matrix[T, PT]).clone()
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	m.matrix = p.(*Matrix[T, PT]).matrix
+
+	return m, nil
+}
+
+// Reshape will read the elements of this matrix in row-major order into the given dimensions, returning a
+// ReshapeError if they would not hold the same number of elements. The elements are moved without being
+// copied.
+func (m *Matrix[T, PT]) Reshape(rows int, cols int) (bignum.Matrix[T, PT], error) {
+	if err := shape.CheckReshape(m, rows, cols); err != nil {
+		return nil, err
+	}
+
+	if rows == m.Height() {
+		return m, nil
+	}
+
+	block := make([]PT, 0, rows*cols)
+
+	for _, row := range m.matrix {
+		block = append(block, row...)
+	}
+
+	m.matrix = make([][]PT, rows)
+
+	for r := range m.matrix {
+		m.matrix[r] = block[r*cols : (r+1)*cols : (r+1)*cols]
+	}
+
+	return m, nil
+}
+
+// HConcat will append copies of the columns of the given matrix to the rows of this matrix, returning a
+// DimensionMismatchError if they do not have the same number of rows.
+func (m *Matrix[T, PT]) HConcat(m2 bignum.Matrix[T, PT]) (bignum.Matrix[T, PT], error) {
+	if err := shape.CheckHConcat(m, m2); err != nil {
+		return nil, err
+	}
+
+	// Appending leaves the existing elements of each row untouched, so m2 can be m itself.
+	w2 := m2.Width()
+
+	for r, row := range m.matrix {
+		for c := 0; c < w2; c++ {
+			row = append(row, PT(new(T)).Set(m2.Get(r, c)))
+		}
+
+		m.matrix[r] = row
+	}
+
+	return m, nil
+}
+
+// VConcat will append copies of the rows of the given matrix to this matrix, returning a
+// DimensionMismatchError if they do not have the same number of columns.
+func (m *Matrix[T, PT]) VConcat(m2 bignum.Matrix[T, PT]) (bignum.Matrix[T, PT], error) {
+	return m.stack("VConcat", m2)
+}
+
+// Stack will append copies of the rows of each of the given matrices to this matrix in order, returning a
+// DimensionMismatchError for the first that does not have the same number of columns.
+func (m *Matrix[T, PT]) Stack(ms ...bignum.Matrix[T, PT]) (bignum.Matrix[T, PT], error) {
+	return m.stack("Stack", ms...)
+}
+
+func (m *Matrix[T, PT]) stack(op string, ms ...bignum.Matrix[T, PT]) (bignum.Matrix[T, PT], error) {
+	if err := shape.CheckStack(op, m, ms...); err != nil {
+		return nil, err
+	}
+
+	// Any of ms can be m itself, so their heights are taken before the first row is appended.
+	heights := make([]int, len(ms))
+
+	for i, m2 := range ms {
+		heights[i] = m2.Height()
+	}
+
+	w := m.Width()
+
+	for i, m2 := range ms {
+		for r := 0; r < heights[i]; r++ {
+			row := make([]PT, w)
+
+			for c := range row {
+				row[c] = PT(new(T)).Set(m2.Get(r, c))
+			}
+
+			m.matrix = append(m.matrix, row)
+		}
+	}
+
+	return m, nil
+}
+
+// SplitRows will keep the rows of this matrix before at and return them along with a new matrix holding the
+// rows from at onwards, returning an InvalidDimensionsError if either part would be empty. The rows are
+// handed over to the new matrix without being copied.
+func (m *Matrix[T, PT]) SplitRows(at int) (bignum.Matrix[T, PT], bignum.Matrix[T, PT], error) {
+	if err := shape.CheckSplitRows(m, at); err != nil {
+		return nil, nil, err
+	}
+
+	rest := &Matrix[T, PT]{matrix: m.matrix[at:]}
+	m.matrix = m.matrix[:at:at]
+
+	return m, rest, nil
+}
+
+// SplitCols will keep the columns of this matrix before at and return them along with a new matrix holding
+// the columns from at onwards, returning an InvalidDimensionsError if either part would be empty. Each row is
+// cut in two without being copied.
+func (m *Matrix[T, PT]) SplitCols(at int) (bignum.Matrix[T, PT], bignum.Matrix[T, PT], error) {
+	if err := shape.CheckSplitCols(m, at); err != nil {
+		return nil, nil, err
+	}
+
+	rest := &Matrix[T, PT]{matrix: make([][]PT, m.Height())}
+
+	for r, row := range m.matrix {
+		rest.matrix[r] = row[at:]
+		m.matrix[r] = row[:at:at]
+	}
+
+	return m, rest, nil
+}
+
+// Pad will surround this matrix with the given number of rows and columns of distinct zeros on each side,
+// returning a PaddingError if any of them is negative.
+func (m *Matrix[T, PT]) Pad(top int, bottom int, left int, right int) (bignum.Matrix[T, PT], error) {
+	if err := shape.CheckPad(top, bottom, left, right); err != nil {
+		return nil, err
+	}
+
+	n := zeros[T, PT](left+m.Width()+right, top+m.Height()+bottom)
+
+	for r, row := range m.matrix {
+		copy(n[top+r][left:], row)
+	}
+
+	m.matrix = n
+
+	return m, nil
+}
+
+// Flip will reverse the order of the rows or the columns of this matrix in place.
+func (m *Matrix[T, PT]) Flip(axis immutabilitybenchmarking.Axis) bignum.Matrix[T, PT] {
+	if axis == immutabilitybenchmarking.RowAxis {
+		for i, j := 0, len(m.matrix)-1; i < j; i, j = i+1, j-1 {
+			m.matrix[i], m.matrix[j] = m.matrix[j], m.matrix[i]
+		}
+
+		return m
+	}
+
+	for _, row := range m.matrix {
+		for i, j := 0, len(row)-1; i < j; i, j = i+1, j-1 {
+			row[i], row[j] = row[j], row[i]
+		}
+	}
+
+	return m
+}
+
+// Rotate90 will turn this matrix a quarter turn clockwise. Square matrices are rotated in place, a ring of
+// four elements at a time.
+func (m *Matrix[T, PT]) Rotate90() bignum.Matrix[T, PT] {
+	h, w := m.Height(), m.Width()
+
+	if h == w {
+		a := m.matrix
+
+		for r := 0; r < h/2; r++ {
+			for c := r; c < h-1-r; c++ {
+				a[r][c], a[c][h-1-r], a[h-1-r][h-1-c], a[h-1-c][r] = a[h-1-c][r], a[r][c], a[c][h-1-r], a[h-1-r][h-1-c]
+			}
+		}
+
+		return m
+	}
+
+	n := make([][]PT, w)
+
+	for r := range n {
+		n[r] = make([]PT, h)
+
+		for c := range n[r] {
+			n[r][c] = m.matrix[h-1-c][r]
+		}
+	}
+
+	m.matrix = n
+
+	return m
+}
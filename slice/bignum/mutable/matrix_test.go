@@ -0,0 +1,354 @@
+package mutable
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/chris-tomich/immutability-benchmarking"
+	"github.com/chris-tomich/immutability-benchmarking/slice/bignum"
+)
+
+func mustNew[T any, PT bignum.Number[T]](matrix [][]PT) *Matrix[T, PT] {
+	m, err := New(matrix)
+	if err != nil {
+		panic(err)
+	}
+
+	return m
+}
+
+func ints(rows ...[]int64) [][]*big.Int {
+	matrix := make([][]*big.Int, len(rows))
+
+	for r := range rows {
+		matrix[r] = make([]*big.Int, len(rows[r]))
+
+		for c := range rows[r] {
+			matrix[r][c] = big.NewInt(rows[r][c])
+		}
+	}
+
+	return matrix
+}
+
+func TestBigIntOperations(t *testing.T) {
+	huge, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+
+	m1 := mustNew([][]*big.Int{{huge, big.NewInt(2)}, {big.NewInt(3), big.NewInt(4)}})
+	m2 := mustNew(ints([]int64{1, 1}, []int64{1, 1}))
+
+	if _, err := m1.Add(m2); err != nil {
+		t.Fatal(err)
+	}
+
+	expected, _ := new(big.Int).SetString("123456789012345678901234567891", 10)
+	if m1.Get(0, 0).Cmp(expected) != 0 {
+		t.Errorf("expected %v but got %v", expected, m1.Get(0, 0))
+	}
+
+	if _, err := m1.Subtract(m2); err != nil {
+		t.Fatal(err)
+	}
+
+	if m1.Get(0, 0).Cmp(huge) != 0 || m1.Get(1, 1).Int64() != 4 {
+		t.Errorf("expected subtract to undo add but got %v", m1.matrix)
+	}
+
+	m3 := mustNew(ints([]int64{1, 2, 3}, []int64{4, 5, 6}))
+	m4 := mustNew(ints([]int64{7, 8}, []int64{9, 10}, []int64{11, 12}))
+
+	if _, err := m3.MatrixMultiply(m4); err != nil {
+		t.Fatal(err)
+	}
+
+	if !m3.Equals(mustNew(ints([]int64{58, 64}, []int64{139, 154}))) {
+		t.Errorf("unexpected product %v", m3.matrix)
+	}
+
+	m3.Transpose()
+	if !m3.Equals(mustNew(ints([]int64{58, 139}, []int64{64, 154}))) {
+		t.Errorf("unexpected transpose %v", m3.matrix)
+	}
+}
+
+func TestBigRatOperations(t *testing.T) {
+	m1 := mustNew([][]*big.Rat{{big.NewRat(1, 3), big.NewRat(1, 2)}})
+	m2 := mustNew([][]*big.Rat{{big.NewRat(2, 3), big.NewRat(1, 2)}})
+
+	if _, err := m1.Add(m2); err != nil {
+		t.Fatal(err)
+	}
+
+	if !m1.Equals(mustNew([][]*big.Rat{{big.NewRat(1, 1), big.NewRat(1, 1)}})) {
+		t.Errorf("expected ones but got %v", m1.matrix)
+	}
+
+	m1.ScalarMultiply(big.NewRat(3, 4))
+	if !m1.Equals(mustNew([][]*big.Rat{{big.NewRat(3, 4), big.NewRat(3, 4)}})) {
+		t.Errorf("expected three quarters but got %v", m1.matrix)
+	}
+}
+
+func TestUpdatesInPlace(t *testing.T) {
+	values := ints([]int64{1, 2}, []int64{3, 4})
+	first := values[0][0]
+
+	m := mustNew(values)
+	m.Add(m)
+
+	if first.Int64() != 2 {
+		t.Errorf("expected the element to be updated in place but it is %v", first)
+	}
+}
+
+func TestFunctionalOperations(t *testing.T) {
+	add := func(acc *big.Int, v *big.Int) *big.Int { return acc.Add(acc, v) }
+	constant := big.NewInt(7)
+
+	m := mustNew(ints([]int64{1, 2}, []int64{3, 4}))
+	m.Map(func(*big.Int) *big.Int { return constant })
+	m.Get(0, 0).SetInt64(1)
+
+	if constant.Int64() != 7 || m.Get(0, 1).Int64() != 7 {
+		t.Errorf("expected Map to copy the returned value into each element")
+	}
+
+	m = mustNew(ints([]int64{1, 2}, []int64{3, 4}))
+	m.MapIndexed(func(row int, col int, v *big.Int) *big.Int { return v.Add(v, big.NewInt(int64(row*10+col))) })
+
+	if !m.Equals(mustNew(ints([]int64{1, 3}, []int64{13, 15}))) {
+		t.Errorf("expected MapIndexed to see the coordinates but got %v", m.matrix)
+	}
+
+	if _, err := m.ZipWith(mustNew(ints([]int64{1, 1}, []int64{2, 2})), func(a *big.Int, b *big.Int) *big.Int { return a.Mul(a, b) }); err != nil {
+		t.Fatal(err)
+	}
+
+	if !m.Equals(mustNew(ints([]int64{1, 3}, []int64{26, 30}))) {
+		t.Errorf("expected ZipWith to multiply every element but got %v", m.matrix)
+	}
+
+	initial := big.NewInt(10)
+
+	if v := m.Fold(initial, add); v.Int64() != 70 {
+		t.Errorf("expected the fold to be 70 but got %v", v)
+	}
+
+	if !mustNew(ints([]int64{1, 2}, []int64{3, 4})).RowReduce(initial, add).Equals(mustNew(ints([]int64{13}, []int64{17}))) {
+		t.Errorf("expected RowReduce to sum each row")
+	}
+
+	if !mustNew(ints([]int64{1, 2}, []int64{3, 4})).ColReduce(initial, add).Equals(mustNew(ints([]int64{14, 16}))) {
+		t.Errorf("expected ColReduce to sum each column")
+	}
+
+	if initial.Int64() != 10 {
+		t.Errorf("expected the initial value to be unchanged but got %v", initial)
+	}
+}
+
+func TestScalarMultiplyByOwnElement(t *testing.T) {
+	m := mustNew(ints([]int64{2, 3}, []int64{4, 5}))
+	m.ScalarMultiply(m.Get(0, 0))
+
+	if !m.Equals(mustNew(ints([]int64{4, 6}, []int64{8, 10}))) {
+		t.Errorf("expected every element to be doubled but got %v", m.matrix)
+	}
+}
+
+func TestNilElementsAreZero(t *testing.T) {
+	m := mustNew([][]*big.Int{{nil, big.NewInt(1)}})
+
+	if m.Get(0, 0).Sign() != 0 {
+		t.Errorf("expected a nil element to be zero but got %v", m.Get(0, 0))
+	}
+}
+
+func TestErrors(t *testing.T) {
+	var dimensions *immutabilitybenchmarking.InvalidDimensionsError
+	if _, err := New[big.Int]([][]*big.Int{}); !errors.As(err, &dimensions) {
+		t.Errorf("expected an InvalidDimensionsError but got %v", err)
+	}
+
+	var jagged *immutabilitybenchmarking.JaggedRowsError
+	if _, err := New(ints([]int64{1, 2}, []int64{3})); !errors.As(err, &jagged) {
+		t.Errorf("expected a JaggedRowsError but got %v", err)
+	}
+
+	var mismatch *immutabilitybenchmarking.DimensionMismatchError
+	if _, err := mustNew(ints([]int64{1, 2})).MatrixMultiply(mustNew(ints([]int64{1, 2}))); !errors.As(err, &mismatch) {
+		t.Errorf("expected a DimensionMismatchError but got %v", err)
+	}
+
+	var bounds *immutabilitybenchmarking.OutOfBoundsError
+	if _, err := mustNew(ints([]int64{1, 2})).At(1, 0); !errors.As(err, &bounds) {
+		t.Errorf("expected an OutOfBoundsError but got %v", err)
+	}
+}
+
+func TestReductions(t *testing.T) {
+	m := mustNew(ints([]int64{3, -7, 2}, []int64{-1, 5, -7}, []int64{4, 0, 6}))
+
+	if trace, err := m.Trace(); err != nil || trace.Int64() != 14 {
+		t.Errorf("expected a trace of 14 but got %v, %v", trace, err)
+	}
+
+	if sum := m.Sum(); sum.Int64() != 5 {
+		t.Errorf("expected a sum of 5 but got %v", sum)
+	}
+
+	if r, c := m.ArgMin(); r != 0 || c != 1 || m.Min().Int64() != -7 {
+		t.Errorf("expected the first -7 at (0, 1) but got %v at (%d, %d)", m.Min(), r, c)
+	}
+
+	if r, c := m.ArgMax(); r != 2 || c != 2 || m.Max().Int64() != 6 {
+		t.Errorf("expected 6 at (2, 2) but got %v at (%d, %d)", m.Max(), r, c)
+	}
+
+	if norm := m.OneNorm(); norm.Int64() != 15 {
+		t.Errorf("expected a one norm of 15 but got %v", norm)
+	}
+
+	if norm := m.InfNorm(); norm.Int64() != 13 {
+		t.Errorf("expected an infinity norm of 13 but got %v", norm)
+	}
+
+	if norm, _ := mustNew(ints([]int64{1, 2}, []int64{2, 4})).FrobeniusNorm().Float64(); norm != 5 {
+		t.Errorf("expected a Frobenius norm of 5 but got %v", norm)
+	}
+
+	if rank := m.Rank(); rank != 3 {
+		t.Errorf("expected a rank of 3 but got %d", rank)
+	}
+
+	if rank := mustNew(ints([]int64{1, 2, 3}, []int64{2, 4, 6})).Rank(); rank != 1 {
+		t.Errorf("expected a rank of 1 but got %d", rank)
+	}
+
+	m.Max().SetInt64(100)
+
+	if m.Get(2, 2).Int64() != 100 {
+		t.Errorf("expected Max to return the element held by the matrix")
+	}
+
+	var square *immutabilitybenchmarking.NotSquareError
+	if _, err := mustNew(ints([]int64{1, 2})).Trace(); !errors.As(err, &square) {
+		t.Errorf("expected a NotSquareError but got %v", err)
+	}
+}
+
+func TestPower(t *testing.T) {
+	fibonacci := mustNew(ints([]int64{1, 1}, []int64{1, 0}))
+
+	p, err := fibonacci.Power(100)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected, _ := new(big.Int).SetString("354224848179261915075", 10)
+	if p.Get(0, 1).Cmp(expected) != 0 || fibonacci.Get(0, 1).Cmp(expected) != 0 {
+		t.Errorf("expected the 100th Fibonacci number %v in place but got %v", expected, fibonacci.Get(0, 1))
+	}
+
+	if p, err := mustNew(ints([]int64{2, 3}, []int64{5, 7})).Power(0); err != nil || !p.Equals(mustNew(ints([]int64{1, 0}, []int64{0, 1}))) {
+		t.Errorf("expected the zeroth power to be the identity but got %v, %v", p, err)
+	}
+
+	var square *immutabilitybenchmarking.NotSquareError
+	if _, err := mustNew(ints([]int64{1, 2})).Power(2); !errors.As(err, &square) {
+		t.Errorf("expected a NotSquareError but got %v", err)
+	}
+
+	var negative *immutabilitybenchmarking.NegativeExponentError
+	if _, err := mustNew(ints([]int64{1, 1}, []int64{1, 0})).Power(-1); !errors.As(err, &negative) {
+		t.Errorf("expected a NegativeExponentError but got %v", err)
+	}
+}
+
+func TestShapeOperations(t *testing.T) {
+	type matrix = bignum.Matrix[big.Int, *big.Int]
+
+	// Each operation rearranges the matrix in place, so each is given a new one.
+	m := func() *Matrix[big.Int, *big.Int] { return mustNew(ints([]int64{1, 2, 3}, []int64{4, 5, 6})) }
+	cases := []struct {
+		name     string
+		got      func() (matrix, error)
+		expected [][]*big.Int
+	}{
+		{"Reshape", func() (matrix, error) { return m().Reshape(3, 2) }, ints([]int64{1, 2}, []int64{3, 4}, []int64{5, 6})},
+		{"HConcat", func() (matrix, error) { return m().HConcat(mustNew(ints([]int64{7}, []int64{8}))) }, ints([]int64{1, 2, 3, 7}, []int64{4, 5, 6, 8})},
+		{"VConcat", func() (matrix, error) { return m().VConcat(mustNew(ints([]int64{7, 8, 9}))) }, ints([]int64{1, 2, 3}, []int64{4, 5, 6}, []int64{7, 8, 9})},
+		{"Stack", func() (matrix, error) {
+			m1 := m()
+			return m1.Stack(m1, mustNew(ints([]int64{7, 8, 9})))
+		}, ints([]int64{1, 2, 3}, []int64{4, 5, 6}, []int64{1, 2, 3}, []int64{4, 5, 6}, []int64{7, 8, 9})},
+		{"Pad", func() (matrix, error) { return m().Pad(1, 0, 0, 1) }, ints([]int64{0, 0, 0, 0}, []int64{1, 2, 3, 0}, []int64{4, 5, 6, 0})},
+		{"FlipRows", func() (matrix, error) { return m().Flip(immutabilitybenchmarking.RowAxis), nil }, ints([]int64{4, 5, 6}, []int64{1, 2, 3})},
+		{"FlipCols", func() (matrix, error) { return m().Flip(immutabilitybenchmarking.ColAxis), nil }, ints([]int64{3, 2, 1}, []int64{6, 5, 4})},
+		{"Rotate90", func() (matrix, error) { return m().Rotate90(), nil }, ints([]int64{4, 1}, []int64{5, 2}, []int64{6, 3})},
+		{"Rotate90Square", func() (matrix, error) {
+			return mustNew(ints([]int64{1, 2, 3}, []int64{4, 5, 6}, []int64{7, 8, 9})).Rotate90(), nil
+		}, ints([]int64{7, 4, 1}, []int64{8, 5, 2}, []int64{9, 6, 3})},
+	}
+
+	for _, c := range cases {
+		got, err := c.got()
+		if err != nil || !got.Equals(mustNew(c.expected)) {
+			t.Errorf("%s: expected %v but got %v, %v", c.name, c.expected, got, err)
+		}
+	}
+
+	top, bottom, err := m().SplitRows(1)
+	if err != nil || !top.Equals(mustNew(ints([]int64{1, 2, 3}))) || !bottom.Equals(mustNew(ints([]int64{4, 5, 6}))) {
+		t.Errorf("expected SplitRows to split after the first row but got %v, %v, %v", top, bottom, err)
+	}
+
+	left, right, err := m().SplitCols(1)
+	if err != nil || !left.Equals(mustNew(ints([]int64{1}, []int64{4}))) || !right.Equals(mustNew(ints([]int64{2, 3}, []int64{5, 6}))) {
+		t.Errorf("expected SplitCols to split after the first column but got %v, %v, %v", left, right, err)
+	}
+}
+
+func TestShapeOperationsKeepElementsDistinct(t *testing.T) {
+	m := mustNew(ints([]int64{1, 2}))
+
+	if _, err := m.HConcat(m); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := m.Pad(0, 1, 0, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	m.Get(0, 0).SetInt64(100)
+	m.Get(1, 0).SetInt64(100)
+
+	if !m.Equals(mustNew(ints([]int64{100, 2, 1, 2}, []int64{100, 0, 0, 0}))) {
+		t.Errorf("expected updating one element to leave the copies and the padding unchanged but got %v", m.matrix)
+	}
+}
+
+func TestShapeErrors(t *testing.T) {
+	m := mustNew(ints([]int64{1, 2, 3}, []int64{4, 5, 6}))
+
+	var reshape *immutabilitybenchmarking.ReshapeError
+	if _, err := m.Reshape(4, 2); !errors.As(err, &reshape) {
+		t.Errorf("expected a ReshapeError but got %v", err)
+	}
+
+	var mismatch *immutabilitybenchmarking.DimensionMismatchError
+	if _, err := m.HConcat(mustNew(ints([]int64{1, 2}))); !errors.As(err, &mismatch) || mismatch.Op != "HConcat" {
+		t.Errorf("expected a DimensionMismatchError from HConcat but got %v", err)
+	}
+
+	var dimensions *immutabilitybenchmarking.InvalidDimensionsError
+	if _, _, err := m.SplitRows(0); !errors.As(err, &dimensions) {
+		t.Errorf("expected an InvalidDimensionsError but got %v", err)
+	}
+
+	var padding *immutabilitybenchmarking.PaddingError
+	if _, err := m.Pad(-1, 0, 0, 0); !errors.As(err, &padding) {
+		t.Errorf("expected a PaddingError but got %v", err)
+	}
+}
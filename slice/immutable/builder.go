@@ -0,0 +1,80 @@
+package immutable
+
+import (
+	"github.com/chris-tomich/immutability-benchmarking"
+	"github.com/pkg/errors"
+)
+
+// Builder constructs an immutable matrix in place. Once Freeze has been called the builder hands its
+// storage over to the returned matrix and can no longer be used.
+type Builder[T immutabilitybenchmarking.Number] struct {
+	matrix [][]T
+}
+
+// NewBuilder creates a new builder for a matrix with the given dimensions.
+func NewBuilder[T immutabilitybenchmarking.Number](width int, height int) *Builder[T] {
+	m := NewEmpty[T](width, height)
+
+	return &Builder[T]{matrix: m.matrix}
+}
+
+// Set sets the element at the provided coordinates. It panics with an OutOfBoundsError if they are outside
+// the matrix.
+func (b *Builder[T]) Set(row int, col int, v T) *Builder[T] {
+	b.check()
+	b.checkBounds(row, col)
+
+	b.matrix[row][col] = v
+
+	return b
+}
+
+// SetRow sets every element in the given row to the provided values. It panics with an OutOfBoundsError if
+// the row is outside the matrix, or a DimensionMismatchError if there is not one value for each column.
+func (b *Builder[T]) SetRow(row int, values []T) *Builder[T] {
+	b.check()
+	b.checkBounds(row, 0)
+
+	if len(values) != len(b.matrix[row]) {
+		panic(&immutabilitybenchmarking.DimensionMismatchError{Op: "SetRow", LeftRows: 1, LeftCols: len(b.matrix[row]), RightRows: 1, RightCols: len(values)})
+	}
+
+	copy(b.matrix[row], values)
+
+	return b
+}
+
+// Fill sets every element in the matrix to the given value.
+func (b *Builder[T]) Fill(v T) *Builder[T] {
+	b.check()
+
+	for r := 0; r < len(b.matrix); r++ {
+		for c := 0; c < len(b.matrix[r]); c++ {
+			b.matrix[r][c] = v
+		}
+	}
+
+	return b
+}
+
+// Freeze returns the built matrix and invalidates the builder.
+func (b *Builder[T]) Freeze() Matrix[T] {
+	b.check()
+
+	m := Matrix[T]{matrix: b.matrix}
+	b.matrix = nil
+
+	return m
+}
+
+func (b *Builder[T]) check() {
+	if b.matrix == nil {
+		panic(errors.New("the builder has already been frozen"))
+	}
+}
+
+func (b *Builder[T]) checkBounds(row int, col int) {
+	if row < 0 || row >= len(b.matrix) || col < 0 || col >= len(b.matrix[0]) {
+		panic(&immutabilitybenchmarking.OutOfBoundsError{Row: row, Col: col, Rows: len(b.matrix), Cols: len(b.matrix[0])})
+	}
+}
@@ -0,0 +1,21 @@
+package immutable
+
+import (
+	"testing"
+
+	"github.com/chris-tomich/immutability-benchmarking"
+	"github.com/chris-tomich/immutability-benchmarking/matrixtest"
+)
+
+func TestImmutableMatrixBuilder(t *testing.T) {
+	matrixtest.RunBuilder(t, func(width int, height int) matrixtest.Builder[int] {
+		b := NewBuilder[int](width, height)
+
+		return matrixtest.Builder[int]{
+			Set:    func(row int, col int, v int) { b.Set(row, col, v) },
+			SetRow: func(row int, values []int) { b.SetRow(row, values) },
+			Fill:   func(v int) { b.Fill(v) },
+			Freeze: func() immutabilitybenchmarking.Matrix[int] { return b.Freeze() },
+		}
+	})
+}
@@ -0,0 +1,252 @@
+package immutable
+
+import (
+	"github.com/chris-tomich/immutability-benchmarking"
+	"github.com/chris-tomich/immutability-benchmarking/internal/numeric"
+)
+
+// LU is the LU factorization with partial pivoting of an immutable matrix, P A = L U, where L is lower
+// triangular with a diagonal of ones and U is upper triangular. The matrix it was computed from is left
+// unchanged.
+type LU[T immutabilitybenchmarking.Float] struct {
+	l Matrix[T]
+	u Matrix[T]
+	// pivots holds the row of the original matrix that each row of the factors came from.
+	pivots []int
+	sign   T
+	// singular is the column of the first pivot within rounding error of zero, or -1 if there is none.
+	singular int
+}
+
+// Factorize returns the LU factorization of a square matrix. Singular matrices can be factorized, but their
+// factors cannot be used to solve systems or find an inverse. A matrix is treated as singular when one of
+// its pivots is within rounding error of zero.
+func Factorize[T immutabilitybenchmarking.Float](m Matrix[T]) (LU[T], error) {
+	if m.Height() != m.Width() {
+		return LU[T]{}, &immutabilitybenchmarking.NotSquareError{Rows: m.Height(), Cols: m.Width()}
+	}
+
+	n := m.Height()
+
+	// The elimination is done on a private copy that is split into L and U once it is finished.
+	a := make([][]T, n)
+
+	for r := 0; r < n; r++ {
+		a[r] = make([]T, n)
+		copy(a[r], m.matrix[r])
+	}
+
+	f := LU[T]{pivots: make([]int, n), sign: 1, singular: -1}
+
+	for i := 0; i < n; i++ {
+		f.pivots[i] = i
+	}
+
+	tolerance := pivotTolerance(a)
+
+	for k := 0; k < n; k++ {
+		p := k
+
+		for r := k + 1; r < n; r++ {
+			if numeric.Abs(a[r][k]) > numeric.Abs(a[p][k]) {
+				p = r
+			}
+		}
+
+		if p != k {
+			a[p], a[k] = a[k], a[p]
+			f.pivots[p], f.pivots[k] = f.pivots[k], f.pivots[p]
+			f.sign = -f.sign
+		}
+
+		if numeric.Abs(a[k][k]) <= tolerance {
+			if f.singular < 0 {
+				f.singular = k
+			}
+
+			continue
+		}
+
+		for r := k + 1; r < n; r++ {
+			l := a[r][k] / a[k][k]
+			a[r][k] = l
+
+			if l == 0 {
+				continue
+			}
+
+			for c := k + 1; c < n; c++ {
+				a[r][c] -= l * a[k][c]
+			}
+		}
+	}
+
+	f.l = NewEmpty[T](n, n)
+	f.u = NewEmpty[T](n, n)
+
+	for r := 0; r < n; r++ {
+		copy(f.l.matrix[r][:r], a[r][:r])
+		f.l.matrix[r][r] = 1
+		copy(f.u.matrix[r][r:], a[r][r:])
+	}
+
+	return f, nil
+}
+
+// pivotTolerance returns the largest magnitude a pivot of a can have and still be treated as zero. Rounding
+// leaves the pivots of a singular matrix slightly off zero, so they are compared with the rounding error
+// that elimination can accumulate relative to the largest element.
+func pivotTolerance[T immutabilitybenchmarking.Float](a [][]T) T {
+	var scale T
+
+	for _, row := range a {
+		for _, v := range row {
+			scale = max(scale, numeric.Abs(v))
+		}
+	}
+
+	return T(len(a)) * numeric.Epsilon[T]() * scale
+}
+
+// L returns the lower triangular factor, whose diagonal is all ones.
+func (f LU[T]) L() Matrix[T] {
+	return f.l
+}
+
+// U returns the upper triangular factor.
+func (f LU[T]) U() Matrix[T] {
+	return f.u
+}
+
+// Pivots returns the row of the original matrix that each row of the factors came from.
+func (f LU[T]) Pivots() []int {
+	return append([]int(nil), f.pivots...)
+}
+
+// Determinant returns the determinant of the factorized matrix.
+func (f LU[T]) Determinant() T {
+	if f.singular >= 0 {
+		return 0
+	}
+
+	d := f.sign
+
+	for i := 0; i < len(f.u.matrix); i++ {
+		d *= f.u.matrix[i][i]
+	}
+
+	return d
+}
+
+// Solve returns X where A X = B and A is the factorized matrix.
+func (f LU[T]) Solve(b immutabilitybenchmarking.Matrix[T]) (Matrix[T], error) {
+	n := len(f.u.matrix)
+
+	if b.Height() != n {
+		return Matrix[T]{}, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "Solve",
+			LeftRows:  n,
+			LeftCols:  n,
+			RightRows: b.Height(),
+			RightCols: b.Width(),
+		}
+	}
+
+	if f.singular >= 0 {
+		return Matrix[T]{}, &immutabilitybenchmarking.SingularMatrixError{Col: f.singular}
+	}
+
+	x := NewEmpty[T](b.Width(), n)
+
+	for r := 0; r < n; r++ {
+		for c := 0; c < len(x.matrix[r]); c++ {
+			x.matrix[r][c] = b.Get(f.pivots[r], c)
+		}
+	}
+
+	return f.substitute(x), nil
+}
+
+// substitute solves L U X = Y in place, where x holds the rows of Y already in pivoted order. x must not
+// have been returned to a caller yet.
+func (f LU[T]) substitute(x Matrix[T]) Matrix[T] {
+	n := len(f.u.matrix)
+	l, u, rows := f.l.matrix, f.u.matrix, x.matrix
+
+	for r := 1; r < n; r++ {
+		for k := 0; k < r; k++ {
+			if l[r][k] == 0 {
+				continue
+			}
+
+			for c := 0; c < len(rows[r]); c++ {
+				rows[r][c] -= l[r][k] * rows[k][c]
+			}
+		}
+	}
+
+	for r := n - 1; r >= 0; r-- {
+		for k := r + 1; k < n; k++ {
+			if u[r][k] == 0 {
+				continue
+			}
+
+			for c := 0; c < len(rows[r]); c++ {
+				rows[r][c] -= u[r][k] * rows[k][c]
+			}
+		}
+
+		for c := 0; c < len(rows[r]); c++ {
+			rows[r][c] /= u[r][r]
+		}
+	}
+
+	return x
+}
+
+// Inverse returns the inverse of the factorized matrix.
+func (f LU[T]) Inverse() (Matrix[T], error) {
+	n := len(f.u.matrix)
+
+	if f.singular >= 0 {
+		return Matrix[T]{}, &immutabilitybenchmarking.SingularMatrixError{Col: f.singular}
+	}
+
+	x := NewEmpty[T](n, n)
+
+	for r := 0; r < n; r++ {
+		x.matrix[r][f.pivots[r]] = 1
+	}
+
+	return f.substitute(x), nil
+}
+
+// Determinant returns the determinant of a square matrix.
+func Determinant[T immutabilitybenchmarking.Float](m Matrix[T]) (T, error) {
+	f, err := Factorize(m)
+	if err != nil {
+		return 0, err
+	}
+
+	return f.Determinant(), nil
+}
+
+// Inverse returns the inverse of a square matrix.
+func Inverse[T immutabilitybenchmarking.Float](m Matrix[T]) (Matrix[T], error) {
+	f, err := Factorize(m)
+	if err != nil {
+		return Matrix[T]{}, err
+	}
+
+	return f.Inverse()
+}
+
+// Solve returns X where m X = b.
+func Solve[T immutabilitybenchmarking.Float](m Matrix[T], b immutabilitybenchmarking.Matrix[T]) (Matrix[T], error) {
+	f, err := Factorize(m)
+	if err != nil {
+		return Matrix[T]{}, err
+	}
+
+	return f.Solve(b)
+}
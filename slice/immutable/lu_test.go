@@ -0,0 +1,167 @@
+package immutable
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/chris-tomich/immutability-benchmarking"
+)
+
+// pivoted needs a row swap before its first column can be eliminated and has a determinant of 3.
+func pivoted() Matrix[float64] {
+	return mustNew([][]float64{
+		{0, 2, 1},
+		{1, 1, 1},
+		{2, 1, 0},
+	})
+}
+
+// approxEquals reports whether the matrices match to within a small tolerance.
+func approxEquals(m1 immutabilitybenchmarking.Matrix[float64], m2 immutabilitybenchmarking.Matrix[float64]) bool {
+	if m1.Height() != m2.Height() || m1.Width() != m2.Width() {
+		return false
+	}
+
+	for r := 0; r < m1.Height(); r++ {
+		for c := 0; c < m1.Width(); c++ {
+			if math.Abs(m1.Get(r, c)-m2.Get(r, c)) > 1e-9 {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func TestImmutableDeterminant(t *testing.T) {
+	d, err := Determinant(pivoted())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if math.Abs(d-3) > 1e-12 {
+		t.Errorf("expected a determinant of 3 but got %v", d)
+	}
+
+	d, err = Determinant(mustNew([][]float64{{1, 2}, {2, 4}}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if d != 0 {
+		t.Errorf("expected a singular matrix to have a determinant of 0 but got %v", d)
+	}
+}
+
+func TestImmutableFactors(t *testing.T) {
+	m := pivoted()
+
+	f, err := Factorize(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !m.Equals(pivoted()) {
+		t.Error("expected factorizing to leave the matrix unchanged")
+	}
+
+	lu, _ := f.L().MatrixMultiply(f.U())
+	pivots := f.Pivots()
+
+	pa, _ := Factory[float64]{}.FromFunc(3, 3, func(row int, col int) float64 {
+		return m.Get(pivots[row], col)
+	})
+
+	if !approxEquals(lu, pa) {
+		t.Errorf("expected L U to equal P A but got %v", lu)
+	}
+
+	for r := 0; r < 3; r++ {
+		if f.L().Get(r, r) != 1 {
+			t.Errorf("expected a unit diagonal in L but got %v", f.L().matrix)
+		}
+
+		for c := r + 1; c < 3; c++ {
+			if f.L().Get(r, c) != 0 || f.U().Get(c, r) != 0 {
+				t.Errorf("expected triangular factors but got %v and %v", f.L().matrix, f.U().matrix)
+			}
+		}
+	}
+}
+
+func TestImmutableInverse(t *testing.T) {
+	m := pivoted()
+
+	inv, err := Inverse(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	identity, _ := Factory[float64]{}.Identity(3)
+	product, _ := m.MatrixMultiply(inv)
+
+	if !approxEquals(product, identity) {
+		t.Errorf("expected the product with the inverse to be the identity but got %v", product)
+	}
+}
+
+func TestImmutableSolve(t *testing.T) {
+	size := 20
+	a := make([][]float64, size)
+	b := make([][]float64, size)
+
+	for r := 0; r < size; r++ {
+		a[r] = make([]float64, size)
+		b[r] = []float64{rand.Float64(), rand.Float64()}
+
+		for c := 0; c < size; c++ {
+			a[r][c] = rand.Float64()
+		}
+	}
+
+	ma, mb := mustNew(a), mustNew(b)
+
+	x, err := Solve(ma, mb)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ax, _ := ma.MatrixMultiply(x)
+
+	if !approxEquals(ax, mb) {
+		t.Error("expected the solution to satisfy the system")
+	}
+
+	if !mb.Equals(mustNew(b)) {
+		t.Error("expected solving to leave the right hand side unchanged")
+	}
+}
+
+func TestImmutableLUErrors(t *testing.T) {
+	var notSquare *immutabilitybenchmarking.NotSquareError
+	if _, err := Factorize(mustNew([][]float64{{1, 2}})); !errors.As(err, &notSquare) {
+		t.Errorf("expected a NotSquareError but got %v", err)
+	}
+
+	var singular *immutabilitybenchmarking.SingularMatrixError
+	if _, err := Inverse(mustNew([][]float64{{1, 2}, {2, 4}})); !errors.As(err, &singular) || singular.Col != 1 {
+		t.Errorf("expected a SingularMatrixError for column 1 but got %v", err)
+	}
+
+	// Eliminating the first two columns leaves a last pivot of about 1e-16 rather than an exact zero.
+	rounded := [][]float64{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}}
+	if _, err := Inverse(mustNew(rounded)); !errors.As(err, &singular) || singular.Col != 2 {
+		t.Errorf("expected a SingularMatrixError for column 2 but got %v", err)
+	}
+
+	if d, err := Determinant(mustNew(rounded)); err != nil || d != 0 {
+		t.Errorf("expected a determinant of 0 but got %v, %v", d, err)
+	}
+
+	var mismatch *immutabilitybenchmarking.DimensionMismatchError
+	if _, err := Solve(pivoted(), mustNew([][]float64{{1}, {2}})); !errors.As(err, &mismatch) {
+		t.Errorf("expected a DimensionMismatchError but got %v", err)
+	}
+}
@@ -1,54 +1,116 @@
 package immutable
 
 import (
-	"github.com/pkg/errors"
 	"github.com/chris-tomich/immutability-benchmarking"
+	"github.com/chris-tomich/immutability-benchmarking/internal/numeric"
+	"github.com/chris-tomich/immutability-benchmarking/internal/power"
+	"github.com/chris-tomich/immutability-benchmarking/internal/shape"
 )
 
 // Matrix is an immutable matrix with non-mutating operations.
-type Matrix struct {
-	matrix [][]int
+type Matrix[T immutabilitybenchmarking.Number] struct {
+	matrix [][]T
 }
 
-// New creates a new immutable matrix with the given initial values.
-func New(matrix [][]int) Matrix {
-	return Matrix{matrix: matrix}
+// New creates a new immutable matrix with a copy of the given initial values.
+func New[T immutabilitybenchmarking.Number](matrix [][]T) (Matrix[T], error) {
+	if err := validate(matrix); err != nil {
+		return Matrix[T]{}, err
+	}
+
+	m := NewEmpty[T](len(matrix[0]), len(matrix))
+
+	for r := 0; r < len(matrix); r++ {
+		copy(m.matrix[r], matrix[r])
+	}
+
+	return m, nil
+}
+
+// NewUnsafeNoCopy creates a new immutable matrix that uses the given initial values directly. The caller
+// must not modify the values afterwards as any change will be visible through the matrix.
+func NewUnsafeNoCopy[T immutabilitybenchmarking.Number](matrix [][]T) (Matrix[T], error) {
+	if err := validate(matrix); err != nil {
+		return Matrix[T]{}, err
+	}
+
+	return Matrix[T]{matrix: matrix}, nil
+}
+
+// validate checks the given values form a non-empty rectangular matrix.
+func validate[T immutabilitybenchmarking.Number](matrix [][]T) error {
+	if len(matrix) == 0 || len(matrix[0]) == 0 {
+		return &immutabilitybenchmarking.InvalidDimensionsError{Rows: len(matrix)}
+	}
+
+	for r := 1; r < len(matrix); r++ {
+		if len(matrix[r]) != len(matrix[0]) {
+			return &immutabilitybenchmarking.JaggedRowsError{Row: r, Width: len(matrix[r]), Expected: len(matrix[0])}
+		}
+	}
+
+	return nil
 }
 
 // NewEmpty createas a new empty matrix with the given dimensions.
-func NewEmpty(width int, height int) Matrix {
-	if width == 0 || height == 0 {
-		panic(errors.New("width and height must both be non-zero"))
+func NewEmpty[T immutabilitybenchmarking.Number](width int, height int) Matrix[T] {
+	if width <= 0 || height <= 0 {
+		panic(&immutabilitybenchmarking.InvalidDimensionsError{Rows: height, Cols: width})
 	}
 
-	m := Matrix{
-		matrix: make([][]int, height),
+	m := Matrix[T]{
+		matrix: make([][]T, height),
 	}
 
 	for i := 0; i < len(m.matrix); i++ {
-		m.matrix[i] = make([]int, width)
+		m.matrix[i] = make([]T, width)
 	}
 
 	return m
 }
 
 // Width returns the number of columns in the matrix.
-func (m1 Matrix) Width() int {
+func (m1 Matrix[T]) Width() int {
+	if len(m1.matrix) == 0 {
+		return 0
+	}
+
 	return len(m1.matrix[0])
 }
 
 // Height returns the number of rows in the matrix.
-func (m1 Matrix) Height() int {
+func (m1 Matrix[T]) Height() int {
 	return len(m1.matrix)
 }
 
-// Get returns the integer at the provided coordinates.
-func (m1 Matrix) Get(row int, col int) int {
+// Get returns the element at the provided coordinates.
+func (m1 Matrix[T]) Get(row int, col int) T {
 	return m1.matrix[row][col]
 }
 
+// At returns the element at the provided coordinates or an OutOfBoundsError if they are outside the matrix.
+func (m1 Matrix[T]) At(row int, col int) (T, error) {
+	if row < 0 || row >= m1.Height() || col < 0 || col >= m1.Width() {
+		var zero T
+		return zero, &immutabilitybenchmarking.OutOfBoundsError{Row: row, Col: col, Rows: m1.Height(), Cols: m1.Width()}
+	}
+
+	return m1.matrix[row][col], nil
+}
+
+// MustAt returns the element at the provided coordinates and panics with an OutOfBoundsError if they are
+// outside the matrix.
+func (m1 Matrix[T]) MustAt(row int, col int) T {
+	v, err := m1.At(row, col)
+	if err != nil {
+		panic(err)
+	}
+
+	return v
+}
+
 // Equals will compare a matrix against this matrix and return if they are equal.
-func (m1 Matrix) Equals(m2 immutabilitybenchmarking.Matrix) bool {
+func (m1 Matrix[T]) Equals(m2 immutabilitybenchmarking.Matrix[T]) bool {
 	if m1.Height() != m2.Height() {
 		return false
 	}
@@ -69,16 +131,18 @@ func (m1 Matrix) Equals(m2 immutabilitybenchmarking.Matrix) bool {
 }
 
 // Add will add the values of a matrix to this matrix.
-func (m1 Matrix) Add(m2 immutabilitybenchmarking.Matrix) (immutabilitybenchmarking.Matrix, error) {
-	if m1.Height() != m2.Height() {
-		return Matrix{}, errors.New("width of both matrices are not the same")
-	}
-
-	if m1.Width() != m2.Width() {
-		return Matrix{}, errors.New("height of both matrices are not the same")
+func (m1 Matrix[T]) Add(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Height() != m2.Height() || m1.Width() != m2.Width() {
+		return Matrix[T]{}, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "Add",
+			LeftRows:  m1.Height(),
+			LeftCols:  m1.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
 	}
 
-	m := NewEmpty(m1.Height(), m1.Width())
+	m := NewEmpty[T](m1.Width(), m1.Height())
 
 	for r := 0; r < m.Height(); r++ {
 		for c := 0; c < len(m.matrix[r]); c++ {
@@ -90,16 +154,18 @@ func (m1 Matrix) Add(m2 immutabilitybenchmarking.Matrix) (immutabilitybenchmarki
 }
 
 // Subtract will subtract the values of a matrix from this matrix.
-func (m1 Matrix) Subtract(m2 immutabilitybenchmarking.Matrix) (immutabilitybenchmarking.Matrix, error) {
-	if m1.Height() != m2.Height() {
-		return Matrix{}, errors.New("width of both matrices are not the same")
-	}
-
-	if m1.Width() != m2.Width() {
-		return Matrix{}, errors.New("height of both matrices are not the same")
+func (m1 Matrix[T]) Subtract(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Height() != m2.Height() || m1.Width() != m2.Width() {
+		return Matrix[T]{}, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "Subtract",
+			LeftRows:  m1.Height(),
+			LeftCols:  m1.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
 	}
 
-	m := NewEmpty(m1.Height(), m1.Width())
+	m := NewEmpty[T](m1.Width(), m1.Height())
 
 	for r := 0; r < m.Height(); r++ {
 		for c := 0; c < len(m.matrix[r]); c++ {
@@ -111,8 +177,8 @@ func (m1 Matrix) Subtract(m2 immutabilitybenchmarking.Matrix) (immutabilitybench
 }
 
 // ScalarMultiply will multiply this matrix by a given scalar value.
-func (m1 Matrix) ScalarMultiply(s int) immutabilitybenchmarking.Matrix {
-	m := NewEmpty(m1.Height(), m1.Width())
+func (m1 Matrix[T]) ScalarMultiply(s T) immutabilitybenchmarking.Matrix[T] {
+	m := NewEmpty[T](m1.Width(), m1.Height())
 
 	for r := 0; r < m1.Height(); r++ {
 		for c := 0; c < len(m1.matrix[r]); c++ {
@@ -124,8 +190,8 @@ func (m1 Matrix) ScalarMultiply(s int) immutabilitybenchmarking.Matrix {
 }
 
 // Transpose will transpose this matrix.
-func (m1 Matrix) Transpose() immutabilitybenchmarking.Matrix {
-	m := NewEmpty(m1.Width(), m1.Height())
+func (m1 Matrix[T]) Transpose() immutabilitybenchmarking.Matrix[T] {
+	m := NewEmpty[T](m1.Height(), m1.Width())
 
 	for rt := 0; rt < m.Height(); rt++ {
 		for ct := 0; ct < m1.Height(); ct++ {
@@ -137,16 +203,22 @@ func (m1 Matrix) Transpose() immutabilitybenchmarking.Matrix {
 }
 
 // MatrixMultiply will multiple the given matrix against this matrix.
-func (m1 Matrix) MatrixMultiply(m2 immutabilitybenchmarking.Matrix) (immutabilitybenchmarking.Matrix, error) {
+func (m1 Matrix[T]) MatrixMultiply(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
 	if m1.Width() != m2.Height() {
-		return Matrix{}, errors.New("the dimensions of the matrices are incompatible, try transposing one first")
+		return Matrix[T]{}, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "MatrixMultiply",
+			LeftRows:  m1.Height(),
+			LeftCols:  m1.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
 	}
 
-	m := NewEmpty(m2.Width(), m1.Height())
+	m := NewEmpty[T](m2.Width(), m1.Height())
 
 	for rm := 0; rm < m1.Height(); rm++ {
 		for cm2 := 0; cm2 < m2.Width(); cm2++ {
-			product := 0
+			var product T
 			for cm := 0; cm < len(m1.matrix[rm]); cm++ {
 				product = product + m1.matrix[rm][cm]*m2.Get(cm, cm2)
 			}
@@ -157,3 +229,446 @@ func (m1 Matrix) MatrixMultiply(m2 immutabilitybenchmarking.Matrix) (immutabilit
 	return m, nil
 }
 
+// Map will apply f to every element of this matrix.
+func (m1 Matrix[T]) Map(f func(v T) T) immutabilitybenchmarking.Matrix[T] {
+	m := NewEmpty[T](m1.Width(), m1.Height())
+
+	for r := 0; r < m1.Height(); r++ {
+		for c := 0; c < len(m1.matrix[r]); c++ {
+			m.matrix[r][c] = f(m1.matrix[r][c])
+		}
+	}
+
+	return m
+}
+
+// MapIndexed will apply f to every element of this matrix along with its coordinates.
+func (m1 Matrix[T]) MapIndexed(f func(row int, col int, v T) T) immutabilitybenchmarking.Matrix[T] {
+	m := NewEmpty[T](m1.Width(), m1.Height())
+
+	for r := 0; r < m1.Height(); r++ {
+		for c := 0; c < len(m1.matrix[r]); c++ {
+			m.matrix[r][c] = f(r, c, m1.matrix[r][c])
+		}
+	}
+
+	return m
+}
+
+// ZipWith will combine each element of this matrix with the element at the same coordinates of the given
+// matrix using f.
+func (m1 Matrix[T]) ZipWith(m2 immutabilitybenchmarking.Matrix[T], f func(a T, b T) T) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Height() != m2.Height() || m1.Width() != m2.Width() {
+		return Matrix[T]{}, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "ZipWith",
+			LeftRows:  m1.Height(),
+			LeftCols:  m1.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	m := NewEmpty[T](m1.Width(), m1.Height())
+
+	for r := 0; r < m.Height(); r++ {
+		for c := 0; c < len(m.matrix[r]); c++ {
+			m.matrix[r][c] = f(m1.matrix[r][c], m2.Get(r, c))
+		}
+	}
+
+	return m, nil
+}
+
+// Fold will combine every element of this matrix into a single value, starting from initial and visiting
+// the elements in row-major order.
+func (m1 Matrix[T]) Fold(initial T, f func(acc T, v T) T) T {
+	acc := initial
+
+	for r := 0; r < m1.Height(); r++ {
+		for c := 0; c < len(m1.matrix[r]); c++ {
+			acc = f(acc, m1.matrix[r][c])
+		}
+	}
+
+	return acc
+}
+
+// RowReduce will fold each row of this matrix into a single value starting from initial, returning a
+// column of the results.
+func (m1 Matrix[T]) RowReduce(initial T, f func(acc T, v T) T) immutabilitybenchmarking.Matrix[T] {
+	m := NewEmpty[T](1, m1.Height())
+
+	for r := 0; r < m1.Height(); r++ {
+		acc := initial
+
+		for c := 0; c < len(m1.matrix[r]); c++ {
+			acc = f(acc, m1.matrix[r][c])
+		}
+
+		m.matrix[r][0] = acc
+	}
+
+	return m
+}
+
+// ColReduce will fold each column of this matrix into a single value starting from initial, returning a
+// row of the results.
+func (m1 Matrix[T]) ColReduce(initial T, f func(acc T, v T) T) immutabilitybenchmarking.Matrix[T] {
+	m := NewEmpty[T](m1.Width(), 1)
+
+	for c := 0; c < m1.Width(); c++ {
+		m.matrix[0][c] = initial
+	}
+
+	for r := 0; r < m1.Height(); r++ {
+		for c := 0; c < len(m1.matrix[r]); c++ {
+			m.matrix[0][c] = f(m.matrix[0][c], m1.matrix[r][c])
+		}
+	}
+
+	return m
+}
+
+// HadamardProduct will multiply each element of this matrix by the element at the same coordinates of the
+// given matrix.
+func (m1 Matrix[T]) HadamardProduct(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Height() != m2.Height() || m1.Width() != m2.Width() {
+		return Matrix[T]{}, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "HadamardProduct",
+			LeftRows:  m1.Height(),
+			LeftCols:  m1.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	m := NewEmpty[T](m1.Width(), m1.Height())
+
+	for r := 0; r < m.Height(); r++ {
+		for c := 0; c < len(m.matrix[r]); c++ {
+			m.matrix[r][c] = m1.matrix[r][c] * m2.Get(r, c)
+		}
+	}
+
+	return m, nil
+}
+
+// ElementwiseDivide will divide each element of this matrix by the element at the same coordinates of the
+// given matrix, returning a DivisionByZeroError if any of them is zero.
+func (m1 Matrix[T]) ElementwiseDivide(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Height() != m2.Height() || m1.Width() != m2.Width() {
+		return Matrix[T]{}, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "ElementwiseDivide",
+			LeftRows:  m1.Height(),
+			LeftCols:  m1.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	m := NewEmpty[T](m1.Width(), m1.Height())
+
+	for r := 0; r < m.Height(); r++ {
+		for c := 0; c < len(m.matrix[r]); c++ {
+			d := m2.Get(r, c)
+			if d == 0 {
+				return Matrix[T]{}, &immutabilitybenchmarking.DivisionByZeroError{Op: "ElementwiseDivide", Row: r, Col: c}
+			}
+
+			m.matrix[r][c] = m1.matrix[r][c] / d
+		}
+	}
+
+	return m, nil
+}
+
+// KroneckerProduct will multiply every element of this matrix by the whole of the given matrix, returning
+// the blocks of products as a matrix whose dimensions are the products of both matrices' dimensions.
+func (m1 Matrix[T]) KroneckerProduct(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	m := NewEmpty[T](m1.Width()*m2.Width(), m1.Height()*m2.Height())
+
+	for r1 := 0; r1 < m1.Height(); r1++ {
+		for r2 := 0; r2 < m2.Height(); r2++ {
+			row := m.matrix[r1*m2.Height()+r2]
+
+			for c1 := 0; c1 < len(m1.matrix[r1]); c1++ {
+				for c2 := 0; c2 < m2.Width(); c2++ {
+					row[c1*m2.Width()+c2] = m1.matrix[r1][c1] * m2.Get(r2, c2)
+				}
+			}
+		}
+	}
+
+	return m, nil
+}
+
+// row returns row r of this matrix without copying it.
+func (m1 Matrix[T]) row(r int) []T {
+	return m1.matrix[r]
+}
+
+// Trace will sum the elements on the diagonal of this matrix, returning a NotSquareError if it is not square.
+func (m1 Matrix[T]) Trace() (T, error) {
+	if m1.Height() != m1.Width() {
+		var zero T
+		return zero, &immutabilitybenchmarking.NotSquareError{Rows: m1.Height(), Cols: m1.Width()}
+	}
+
+	var trace T
+
+	for i := 0; i < m1.Height(); i++ {
+		trace += m1.matrix[i][i]
+	}
+
+	return trace, nil
+}
+
+// Sum will add together every element of this matrix.
+func (m1 Matrix[T]) Sum() T {
+	return numeric.Sum(m1.Height(), m1.row)
+}
+
+// Min will return the smallest element of this matrix. Complex elements are ordered by their real parts and
+// then by their imaginary parts.
+func (m1 Matrix[T]) Min() T {
+	r, c := numeric.ArgMin(m1.Height(), m1.row)
+
+	return m1.matrix[r][c]
+}
+
+// Max will return the largest element of this matrix. Complex elements are ordered by their real parts and
+// then by their imaginary parts.
+func (m1 Matrix[T]) Max() T {
+	r, c := numeric.ArgMax(m1.Height(), m1.row)
+
+	return m1.matrix[r][c]
+}
+
+// ArgMin will return the coordinates of the first smallest element of this matrix in row-major order.
+func (m1 Matrix[T]) ArgMin() (int, int) {
+	return numeric.ArgMin(m1.Height(), m1.row)
+}
+
+// ArgMax will return the coordinates of the first largest element of this matrix in row-major order.
+func (m1 Matrix[T]) ArgMax() (int, int) {
+	return numeric.ArgMax(m1.Height(), m1.row)
+}
+
+// FrobeniusNorm will return the square root of the sum of the squared absolute values of the elements of
+// this matrix.
+func (m1 Matrix[T]) FrobeniusNorm() float64 {
+	return numeric.FrobeniusNorm(m1.Height(), m1.row)
+}
+
+// OneNorm will return the largest sum of the absolute values in a column of this matrix.
+func (m1 Matrix[T]) OneNorm() float64 {
+	return numeric.OneNorm(m1.Height(), m1.Width(), m1.row)
+}
+
+// InfNorm will return the largest sum of the absolute values in a row of this matrix.
+func (m1 Matrix[T]) InfNorm() float64 {
+	return numeric.InfNorm(m1.Height(), m1.row)
+}
+
+// Rank will return the number of linearly independent rows of this matrix, treating values within rounding
+// error of zero as zero.
+func (m1 Matrix[T]) Rank() int {
+	return numeric.Rank(m1.Height(), m1.Width(), m1.row)
+}
+
+// Power will raise this matrix to the power n by repeated squaring, returning a NotSquareError if it is not
+// square or a NegativeExponentError if n is negative. The zeroth power is the identity matrix.
+func (m1 Matrix[T]) Power(n int) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Height() != m1.Width() {
+		return Matrix[T]{}, &immutabilitybenchmarking.NotSquareError{Rows: m1.Height(), Cols: m1.Width()}
+	}
+
+	if n < 0 {
+		return Matrix[T]{}, &immutabilitybenchmarking.NegativeExponentError{Exponent: n}
+	}
+
+	return power.BySquaring[immutabilitybenchmarking.Matrix[T]](m1, n, func() (immutabilitybenchmarking.Matrix[T], error) {
+		return Factory[T]{}.Identity(m1.Height())
+	}, nil)
+}
+
+// fromResult creates a matrix holding a copy of the elements a shape operation describes.
+func fromResult[T immutabilitybenchmarking.Number](res shape.Result[T]) Matrix[T] {
+	m := NewEmpty[T](res.Cols, res.Rows)
+
+	for r := 0; r < res.Rows; r++ {
+		for c := 0; c < res.Cols; c++ {
+			m.matrix[r][c] = res.At(r, c)
+		}
+	}
+
+	return m
+}
+
+// Reshape will read the elements of this matrix in row-major order into a matrix with the given dimensions,
+// returning a ReshapeError if it would not hold the same number of elements. Each row has its own slice, so
+// the elements are copied unless the dimensions are unchanged.
+func (m1 Matrix[T]) Reshape(rows int, cols int) (immutabilitybenchmarking.Matrix[T], error) {
+	res, err := shape.Reshape[T](m1, rows, cols)
+	if err != nil {
+		return Matrix[T]{}, err
+	}
+
+	if rows == m1.Height() {
+		return m1, nil
+	}
+
+	return fromResult(res), nil
+}
+
+// HConcat will place the given matrix to the right of this matrix, returning a DimensionMismatchError if
+// they do not have the same number of rows.
+func (m1 Matrix[T]) HConcat(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if err := shape.CheckHConcat(m1, m2); err != nil {
+		return Matrix[T]{}, err
+	}
+
+	m := NewEmpty[T](m1.Width()+m2.Width(), m1.Height())
+
+	for r := 0; r < m.Height(); r++ {
+		copy(m.matrix[r], m1.matrix[r])
+
+		for c := 0; c < m2.Width(); c++ {
+			m.matrix[r][m1.Width()+c] = m2.Get(r, c)
+		}
+	}
+
+	return m, nil
+}
+
+// VConcat will place the given matrix below this matrix, returning a DimensionMismatchError if they do not
+// have the same number of columns. The result shares the rows of both when the given matrix is also from
+// this package.
+func (m1 Matrix[T]) VConcat(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	return m1.stack("VConcat", m2)
+}
+
+// Stack will place the given matrices below this matrix in order, returning a DimensionMismatchError for
+// the first that does not have the same number of columns. The result shares the rows of this matrix and of
+// any of the given matrices from this package.
+func (m1 Matrix[T]) Stack(ms ...immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	return m1.stack("Stack", ms...)
+}
+
+func (m1 Matrix[T]) stack(op string, ms ...immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if err := shape.CheckStack(op, m1, ms...); err != nil {
+		return Matrix[T]{}, err
+	}
+
+	height := m1.Height()
+
+	for _, m2 := range ms {
+		height += m2.Height()
+	}
+
+	rows := append(make([][]T, 0, height), m1.matrix...)
+
+	for _, m2 := range ms {
+		if o, ok := m2.(Matrix[T]); ok {
+			rows = append(rows, o.matrix...)
+			continue
+		}
+
+		for r := 0; r < m2.Height(); r++ {
+			row := make([]T, m2.Width())
+
+			for c := range row {
+				row[c] = m2.Get(r, c)
+			}
+
+			rows = append(rows, row)
+		}
+	}
+
+	return Matrix[T]{matrix: rows}, nil
+}
+
+// SplitRows will split this matrix into its rows before at and its rows from at onwards, returning an
+// InvalidDimensionsError if either part would be empty. Both parts share the rows of this matrix.
+func (m1 Matrix[T]) SplitRows(at int) (immutabilitybenchmarking.Matrix[T], immutabilitybenchmarking.Matrix[T], error) {
+	if err := shape.CheckSplitRows(m1, at); err != nil {
+		return Matrix[T]{}, Matrix[T]{}, err
+	}
+
+	return Matrix[T]{matrix: m1.matrix[:at:at]}, Matrix[T]{matrix: m1.matrix[at:]}, nil
+}
+
+// SplitCols will split this matrix into its columns before at and its columns from at onwards, returning an
+// InvalidDimensionsError if either part would be empty. Each row of both parts is a slice of the same row of
+// this matrix.
+func (m1 Matrix[T]) SplitCols(at int) (immutabilitybenchmarking.Matrix[T], immutabilitybenchmarking.Matrix[T], error) {
+	if err := shape.CheckSplitCols(m1, at); err != nil {
+		return Matrix[T]{}, Matrix[T]{}, err
+	}
+
+	left := Matrix[T]{matrix: make([][]T, m1.Height())}
+	right := Matrix[T]{matrix: make([][]T, m1.Height())}
+
+	for r, row := range m1.matrix {
+		left.matrix[r] = row[:at:at]
+		right.matrix[r] = row[at:]
+	}
+
+	return left, right, nil
+}
+
+// Pad will surround this matrix with the given number of rows and columns of zeros on each side, returning
+// a PaddingError if any of them is negative.
+func (m1 Matrix[T]) Pad(top int, bottom int, left int, right int) (immutabilitybenchmarking.Matrix[T], error) {
+	if err := shape.CheckPad(top, bottom, left, right); err != nil {
+		return Matrix[T]{}, err
+	}
+
+	m := NewEmpty[T](left+m1.Width()+right, top+m1.Height()+bottom)
+
+	for r, row := range m1.matrix {
+		copy(m.matrix[top+r][left:], row)
+	}
+
+	return m, nil
+}
+
+// Flip will reverse the order of the rows or the columns of this matrix. Flipping the rows shares them with
+// this matrix in the opposite order.
+func (m1 Matrix[T]) Flip(axis immutabilitybenchmarking.Axis) immutabilitybenchmarking.Matrix[T] {
+	h := m1.Height()
+
+	if axis == immutabilitybenchmarking.RowAxis {
+		m := Matrix[T]{matrix: make([][]T, h)}
+
+		for r, row := range m1.matrix {
+			m.matrix[h-1-r] = row
+		}
+
+		return m
+	}
+
+	m := NewEmpty[T](m1.Width(), h)
+
+	for r, row := range m1.matrix {
+		for c, v := range row {
+			m.matrix[r][len(row)-1-c] = v
+		}
+	}
+
+	return m
+}
+
+// Rotate90 will turn this matrix a quarter turn clockwise.
+func (m1 Matrix[T]) Rotate90() immutabilitybenchmarking.Matrix[T] {
+	h := m1.Height()
+	m := NewEmpty[T](h, m1.Width())
+
+	for r, row := range m1.matrix {
+		for c, v := range row {
+			m.matrix[c][h-1-r] = v
+		}
+	}
+
+	return m
+}
@@ -1,16 +1,22 @@
 package immutable
 
-import "testing"
+import (
+	"errors"
+	"testing"
+
+	"github.com/chris-tomich/immutability-benchmarking"
+	"github.com/chris-tomich/immutability-benchmarking/matrixtest"
+)
 
 func TestImmutableMatrixMultiplication(t *testing.T) {
-	m1 := New(
+	m1 := mustNew(
 		[][]int{
 			{2, 3, 4},
 			{1, 0, 0},
 		},
 	)
 
-	m2 := New(
+	m2 := mustNew(
 		[][]int{
 			{0, 1000},
 			{1, 100},
@@ -19,7 +25,7 @@ func TestImmutableMatrixMultiplication(t *testing.T) {
 	)
 
 	m3, _ := m1.MatrixMultiply(m2)
-	isCorrect := m3.Equals(New(
+	isCorrect := m3.Equals(mustNew(
 		[][]int{
 			{3, 2340},
 			{0, 1000},
@@ -30,13 +36,13 @@ func TestImmutableMatrixMultiplication(t *testing.T) {
 		t.Fail()
 	}
 
-	m4 := New(
+	m4 := mustNew(
 		[][]int{
 			{2, 3, 4},
 		},
 	)
 
-	m5 := New(
+	m5 := mustNew(
 		[][]int{
 			{0, 1000},
 			{1, 100},
@@ -45,7 +51,7 @@ func TestImmutableMatrixMultiplication(t *testing.T) {
 	)
 
 	m6, _ := m4.MatrixMultiply(m5)
-	isCorrect = m6.Equals(New(
+	isCorrect = m6.Equals(mustNew(
 		[][]int{
 			{3, 2340},
 		},
@@ -55,14 +61,14 @@ func TestImmutableMatrixMultiplication(t *testing.T) {
 		t.Fail()
 	}
 
-	m7 := New(
+	m7 := mustNew(
 		[][]int{
 			{2, 3, 4},
 			{1, 0, 0},
 		},
 	)
 
-	m8 := New(
+	m8 := mustNew(
 		[][]int{
 			{0},
 			{1},
@@ -71,7 +77,7 @@ func TestImmutableMatrixMultiplication(t *testing.T) {
 	)
 
 	m9, _ := m7.MatrixMultiply(m8)
-	isCorrect = m9.Equals(New(
+	isCorrect = m9.Equals(mustNew(
 		[][]int{
 			{3},
 			{0},
@@ -82,3 +88,234 @@ func TestImmutableMatrixMultiplication(t *testing.T) {
 		t.Fail()
 	}
 }
+
+func TestImmutableFloat64MatrixMultiplication(t *testing.T) {
+	m1 := mustNew(
+		[][]float64{
+			{0.5, 1.5},
+			{2, -1},
+		},
+	)
+
+	m2 := mustNew(
+		[][]float64{
+			{4, 0},
+			{0.25, 2},
+		},
+	)
+
+	m3, _ := m1.MatrixMultiply(m2)
+	isCorrect := m3.Equals(mustNew(
+		[][]float64{
+			{2.375, 3},
+			{7.75, -2},
+		},
+	))
+
+	if !isCorrect {
+		t.Fail()
+	}
+}
+
+func TestImmutableMatrixNewCopies(t *testing.T) {
+	values := [][]int{
+		{1, 2},
+		{3, 4},
+	}
+
+	m1 := mustNew(values)
+	values[0][0] = 100
+
+	if m1.Get(0, 0) != 1 {
+		t.Error("expected New to copy its input")
+	}
+
+	m2, err := NewUnsafeNoCopy(values)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	values[0][0] = 200
+
+	if m2.Get(0, 0) != 200 {
+		t.Error("expected NewUnsafeNoCopy to use its input directly")
+	}
+}
+
+func TestImmutableMatrixNewValidation(t *testing.T) {
+	if _, err := New([][]int{}); err == nil {
+		t.Error("expected an empty matrix to be rejected")
+	}
+
+	if _, err := New([][]int{{}}); err == nil {
+		t.Error("expected a matrix with empty rows to be rejected")
+	}
+
+	if _, err := New([][]int{{1, 2}, {3}}); err == nil {
+		t.Error("expected a jagged matrix to be rejected")
+	}
+
+	if _, err := NewUnsafeNoCopy([][]int{{1}, {2, 3}}); err == nil {
+		t.Error("expected a jagged matrix to be rejected")
+	}
+}
+
+func mustNew[T immutabilitybenchmarking.Number](matrix [][]T) Matrix[T] {
+	m, err := New(matrix)
+	if err != nil {
+		panic(err)
+	}
+
+	return m
+}
+
+func TestImmutableMatrixDimensionMismatch(t *testing.T) {
+	m1 := mustNew([][]int{{1, 2, 3}})
+	m2 := mustNew([][]int{{1, 2}})
+
+	_, err := m1.Add(m2)
+
+	var e *immutabilitybenchmarking.DimensionMismatchError
+	if !errors.As(err, &e) {
+		t.Fatalf("expected a DimensionMismatchError but got %v", err)
+	}
+
+	if e.Op != "Add" || e.LeftRows != 1 || e.LeftCols != 3 || e.RightRows != 1 || e.RightCols != 2 {
+		t.Errorf("unexpected error details %+v", e)
+	}
+
+	if _, err := m1.MatrixMultiply(m2); !errors.As(err, &e) || e.Op != "MatrixMultiply" {
+		t.Errorf("expected a MatrixMultiply DimensionMismatchError but got %v", err)
+	}
+}
+
+func TestImmutableMatrixNonSquare(t *testing.T) {
+	m1 := mustNew(
+		[][]int{
+			{1, 2, 3},
+			{4, 5, 6},
+		},
+	)
+
+	m2, err := m1.Add(m1.ScalarMultiply(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	isCorrect := m2.Equals(mustNew(
+		[][]int{
+			{3, 6, 9},
+			{12, 15, 18},
+		},
+	))
+
+	if !isCorrect {
+		t.Error("incorrect sum of non-square matrices")
+	}
+
+	isCorrect = m1.Transpose().Equals(mustNew(
+		[][]int{
+			{1, 4},
+			{2, 5},
+			{3, 6},
+		},
+	))
+
+	if !isCorrect {
+		t.Error("incorrect transpose of a non-square matrix")
+	}
+}
+
+func TestImmutableMatrixFactory(t *testing.T) {
+	var f immutabilitybenchmarking.Factory[float64] = Factory[float64]{}
+
+	m1, err := f.Identity(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m2, err := f.FromFunc(3, 2, func(row int, col int) float64 {
+		return float64(row*2 + col)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m3, err := m1.MatrixMultiply(m2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !m3.Equals(m2) {
+		t.Error("expected multiplying by the identity to return the same values")
+	}
+
+	m4, err := f.Zeros(3, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if m4.Height() != 3 || m4.Width() != 2 || m4.Get(2, 1) != 0 {
+		t.Error("expected a 3x2 matrix of zeros")
+	}
+
+	if _, err := f.Zeros(0, 2); err == nil {
+		t.Error("expected a matrix with no rows to be rejected")
+	}
+
+	if _, err := f.FromRows([][]float64{{1}, {2, 3}}); err == nil {
+		t.Error("expected a jagged matrix to be rejected")
+	}
+}
+
+func TestImmutableMatrixConformance(t *testing.T) {
+	t.Run("int", func(t *testing.T) {
+		matrixtest.RunConformance[int](t, Factory[int]{})
+	})
+
+	t.Run("uint8", func(t *testing.T) {
+		matrixtest.RunConformance[uint8](t, Factory[uint8]{})
+	})
+
+	t.Run("float64", func(t *testing.T) {
+		matrixtest.RunConformance[float64](t, Factory[float64]{})
+	})
+
+	t.Run("complex128", func(t *testing.T) {
+		matrixtest.RunConformance[complex128](t, Factory[complex128]{})
+	})
+}
+
+func TestImmutableMatrixShapeSharesRows(t *testing.T) {
+	m1 := mustNew([][]int{
+		{1, 2, 3},
+		{4, 5, 6},
+	})
+
+	top, bottom, err := m1.SplitRows(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if &top.(Matrix[int]).matrix[0][0] != &m1.matrix[0][0] || &bottom.(Matrix[int]).matrix[0][0] != &m1.matrix[1][0] {
+		t.Error("expected SplitRows to share the rows")
+	}
+
+	left, right, err := m1.SplitCols(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if &left.(Matrix[int]).matrix[1][0] != &m1.matrix[1][0] || &right.(Matrix[int]).matrix[1][0] != &m1.matrix[1][2] {
+		t.Error("expected SplitCols to share the rows")
+	}
+
+	if cap(left.(Matrix[int]).matrix[0]) != 2 {
+		t.Error("expected the left part to be unable to grow into the right part")
+	}
+
+	flipped := m1.Flip(immutabilitybenchmarking.RowAxis).(Matrix[int])
+	if &flipped.matrix[0][0] != &m1.matrix[1][0] {
+		t.Error("expected flipping the rows to share them")
+	}
+}
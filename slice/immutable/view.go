@@ -0,0 +1,624 @@
+package immutable
+
+import (
+	"github.com/chris-tomich/immutability-benchmarking"
+	"github.com/chris-tomich/immutability-benchmarking/internal/numeric"
+	"github.com/chris-tomich/immutability-benchmarking/internal/power"
+	"github.com/chris-tomich/immutability-benchmarking/internal/shape"
+)
+
+// View is an immutable matrix that reads its elements from part of an immutable matrix without copying
+// them, optionally transposed. As neither can change, a view can be kept for as long as it is needed.
+// Operations on a view return new Matrix values.
+type View[T immutabilitybenchmarking.Number] struct {
+	matrix [][]T
+	// row0, col0, rows and cols are the part of matrix being viewed, before any transpose.
+	row0       int
+	col0       int
+	rows       int
+	cols       int
+	transposed bool
+}
+
+// view returns a view of the whole matrix.
+func (m1 Matrix[T]) view() View[T] {
+	return View[T]{matrix: m1.matrix, rows: m1.Height(), cols: m1.Width()}
+}
+
+// Slice returns a view of the rows from r0 up to but not including r1 and the columns from c0 up to but not
+// including c1.
+func (m1 Matrix[T]) Slice(r0 int, r1 int, c0 int, c1 int) (View[T], error) {
+	return m1.view().Slice(r0, r1, c0, c1)
+}
+
+// Row returns a 1 x n view of row i.
+func (m1 Matrix[T]) Row(i int) (View[T], error) {
+	return m1.view().Row(i)
+}
+
+// Col returns an n x 1 view of column j.
+func (m1 Matrix[T]) Col(j int) (View[T], error) {
+	return m1.view().Col(j)
+}
+
+// T returns a transposed view of the matrix. Unlike Transpose it does not copy any elements.
+func (m1 Matrix[T]) T() View[T] {
+	return m1.view().T()
+}
+
+// Slice returns a view of the rows from r0 up to but not including r1 and the columns from c0 up to but not
+// including c1 of this view. Ranges that reach outside the view return an OutOfBoundsError and empty ranges
+// return an InvalidDimensionsError.
+func (v View[T]) Slice(r0 int, r1 int, c0 int, c1 int) (View[T], error) {
+	if r0 < 0 || c0 < 0 {
+		return View[T]{}, &immutabilitybenchmarking.OutOfBoundsError{Row: r0, Col: c0, Rows: v.Height(), Cols: v.Width()}
+	}
+
+	if r1 > v.Height() || c1 > v.Width() {
+		return View[T]{}, &immutabilitybenchmarking.OutOfBoundsError{Row: r1 - 1, Col: c1 - 1, Rows: v.Height(), Cols: v.Width()}
+	}
+
+	if r1 <= r0 || c1 <= c0 {
+		return View[T]{}, &immutabilitybenchmarking.InvalidDimensionsError{Rows: r1 - r0, Cols: c1 - c0}
+	}
+
+	s := v
+
+	if v.transposed {
+		r0, r1, c0, c1 = c0, c1, r0, r1
+	}
+
+	s.row0 += r0
+	s.col0 += c0
+	s.rows = r1 - r0
+	s.cols = c1 - c0
+
+	return s, nil
+}
+
+// Row returns a 1 x n view of row i of this view.
+func (v View[T]) Row(i int) (View[T], error) {
+	return v.Slice(i, i+1, 0, v.Width())
+}
+
+// Col returns an n x 1 view of column j of this view.
+func (v View[T]) Col(j int) (View[T], error) {
+	return v.Slice(0, v.Height(), j, j+1)
+}
+
+// T returns a transposed view of this view.
+func (v View[T]) T() View[T] {
+	v.transposed = !v.transposed
+
+	return v
+}
+
+// Copy returns a matrix holding a copy of the elements in the view.
+func (v View[T]) Copy() Matrix[T] {
+	m := NewEmpty[T](v.Width(), v.Height())
+
+	for r := 0; r < m.Height(); r++ {
+		for c := 0; c < len(m.matrix[r]); c++ {
+			m.matrix[r][c] = v.Get(r, c)
+		}
+	}
+
+	return m
+}
+
+// Width returns the number of columns in the view.
+func (v View[T]) Width() int {
+	if v.transposed {
+		return v.rows
+	}
+
+	return v.cols
+}
+
+// Height returns the number of rows in the view.
+func (v View[T]) Height() int {
+	if v.transposed {
+		return v.cols
+	}
+
+	return v.rows
+}
+
+// Get returns the element at the provided coordinates.
+func (v View[T]) Get(row int, col int) T {
+	if v.transposed {
+		row, col = col, row
+	}
+
+	return v.matrix[v.row0+row][v.col0+col]
+}
+
+// At returns the element at the provided coordinates or an OutOfBoundsError if they are outside the view.
+func (v View[T]) At(row int, col int) (T, error) {
+	if row < 0 || row >= v.Height() || col < 0 || col >= v.Width() {
+		var zero T
+		return zero, &immutabilitybenchmarking.OutOfBoundsError{Row: row, Col: col, Rows: v.Height(), Cols: v.Width()}
+	}
+
+	return v.Get(row, col), nil
+}
+
+// MustAt returns the element at the provided coordinates and panics with an OutOfBoundsError if they are
+// outside the view.
+func (v View[T]) MustAt(row int, col int) T {
+	e, err := v.At(row, col)
+	if err != nil {
+		panic(err)
+	}
+
+	return e
+}
+
+// Equals will compare a matrix against this view and return if they are equal.
+func (v View[T]) Equals(m2 immutabilitybenchmarking.Matrix[T]) bool {
+	if v.Height() != m2.Height() || v.Width() != m2.Width() {
+		return false
+	}
+
+	for r := 0; r < v.Height(); r++ {
+		for c := 0; c < v.Width(); c++ {
+			if v.Get(r, c) != m2.Get(r, c) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// Add will add the values of a matrix to this view.
+func (v View[T]) Add(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if v.Height() != m2.Height() || v.Width() != m2.Width() {
+		return Matrix[T]{}, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "Add",
+			LeftRows:  v.Height(),
+			LeftCols:  v.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	m := NewEmpty[T](v.Width(), v.Height())
+
+	for r := 0; r < m.Height(); r++ {
+		for c := 0; c < len(m.matrix[r]); c++ {
+			m.matrix[r][c] = v.Get(r, c) + m2.Get(r, c)
+		}
+	}
+
+	return m, nil
+}
+
+// Subtract will subtract the values of a matrix from this view.
+func (v View[T]) Subtract(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if v.Height() != m2.Height() || v.Width() != m2.Width() {
+		return Matrix[T]{}, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "Subtract",
+			LeftRows:  v.Height(),
+			LeftCols:  v.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	m := NewEmpty[T](v.Width(), v.Height())
+
+	for r := 0; r < m.Height(); r++ {
+		for c := 0; c < len(m.matrix[r]); c++ {
+			m.matrix[r][c] = v.Get(r, c) - m2.Get(r, c)
+		}
+	}
+
+	return m, nil
+}
+
+// ScalarMultiply will multiply this view by a given scalar value.
+func (v View[T]) ScalarMultiply(s T) immutabilitybenchmarking.Matrix[T] {
+	m := NewEmpty[T](v.Width(), v.Height())
+
+	for r := 0; r < m.Height(); r++ {
+		for c := 0; c < len(m.matrix[r]); c++ {
+			m.matrix[r][c] = v.Get(r, c) * s
+		}
+	}
+
+	return m
+}
+
+// Transpose returns a transposed view without copying any elements.
+func (v View[T]) Transpose() immutabilitybenchmarking.Matrix[T] {
+	return v.T()
+}
+
+// MatrixMultiply will multiple the given matrix against this view.
+func (v View[T]) MatrixMultiply(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if v.Width() != m2.Height() {
+		return Matrix[T]{}, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "MatrixMultiply",
+			LeftRows:  v.Height(),
+			LeftCols:  v.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	m := NewEmpty[T](m2.Width(), v.Height())
+
+	for rm := 0; rm < v.Height(); rm++ {
+		for cm2 := 0; cm2 < m2.Width(); cm2++ {
+			var product T
+			for cm := 0; cm < v.Width(); cm++ {
+				product = product + v.Get(rm, cm)*m2.Get(cm, cm2)
+			}
+			m.matrix[rm][cm2] = product
+		}
+	}
+
+	return m, nil
+}
+
+// Map will apply f to every element of this view.
+func (v View[T]) Map(f func(v T) T) immutabilitybenchmarking.Matrix[T] {
+	m := NewEmpty[T](v.Width(), v.Height())
+
+	for r := 0; r < m.Height(); r++ {
+		for c := 0; c < len(m.matrix[r]); c++ {
+			m.matrix[r][c] = f(v.Get(r, c))
+		}
+	}
+
+	return m
+}
+
+// MapIndexed will apply f to every element of this view along with its coordinates.
+func (v View[T]) MapIndexed(f func(row int, col int, v T) T) immutabilitybenchmarking.Matrix[T] {
+	m := NewEmpty[T](v.Width(), v.Height())
+
+	for r := 0; r < m.Height(); r++ {
+		for c := 0; c < len(m.matrix[r]); c++ {
+			m.matrix[r][c] = f(r, c, v.Get(r, c))
+		}
+	}
+
+	return m
+}
+
+// ZipWith will combine each element of this view with the element at the same coordinates of the given
+// matrix using f.
+func (v View[T]) ZipWith(m2 immutabilitybenchmarking.Matrix[T], f func(a T, b T) T) (immutabilitybenchmarking.Matrix[T], error) {
+	if v.Height() != m2.Height() || v.Width() != m2.Width() {
+		return Matrix[T]{}, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "ZipWith",
+			LeftRows:  v.Height(),
+			LeftCols:  v.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	m := NewEmpty[T](v.Width(), v.Height())
+
+	for r := 0; r < m.Height(); r++ {
+		for c := 0; c < len(m.matrix[r]); c++ {
+			m.matrix[r][c] = f(v.Get(r, c), m2.Get(r, c))
+		}
+	}
+
+	return m, nil
+}
+
+// Fold will combine every element of this view into a single value, starting from initial and visiting the
+// elements in row-major order.
+func (v View[T]) Fold(initial T, f func(acc T, v T) T) T {
+	acc := initial
+
+	for r := 0; r < v.Height(); r++ {
+		for c := 0; c < v.Width(); c++ {
+			acc = f(acc, v.Get(r, c))
+		}
+	}
+
+	return acc
+}
+
+// RowReduce will fold each row of this view into a single value starting from initial, returning a column
+// of the results.
+func (v View[T]) RowReduce(initial T, f func(acc T, v T) T) immutabilitybenchmarking.Matrix[T] {
+	m := NewEmpty[T](1, v.Height())
+
+	for r := 0; r < v.Height(); r++ {
+		acc := initial
+
+		for c := 0; c < v.Width(); c++ {
+			acc = f(acc, v.Get(r, c))
+		}
+
+		m.matrix[r][0] = acc
+	}
+
+	return m
+}
+
+// ColReduce will fold each column of this view into a single value starting from initial, returning a row
+// of the results.
+func (v View[T]) ColReduce(initial T, f func(acc T, v T) T) immutabilitybenchmarking.Matrix[T] {
+	m := NewEmpty[T](v.Width(), 1)
+
+	for c := 0; c < v.Width(); c++ {
+		m.matrix[0][c] = initial
+	}
+
+	for r := 0; r < v.Height(); r++ {
+		for c := 0; c < v.Width(); c++ {
+			m.matrix[0][c] = f(m.matrix[0][c], v.Get(r, c))
+		}
+	}
+
+	return m
+}
+
+// HadamardProduct will multiply each element of this view by the element at the same coordinates of the
+// given matrix.
+func (v View[T]) HadamardProduct(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if v.Height() != m2.Height() || v.Width() != m2.Width() {
+		return Matrix[T]{}, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "HadamardProduct",
+			LeftRows:  v.Height(),
+			LeftCols:  v.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	m := NewEmpty[T](v.Width(), v.Height())
+
+	for r := 0; r < m.Height(); r++ {
+		for c := 0; c < len(m.matrix[r]); c++ {
+			m.matrix[r][c] = v.Get(r, c) * m2.Get(r, c)
+		}
+	}
+
+	return m, nil
+}
+
+// ElementwiseDivide will divide each element of this view by the element at the same coordinates of the
+// given matrix, returning a DivisionByZeroError if any of them is zero.
+func (v View[T]) ElementwiseDivide(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if v.Height() != m2.Height() || v.Width() != m2.Width() {
+		return Matrix[T]{}, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "ElementwiseDivide",
+			LeftRows:  v.Height(),
+			LeftCols:  v.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	m := NewEmpty[T](v.Width(), v.Height())
+
+	for r := 0; r < m.Height(); r++ {
+		for c := 0; c < len(m.matrix[r]); c++ {
+			d := m2.Get(r, c)
+			if d == 0 {
+				return Matrix[T]{}, &immutabilitybenchmarking.DivisionByZeroError{Op: "ElementwiseDivide", Row: r, Col: c}
+			}
+
+			m.matrix[r][c] = v.Get(r, c) / d
+		}
+	}
+
+	return m, nil
+}
+
+// KroneckerProduct will multiply every element of this view by the whole of the given matrix, returning the
+// blocks of products as a new matrix.
+func (v View[T]) KroneckerProduct(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	m := NewEmpty[T](v.Width()*m2.Width(), v.Height()*m2.Height())
+
+	for r1 := 0; r1 < v.Height(); r1++ {
+		for r2 := 0; r2 < m2.Height(); r2++ {
+			row := m.matrix[r1*m2.Height()+r2]
+
+			for c1 := 0; c1 < v.Width(); c1++ {
+				for c2 := 0; c2 < m2.Width(); c2++ {
+					row[c1*m2.Width()+c2] = v.Get(r1, c1) * m2.Get(r2, c2)
+				}
+			}
+		}
+	}
+
+	return m, nil
+}
+
+// rowReader returns a function returning each row of this view, sharing the rows of the underlying matrix
+// unless the view is transposed.
+func (v View[T]) rowReader() func(r int) []T {
+	if v.transposed {
+		return numeric.RowsOf[T](v)
+	}
+
+	return func(r int) []T {
+		return v.matrix[v.row0+r][v.col0 : v.col0+v.cols]
+	}
+}
+
+// Trace will sum the elements on the diagonal of this view, returning a NotSquareError if it is not square.
+func (v View[T]) Trace() (T, error) {
+	if v.Height() != v.Width() {
+		var zero T
+		return zero, &immutabilitybenchmarking.NotSquareError{Rows: v.Height(), Cols: v.Width()}
+	}
+
+	var trace T
+
+	for i := 0; i < v.Height(); i++ {
+		trace += v.Get(i, i)
+	}
+
+	return trace, nil
+}
+
+// Sum will add together every element of this view.
+func (v View[T]) Sum() T {
+	return numeric.Sum(v.Height(), v.rowReader())
+}
+
+// Min will return the smallest element of this view. Complex elements are ordered by their real parts and
+// then by their imaginary parts.
+func (v View[T]) Min() T {
+	r, c := numeric.ArgMin(v.Height(), v.rowReader())
+
+	return v.Get(r, c)
+}
+
+// Max will return the largest element of this view. Complex elements are ordered by their real parts and
+// then by their imaginary parts.
+func (v View[T]) Max() T {
+	r, c := numeric.ArgMax(v.Height(), v.rowReader())
+
+	return v.Get(r, c)
+}
+
+// ArgMin will return the coordinates of the first smallest element of this view in row-major order.
+func (v View[T]) ArgMin() (int, int) {
+	return numeric.ArgMin(v.Height(), v.rowReader())
+}
+
+// ArgMax will return the coordinates of the first largest element of this view in row-major order.
+func (v View[T]) ArgMax() (int, int) {
+	return numeric.ArgMax(v.Height(), v.rowReader())
+}
+
+// FrobeniusNorm will return the square root of the sum of the squared absolute values of the elements of
+// this view.
+func (v View[T]) FrobeniusNorm() float64 {
+	return numeric.FrobeniusNorm(v.Height(), v.rowReader())
+}
+
+// OneNorm will return the largest sum of the absolute values in a column of this view.
+func (v View[T]) OneNorm() float64 {
+	return numeric.OneNorm(v.Height(), v.Width(), v.rowReader())
+}
+
+// InfNorm will return the largest sum of the absolute values in a row of this view.
+func (v View[T]) InfNorm() float64 {
+	return numeric.InfNorm(v.Height(), v.rowReader())
+}
+
+// Rank will return the number of linearly independent rows of this view, treating values within rounding
+// error of zero as zero.
+func (v View[T]) Rank() int {
+	return numeric.Rank(v.Height(), v.Width(), v.rowReader())
+}
+
+// Power will raise this view to the power n by repeated squaring, returning a NotSquareError if it is not
+// square or a NegativeExponentError if n is negative. The zeroth power is the identity matrix.
+func (v View[T]) Power(n int) (immutabilitybenchmarking.Matrix[T], error) {
+	if v.Height() != v.Width() {
+		return Matrix[T]{}, &immutabilitybenchmarking.NotSquareError{Rows: v.Height(), Cols: v.Width()}
+	}
+
+	if n < 0 {
+		return Matrix[T]{}, &immutabilitybenchmarking.NegativeExponentError{Exponent: n}
+	}
+
+	return power.BySquaring[immutabilitybenchmarking.Matrix[T]](v, n, func() (immutabilitybenchmarking.Matrix[T], error) {
+		return Factory[T]{}.Identity(v.Height())
+	}, nil)
+}
+
+// Reshape will read the elements of this view in row-major order into a new matrix with the given
+// dimensions, returning a ReshapeError if it would not hold the same number of elements.
+func (v View[T]) Reshape(rows int, cols int) (immutabilitybenchmarking.Matrix[T], error) {
+	res, err := shape.Reshape[T](v, rows, cols)
+	if err != nil {
+		return Matrix[T]{}, err
+	}
+
+	return fromResult(res), nil
+}
+
+// HConcat will place the given matrix to the right of this view in a new matrix, returning a
+// DimensionMismatchError if they do not have the same number of rows.
+func (v View[T]) HConcat(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	res, err := shape.HConcat[T](v, m2)
+	if err != nil {
+		return Matrix[T]{}, err
+	}
+
+	return fromResult(res), nil
+}
+
+// VConcat will place the given matrix below this view in a new matrix, returning a DimensionMismatchError
+// if they do not have the same number of columns.
+func (v View[T]) VConcat(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	res, err := shape.Stack[T]("VConcat", v, m2)
+	if err != nil {
+		return Matrix[T]{}, err
+	}
+
+	return fromResult(res), nil
+}
+
+// Stack will place the given matrices below this view in order in a new matrix, returning a
+// DimensionMismatchError for the first that does not have the same number of columns.
+func (v View[T]) Stack(ms ...immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	res, err := shape.Stack[T]("Stack", v, ms...)
+	if err != nil {
+		return Matrix[T]{}, err
+	}
+
+	return fromResult(res), nil
+}
+
+// SplitRows will split this view into views of its rows before at and its rows from at onwards, returning
+// an InvalidDimensionsError if either part would be empty.
+func (v View[T]) SplitRows(at int) (immutabilitybenchmarking.Matrix[T], immutabilitybenchmarking.Matrix[T], error) {
+	if err := shape.CheckSplitRows(v, at); err != nil {
+		return Matrix[T]{}, Matrix[T]{}, err
+	}
+
+	top, _ := v.Slice(0, at, 0, v.Width())
+	bottom, _ := v.Slice(at, v.Height(), 0, v.Width())
+
+	return top, bottom, nil
+}
+
+// SplitCols will split this view into views of its columns before at and its columns from at onwards,
+// returning an InvalidDimensionsError if either part would be empty.
+func (v View[T]) SplitCols(at int) (immutabilitybenchmarking.Matrix[T], immutabilitybenchmarking.Matrix[T], error) {
+	if err := shape.CheckSplitCols(v, at); err != nil {
+		return Matrix[T]{}, Matrix[T]{}, err
+	}
+
+	left, _ := v.Slice(0, v.Height(), 0, at)
+	right, _ := v.Slice(0, v.Height(), at, v.Width())
+
+	return left, right, nil
+}
+
+// Pad will surround this view with the given number of rows and columns of zeros on each side in a new
+// matrix, returning a PaddingError if any of them is negative.
+func (v View[T]) Pad(top int, bottom int, left int, right int) (immutabilitybenchmarking.Matrix[T], error) {
+	res, err := shape.Pad[T](v, top, bottom, left, right)
+	if err != nil {
+		return Matrix[T]{}, err
+	}
+
+	return fromResult(res), nil
+}
+
+// Flip will reverse the order of the rows or the columns of this view in a new matrix.
+func (v View[T]) Flip(axis immutabilitybenchmarking.Axis) immutabilitybenchmarking.Matrix[T] {
+	return fromResult(shape.Flip[T](v, axis))
+}
+
+// Rotate90 will turn this view a quarter turn clockwise in a new matrix.
+func (v View[T]) Rotate90() immutabilitybenchmarking.Matrix[T] {
+	return fromResult(shape.Rotate90[T](v))
+}
@@ -0,0 +1,221 @@
+package immutable
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/chris-tomich/immutability-benchmarking"
+	"github.com/chris-tomich/immutability-benchmarking/matrixtest"
+)
+
+// viewFactory creates matrices as transposed views into the middle of a larger matrix, so the conformance
+// suite exercises both the offsets and the transpose of a view.
+type viewFactory[T immutabilitybenchmarking.Number] struct{}
+
+func (f viewFactory[T]) Zeros(rows int, cols int) (immutabilitybenchmarking.Matrix[T], error) {
+	return f.FromFunc(rows, cols, func(int, int) T {
+		return 0
+	})
+}
+
+func (f viewFactory[T]) Identity(n int) (immutabilitybenchmarking.Matrix[T], error) {
+	return f.FromFunc(n, n, func(row int, col int) T {
+		if row == col {
+			return 1
+		}
+
+		return 0
+	})
+}
+
+func (viewFactory[T]) FromRows(rows [][]T) (immutabilitybenchmarking.Matrix[T], error) {
+	if err := validate(rows); err != nil {
+		return nil, err
+	}
+
+	height, width := len(rows), len(rows[0])
+	backing := NewEmpty[T](height+2, width+2)
+
+	for r := 0; r < len(backing.matrix); r++ {
+		for c := 0; c < len(backing.matrix[r]); c++ {
+			backing.matrix[r][c] = 7
+		}
+	}
+
+	for r := 0; r < height; r++ {
+		for c := 0; c < width; c++ {
+			backing.matrix[c+1][r+1] = rows[r][c]
+		}
+	}
+
+	v, err := backing.Slice(1, width+1, 1, height+1)
+	if err != nil {
+		return nil, err
+	}
+
+	return v.T(), nil
+}
+
+func (f viewFactory[T]) FromFunc(rows int, cols int, fn func(row int, col int) T) (immutabilitybenchmarking.Matrix[T], error) {
+	if rows <= 0 || cols <= 0 {
+		return nil, &immutabilitybenchmarking.InvalidDimensionsError{Rows: rows, Cols: cols}
+	}
+
+	values := make([][]T, rows)
+
+	for r := 0; r < rows; r++ {
+		values[r] = make([]T, cols)
+
+		for c := 0; c < cols; c++ {
+			values[r][c] = fn(r, c)
+		}
+	}
+
+	return f.FromRows(values)
+}
+
+func TestViewConformance(t *testing.T) {
+	t.Run("int", func(t *testing.T) {
+		matrixtest.RunConformance[int](t, viewFactory[int]{})
+	})
+
+	t.Run("uint8", func(t *testing.T) {
+		matrixtest.RunConformance[uint8](t, viewFactory[uint8]{})
+	})
+
+	t.Run("float64", func(t *testing.T) {
+		matrixtest.RunConformance[float64](t, viewFactory[float64]{})
+	})
+
+	t.Run("complex128", func(t *testing.T) {
+		matrixtest.RunConformance[complex128](t, viewFactory[complex128]{})
+	})
+}
+
+func TestViewSlice(t *testing.T) {
+	m := mustNew([][]int{
+		{1, 2, 3, 4},
+		{5, 6, 7, 8},
+		{9, 10, 11, 12},
+	})
+
+	v, err := m.Slice(1, 3, 1, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !v.Equals(mustNew([][]int{{6, 7}, {10, 11}})) {
+		t.Errorf("unexpected slice %v", v.Copy().matrix)
+	}
+
+	row, err := v.Row(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !row.Equals(mustNew([][]int{{10, 11}})) {
+		t.Errorf("unexpected row %v", row.Copy().matrix)
+	}
+
+	col, err := m.Col(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !col.Equals(mustNew([][]int{{4}, {8}, {12}})) {
+		t.Errorf("unexpected column %v", col.Copy().matrix)
+	}
+}
+
+func TestViewTranspose(t *testing.T) {
+	m := mustNew([][]int{
+		{1, 2, 3},
+		{4, 5, 6},
+	})
+
+	if !m.T().Equals(m.Transpose()) {
+		t.Errorf("unexpected transpose %v", m.T().Copy().matrix)
+	}
+
+	if !m.T().T().Equals(m) {
+		t.Error("transposing twice should return the original matrix")
+	}
+
+	// Slicing a transposed view takes its coordinates from the transposed view.
+	v, err := m.T().Slice(1, 3, 0, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !v.Equals(mustNew([][]int{{2}, {3}})) {
+		t.Errorf("unexpected slice %v", v.Copy().matrix)
+	}
+
+	row, err := m.T().Row(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !row.Equals(mustNew([][]int{{3, 6}})) {
+		t.Errorf("unexpected row %v", row.Copy().matrix)
+	}
+}
+
+func TestViewSharesBacking(t *testing.T) {
+	values := [][]int{
+		{1, 2},
+		{3, 4},
+	}
+
+	m, err := NewUnsafeNoCopy(values)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	v := m.T()
+	c := v.Copy()
+
+	values[0][1] = 20
+
+	if v.Get(1, 0) != 20 {
+		t.Errorf("expected the view to read from the shared backing, got %v", v.Get(1, 0))
+	}
+
+	if c.Get(1, 0) != 2 {
+		t.Errorf("expected the copy to be unaffected, got %v", c.Get(1, 0))
+	}
+}
+
+func TestViewSliceErrors(t *testing.T) {
+	m := mustNew([][]int{
+		{1, 2, 3},
+		{4, 5, 6},
+	})
+
+	var outOfBounds *immutabilitybenchmarking.OutOfBoundsError
+	var invalid *immutabilitybenchmarking.InvalidDimensionsError
+
+	if _, err := m.Slice(-1, 1, 0, 1); !errors.As(err, &outOfBounds) {
+		t.Errorf("expected an OutOfBoundsError, got %v", err)
+	}
+
+	if _, err := m.Slice(0, 3, 0, 1); !errors.As(err, &outOfBounds) {
+		t.Errorf("expected an OutOfBoundsError, got %v", err)
+	}
+
+	if _, err := m.T().Slice(0, 3, 0, 3); !errors.As(err, &outOfBounds) {
+		t.Errorf("expected an OutOfBoundsError, got %v", err)
+	}
+
+	if _, err := m.Slice(1, 1, 0, 3); !errors.As(err, &invalid) {
+		t.Errorf("expected an InvalidDimensionsError, got %v", err)
+	}
+
+	if _, err := m.Row(2); !errors.As(err, &outOfBounds) {
+		t.Errorf("expected an OutOfBoundsError, got %v", err)
+	}
+
+	if _, err := m.Col(-1); !errors.As(err, &outOfBounds) {
+		t.Errorf("expected an OutOfBoundsError, got %v", err)
+	}
+}
@@ -0,0 +1,60 @@
+package mutable
+
+import "github.com/chris-tomich/immutability-benchmarking"
+
+// Factory creates mutable matrices backed by a slice per row.
+type Factory[T immutabilitybenchmarking.Number] struct{}
+
+// Zeros creates a rows x cols matrix with every element set to zero.
+func (Factory[T]) Zeros(rows int, cols int) (immutabilitybenchmarking.Matrix[T], error) {
+	m, err := NewEmpty[T](cols, rows)
+	if err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// Identity creates an n x n identity matrix.
+func (f Factory[T]) Identity(n int) (immutabilitybenchmarking.Matrix[T], error) {
+	return f.FromFunc(n, n, func(row int, col int) T {
+		if row == col {
+			return 1
+		}
+
+		return 0
+	})
+}
+
+// FromRows creates a matrix holding a copy of the given rectangular values.
+func (f Factory[T]) FromRows(rows [][]T) (immutabilitybenchmarking.Matrix[T], error) {
+	if len(rows) == 0 || len(rows[0]) == 0 {
+		return nil, &immutabilitybenchmarking.InvalidDimensionsError{Rows: len(rows)}
+	}
+
+	for r := 1; r < len(rows); r++ {
+		if len(rows[r]) != len(rows[0]) {
+			return nil, &immutabilitybenchmarking.JaggedRowsError{Row: r, Width: len(rows[r]), Expected: len(rows[0])}
+		}
+	}
+
+	return f.FromFunc(len(rows), len(rows[0]), func(row int, col int) T {
+		return rows[row][col]
+	})
+}
+
+// FromFunc creates a rows x cols matrix with each element set to the result of f.
+func (Factory[T]) FromFunc(rows int, cols int, f func(row int, col int) T) (immutabilitybenchmarking.Matrix[T], error) {
+	m, err := NewEmpty[T](cols, rows)
+	if err != nil {
+		return nil, err
+	}
+
+	for r := 0; r < rows; r++ {
+		for c := 0; c < cols; c++ {
+			m.matrix[r][c] = f(r, c)
+		}
+	}
+
+	return m, nil
+}
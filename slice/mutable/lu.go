@@ -0,0 +1,230 @@
+package mutable
+
+import (
+	"github.com/chris-tomich/immutability-benchmarking"
+	"github.com/chris-tomich/immutability-benchmarking/internal/numeric"
+)
+
+// LU is an LU factorization with partial pivoting computed in place, LAPACK style. The factorized matrix
+// holds U on and above its diagonal and the multipliers of L, whose diagonal is all ones, below it.
+type LU[T immutabilitybenchmarking.Float] struct {
+	lu *Matrix[T]
+	// pivots holds the row of the original matrix that each row of the factors came from.
+	pivots []int
+	sign   T
+	// singular is the column of the first pivot within rounding error of zero, or -1 if there is none.
+	singular int
+}
+
+// Factorize computes the LU factorization of a square matrix, overwriting m with its factors. Singular
+// matrices can be factorized, but their factors cannot be used to solve systems or find an inverse. A matrix
+// is treated as singular when one of its pivots is within rounding error of zero.
+func Factorize[T immutabilitybenchmarking.Float](m *Matrix[T]) (*LU[T], error) {
+	if m.Height() != m.Width() {
+		return nil, &immutabilitybenchmarking.NotSquareError{Rows: m.Height(), Cols: m.Width()}
+	}
+
+	n := m.Height()
+	a := m.matrix
+
+	f := &LU[T]{lu: m, pivots: make([]int, n), sign: 1, singular: -1}
+
+	for i := 0; i < n; i++ {
+		f.pivots[i] = i
+	}
+
+	tolerance := pivotTolerance(a)
+
+	for k := 0; k < n; k++ {
+		p := k
+
+		for r := k + 1; r < n; r++ {
+			if numeric.Abs(a[r][k]) > numeric.Abs(a[p][k]) {
+				p = r
+			}
+		}
+
+		if p != k {
+			a[p], a[k] = a[k], a[p]
+			f.pivots[p], f.pivots[k] = f.pivots[k], f.pivots[p]
+			f.sign = -f.sign
+		}
+
+		if numeric.Abs(a[k][k]) <= tolerance {
+			if f.singular < 0 {
+				f.singular = k
+			}
+
+			continue
+		}
+
+		for r := k + 1; r < n; r++ {
+			l := a[r][k] / a[k][k]
+			a[r][k] = l
+
+			if l == 0 {
+				continue
+			}
+
+			for c := k + 1; c < n; c++ {
+				a[r][c] -= l * a[k][c]
+			}
+		}
+	}
+
+	return f, nil
+}
+
+// pivotTolerance returns the largest magnitude a pivot of a can have and still be treated as zero. Rounding
+// leaves the pivots of a singular matrix slightly off zero, so they are compared with the rounding error
+// that elimination can accumulate relative to the largest element.
+func pivotTolerance[T immutabilitybenchmarking.Float](a [][]T) T {
+	var scale T
+
+	for _, row := range a {
+		for _, v := range row {
+			scale = max(scale, numeric.Abs(v))
+		}
+	}
+
+	return T(len(a)) * numeric.Epsilon[T]() * scale
+}
+
+// Determinant returns the determinant of the factorized matrix.
+func (f *LU[T]) Determinant() T {
+	if f.singular >= 0 {
+		return 0
+	}
+
+	d := f.sign
+
+	for i := 0; i < len(f.lu.matrix); i++ {
+		d *= f.lu.matrix[i][i]
+	}
+
+	return d
+}
+
+// Solve solves A X = B, where A is the factorized matrix, overwriting b with X.
+func (f *LU[T]) Solve(b *Matrix[T]) (*Matrix[T], error) {
+	n := len(f.lu.matrix)
+
+	if b.Height() != n {
+		return nil, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "Solve",
+			LeftRows:  n,
+			LeftCols:  n,
+			RightRows: b.Height(),
+			RightCols: b.Width(),
+		}
+	}
+
+	if f.singular >= 0 {
+		return nil, &immutabilitybenchmarking.SingularMatrixError{Col: f.singular}
+	}
+
+	a := f.lu.matrix
+
+	// Rows are swapped by reordering the row slices rather than copying their elements.
+	rows := make([][]T, n)
+
+	for i := 0; i < n; i++ {
+		rows[i] = b.matrix[f.pivots[i]]
+	}
+
+	b.matrix = rows
+
+	// Forward substitution with L, whose diagonal is all ones, then back substitution with U.
+	for r := 1; r < n; r++ {
+		for k := 0; k < r; k++ {
+			if a[r][k] == 0 {
+				continue
+			}
+
+			for c := 0; c < len(rows[r]); c++ {
+				rows[r][c] -= a[r][k] * rows[k][c]
+			}
+		}
+	}
+
+	for r := n - 1; r >= 0; r-- {
+		for k := r + 1; k < n; k++ {
+			if a[r][k] == 0 {
+				continue
+			}
+
+			for c := 0; c < len(rows[r]); c++ {
+				rows[r][c] -= a[r][k] * rows[k][c]
+			}
+		}
+
+		for c := 0; c < len(rows[r]); c++ {
+			rows[r][c] /= a[r][r]
+		}
+	}
+
+	return b, nil
+}
+
+// Inverse returns the inverse of the factorized matrix as a new matrix.
+func (f *LU[T]) Inverse() (*Matrix[T], error) {
+	n := len(f.lu.matrix)
+
+	inv, err := NewEmpty[T](n, n)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := 0; i < n; i++ {
+		inv.matrix[i][i] = 1
+	}
+
+	return f.Solve(inv)
+}
+
+// Determinant returns the determinant of a square matrix, overwriting m with its LU factors.
+func Determinant[T immutabilitybenchmarking.Float](m *Matrix[T]) (T, error) {
+	f, err := Factorize(m)
+	if err != nil {
+		return 0, err
+	}
+
+	return f.Determinant(), nil
+}
+
+// Inverse replaces a square matrix with its inverse. A singular matrix is left holding its LU factors.
+func Inverse[T immutabilitybenchmarking.Float](m *Matrix[T]) (*Matrix[T], error) {
+	f, err := Factorize(m)
+	if err != nil {
+		return nil, err
+	}
+
+	inv, err := f.Inverse()
+	if err != nil {
+		return nil, err
+	}
+
+	m.matrix = inv.matrix
+
+	return m, nil
+}
+
+// Solve solves m X = b, overwriting m with its LU factors and b with X.
+func Solve[T immutabilitybenchmarking.Float](m *Matrix[T], b *Matrix[T]) (*Matrix[T], error) {
+	if m.Height() == m.Width() && b.Height() != m.Height() {
+		return nil, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "Solve",
+			LeftRows:  m.Height(),
+			LeftCols:  m.Width(),
+			RightRows: b.Height(),
+			RightCols: b.Width(),
+		}
+	}
+
+	f, err := Factorize(m)
+	if err != nil {
+		return nil, err
+	}
+
+	return f.Solve(b)
+}
@@ -0,0 +1,159 @@
+package mutable
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/chris-tomich/immutability-benchmarking"
+)
+
+// pivoted needs a row swap before its first column can be eliminated and has a determinant of 3.
+func pivoted() *Matrix[float64] {
+	return mustNew([][]float64{
+		{0, 2, 1},
+		{1, 1, 1},
+		{2, 1, 0},
+	})
+}
+
+// approxEquals reports whether the matrices match to within a small tolerance.
+func approxEquals(m1 immutabilitybenchmarking.Matrix[float64], m2 immutabilitybenchmarking.Matrix[float64]) bool {
+	if m1.Height() != m2.Height() || m1.Width() != m2.Width() {
+		return false
+	}
+
+	for r := 0; r < m1.Height(); r++ {
+		for c := 0; c < m1.Width(); c++ {
+			if math.Abs(m1.Get(r, c)-m2.Get(r, c)) > 1e-9 {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func TestMutableDeterminant(t *testing.T) {
+	d, err := Determinant(pivoted())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if math.Abs(d-3) > 1e-12 {
+		t.Errorf("expected a determinant of 3 but got %v", d)
+	}
+
+	d, err = Determinant(mustNew([][]float64{{1, 2}, {2, 4}}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if d != 0 {
+		t.Errorf("expected a singular matrix to have a determinant of 0 but got %v", d)
+	}
+}
+
+func TestMutableInverse(t *testing.T) {
+	m := pivoted()
+
+	inv, err := Inverse(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if inv != m {
+		t.Error("expected the inverse to replace the matrix")
+	}
+
+	identity, _ := Factory[float64]{}.Identity(3)
+	product, _ := pivoted().MatrixMultiply(inv)
+
+	if !approxEquals(product, identity) {
+		t.Errorf("expected the product with the inverse to be the identity but got %v", product)
+	}
+}
+
+func TestMutableSolve(t *testing.T) {
+	size := 20
+	a := make([][]float64, size)
+	b := make([][]float64, size)
+
+	for r := 0; r < size; r++ {
+		a[r] = make([]float64, size)
+		b[r] = []float64{rand.Float64(), rand.Float64()}
+
+		for c := 0; c < size; c++ {
+			a[r][c] = rand.Float64()
+		}
+	}
+
+	x, err := Solve(mustNew(copyRows(a)), mustNew(copyRows(b)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ax, _ := mustNew(a).MatrixMultiply(x)
+
+	if !approxEquals(ax, mustNew(b)) {
+		t.Error("expected the solution to satisfy the system")
+	}
+}
+
+func copyRows(rows [][]float64) [][]float64 {
+	c := make([][]float64, len(rows))
+
+	for r := range rows {
+		c[r] = append([]float64(nil), rows[r]...)
+	}
+
+	return c
+}
+
+func TestMutableFactorizeInPlace(t *testing.T) {
+	m := pivoted()
+
+	f, err := Factorize(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The largest pivot in the first column is the 2 in the last row.
+	if m.Get(0, 0) != 2 || f.pivots[0] != 2 {
+		t.Errorf("expected the factors to overwrite the matrix, got %v", m.matrix)
+	}
+}
+
+func TestMutableLUErrors(t *testing.T) {
+	var notSquare *immutabilitybenchmarking.NotSquareError
+	if _, err := Factorize(mustNew([][]float64{{1, 2}})); !errors.As(err, &notSquare) {
+		t.Errorf("expected a NotSquareError but got %v", err)
+	}
+
+	var singular *immutabilitybenchmarking.SingularMatrixError
+	if _, err := Inverse(mustNew([][]float64{{1, 2}, {2, 4}})); !errors.As(err, &singular) || singular.Col != 1 {
+		t.Errorf("expected a SingularMatrixError for column 1 but got %v", err)
+	}
+
+	m := pivoted()
+
+	// Eliminating the first two columns leaves a last pivot of about 1e-16 rather than an exact zero.
+	rounded := func() *Matrix[float64] { return mustNew([][]float64{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}}) }
+	if _, err := Inverse(rounded()); !errors.As(err, &singular) || singular.Col != 2 {
+		t.Errorf("expected a SingularMatrixError for column 2 but got %v", err)
+	}
+
+	if d, err := Determinant(rounded()); err != nil || d != 0 {
+		t.Errorf("expected a determinant of 0 but got %v, %v", d, err)
+	}
+
+	var mismatch *immutabilitybenchmarking.DimensionMismatchError
+	if _, err := Solve(m, mustNew([][]float64{{1}, {2}})); !errors.As(err, &mismatch) {
+		t.Errorf("expected a DimensionMismatchError but got %v", err)
+	}
+
+	if !m.Equals(pivoted()) {
+		t.Error("expected a failed solve to leave the matrix unfactorized")
+	}
+}
@@ -1,54 +1,96 @@
 package mutable
 
 import (
-	"github.com/pkg/errors"
 	"github.com/chris-tomich/immutability-benchmarking"
+	"github.com/chris-tomich/immutability-benchmarking/internal/numeric"
+	"github.com/chris-tomich/immutability-benchmarking/internal/power"
+	"github.com/chris-tomich/immutability-benchmarking/internal/shape"
 )
 
 // Matrix is a matrix with mutating operations.
-type Matrix struct {
-	matrix [][]int
+type Matrix[T immutabilitybenchmarking.Number] struct {
+	matrix [][]T
 }
 
-// New creates a new matrix with the given initial values.
-func New(matrix [][]int) *Matrix {
-	return &Matrix{matrix: matrix}
+// New creates a new matrix that operates directly on the given initial values.
+func New[T immutabilitybenchmarking.Number](matrix [][]T) (*Matrix[T], error) {
+	if len(matrix) == 0 || len(matrix[0]) == 0 {
+		return nil, &immutabilitybenchmarking.InvalidDimensionsError{Rows: len(matrix)}
+	}
+
+	for r := 1; r < len(matrix); r++ {
+		if len(matrix[r]) != len(matrix[0]) {
+			return nil, &immutabilitybenchmarking.JaggedRowsError{Row: r, Width: len(matrix[r]), Expected: len(matrix[0])}
+		}
+	}
+
+	return &Matrix[T]{matrix: matrix}, nil
 }
 
 // NewEmpty createas a new empty matrix with the given dimensions.
-func NewEmpty(width int, height int) (*Matrix, error) {
-	if width == 0 || height == 0 {
-		return nil, errors.New("width and height must both be non-zero")
+func NewEmpty[T immutabilitybenchmarking.Number](width int, height int) (*Matrix[T], error) {
+	if width <= 0 || height <= 0 {
+		return nil, &immutabilitybenchmarking.InvalidDimensionsError{Rows: height, Cols: width}
 	}
 
-	m := &Matrix{
-		matrix: make([][]int, height),
+	m := &Matrix[T]{
+		matrix: make([][]T, height),
 	}
 
 	for i := 0; i < len(m.matrix); i++ {
-		m.matrix[i] = make([]int, width)
+		m.matrix[i] = make([]T, width)
 	}
 
 	return m, nil
 }
 
 // Width returns the number of columns in the matrix.
-func (m *Matrix) Width() int {
+func (m *Matrix[T]) Width() int {
+	if len(m.matrix) == 0 {
+		return 0
+	}
+
 	return len(m.matrix[0])
 }
 
 // Height returns the number of rows in the matrix.
-func (m *Matrix) Height() int {
+func (m *Matrix[T]) Height() int {
 	return len(m.matrix)
 }
 
-// Get returns the integer at the provided coordinates.
-func (m *Matrix) Get(row int, col int) int {
+// Get returns the element at the provided coordinates.
+func (m *Matrix[T]) Get(row int, col int) T {
 	return m.matrix[row][col]
 }
 
+// At returns the element at the provided coordinates or an OutOfBoundsError if they are outside the matrix.
+func (m *Matrix[T]) At(row int, col int) (T, error) {
+	if row < 0 || row >= m.Height() || col < 0 || col >= m.Width() {
+		var zero T
+		return zero, &immutabilitybenchmarking.OutOfBoundsError{Row: row, Col: col, Rows: m.Height(), Cols: m.Width()}
+	}
+
+	return m.matrix[row][col], nil
+}
+
+// MustAt returns the element at the provided coordinates and panics with an OutOfBoundsError if they are
+// outside the matrix.
+func (m *Matrix[T]) MustAt(row int, col int) T {
+	v, err := m.At(row, col)
+	if err != nil {
+		panic(err)
+	}
+
+	return v
+}
+
+// Set replaces the element at the provided coordinates in place.
+func (m *Matrix[T]) Set(row int, col int, v T) {
+	m.matrix[row][col] = v
+}
+
 // Equals will compare a matrix against this matrix and return if they are equal.
-func (m *Matrix) Equals(m2 immutabilitybenchmarking.Matrix) bool {
+func (m *Matrix[T]) Equals(m2 immutabilitybenchmarking.Matrix[T]) bool {
 	if m.Height() != m2.Height() {
 		return false
 	}
@@ -69,18 +111,20 @@ func (m *Matrix) Equals(m2 immutabilitybenchmarking.Matrix) bool {
 }
 
 // Add will add the values of a matrix to this matrix.
-func (m *Matrix) Add(m2 immutabilitybenchmarking.Matrix) (immutabilitybenchmarking.Matrix, error) {
-	if m.Height() != m2.Height() {
-		return nil, errors.New("width of both matrices are not the same")
-	}
-
-	if m.Width() != m2.Width() {
-		return nil, errors.New("height of both matrices are not the same")
+func (m *Matrix[T]) Add(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m.Height() != m2.Height() || m.Width() != m2.Width() {
+		return nil, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "Add",
+			LeftRows:  m.Height(),
+			LeftCols:  m.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
 	}
 
 	for r := 0; r < len(m.matrix); r++ {
 		for c := 0; c < len(m.matrix[r]); c++ {
-			m.matrix[r][c] = m.matrix[r][c] + m2.Get(r ,c)
+			m.matrix[r][c] = m.matrix[r][c] + m2.Get(r, c)
 		}
 	}
 
@@ -88,13 +132,15 @@ func (m *Matrix) Add(m2 immutabilitybenchmarking.Matrix) (immutabilitybenchmarki
 }
 
 // Subtract will subtract the values of a matrix from this matrix.
-func (m *Matrix) Subtract(m2 immutabilitybenchmarking.Matrix) (immutabilitybenchmarking.Matrix, error) {
-	if m.Height() != m2.Height() {
-		return nil, errors.New("width of both matrices are not the same")
-	}
-
-	if m.Width() != m2.Width() {
-		return nil, errors.New("height of both matrices are not the same")
+func (m *Matrix[T]) Subtract(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m.Height() != m2.Height() || m.Width() != m2.Width() {
+		return nil, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "Subtract",
+			LeftRows:  m.Height(),
+			LeftCols:  m.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
 	}
 
 	for r := 0; r < len(m.matrix); r++ {
@@ -107,7 +153,7 @@ func (m *Matrix) Subtract(m2 immutabilitybenchmarking.Matrix) (immutabilitybench
 }
 
 // ScalarMultiply will multiply this matrix by a given scalar value.
-func (m *Matrix) ScalarMultiply(s int) immutabilitybenchmarking.Matrix {
+func (m *Matrix[T]) ScalarMultiply(s T) immutabilitybenchmarking.Matrix[T] {
 	for r := 0; r < len(m.matrix); r++ {
 		for c := 0; c < len(m.matrix[r]); c++ {
 			m.matrix[r][c] = m.matrix[r][c] * s
@@ -118,13 +164,13 @@ func (m *Matrix) ScalarMultiply(s int) immutabilitybenchmarking.Matrix {
 }
 
 // Transpose will transpose this matrix.
-func (m *Matrix) Transpose() immutabilitybenchmarking.Matrix {
-	t := make([][]int, len(m.matrix[0]))
+func (m *Matrix[T]) Transpose() immutabilitybenchmarking.Matrix[T] {
+	t := make([][]T, len(m.matrix[0]))
 
 	for rt := 0; rt < len(t); rt++ {
 		for ct := 0; ct < len(m.matrix); ct++ {
 			if t[rt] == nil {
-				t[rt] = make([]int, len(m.matrix))
+				t[rt] = make([]T, len(m.matrix))
 			}
 			t[rt][ct] = m.matrix[ct][rt]
 		}
@@ -136,20 +182,26 @@ func (m *Matrix) Transpose() immutabilitybenchmarking.Matrix {
 }
 
 // MatrixMultiply will multiple the given matrix against this matrix.
-func (m *Matrix) MatrixMultiply(m2 immutabilitybenchmarking.Matrix) (immutabilitybenchmarking.Matrix, error) {
+func (m *Matrix[T]) MatrixMultiply(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
 	if m.Width() != m2.Height() {
-		return nil, errors.New("the dimensions of the matrices are incompatible, try transposing one first")
+		return nil, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "MatrixMultiply",
+			LeftRows:  m.Height(),
+			LeftCols:  m.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
 	}
 
-	n := make([][]int, m.Height())
+	n := make([][]T, m.Height())
 
 	for i := 0; i < m.Height(); i++ {
-		n[i] = make([]int, m2.Width())
+		n[i] = make([]T, m2.Width())
 	}
 
 	for rm := 0; rm < m.Height(); rm++ {
 		for cm2 := 0; cm2 < m2.Width(); cm2++ {
-			product := 0
+			var product T
 			for cm := 0; cm < m.Width(); cm++ {
 				product = product + m.matrix[rm][cm]*m2.Get(cm, cm2)
 			}
@@ -161,3 +213,479 @@ func (m *Matrix) MatrixMultiply(m2 immutabilitybenchmarking.Matrix) (immutabilit
 
 	return m, nil
 }
+
+// Map will apply f to every element of this matrix.
+func (m *Matrix[T]) Map(f func(v T) T) immutabilitybenchmarking.Matrix[T] {
+	for r := 0; r < len(m.matrix); r++ {
+		for c := 0; c < len(m.matrix[r]); c++ {
+			m.matrix[r][c] = f(m.matrix[r][c])
+		}
+	}
+
+	return m
+}
+
+// MapIndexed will apply f to every element of this matrix along with its coordinates.
+func (m *Matrix[T]) MapIndexed(f func(row int, col int, v T) T) immutabilitybenchmarking.Matrix[T] {
+	for r := 0; r < len(m.matrix); r++ {
+		for c := 0; c < len(m.matrix[r]); c++ {
+			m.matrix[r][c] = f(r, c, m.matrix[r][c])
+		}
+	}
+
+	return m
+}
+
+// ZipWith will combine each element of this matrix with the element at the same coordinates of the given
+// matrix using f.
+func (m *Matrix[T]) ZipWith(m2 immutabilitybenchmarking.Matrix[T], f func(a T, b T) T) (immutabilitybenchmarking.Matrix[T], error) {
+	if m.Height() != m2.Height() || m.Width() != m2.Width() {
+		return nil, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "ZipWith",
+			LeftRows:  m.Height(),
+			LeftCols:  m.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	for r := 0; r < len(m.matrix); r++ {
+		for c := 0; c < len(m.matrix[r]); c++ {
+			m.matrix[r][c] = f(m.matrix[r][c], m2.Get(r, c))
+		}
+	}
+
+	return m, nil
+}
+
+// Fold will combine every element of this matrix into a single value, starting from initial and visiting
+// the elements in row-major order.
+func (m *Matrix[T]) Fold(initial T, f func(acc T, v T) T) T {
+	acc := initial
+
+	for r := 0; r < len(m.matrix); r++ {
+		for c := 0; c < len(m.matrix[r]); c++ {
+			acc = f(acc, m.matrix[r][c])
+		}
+	}
+
+	return acc
+}
+
+// RowReduce will fold each row of this matrix into a single value starting from initial, replacing this
+// matrix with a column of the results.
+func (m *Matrix[T]) RowReduce(initial T, f func(acc T, v T) T) immutabilitybenchmarking.Matrix[T] {
+	for r := 0; r < len(m.matrix); r++ {
+		acc := initial
+
+		for c := 0; c < len(m.matrix[r]); c++ {
+			acc = f(acc, m.matrix[r][c])
+		}
+
+		m.matrix[r] = append(m.matrix[r][:0], acc)
+	}
+
+	return m
+}
+
+// ColReduce will fold each column of this matrix into a single value starting from initial, replacing this
+// matrix with a row of the results.
+func (m *Matrix[T]) ColReduce(initial T, f func(acc T, v T) T) immutabilitybenchmarking.Matrix[T] {
+	n := make([]T, m.Width())
+
+	for c := 0; c < len(n); c++ {
+		n[c] = initial
+	}
+
+	for r := 0; r < len(m.matrix); r++ {
+		for c := 0; c < len(m.matrix[r]); c++ {
+			n[c] = f(n[c], m.matrix[r][c])
+		}
+	}
+
+	m.matrix = [][]T{n}
+
+	return m
+}
+
+// HadamardProduct will multiply each element of this matrix by the element at the same coordinates of the
+// given matrix.
+func (m *Matrix[T]) HadamardProduct(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m.Height() != m2.Height() || m.Width() != m2.Width() {
+		return nil, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "HadamardProduct",
+			LeftRows:  m.Height(),
+			LeftCols:  m.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	for r := 0; r < len(m.matrix); r++ {
+		for c := 0; c < len(m.matrix[r]); c++ {
+			m.matrix[r][c] = m.matrix[r][c] * m2.Get(r, c)
+		}
+	}
+
+	return m, nil
+}
+
+// ElementwiseDivide will divide each element of this matrix by the element at the same coordinates of the
+// given matrix. The divisor is checked for zeros before any element is changed, so a DivisionByZeroError
+// leaves this matrix as it was.
+func (m *Matrix[T]) ElementwiseDivide(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m.Height() != m2.Height() || m.Width() != m2.Width() {
+		return nil, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "ElementwiseDivide",
+			LeftRows:  m.Height(),
+			LeftCols:  m.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	for r := 0; r < len(m.matrix); r++ {
+		for c := 0; c < len(m.matrix[r]); c++ {
+			if m2.Get(r, c) == 0 {
+				return nil, &immutabilitybenchmarking.DivisionByZeroError{Op: "ElementwiseDivide", Row: r, Col: c}
+			}
+		}
+	}
+
+	for r := 0; r < len(m.matrix); r++ {
+		for c := 0; c < len(m.matrix[r]); c++ {
+			m.matrix[r][c] = m.matrix[r][c] / m2.Get(r, c)
+		}
+	}
+
+	return m, nil
+}
+
+// KroneckerProduct will multiply every element of this matrix by the whole of the given matrix, replacing
+// this matrix with the blocks of products.
+func (m *Matrix[T]) KroneckerProduct(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	n := make([][]T, m.Height()*m2.Height())
+
+	for r1 := 0; r1 < m.Height(); r1++ {
+		for r2 := 0; r2 < m2.Height(); r2++ {
+			row := make([]T, m.Width()*m2.Width())
+
+			for c1 := 0; c1 < m.Width(); c1++ {
+				for c2 := 0; c2 < m2.Width(); c2++ {
+					row[c1*m2.Width()+c2] = m.matrix[r1][c1] * m2.Get(r2, c2)
+				}
+			}
+
+			n[r1*m2.Height()+r2] = row
+		}
+	}
+
+	m.matrix = n
+
+	return m, nil
+}
+
+// row returns row r of this matrix without copying it.
+func (m *Matrix[T]) row(r int) []T {
+	return m.matrix[r]
+}
+
+// Trace will sum the elements on the diagonal of this matrix, returning a NotSquareError if it is not square.
+func (m *Matrix[T]) Trace() (T, error) {
+	if m.Height() != m.Width() {
+		var zero T
+		return zero, &immutabilitybenchmarking.NotSquareError{Rows: m.Height(), Cols: m.Width()}
+	}
+
+	var trace T
+
+	for i := 0; i < m.Height(); i++ {
+		trace += m.matrix[i][i]
+	}
+
+	return trace, nil
+}
+
+// Sum will add together every element of this matrix.
+func (m *Matrix[T]) Sum() T {
+	return numeric.Sum(m.Height(), m.row)
+}
+
+// Min will return the smallest element of this matrix. Complex elements are ordered by their real parts and
+// then by their imaginary parts.
+func (m *Matrix[T]) Min() T {
+	r, c := numeric.ArgMin(m.Height(), m.row)
+
+	return m.matrix[r][c]
+}
+
+// Max will return the largest element of this matrix. Complex elements are ordered by their real parts and
+// then by their imaginary parts.
+func (m *Matrix[T]) Max() T {
+	r, c := numeric.ArgMax(m.Height(), m.row)
+
+	return m.matrix[r][c]
+}
+
+// ArgMin will return the coordinates of the first smallest element of this matrix in row-major order.
+func (m *Matrix[T]) ArgMin() (int, int) {
+	return numeric.ArgMin(m.Height(), m.row)
+}
+
+// ArgMax will return the coordinates of the first largest element of this matrix in row-major order.
+func (m *Matrix[T]) ArgMax() (int, int) {
+	return numeric.ArgMax(m.Height(), m.row)
+}
+
+// FrobeniusNorm will return the square root of the sum of the squared absolute values of the elements of
+// this matrix.
+func (m *Matrix[T]) FrobeniusNorm() float64 {
+	return numeric.FrobeniusNorm(m.Height(), m.row)
+}
+
+// OneNorm will return the largest sum of the absolute values in a column of this matrix.
+func (m *Matrix[T]) OneNorm() float64 {
+	return numeric.OneNorm(m.Height(), m.Width(), m.row)
+}
+
+// InfNorm will return the largest sum of the absolute values in a row of this matrix.
+func (m *Matrix[T]) InfNorm() float64 {
+	return numeric.InfNorm(m.Height(), m.row)
+}
+
+// Rank will return the number of linearly independent rows of this matrix, treating values within rounding
+// error of zero as zero.
+func (m *Matrix[T]) Rank() int {
+	return numeric.Rank(m.Height(), m.Width(), m.row)
+}
+
+// clone returns a copy of this matrix that does not share its rows.
+func (m *Matrix[T]) clone() *Matrix[T] {
+	c := &Matrix[T]{matrix: make([][]T, len(m.matrix))}
+
+	for r := range m.matrix {
+		c.matrix[r] = append([]T(nil), m.matrix[r]...)
+	}
+
+	return c
+}
+
+// Power will raise this matrix to the power n in place by repeated squaring, returning a NotSquareError if it
+// is not square or a NegativeExponentError if n is negative. The zeroth power is the identity matrix.
+func (m *Matrix[T]) Power(n int) (immutabilitybenchmarking.Matrix[T], error) {
+	if m.Height() != m.Width() {
+		return nil, &immutabilitybenchmarking.NotSquareError{Rows: m.Height(), Cols: m.Width()}
+	}
+
+	if n < 0 {
+		return nil, &immutabilitybenchmarking.NegativeExponentError{Exponent: n}
+	}
+
+	// Squaring replaces the elements of m, so the first power kept for the result has to be a copy.
+	p, err := power.BySquaring[immutabilitybenchmarking.Matrix[T]](m, n, func() (immutabilitybenchmarking.Matrix[T], error) {
+		return Factory[T]{}.Identity(m.Height())
+	}, func(b immutabilitybenchmarking.Matrix[T]) immutabilitybenchmarking.Matrix[T] {
+		return b.(*Matrix[T]).clone()
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	m.matrix = p.(*Matrix[T]).matrix
+
+	return m, nil
+}
+
+// Reshape will read the elements of this matrix in row-major order into the given dimensions, returning a
+// ReshapeError if they would not hold the same number of elements. The new rows are cut from a single block.
+func (m *Matrix[T]) Reshape(rows int, cols int) (immutabilitybenchmarking.Matrix[T], error) {
+	if err := shape.CheckReshape(m, rows, cols); err != nil {
+		return nil, err
+	}
+
+	if rows == m.Height() {
+		return m, nil
+	}
+
+	block := make([]T, 0, rows*cols)
+
+	for _, row := range m.matrix {
+		block = append(block, row...)
+	}
+
+	m.matrix = make([][]T, rows)
+
+	for r := range m.matrix {
+		m.matrix[r] = block[r*cols : (r+1)*cols : (r+1)*cols]
+	}
+
+	return m, nil
+}
+
+// HConcat will append the columns of the given matrix to the rows of this matrix, returning a
+// DimensionMismatchError if they do not have the same number of rows.
+func (m *Matrix[T]) HConcat(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if err := shape.CheckHConcat(m, m2); err != nil {
+		return nil, err
+	}
+
+	// Appending leaves the existing elements of each row untouched, so m2 can be m itself.
+	w2 := m2.Width()
+
+	for r, row := range m.matrix {
+		for c := 0; c < w2; c++ {
+			row = append(row, m2.Get(r, c))
+		}
+
+		m.matrix[r] = row
+	}
+
+	return m, nil
+}
+
+// VConcat will append copies of the rows of the given matrix to this matrix, returning a
+// DimensionMismatchError if they do not have the same number of columns.
+func (m *Matrix[T]) VConcat(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	return m.stack("VConcat", m2)
+}
+
+// Stack will append copies of the rows of each of the given matrices to this matrix in order, returning a
+// DimensionMismatchError for the first that does not have the same number of columns.
+func (m *Matrix[T]) Stack(ms ...immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	return m.stack("Stack", ms...)
+}
+
+func (m *Matrix[T]) stack(op string, ms ...immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if err := shape.CheckStack(op, m, ms...); err != nil {
+		return nil, err
+	}
+
+	// Any of ms can be m itself, so their heights are taken before the first row is appended.
+	heights := make([]int, len(ms))
+
+	for i, m2 := range ms {
+		heights[i] = m2.Height()
+	}
+
+	w := m.Width()
+
+	for i, m2 := range ms {
+		for r := 0; r < heights[i]; r++ {
+			row := make([]T, w)
+
+			for c := range row {
+				row[c] = m2.Get(r, c)
+			}
+
+			m.matrix = append(m.matrix, row)
+		}
+	}
+
+	return m, nil
+}
+
+// SplitRows will keep the rows of this matrix before at and return them along with a new matrix holding the
+// rows from at onwards, returning an InvalidDimensionsError if either part would be empty. The rows are
+// handed over to the new matrix without being copied.
+func (m *Matrix[T]) SplitRows(at int) (immutabilitybenchmarking.Matrix[T], immutabilitybenchmarking.Matrix[T], error) {
+	if err := shape.CheckSplitRows(m, at); err != nil {
+		return nil, nil, err
+	}
+
+	rest := &Matrix[T]{matrix: m.matrix[at:]}
+	m.matrix = m.matrix[:at:at]
+
+	return m, rest, nil
+}
+
+// SplitCols will keep the columns of this matrix before at and return them along with a new matrix holding
+// the columns from at onwards, returning an InvalidDimensionsError if either part would be empty. Each row is
+// cut in two without being copied.
+func (m *Matrix[T]) SplitCols(at int) (immutabilitybenchmarking.Matrix[T], immutabilitybenchmarking.Matrix[T], error) {
+	if err := shape.CheckSplitCols(m, at); err != nil {
+		return nil, nil, err
+	}
+
+	rest := &Matrix[T]{matrix: make([][]T, m.Height())}
+
+	for r, row := range m.matrix {
+		rest.matrix[r] = row[at:]
+		m.matrix[r] = row[:at:at]
+	}
+
+	return m, rest, nil
+}
+
+// Pad will surround this matrix with the given number of rows and columns of zeros on each side, returning
+// a PaddingError if any of them is negative.
+func (m *Matrix[T]) Pad(top int, bottom int, left int, right int) (immutabilitybenchmarking.Matrix[T], error) {
+	if err := shape.CheckPad(top, bottom, left, right); err != nil {
+		return nil, err
+	}
+
+	w := left + m.Width() + right
+	n := make([][]T, top+m.Height()+bottom)
+
+	for r := range n {
+		n[r] = make([]T, w)
+
+		if r >= top && r < top+m.Height() {
+			copy(n[r][left:], m.matrix[r-top])
+		}
+	}
+
+	m.matrix = n
+
+	return m, nil
+}
+
+// Flip will reverse the order of the rows or the columns of this matrix in place.
+func (m *Matrix[T]) Flip(axis immutabilitybenchmarking.Axis) immutabilitybenchmarking.Matrix[T] {
+	if axis == immutabilitybenchmarking.RowAxis {
+		for i, j := 0, len(m.matrix)-1; i < j; i, j = i+1, j-1 {
+			m.matrix[i], m.matrix[j] = m.matrix[j], m.matrix[i]
+		}
+
+		return m
+	}
+
+	for _, row := range m.matrix {
+		for i, j := 0, len(row)-1; i < j; i, j = i+1, j-1 {
+			row[i], row[j] = row[j], row[i]
+		}
+	}
+
+	return m
+}
+
+// Rotate90 will turn this matrix a quarter turn clockwise. Square matrices are rotated in place, a ring of
+// four elements at a time.
+func (m *Matrix[T]) Rotate90() immutabilitybenchmarking.Matrix[T] {
+	h, w := m.Height(), m.Width()
+
+	if h == w {
+		a := m.matrix
+
+		for r := 0; r < h/2; r++ {
+			for c := r; c < h-1-r; c++ {
+				a[r][c], a[c][h-1-r], a[h-1-r][h-1-c], a[h-1-c][r] = a[h-1-c][r], a[r][c], a[c][h-1-r], a[h-1-r][h-1-c]
+			}
+		}
+
+		return m
+	}
+
+	n := make([][]T, w)
+
+	for r := range n {
+		n[r] = make([]T, h)
+
+		for c := range n[r] {
+			n[r][c] = m.matrix[h-1-c][r]
+		}
+	}
+
+	m.matrix = n
+
+	return m
+}
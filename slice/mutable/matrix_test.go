@@ -1,19 +1,23 @@
 package mutable
 
 import (
-	"testing"
+	"errors"
 	"fmt"
+	"testing"
+
+	"github.com/chris-tomich/immutability-benchmarking"
+	"github.com/chris-tomich/immutability-benchmarking/matrixtest"
 )
 
 func TestMutableMatrixMultiplication(t *testing.T) {
-	m1 := New(
+	m1 := mustNew(
 		[][]int{
 			{2, 3, 4},
 			{1, 0, 0},
 		},
 	)
 
-	m2 := New(
+	m2 := mustNew(
 		[][]int{
 			{0, 1000},
 			{1, 100},
@@ -22,7 +26,7 @@ func TestMutableMatrixMultiplication(t *testing.T) {
 	)
 
 	m1.MatrixMultiply(m2)
-	m1EqualsM2 := m1.Equals(New(
+	m1EqualsM2 := m1.Equals(mustNew(
 		[][]int{
 			{3, 2340},
 			{0, 1000},
@@ -34,13 +38,13 @@ func TestMutableMatrixMultiplication(t *testing.T) {
 		t.Fail()
 	}
 
-	m3 := New(
+	m3 := mustNew(
 		[][]int{
 			{2, 3, 4},
 		},
 	)
 
-	m4 := New(
+	m4 := mustNew(
 		[][]int{
 			{0, 1000},
 			{1, 100},
@@ -49,7 +53,7 @@ func TestMutableMatrixMultiplication(t *testing.T) {
 	)
 
 	m3.MatrixMultiply(m4)
-	m3EqualsM4 := m3.Equals(New(
+	m3EqualsM4 := m3.Equals(mustNew(
 		[][]int{
 			{3, 2340},
 		},
@@ -60,14 +64,14 @@ func TestMutableMatrixMultiplication(t *testing.T) {
 		t.Fail()
 	}
 
-	m5 := New(
+	m5 := mustNew(
 		[][]int{
 			{2, 3, 4},
 			{1, 0, 0},
 		},
 	)
 
-	m6 := New(
+	m6 := mustNew(
 		[][]int{
 			{0},
 			{1},
@@ -76,7 +80,7 @@ func TestMutableMatrixMultiplication(t *testing.T) {
 	)
 
 	m5.MatrixMultiply(m6)
-	m5EqualsM6 := m5.Equals(New(
+	m5EqualsM6 := m5.Equals(mustNew(
 		[][]int{
 			{3},
 			{0},
@@ -88,3 +92,113 @@ func TestMutableMatrixMultiplication(t *testing.T) {
 		t.Fail()
 	}
 }
+
+func TestMutableComplexMatrixMultiplication(t *testing.T) {
+	m1 := mustNew(
+		[][]complex128{
+			{1i, 2},
+			{0, 1 - 1i},
+		},
+	)
+
+	m2 := mustNew(
+		[][]complex128{
+			{1i},
+			{3},
+		},
+	)
+
+	m1.MatrixMultiply(m2)
+	m1EqualsM2 := m1.Equals(mustNew(
+		[][]complex128{
+			{5},
+			{3 - 3i},
+		},
+	))
+
+	if !m1EqualsM2 {
+		fmt.Println(m1)
+		t.Fail()
+	}
+}
+
+func TestMutableMatrixDimensionMismatch(t *testing.T) {
+	m1 := mustNew([][]int{{1, 2, 3}})
+	m2 := mustNew([][]int{{1}, {2}})
+
+	_, err := m1.Subtract(m2)
+
+	var e *immutabilitybenchmarking.DimensionMismatchError
+	if !errors.As(err, &e) {
+		t.Fatalf("expected a DimensionMismatchError but got %v", err)
+	}
+
+	if e.Op != "Subtract" || e.LeftRows != 1 || e.LeftCols != 3 || e.RightRows != 2 || e.RightCols != 1 {
+		t.Errorf("unexpected error details %+v", e)
+	}
+}
+
+func TestMutableMatrixFactory(t *testing.T) {
+	var f immutabilitybenchmarking.Factory[int] = Factory[int]{}
+
+	values := [][]int{
+		{1, 2},
+		{3, 4},
+	}
+
+	m1, err := f.FromRows(values)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	values[0][0] = 100
+
+	if m1.Get(0, 0) != 1 {
+		t.Error("expected FromRows to copy its input")
+	}
+
+	m2, err := f.Identity(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m3, err := m2.MatrixMultiply(m1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !m3.Equals(m1) {
+		t.Error("expected multiplying by the identity to return the same values")
+	}
+
+	if _, err := f.Zeros(-1, 2); err == nil {
+		t.Error("expected a negative number of rows to be rejected")
+	}
+}
+
+func TestMutableMatrixConformance(t *testing.T) {
+	t.Run("int", func(t *testing.T) {
+		matrixtest.RunConformance[int](t, Factory[int]{})
+	})
+
+	t.Run("uint8", func(t *testing.T) {
+		matrixtest.RunConformance[uint8](t, Factory[uint8]{})
+	})
+
+	t.Run("float64", func(t *testing.T) {
+		matrixtest.RunConformance[float64](t, Factory[float64]{})
+	})
+
+	t.Run("complex128", func(t *testing.T) {
+		matrixtest.RunConformance[complex128](t, Factory[complex128]{})
+	})
+}
+
+func mustNew[T immutabilitybenchmarking.Number](matrix [][]T) *Matrix[T] {
+	m, err := New(matrix)
+	if err != nil {
+		panic(err)
+	}
+
+	return m
+}
@@ -1,74 +1,128 @@
 package slice
 
 import (
+	"flag"
+	"fmt"
+	"math/big"
+	"math/rand"
 	"testing"
+
 	"github.com/chris-tomich/immutability-benchmarking"
-	"github.com/chris-tomich/immutability-benchmarking/slice/mutable"
-	"math/rand"
+	"github.com/chris-tomich/immutability-benchmarking/immutabilitycheck"
+	"github.com/chris-tomich/immutability-benchmarking/overflow"
+	"github.com/chris-tomich/immutability-benchmarking/slice/bignum"
+	bigimmutable "github.com/chris-tomich/immutability-benchmarking/slice/bignum/immutable"
+	bigmutable "github.com/chris-tomich/immutability-benchmarking/slice/bignum/mutable"
 	"github.com/chris-tomich/immutability-benchmarking/slice/immutable"
+	"github.com/chris-tomich/immutability-benchmarking/slice/mutable"
 )
 
-type MatrixGenerator interface {
+var checkImmutability = flag.Bool("immutabilitycheck", false, "verify immutable matrices are never modified by the benchmarked operations")
+
+type MatrixGenerator[T immutabilitybenchmarking.Number] interface {
 	Size() int
-	GenerateMatrix() (immutabilitybenchmarking.Matrix, immutabilitybenchmarking.Matrix)
+	Immutable() bool
+	GenerateMatrix() (immutabilitybenchmarking.Matrix[T], immutabilitybenchmarking.Matrix[T])
 }
 
-type MutableMatrixGenerator struct {
+type MutableMatrixGenerator[T immutabilitybenchmarking.Number] struct {
 	MatrixSize int
+	Random     func() T
 }
 
-func (m MutableMatrixGenerator) Size() int {
+func (m MutableMatrixGenerator[T]) Size() int {
 	return m.MatrixSize
 }
 
-func (m MutableMatrixGenerator) GenerateMatrix() (immutabilitybenchmarking.Matrix, immutabilitybenchmarking.Matrix) {
-	m1 := make([][]int, m.Size())
-	m2 := make([][]int, m.Size())
+func (m MutableMatrixGenerator[T]) Immutable() bool {
+	return false
+}
+
+func (m MutableMatrixGenerator[T]) GenerateMatrix() (immutabilitybenchmarking.Matrix[T], immutabilitybenchmarking.Matrix[T]) {
+	m1 := make([][]T, m.Size())
+	m2 := make([][]T, m.Size())
 
 	for i := 0; i < m.Size(); i++ {
-		m1[i] = make([]int, m.Size())
-		m2[i] = make([]int, m.Size())
+		m1[i] = make([]T, m.Size())
+		m2[i] = make([]T, m.Size())
 
 		for j := 0; j < m.Size(); j++ {
-			m1[i][j] = rand.Int()
-			m2[i][j] = rand.Int()
+			m1[i][j] = m.Random()
+			m2[i][j] = m.Random()
 		}
 	}
 
-	return mutable.New(m1), mutable.New(m2)
+	mm1, err := mutable.New(m1)
+	if err != nil {
+		panic(err)
+	}
+
+	mm2, err := mutable.New(m2)
+	if err != nil {
+		panic(err)
+	}
+
+	return mm1, mm2
 }
 
-type ImmutableMatrixGenerator struct {
+type ImmutableMatrixGenerator[T immutabilitybenchmarking.Number] struct {
 	MatrixSize int
+	Random     func() T
 }
 
-func (m ImmutableMatrixGenerator) Size() int {
+func (m ImmutableMatrixGenerator[T]) Size() int {
 	return m.MatrixSize
 }
 
-func (m ImmutableMatrixGenerator) GenerateMatrix() (immutabilitybenchmarking.Matrix, immutabilitybenchmarking.Matrix) {
-	m1 := make([][]int, m.Size())
-	m2 := make([][]int, m.Size())
+func (m ImmutableMatrixGenerator[T]) Immutable() bool {
+	return true
+}
+
+func (m ImmutableMatrixGenerator[T]) GenerateMatrix() (immutabilitybenchmarking.Matrix[T], immutabilitybenchmarking.Matrix[T]) {
+	m1 := make([][]T, m.Size())
+	m2 := make([][]T, m.Size())
 
 	for i := 0; i < m.Size(); i++ {
-		m1[i] = make([]int, m.Size())
-		m2[i] = make([]int, m.Size())
+		m1[i] = make([]T, m.Size())
+		m2[i] = make([]T, m.Size())
 
 		for j := 0; j < m.Size(); j++ {
-			m1[i][j] = rand.Int()
-			m2[i][j] = rand.Int()
+			m1[i][j] = m.Random()
+			m2[i][j] = m.Random()
 		}
 	}
 
-	return immutable.New(m1), immutable.New(m2)
+	mm1, err := immutable.New(m1)
+	if err != nil {
+		panic(err)
+	}
+
+	mm2, err := immutable.New(m2)
+	if err != nil {
+		panic(err)
+	}
+
+	return mm1, mm2
+}
+
+// generateMatrix generates a pair of matrices, wrapping immutable ones in an immutability check when
+// -immutabilitycheck is set.
+func generateMatrix[T immutabilitybenchmarking.Number](b *testing.B, g MatrixGenerator[T]) (immutabilitybenchmarking.Matrix[T], immutabilitybenchmarking.Matrix[T]) {
+	m1, m2 := g.GenerateMatrix()
+
+	if *checkImmutability && g.Immutable() {
+		return immutabilitycheck.Wrap(b, m1), immutabilitycheck.Wrap(b, m2)
+	}
+
+	return m1, m2
 }
 
-func MatrixAddRunner(b *testing.B, g MatrixGenerator, totalMatrices int) {
-	mm1 := make([]immutabilitybenchmarking.Matrix, totalMatrices)
-	mm2 := make([]immutabilitybenchmarking.Matrix, totalMatrices)
+func MatrixAddRunner[T immutabilitybenchmarking.Number](b *testing.B, g MatrixGenerator[T], totalMatrices int) {
+	mm1 := make([]immutabilitybenchmarking.Matrix[T], totalMatrices)
+	mm2 := make([]immutabilitybenchmarking.Matrix[T], totalMatrices)
 
 	for i := 0; i < totalMatrices; i++ {
-		mm1[i], mm2[i] = g.GenerateMatrix()
+		mm1[i], mm2[i] = generateMatrix(b, g)
 	}
 
 	b.ResetTimer()
@@ -79,12 +133,12 @@ func MatrixAddRunner(b *testing.B, g MatrixGenerator, totalMatrices int) {
 	}
 }
 
-func MatrixScalarRunner(b *testing.B, g MatrixGenerator, totalMatrices int) {
-	mm1 := make([]immutabilitybenchmarking.Matrix, totalMatrices)
-	mm2 := make([]immutabilitybenchmarking.Matrix, totalMatrices)
+func MatrixScalarRunner[T immutabilitybenchmarking.Number](b *testing.B, g MatrixGenerator[T], totalMatrices int) {
+	mm1 := make([]immutabilitybenchmarking.Matrix[T], totalMatrices)
+	mm2 := make([]immutabilitybenchmarking.Matrix[T], totalMatrices)
 
 	for i := 0; i < totalMatrices; i++ {
-		mm1[i], mm2[i] = g.GenerateMatrix()
+		mm1[i], mm2[i] = generateMatrix(b, g)
 	}
 
 	b.ResetTimer()
@@ -95,12 +149,12 @@ func MatrixScalarRunner(b *testing.B, g MatrixGenerator, totalMatrices int) {
 	}
 }
 
-func MatrixMultiplyRunner(b *testing.B, g MatrixGenerator, totalMatrices int) {
-	mm1 := make([]immutabilitybenchmarking.Matrix, totalMatrices)
-	mm2 := make([]immutabilitybenchmarking.Matrix, totalMatrices)
+func MatrixMultiplyRunner[T immutabilitybenchmarking.Number](b *testing.B, g MatrixGenerator[T], totalMatrices int) {
+	mm1 := make([]immutabilitybenchmarking.Matrix[T], totalMatrices)
+	mm2 := make([]immutabilitybenchmarking.Matrix[T], totalMatrices)
 
 	for i := 0; i < totalMatrices; i++ {
-		mm1[i], mm2[i] = g.GenerateMatrix()
+		mm1[i], mm2[i] = generateMatrix(b, g)
 	}
 
 	b.ResetTimer()
@@ -111,12 +165,12 @@ func MatrixMultiplyRunner(b *testing.B, g MatrixGenerator, totalMatrices int) {
 	}
 }
 
-func MatrixSubtractRunner(b *testing.B, g MatrixGenerator, totalMatrices int) {
-	mm1 := make([]immutabilitybenchmarking.Matrix, totalMatrices)
-	mm2 := make([]immutabilitybenchmarking.Matrix, totalMatrices)
+func MatrixSubtractRunner[T immutabilitybenchmarking.Number](b *testing.B, g MatrixGenerator[T], totalMatrices int) {
+	mm1 := make([]immutabilitybenchmarking.Matrix[T], totalMatrices)
+	mm2 := make([]immutabilitybenchmarking.Matrix[T], totalMatrices)
 
 	for i := 0; i < totalMatrices; i++ {
-		mm1[i], mm2[i] = g.GenerateMatrix()
+		mm1[i], mm2[i] = generateMatrix(b, g)
 	}
 
 	b.ResetTimer()
@@ -127,210 +181,1682 @@ func MatrixSubtractRunner(b *testing.B, g MatrixGenerator, totalMatrices int) {
 	}
 }
 
-func BenchmarkMutableMatrix10x10Add(b *testing.B) {
-	g := MutableMatrixGenerator{MatrixSize: 10}
-	MatrixAddRunner(b, g, 10)
-}
+// MatrixZipWithRunner adds the matrices through ZipWith so the cost of calling a closure for every element
+// can be compared with the hand-written loop in MatrixAddRunner.
+func MatrixZipWithRunner[T immutabilitybenchmarking.Number](b *testing.B, g MatrixGenerator[T], totalMatrices int) {
+	mm1 := make([]immutabilitybenchmarking.Matrix[T], totalMatrices)
+	mm2 := make([]immutabilitybenchmarking.Matrix[T], totalMatrices)
 
-func BenchmarkImmutableMatrix10x10Add(b *testing.B) {
-	g := ImmutableMatrixGenerator{MatrixSize: 10}
-	MatrixAddRunner(b, g, 10)
-}
+	for i := 0; i < totalMatrices; i++ {
+		mm1[i], mm2[i] = generateMatrix(b, g)
+	}
 
-func BenchmarkMutableMatrix10x10Scalar(b *testing.B) {
-	g := MutableMatrixGenerator{MatrixSize: 10}
-	MatrixScalarRunner(b, g, 10)
+	add := func(a T, b T) T { return a + b }
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for j := 0; j < totalMatrices; j++ {
+			mm1[j], _ = mm1[j].ZipWith(mm2[j], add)
+		}
+	}
 }
 
-func BenchmarkImmutableMatrix10x10Scalar(b *testing.B) {
-	g := ImmutableMatrixGenerator{MatrixSize: 10}
-	MatrixScalarRunner(b, g, 10)
+// MatrixMapRunner multiplies by 3 through Map so the cost of calling a closure for every element can be
+// compared with the hand-written loop in MatrixScalarRunner.
+func MatrixMapRunner[T immutabilitybenchmarking.Number](b *testing.B, g MatrixGenerator[T], totalMatrices int) {
+	mm1 := make([]immutabilitybenchmarking.Matrix[T], totalMatrices)
+	mm2 := make([]immutabilitybenchmarking.Matrix[T], totalMatrices)
+
+	for i := 0; i < totalMatrices; i++ {
+		mm1[i], mm2[i] = generateMatrix(b, g)
+	}
+
+	triple := func(v T) T { return v * 3 }
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for j := 0; j < totalMatrices; j++ {
+			mm1[j] = mm1[j].Map(triple)
+		}
+	}
 }
 
-func BenchmarkMutableMatrix10x10Multiply(b *testing.B) {
-	g := MutableMatrixGenerator{MatrixSize: 10}
-	MatrixMultiplyRunner(b, g, 10)
+// MatrixPowerRunner raises each matrix to the tenth power, which takes three squarings and one further
+// product. Every mutable MatrixMultiply already builds its product in new storage before replacing its own,
+// so chained products are where the mutable and immutable matrices do the same work, apart from the copy the
+// mutable Power takes of its first factor.
+func MatrixPowerRunner[T immutabilitybenchmarking.Number](b *testing.B, g MatrixGenerator[T], totalMatrices int) {
+	mm1 := make([]immutabilitybenchmarking.Matrix[T], totalMatrices)
+
+	for i := 0; i < totalMatrices; i++ {
+		mm1[i], _ = generateMatrix(b, g)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for j := 0; j < totalMatrices; j++ {
+			mm1[j], _ = mm1[j].Power(10)
+		}
+	}
 }
 
-func BenchmarkImmutableMatrix10x10Multiply(b *testing.B) {
-	g := ImmutableMatrixGenerator{MatrixSize: 10}
-	MatrixMultiplyRunner(b, g, 10)
+// MatrixKroneckerRunner calculates the Kronecker product of each pair of matrices. A mutable matrix grows to
+// hold its product, so fresh pairs are generated with the timer stopped.
+func MatrixKroneckerRunner[T immutabilitybenchmarking.Number](b *testing.B, g MatrixGenerator[T], totalMatrices int) {
+	mm1 := make([]immutabilitybenchmarking.Matrix[T], totalMatrices)
+	mm2 := make([]immutabilitybenchmarking.Matrix[T], totalMatrices)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		for j := 0; j < totalMatrices; j++ {
+			mm1[j], mm2[j] = generateMatrix(b, g)
+		}
+		b.StartTimer()
+
+		for j := 0; j < totalMatrices; j++ {
+			mm1[j], _ = mm1[j].KroneckerProduct(mm2[j])
+		}
+	}
 }
 
-func BenchmarkMutableMatrix10x10Subtract(b *testing.B) {
-	g := MutableMatrixGenerator{MatrixSize: 10}
-	MatrixSubtractRunner(b, g, 10)
+// MatrixShapeRunner benchmarks each operation that rearranges a matrix. The mutable matrices change shape in
+// place, so fresh pairs are generated with the timer stopped, and the immutable matrices share as much of
+// their storage as each operation allows.
+func MatrixShapeRunner[T immutabilitybenchmarking.Number](b *testing.B, g MatrixGenerator[T], totalMatrices int) {
+	ops := []struct {
+		name string
+		op   func(m1 immutabilitybenchmarking.Matrix[T], m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error)
+	}{
+		{name: "Reshape", op: func(m1 immutabilitybenchmarking.Matrix[T], _ immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+			return m1.Reshape(m1.Height()/2, m1.Width()*2)
+		}},
+		{name: "HConcat", op: immutabilitybenchmarking.Matrix[T].HConcat},
+		{name: "VConcat", op: immutabilitybenchmarking.Matrix[T].VConcat},
+		{name: "SplitRows", op: func(m1 immutabilitybenchmarking.Matrix[T], _ immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+			top, _, err := m1.SplitRows(m1.Height() / 2)
+			return top, err
+		}},
+		{name: "SplitCols", op: func(m1 immutabilitybenchmarking.Matrix[T], _ immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+			left, _, err := m1.SplitCols(m1.Width() / 2)
+			return left, err
+		}},
+		{name: "Pad", op: func(m1 immutabilitybenchmarking.Matrix[T], _ immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+			return m1.Pad(1, 1, 1, 1)
+		}},
+		{name: "Flip", op: func(m1 immutabilitybenchmarking.Matrix[T], _ immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+			return m1.Flip(immutabilitybenchmarking.ColAxis), nil
+		}},
+		{name: "Rotate90", op: func(m1 immutabilitybenchmarking.Matrix[T], _ immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+			return m1.Rotate90(), nil
+		}},
+	}
+
+	mm1 := make([]immutabilitybenchmarking.Matrix[T], totalMatrices)
+	mm2 := make([]immutabilitybenchmarking.Matrix[T], totalMatrices)
+
+	for _, op := range ops {
+		b.Run(op.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				for j := 0; j < totalMatrices; j++ {
+					mm1[j], mm2[j] = generateMatrix(b, g)
+				}
+				b.StartTimer()
+
+				for j := 0; j < totalMatrices; j++ {
+					mm1[j], _ = op.op(mm1[j], mm2[j])
+				}
+			}
+		})
+	}
 }
 
-func BenchmarkImmutableMatrix10x10Subtract(b *testing.B) {
-	g := ImmutableMatrixGenerator{MatrixSize: 10}
-	MatrixSubtractRunner(b, g, 10)
+// OverflowPolicyRunner benchmarks every policy on the same inputs each iteration, so Checked never stops early.
+func OverflowPolicyRunner[T overflow.Integer](b *testing.B, g MatrixGenerator[T], f immutabilitybenchmarking.Factory[T], totalMatrices int) {
+	mm1 := make([]immutabilitybenchmarking.Matrix[T], totalMatrices)
+	mm2 := make([]immutabilitybenchmarking.Matrix[T], totalMatrices)
+
+	for i := 0; i < totalMatrices; i++ {
+		mm1[i], mm2[i] = generateMatrix(b, g)
+	}
+
+	ops := []struct {
+		name string
+		op   func(a overflow.Arithmetic[T], m1 immutabilitybenchmarking.Matrix[T], m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error)
+	}{
+		{name: "Add", op: overflow.Arithmetic[T].Add},
+		{name: "Scalar", op: func(a overflow.Arithmetic[T], m1 immutabilitybenchmarking.Matrix[T], _ immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+			return a.ScalarMultiply(m1, 3)
+		}},
+		{name: "Multiply", op: overflow.Arithmetic[T].MatrixMultiply},
+		{name: "Subtract", op: overflow.Arithmetic[T].Subtract},
+	}
+
+	for _, op := range ops {
+		for _, p := range []overflow.Policy{overflow.Wrapping, overflow.Checked, overflow.Saturating} {
+			a := overflow.New(f, p)
+
+			b.Run(fmt.Sprintf("%s/%s", op.name, p), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					for j := 0; j < totalMatrices; j++ {
+						op.op(a, mm1[j], mm2[j])
+					}
+				}
+			})
+		}
+	}
 }
 
+// smallInt returns values small enough that the benchmarked operations do not overflow, so the checked
+// policy does the full amount of work.
+func smallInt() int {
+	return rand.Intn(1 << 16)
+}
 
+// BigMatrixGenerator generates arbitrary precision matrices. Besides random operands it generates a signed
+// permutation matrix, which the multiply benchmark uses so that repeatedly multiplying by it does not grow
+// the elements and slow down later iterations.
+type BigMatrixGenerator[T any, PT bignum.Number[T]] interface {
+	GenerateMatrix() (bignum.Matrix[T, PT], bignum.Matrix[T, PT])
+	GeneratePermutation() bignum.Matrix[T, PT]
+	Int64(v int64) PT
+}
 
-func BenchmarkMutableMatrix30x30Add(b *testing.B) {
-	g := MutableMatrixGenerator{MatrixSize: 30}
-	MatrixAddRunner(b, g, 10)
+type MutableBigMatrixGenerator[T any, PT bignum.Number[T]] struct {
+	MatrixSize int
+	Random     func() PT
+	FromInt64  func(int64) PT
 }
 
-func BenchmarkImmutableMatrix30x30Add(b *testing.B) {
-	g := ImmutableMatrixGenerator{MatrixSize: 30}
-	MatrixAddRunner(b, g, 10)
+func (m MutableBigMatrixGenerator[T, PT]) Int64(v int64) PT {
+	return m.FromInt64(v)
 }
 
-func BenchmarkMutableMatrix30x30Scalar(b *testing.B) {
-	g := MutableMatrixGenerator{MatrixSize: 30}
-	MatrixScalarRunner(b, g, 10)
+func (m MutableBigMatrixGenerator[T, PT]) GenerateMatrix() (bignum.Matrix[T, PT], bignum.Matrix[T, PT]) {
+	mm1, err := bigmutable.New(randomBigRows(m.MatrixSize, m.Random))
+	if err != nil {
+		panic(err)
+	}
+
+	mm2, err := bigmutable.New(randomBigRows(m.MatrixSize, m.Random))
+	if err != nil {
+		panic(err)
+	}
+
+	return mm1, mm2
 }
 
-func BenchmarkImmutableMatrix30x30Scalar(b *testing.B) {
-	g := ImmutableMatrixGenerator{MatrixSize: 30}
-	MatrixScalarRunner(b, g, 10)
+func (m MutableBigMatrixGenerator[T, PT]) GeneratePermutation() bignum.Matrix[T, PT] {
+	p, err := bigmutable.New(signedPermutation(m.MatrixSize, m.FromInt64))
+	if err != nil {
+		panic(err)
+	}
+
+	return p
 }
 
-func BenchmarkMutableMatrix30x30Multiply(b *testing.B) {
-	g := MutableMatrixGenerator{MatrixSize: 30}
-	MatrixMultiplyRunner(b, g, 10)
+type ImmutableBigMatrixGenerator[T any, PT bignum.Number[T]] struct {
+	MatrixSize int
+	Random     func() PT
+	FromInt64  func(int64) PT
 }
 
-func BenchmarkImmutableMatrix30x30Multiply(b *testing.B) {
-	g := ImmutableMatrixGenerator{MatrixSize: 30}
-	MatrixMultiplyRunner(b, g, 10)
+func (m ImmutableBigMatrixGenerator[T, PT]) Int64(v int64) PT {
+	return m.FromInt64(v)
 }
 
-func BenchmarkMutableMatrix30x30Subtract(b *testing.B) {
-	g := MutableMatrixGenerator{MatrixSize: 30}
-	MatrixSubtractRunner(b, g, 10)
+func (m ImmutableBigMatrixGenerator[T, PT]) GenerateMatrix() (bignum.Matrix[T, PT], bignum.Matrix[T, PT]) {
+	mm1, err := bigimmutable.New(randomBigRows(m.MatrixSize, m.Random))
+	if err != nil {
+		panic(err)
+	}
+
+	mm2, err := bigimmutable.New(randomBigRows(m.MatrixSize, m.Random))
+	if err != nil {
+		panic(err)
+	}
+
+	return mm1, mm2
 }
 
-func BenchmarkImmutableMatrix30x30Subtract(b *testing.B) {
-	g := ImmutableMatrixGenerator{MatrixSize: 30}
-	MatrixSubtractRunner(b, g, 10)
+func (m ImmutableBigMatrixGenerator[T, PT]) GeneratePermutation() bignum.Matrix[T, PT] {
+	p, err := bigimmutable.New(signedPermutation(m.MatrixSize, m.FromInt64))
+	if err != nil {
+		panic(err)
+	}
+
+	return p
 }
 
+func randomBigRows[T any, PT bignum.Number[T]](size int, random func() PT) [][]PT {
+	m := make([][]PT, size)
 
+	for i := 0; i < size; i++ {
+		m[i] = make([]PT, size)
 
-func BenchmarkMutableMatrix90x90Add(b *testing.B) {
-	g := MutableMatrixGenerator{MatrixSize: 90}
-	MatrixAddRunner(b, g, 10)
+		for j := 0; j < size; j++ {
+			m[i][j] = random()
+		}
+	}
+
+	return m
 }
 
-func BenchmarkImmutableMatrix90x90Add(b *testing.B) {
-	g := ImmutableMatrixGenerator{MatrixSize: 90}
-	MatrixAddRunner(b, g, 10)
+// signedPermutation returns the rows of a randomly shuffled identity matrix with randomly negated ones.
+func signedPermutation[T any, PT bignum.Number[T]](size int, fromInt64 func(int64) PT) [][]PT {
+	m := make([][]PT, size)
+
+	for i, j := range rand.Perm(size) {
+		m[i] = make([]PT, size)
+
+		for c := 0; c < size; c++ {
+			m[i][c] = fromInt64(0)
+		}
+
+		m[i][j] = fromInt64(int64(1 - 2*rand.Intn(2)))
+	}
+
+	return m
 }
 
-func BenchmarkMutableMatrix90x90Scalar(b *testing.B) {
-	g := MutableMatrixGenerator{MatrixSize: 90}
-	MatrixScalarRunner(b, g, 10)
+// randomBigInt returns a random 256 bit integer.
+func randomBigInt() *big.Int {
+	return new(big.Int).Rand(bigRand, new(big.Int).Lsh(big.NewInt(1), 256))
 }
 
-func BenchmarkImmutableMatrix90x90Scalar(b *testing.B) {
-	g := ImmutableMatrixGenerator{MatrixSize: 90}
-	MatrixScalarRunner(b, g, 10)
+// randomBigRat returns a random fraction with a 64 bit numerator and a 32 bit denominator.
+func randomBigRat() *big.Rat {
+	return big.NewRat(rand.Int63(), rand.Int63n(1<<32)+1)
 }
 
-func BenchmarkMutableMatrix90x90Multiply(b *testing.B) {
-	g := MutableMatrixGenerator{MatrixSize: 90}
-	MatrixMultiplyRunner(b, g, 10)
+var bigRand = rand.New(rand.NewSource(1))
+
+func BigMatrixAddRunner[T any, PT bignum.Number[T]](b *testing.B, g BigMatrixGenerator[T, PT], totalMatrices int) {
+	mm1 := make([]bignum.Matrix[T, PT], totalMatrices)
+	mm2 := make([]bignum.Matrix[T, PT], totalMatrices)
+
+	for i := 0; i < totalMatrices; i++ {
+		mm1[i], mm2[i] = g.GenerateMatrix()
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for j := 0; j < totalMatrices; j++ {
+			mm1[j], _ = mm1[j].Add(mm2[j])
+		}
+	}
 }
 
-func BenchmarkImmutableMatrix90x90Multiply(b *testing.B) {
-	g := ImmutableMatrixGenerator{MatrixSize: 90}
-	MatrixMultiplyRunner(b, g, 10)
+// BigMatrixScalarRunner multiplies by -1 so the elements keep the same size on every iteration.
+func BigMatrixScalarRunner[T any, PT bignum.Number[T]](b *testing.B, g BigMatrixGenerator[T, PT], totalMatrices int) {
+	mm1 := make([]bignum.Matrix[T, PT], totalMatrices)
+
+	for i := 0; i < totalMatrices; i++ {
+		mm1[i], _ = g.GenerateMatrix()
+	}
+
+	s := g.Int64(-1)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for j := 0; j < totalMatrices; j++ {
+			mm1[j] = mm1[j].ScalarMultiply(s)
+		}
+	}
 }
 
-func BenchmarkMutableMatrix90x90Subtract(b *testing.B) {
-	g := MutableMatrixGenerator{MatrixSize: 90}
-	MatrixSubtractRunner(b, g, 10)
+func BigMatrixMultiplyRunner[T any, PT bignum.Number[T]](b *testing.B, g BigMatrixGenerator[T, PT], totalMatrices int) {
+	mm1 := make([]bignum.Matrix[T, PT], totalMatrices)
+	mm2 := make([]bignum.Matrix[T, PT], totalMatrices)
+
+	for i := 0; i < totalMatrices; i++ {
+		mm1[i], _ = g.GenerateMatrix()
+		mm2[i] = g.GeneratePermutation()
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for j := 0; j < totalMatrices; j++ {
+			mm1[j], _ = mm1[j].MatrixMultiply(mm2[j])
+		}
+	}
 }
 
-func BenchmarkImmutableMatrix90x90Subtract(b *testing.B) {
-	g := ImmutableMatrixGenerator{MatrixSize: 90}
-	MatrixSubtractRunner(b, g, 10)
+func BigMatrixSubtractRunner[T any, PT bignum.Number[T]](b *testing.B, g BigMatrixGenerator[T, PT], totalMatrices int) {
+	mm1 := make([]bignum.Matrix[T, PT], totalMatrices)
+	mm2 := make([]bignum.Matrix[T, PT], totalMatrices)
+
+	for i := 0; i < totalMatrices; i++ {
+		mm1[i], mm2[i] = g.GenerateMatrix()
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for j := 0; j < totalMatrices; j++ {
+			mm1[j], _ = mm1[j].Subtract(mm2[j])
+		}
+	}
 }
 
+// BigMatrixPowerRunner raises each matrix to the tenth power. The elements of a power grow with the
+// exponent, so fresh matrices are generated with the timer stopped rather than raising the same matrices
+// again, and most of the time is spent multiplying the growing big.Int elements.
+func BigMatrixPowerRunner[T any, PT bignum.Number[T]](b *testing.B, g BigMatrixGenerator[T, PT], totalMatrices int) {
+	mm1 := make([]bignum.Matrix[T, PT], totalMatrices)
 
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		for j := 0; j < totalMatrices; j++ {
+			mm1[j], _ = g.GenerateMatrix()
+		}
+		b.StartTimer()
 
-func BenchmarkMutableMatrix270x270Add(b *testing.B) {
-	g := MutableMatrixGenerator{MatrixSize: 270}
-	MatrixAddRunner(b, g, 10)
+		for j := 0; j < totalMatrices; j++ {
+			mm1[j], _ = mm1[j].Power(10)
+		}
+	}
 }
 
-func BenchmarkImmutableMatrix270x270Add(b *testing.B) {
-	g := ImmutableMatrixGenerator{MatrixSize: 270}
-	MatrixAddRunner(b, g, 10)
+// randomRows returns the rows of a rows x cols matrix of random values.
+func randomRows[T any](rows int, cols int, random func() T) [][]T {
+	m := make([][]T, rows)
+
+	for i := 0; i < rows; i++ {
+		m[i] = make([]T, cols)
+
+		for j := 0; j < cols; j++ {
+			m[i][j] = random()
+		}
+	}
+
+	return m
 }
 
-func BenchmarkMutableMatrix270x270Scalar(b *testing.B) {
-	g := MutableMatrixGenerator{MatrixSize: 270}
-	MatrixScalarRunner(b, g, 10)
+// copyRows returns a copy of the rows using clone to copy each element.
+func copyRows[T any](rows [][]T, clone func(T) T) [][]T {
+	c := make([][]T, len(rows))
+
+	for i := range rows {
+		c[i] = make([]T, len(rows[i]))
+
+		for j := range rows[i] {
+			c[i][j] = clone(rows[i][j])
+		}
+	}
+
+	return c
 }
 
-func BenchmarkImmutableMatrix270x270Scalar(b *testing.B) {
-	g := ImmutableMatrixGenerator{MatrixSize: 270}
-	MatrixScalarRunner(b, g, 10)
+// luOps are the operations benchmarked by the LU runners, each given a square matrix and a single column
+// right hand side.
+var luOps = []string{"Factorize", "Determinant", "Inverse", "Solve"}
+
+// MutableLURunner benchmarks the in-place LU operations. They overwrite their operands, so fresh copies
+// are made with the timer stopped.
+func MutableLURunner(b *testing.B, size int) {
+	a := randomRows(size, size, rand.Float64)
+	rhs := randomRows(size, 1, rand.Float64)
+	clone := func(v float64) float64 { return v }
+
+	for _, op := range luOps {
+		b.Run(op, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				m, _ := mutable.New(copyRows(a, clone))
+				y, _ := mutable.New(copyRows(rhs, clone))
+				b.StartTimer()
+
+				switch op {
+				case "Factorize":
+					mutable.Factorize(m)
+				case "Determinant":
+					mutable.Determinant(m)
+				case "Inverse":
+					mutable.Inverse(m)
+				case "Solve":
+					mutable.Solve(m, y)
+				}
+			}
+		})
+	}
 }
 
-func BenchmarkMutableMatrix270x270Multiply(b *testing.B) {
-	g := MutableMatrixGenerator{MatrixSize: 270}
-	MatrixMultiplyRunner(b, g, 10)
+// ImmutableLURunner benchmarks the LU operations that return new matrices.
+func ImmutableLURunner(b *testing.B, size int) {
+	m, _ := immutable.New(randomRows(size, size, rand.Float64))
+	y, _ := immutable.New(randomRows(size, 1, rand.Float64))
+
+	for _, op := range luOps {
+		b.Run(op, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				switch op {
+				case "Factorize":
+					immutable.Factorize(m)
+				case "Determinant":
+					immutable.Determinant(m)
+				case "Inverse":
+					immutable.Inverse(m)
+				case "Solve":
+					immutable.Solve(m, y)
+				}
+			}
+		})
+	}
 }
 
-func BenchmarkImmutableMatrix270x270Multiply(b *testing.B) {
-	g := ImmutableMatrixGenerator{MatrixSize: 270}
-	MatrixMultiplyRunner(b, g, 10)
+// smallBigRat returns a random small integer. Elimination still produces fractions, but starting from
+// integers keeps their denominators from growing so much that larger sizes take too long to benchmark.
+func smallBigRat() *big.Rat {
+	return big.NewRat(rand.Int63n(199)-99, 1)
 }
 
-func BenchmarkMutableMatrix270x270Subtract(b *testing.B) {
-	g := MutableMatrixGenerator{MatrixSize: 270}
-	MatrixSubtractRunner(b, g, 10)
+// MutableBigRatLURunner benchmarks the exact in-place LU operations. They overwrite their operands, so
+// fresh copies are made with the timer stopped.
+func MutableBigRatLURunner(b *testing.B, size int) {
+	a := randomRows(size, size, smallBigRat)
+	rhs := randomRows(size, 1, smallBigRat)
+	clone := func(v *big.Rat) *big.Rat { return new(big.Rat).Set(v) }
+
+	for _, op := range luOps {
+		b.Run(op, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				m, _ := bigmutable.New(copyRows(a, clone))
+				y, _ := bigmutable.New(copyRows(rhs, clone))
+				b.StartTimer()
+
+				switch op {
+				case "Factorize":
+					bigmutable.Factorize(m)
+				case "Determinant":
+					bigmutable.Determinant(m)
+				case "Inverse":
+					bigmutable.Inverse(m)
+				case "Solve":
+					bigmutable.Solve(m, y)
+				}
+			}
+		})
+	}
 }
 
-func BenchmarkImmutableMatrix270x270Subtract(b *testing.B) {
-	g := ImmutableMatrixGenerator{MatrixSize: 270}
-	MatrixSubtractRunner(b, g, 10)
+// ImmutableBigRatLURunner benchmarks the exact LU operations that return new matrices.
+func ImmutableBigRatLURunner(b *testing.B, size int) {
+	m, _ := bigimmutable.New(randomRows(size, size, smallBigRat))
+	y, _ := bigimmutable.New(randomRows(size, 1, smallBigRat))
+
+	for _, op := range luOps {
+		b.Run(op, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				switch op {
+				case "Factorize":
+					bigimmutable.Factorize(m)
+				case "Determinant":
+					bigimmutable.Determinant(m)
+				case "Inverse":
+					bigimmutable.Inverse(m)
+				case "Solve":
+					bigimmutable.Solve(m, y)
+				}
+			}
+		})
+	}
 }
 
+func ImmutableMatrixNewRunner(b *testing.B, size int, newMatrix func([][]int) (immutable.Matrix[int], error)) {
+	m := make([][]int, size)
 
+	for i := 0; i < size; i++ {
+		m[i] = make([]int, size)
 
-func BenchmarkMutableMatrix810x810Add(b *testing.B) {
-	g := MutableMatrixGenerator{MatrixSize: 810}
-	MatrixAddRunner(b, g, 10)
-}
+		for j := 0; j < size; j++ {
+			m[i][j] = rand.Int()
+		}
+	}
 
-func BenchmarkImmutableMatrix810x810Add(b *testing.B) {
-	g := ImmutableMatrixGenerator{MatrixSize: 810}
-	MatrixAddRunner(b, g, 10)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		newMatrix(m)
+	}
 }
 
-func BenchmarkMutableMatrix810x810Scalar(b *testing.B) {
-	g := MutableMatrixGenerator{MatrixSize: 810}
-	MatrixScalarRunner(b, g, 10)
-}
+// randomImmutableMatrix returns a size x size immutable matrix of random integers.
+func randomImmutableMatrix(size int) immutable.Matrix[int] {
+	g := ImmutableMatrixGenerator[int]{MatrixSize: size, Random: rand.Int}
+	m, _ := g.GenerateMatrix()
 
-func BenchmarkImmutableMatrix810x810Scalar(b *testing.B) {
-	g := ImmutableMatrixGenerator{MatrixSize: 810}
-	MatrixScalarRunner(b, g, 10)
+	return m.(immutable.Matrix[int])
 }
 
-func BenchmarkMutableMatrix810x810Multiply(b *testing.B) {
-	g := MutableMatrixGenerator{MatrixSize: 810}
-	MatrixMultiplyRunner(b, g, 10)
-}
+func ImmutableMatrixTransposeRunner(b *testing.B, size int, transpose func(immutable.Matrix[int]) immutabilitybenchmarking.Matrix[int]) {
+	m := randomImmutableMatrix(size)
 
-func BenchmarkImmutableMatrix810x810Multiply(b *testing.B) {
-	g := ImmutableMatrixGenerator{MatrixSize: 810}
-	MatrixMultiplyRunner(b, g, 10)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		transpose(m)
+	}
 }
 
-func BenchmarkMutableMatrix810x810Subtract(b *testing.B) {
-	g := MutableMatrixGenerator{MatrixSize: 810}
-	MatrixSubtractRunner(b, g, 10)
-}
+// ImmutableMatrixQuadrantsRunner partitions a matrix into its four quadrants on every iteration.
+func ImmutableMatrixQuadrantsRunner(b *testing.B, size int, quadrant func(immutable.View[int]) immutabilitybenchmarking.Matrix[int]) {
+	m := randomImmutableMatrix(size)
+	h := size / 2
 
-func BenchmarkImmutableMatrix810x810Subtract(b *testing.B) {
-	g := ImmutableMatrixGenerator{MatrixSize: 810}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, q := range [][4]int{{0, h, 0, h}, {0, h, h, size}, {h, size, 0, h}, {h, size, h, size}} {
+			v, _ := m.Slice(q[0], q[1], q[2], q[3])
+			quadrant(v)
+		}
+	}
+}
+
+// transposeCopy transposes by copying every element.
+func transposeCopy(m immutable.Matrix[int]) immutabilitybenchmarking.Matrix[int] {
+	return m.Transpose()
+}
+
+// transposeView transposes by remapping indices over the same elements.
+func transposeView(m immutable.Matrix[int]) immutabilitybenchmarking.Matrix[int] {
+	return m.T()
+}
+
+// quadrantCopy copies the quadrant into a new matrix.
+func quadrantCopy(v immutable.View[int]) immutabilitybenchmarking.Matrix[int] {
+	return v.Copy()
+}
+
+// quadrantView uses the quadrant view directly.
+func quadrantView(v immutable.View[int]) immutabilitybenchmarking.Matrix[int] {
+	return v
+}
+
+func BenchmarkMutableMatrix10x10Add(b *testing.B) {
+	g := MutableMatrixGenerator[int]{MatrixSize: 10, Random: rand.Int}
+	MatrixAddRunner(b, g, 10)
+}
+
+func BenchmarkImmutableMatrix10x10Add(b *testing.B) {
+	g := ImmutableMatrixGenerator[int]{MatrixSize: 10, Random: rand.Int}
+	MatrixAddRunner(b, g, 10)
+}
+
+func BenchmarkMutableMatrix10x10Scalar(b *testing.B) {
+	g := MutableMatrixGenerator[int]{MatrixSize: 10, Random: rand.Int}
+	MatrixScalarRunner(b, g, 10)
+}
+
+func BenchmarkImmutableMatrix10x10Scalar(b *testing.B) {
+	g := ImmutableMatrixGenerator[int]{MatrixSize: 10, Random: rand.Int}
+	MatrixScalarRunner(b, g, 10)
+}
+
+func BenchmarkMutableMatrix10x10Multiply(b *testing.B) {
+	g := MutableMatrixGenerator[int]{MatrixSize: 10, Random: rand.Int}
+	MatrixMultiplyRunner(b, g, 10)
+}
+
+func BenchmarkImmutableMatrix10x10Multiply(b *testing.B) {
+	g := ImmutableMatrixGenerator[int]{MatrixSize: 10, Random: rand.Int}
+	MatrixMultiplyRunner(b, g, 10)
+}
+
+func BenchmarkMutableMatrix10x10Subtract(b *testing.B) {
+	g := MutableMatrixGenerator[int]{MatrixSize: 10, Random: rand.Int}
+	MatrixSubtractRunner(b, g, 10)
+}
+
+func BenchmarkImmutableMatrix10x10Subtract(b *testing.B) {
+	g := ImmutableMatrixGenerator[int]{MatrixSize: 10, Random: rand.Int}
+	MatrixSubtractRunner(b, g, 10)
+}
+
+func BenchmarkMutableMatrix10x10ZipWith(b *testing.B) {
+	g := MutableMatrixGenerator[int]{MatrixSize: 10, Random: rand.Int}
+	MatrixZipWithRunner(b, g, 10)
+}
+
+func BenchmarkMutableMatrix10x10Map(b *testing.B) {
+	g := MutableMatrixGenerator[int]{MatrixSize: 10, Random: rand.Int}
+	MatrixMapRunner(b, g, 10)
+}
+
+func BenchmarkImmutableMatrix10x10ZipWith(b *testing.B) {
+	g := ImmutableMatrixGenerator[int]{MatrixSize: 10, Random: rand.Int}
+	MatrixZipWithRunner(b, g, 10)
+}
+
+func BenchmarkImmutableMatrix10x10Map(b *testing.B) {
+	g := ImmutableMatrixGenerator[int]{MatrixSize: 10, Random: rand.Int}
+	MatrixMapRunner(b, g, 10)
+}
+
+func BenchmarkMutableMatrix10x10Power(b *testing.B) {
+	g := MutableMatrixGenerator[int]{MatrixSize: 10, Random: rand.Int}
+	MatrixPowerRunner(b, g, 10)
+}
+
+func BenchmarkImmutableMatrix10x10Power(b *testing.B) {
+	g := ImmutableMatrixGenerator[int]{MatrixSize: 10, Random: rand.Int}
+	MatrixPowerRunner(b, g, 10)
+}
+
+func BenchmarkMutableMatrix10x10Shape(b *testing.B) {
+	g := MutableMatrixGenerator[int]{MatrixSize: 10, Random: rand.Int}
+	MatrixShapeRunner(b, g, 10)
+}
+
+func BenchmarkImmutableMatrix10x10Shape(b *testing.B) {
+	g := ImmutableMatrixGenerator[int]{MatrixSize: 10, Random: rand.Int}
+	MatrixShapeRunner(b, g, 10)
+}
+
+func BenchmarkMutableMatrix10x10Kronecker(b *testing.B) {
+	g := MutableMatrixGenerator[int]{MatrixSize: 10, Random: rand.Int}
+	MatrixKroneckerRunner(b, g, 10)
+}
+
+func BenchmarkImmutableMatrix10x10Kronecker(b *testing.B) {
+	g := ImmutableMatrixGenerator[int]{MatrixSize: 10, Random: rand.Int}
+	MatrixKroneckerRunner(b, g, 10)
+}
+
+func BenchmarkImmutableMatrix10x10New(b *testing.B) {
+	ImmutableMatrixNewRunner(b, 10, immutable.New[int])
+}
+
+func BenchmarkImmutableMatrix10x10NewUnsafeNoCopy(b *testing.B) {
+	ImmutableMatrixNewRunner(b, 10, immutable.NewUnsafeNoCopy[int])
+}
+
+func BenchmarkImmutableMatrix10x10Transpose(b *testing.B) {
+	ImmutableMatrixTransposeRunner(b, 10, transposeCopy)
+}
+
+func BenchmarkImmutableMatrix10x10TransposeView(b *testing.B) {
+	ImmutableMatrixTransposeRunner(b, 10, transposeView)
+}
+
+func BenchmarkImmutableMatrix10x10Quadrants(b *testing.B) {
+	ImmutableMatrixQuadrantsRunner(b, 10, quadrantCopy)
+}
+
+func BenchmarkImmutableMatrix10x10QuadrantsView(b *testing.B) {
+	ImmutableMatrixQuadrantsRunner(b, 10, quadrantView)
+}
+
+func BenchmarkMutableMatrix30x30Add(b *testing.B) {
+	g := MutableMatrixGenerator[int]{MatrixSize: 30, Random: rand.Int}
+	MatrixAddRunner(b, g, 10)
+}
+
+func BenchmarkImmutableMatrix30x30Add(b *testing.B) {
+	g := ImmutableMatrixGenerator[int]{MatrixSize: 30, Random: rand.Int}
+	MatrixAddRunner(b, g, 10)
+}
+
+func BenchmarkMutableMatrix30x30Scalar(b *testing.B) {
+	g := MutableMatrixGenerator[int]{MatrixSize: 30, Random: rand.Int}
+	MatrixScalarRunner(b, g, 10)
+}
+
+func BenchmarkImmutableMatrix30x30Scalar(b *testing.B) {
+	g := ImmutableMatrixGenerator[int]{MatrixSize: 30, Random: rand.Int}
+	MatrixScalarRunner(b, g, 10)
+}
+
+func BenchmarkMutableMatrix30x30Multiply(b *testing.B) {
+	g := MutableMatrixGenerator[int]{MatrixSize: 30, Random: rand.Int}
+	MatrixMultiplyRunner(b, g, 10)
+}
+
+func BenchmarkImmutableMatrix30x30Multiply(b *testing.B) {
+	g := ImmutableMatrixGenerator[int]{MatrixSize: 30, Random: rand.Int}
+	MatrixMultiplyRunner(b, g, 10)
+}
+
+func BenchmarkMutableMatrix30x30Subtract(b *testing.B) {
+	g := MutableMatrixGenerator[int]{MatrixSize: 30, Random: rand.Int}
+	MatrixSubtractRunner(b, g, 10)
+}
+
+func BenchmarkImmutableMatrix30x30Subtract(b *testing.B) {
+	g := ImmutableMatrixGenerator[int]{MatrixSize: 30, Random: rand.Int}
+	MatrixSubtractRunner(b, g, 10)
+}
+
+func BenchmarkMutableMatrix30x30ZipWith(b *testing.B) {
+	g := MutableMatrixGenerator[int]{MatrixSize: 30, Random: rand.Int}
+	MatrixZipWithRunner(b, g, 10)
+}
+
+func BenchmarkMutableMatrix30x30Map(b *testing.B) {
+	g := MutableMatrixGenerator[int]{MatrixSize: 30, Random: rand.Int}
+	MatrixMapRunner(b, g, 10)
+}
+
+func BenchmarkImmutableMatrix30x30ZipWith(b *testing.B) {
+	g := ImmutableMatrixGenerator[int]{MatrixSize: 30, Random: rand.Int}
+	MatrixZipWithRunner(b, g, 10)
+}
+
+func BenchmarkImmutableMatrix30x30Map(b *testing.B) {
+	g := ImmutableMatrixGenerator[int]{MatrixSize: 30, Random: rand.Int}
+	MatrixMapRunner(b, g, 10)
+}
+
+func BenchmarkMutableMatrix30x30Power(b *testing.B) {
+	g := MutableMatrixGenerator[int]{MatrixSize: 30, Random: rand.Int}
+	MatrixPowerRunner(b, g, 10)
+}
+
+func BenchmarkImmutableMatrix30x30Power(b *testing.B) {
+	g := ImmutableMatrixGenerator[int]{MatrixSize: 30, Random: rand.Int}
+	MatrixPowerRunner(b, g, 10)
+}
+
+func BenchmarkMutableMatrix30x30Shape(b *testing.B) {
+	g := MutableMatrixGenerator[int]{MatrixSize: 30, Random: rand.Int}
+	MatrixShapeRunner(b, g, 10)
+}
+
+func BenchmarkImmutableMatrix30x30Shape(b *testing.B) {
+	g := ImmutableMatrixGenerator[int]{MatrixSize: 30, Random: rand.Int}
+	MatrixShapeRunner(b, g, 10)
+}
+
+func BenchmarkMutableMatrix30x30Kronecker(b *testing.B) {
+	g := MutableMatrixGenerator[int]{MatrixSize: 30, Random: rand.Int}
+	MatrixKroneckerRunner(b, g, 10)
+}
+
+func BenchmarkImmutableMatrix30x30Kronecker(b *testing.B) {
+	g := ImmutableMatrixGenerator[int]{MatrixSize: 30, Random: rand.Int}
+	MatrixKroneckerRunner(b, g, 10)
+}
+
+func BenchmarkImmutableMatrix30x30New(b *testing.B) {
+	ImmutableMatrixNewRunner(b, 30, immutable.New[int])
+}
+
+func BenchmarkImmutableMatrix30x30NewUnsafeNoCopy(b *testing.B) {
+	ImmutableMatrixNewRunner(b, 30, immutable.NewUnsafeNoCopy[int])
+}
+
+func BenchmarkImmutableMatrix30x30Transpose(b *testing.B) {
+	ImmutableMatrixTransposeRunner(b, 30, transposeCopy)
+}
+
+func BenchmarkImmutableMatrix30x30TransposeView(b *testing.B) {
+	ImmutableMatrixTransposeRunner(b, 30, transposeView)
+}
+
+func BenchmarkImmutableMatrix30x30Quadrants(b *testing.B) {
+	ImmutableMatrixQuadrantsRunner(b, 30, quadrantCopy)
+}
+
+func BenchmarkImmutableMatrix30x30QuadrantsView(b *testing.B) {
+	ImmutableMatrixQuadrantsRunner(b, 30, quadrantView)
+}
+
+func BenchmarkMutableMatrix90x90Add(b *testing.B) {
+	g := MutableMatrixGenerator[int]{MatrixSize: 90, Random: rand.Int}
+	MatrixAddRunner(b, g, 10)
+}
+
+func BenchmarkImmutableMatrix90x90Add(b *testing.B) {
+	g := ImmutableMatrixGenerator[int]{MatrixSize: 90, Random: rand.Int}
+	MatrixAddRunner(b, g, 10)
+}
+
+func BenchmarkMutableMatrix90x90Scalar(b *testing.B) {
+	g := MutableMatrixGenerator[int]{MatrixSize: 90, Random: rand.Int}
+	MatrixScalarRunner(b, g, 10)
+}
+
+func BenchmarkImmutableMatrix90x90Scalar(b *testing.B) {
+	g := ImmutableMatrixGenerator[int]{MatrixSize: 90, Random: rand.Int}
+	MatrixScalarRunner(b, g, 10)
+}
+
+func BenchmarkMutableMatrix90x90Multiply(b *testing.B) {
+	g := MutableMatrixGenerator[int]{MatrixSize: 90, Random: rand.Int}
+	MatrixMultiplyRunner(b, g, 10)
+}
+
+func BenchmarkImmutableMatrix90x90Multiply(b *testing.B) {
+	g := ImmutableMatrixGenerator[int]{MatrixSize: 90, Random: rand.Int}
+	MatrixMultiplyRunner(b, g, 10)
+}
+
+func BenchmarkMutableMatrix90x90Subtract(b *testing.B) {
+	g := MutableMatrixGenerator[int]{MatrixSize: 90, Random: rand.Int}
+	MatrixSubtractRunner(b, g, 10)
+}
+
+func BenchmarkImmutableMatrix90x90Subtract(b *testing.B) {
+	g := ImmutableMatrixGenerator[int]{MatrixSize: 90, Random: rand.Int}
+	MatrixSubtractRunner(b, g, 10)
+}
+
+func BenchmarkMutableMatrix90x90ZipWith(b *testing.B) {
+	g := MutableMatrixGenerator[int]{MatrixSize: 90, Random: rand.Int}
+	MatrixZipWithRunner(b, g, 10)
+}
+
+func BenchmarkMutableMatrix90x90Map(b *testing.B) {
+	g := MutableMatrixGenerator[int]{MatrixSize: 90, Random: rand.Int}
+	MatrixMapRunner(b, g, 10)
+}
+
+func BenchmarkImmutableMatrix90x90ZipWith(b *testing.B) {
+	g := ImmutableMatrixGenerator[int]{MatrixSize: 90, Random: rand.Int}
+	MatrixZipWithRunner(b, g, 10)
+}
+
+func BenchmarkImmutableMatrix90x90Map(b *testing.B) {
+	g := ImmutableMatrixGenerator[int]{MatrixSize: 90, Random: rand.Int}
+	MatrixMapRunner(b, g, 10)
+}
+
+func BenchmarkMutableMatrix90x90Power(b *testing.B) {
+	g := MutableMatrixGenerator[int]{MatrixSize: 90, Random: rand.Int}
+	MatrixPowerRunner(b, g, 10)
+}
+
+func BenchmarkImmutableMatrix90x90Power(b *testing.B) {
+	g := ImmutableMatrixGenerator[int]{MatrixSize: 90, Random: rand.Int}
+	MatrixPowerRunner(b, g, 10)
+}
+
+func BenchmarkMutableMatrix90x90Shape(b *testing.B) {
+	g := MutableMatrixGenerator[int]{MatrixSize: 90, Random: rand.Int}
+	MatrixShapeRunner(b, g, 10)
+}
+
+func BenchmarkImmutableMatrix90x90Shape(b *testing.B) {
+	g := ImmutableMatrixGenerator[int]{MatrixSize: 90, Random: rand.Int}
+	MatrixShapeRunner(b, g, 10)
+}
+
+func BenchmarkImmutableMatrix90x90New(b *testing.B) {
+	ImmutableMatrixNewRunner(b, 90, immutable.New[int])
+}
+
+func BenchmarkImmutableMatrix90x90NewUnsafeNoCopy(b *testing.B) {
+	ImmutableMatrixNewRunner(b, 90, immutable.NewUnsafeNoCopy[int])
+}
+
+func BenchmarkImmutableMatrix90x90Transpose(b *testing.B) {
+	ImmutableMatrixTransposeRunner(b, 90, transposeCopy)
+}
+
+func BenchmarkImmutableMatrix90x90TransposeView(b *testing.B) {
+	ImmutableMatrixTransposeRunner(b, 90, transposeView)
+}
+
+func BenchmarkImmutableMatrix90x90Quadrants(b *testing.B) {
+	ImmutableMatrixQuadrantsRunner(b, 90, quadrantCopy)
+}
+
+func BenchmarkImmutableMatrix90x90QuadrantsView(b *testing.B) {
+	ImmutableMatrixQuadrantsRunner(b, 90, quadrantView)
+}
+
+func BenchmarkMutableMatrix270x270Add(b *testing.B) {
+	g := MutableMatrixGenerator[int]{MatrixSize: 270, Random: rand.Int}
+	MatrixAddRunner(b, g, 10)
+}
+
+func BenchmarkImmutableMatrix270x270Add(b *testing.B) {
+	g := ImmutableMatrixGenerator[int]{MatrixSize: 270, Random: rand.Int}
+	MatrixAddRunner(b, g, 10)
+}
+
+func BenchmarkMutableMatrix270x270Scalar(b *testing.B) {
+	g := MutableMatrixGenerator[int]{MatrixSize: 270, Random: rand.Int}
+	MatrixScalarRunner(b, g, 10)
+}
+
+func BenchmarkImmutableMatrix270x270Scalar(b *testing.B) {
+	g := ImmutableMatrixGenerator[int]{MatrixSize: 270, Random: rand.Int}
+	MatrixScalarRunner(b, g, 10)
+}
+
+func BenchmarkMutableMatrix270x270Multiply(b *testing.B) {
+	g := MutableMatrixGenerator[int]{MatrixSize: 270, Random: rand.Int}
+	MatrixMultiplyRunner(b, g, 10)
+}
+
+func BenchmarkImmutableMatrix270x270Multiply(b *testing.B) {
+	g := ImmutableMatrixGenerator[int]{MatrixSize: 270, Random: rand.Int}
+	MatrixMultiplyRunner(b, g, 10)
+}
+
+func BenchmarkMutableMatrix270x270Subtract(b *testing.B) {
+	g := MutableMatrixGenerator[int]{MatrixSize: 270, Random: rand.Int}
+	MatrixSubtractRunner(b, g, 10)
+}
+
+func BenchmarkImmutableMatrix270x270Subtract(b *testing.B) {
+	g := ImmutableMatrixGenerator[int]{MatrixSize: 270, Random: rand.Int}
+	MatrixSubtractRunner(b, g, 10)
+}
+
+func BenchmarkMutableMatrix270x270ZipWith(b *testing.B) {
+	g := MutableMatrixGenerator[int]{MatrixSize: 270, Random: rand.Int}
+	MatrixZipWithRunner(b, g, 10)
+}
+
+func BenchmarkMutableMatrix270x270Map(b *testing.B) {
+	g := MutableMatrixGenerator[int]{MatrixSize: 270, Random: rand.Int}
+	MatrixMapRunner(b, g, 10)
+}
+
+func BenchmarkImmutableMatrix270x270ZipWith(b *testing.B) {
+	g := ImmutableMatrixGenerator[int]{MatrixSize: 270, Random: rand.Int}
+	MatrixZipWithRunner(b, g, 10)
+}
+
+func BenchmarkImmutableMatrix270x270Map(b *testing.B) {
+	g := ImmutableMatrixGenerator[int]{MatrixSize: 270, Random: rand.Int}
+	MatrixMapRunner(b, g, 10)
+}
+
+func BenchmarkMutableMatrix270x270Power(b *testing.B) {
+	g := MutableMatrixGenerator[int]{MatrixSize: 270, Random: rand.Int}
+	MatrixPowerRunner(b, g, 10)
+}
+
+func BenchmarkImmutableMatrix270x270Power(b *testing.B) {
+	g := ImmutableMatrixGenerator[int]{MatrixSize: 270, Random: rand.Int}
+	MatrixPowerRunner(b, g, 10)
+}
+
+func BenchmarkImmutableMatrix270x270New(b *testing.B) {
+	ImmutableMatrixNewRunner(b, 270, immutable.New[int])
+}
+
+func BenchmarkImmutableMatrix270x270NewUnsafeNoCopy(b *testing.B) {
+	ImmutableMatrixNewRunner(b, 270, immutable.NewUnsafeNoCopy[int])
+}
+
+func BenchmarkImmutableMatrix270x270Transpose(b *testing.B) {
+	ImmutableMatrixTransposeRunner(b, 270, transposeCopy)
+}
+
+func BenchmarkImmutableMatrix270x270TransposeView(b *testing.B) {
+	ImmutableMatrixTransposeRunner(b, 270, transposeView)
+}
+
+func BenchmarkImmutableMatrix270x270Quadrants(b *testing.B) {
+	ImmutableMatrixQuadrantsRunner(b, 270, quadrantCopy)
+}
+
+func BenchmarkImmutableMatrix270x270QuadrantsView(b *testing.B) {
+	ImmutableMatrixQuadrantsRunner(b, 270, quadrantView)
+}
+
+func BenchmarkMutableMatrix810x810Add(b *testing.B) {
+	g := MutableMatrixGenerator[int]{MatrixSize: 810, Random: rand.Int}
+	MatrixAddRunner(b, g, 10)
+}
+
+func BenchmarkImmutableMatrix810x810Add(b *testing.B) {
+	g := ImmutableMatrixGenerator[int]{MatrixSize: 810, Random: rand.Int}
+	MatrixAddRunner(b, g, 10)
+}
+
+func BenchmarkMutableMatrix810x810Scalar(b *testing.B) {
+	g := MutableMatrixGenerator[int]{MatrixSize: 810, Random: rand.Int}
+	MatrixScalarRunner(b, g, 10)
+}
+
+func BenchmarkImmutableMatrix810x810Scalar(b *testing.B) {
+	g := ImmutableMatrixGenerator[int]{MatrixSize: 810, Random: rand.Int}
+	MatrixScalarRunner(b, g, 10)
+}
+
+func BenchmarkMutableMatrix810x810Multiply(b *testing.B) {
+	g := MutableMatrixGenerator[int]{MatrixSize: 810, Random: rand.Int}
+	MatrixMultiplyRunner(b, g, 10)
+}
+
+func BenchmarkImmutableMatrix810x810Multiply(b *testing.B) {
+	g := ImmutableMatrixGenerator[int]{MatrixSize: 810, Random: rand.Int}
+	MatrixMultiplyRunner(b, g, 10)
+}
+
+func BenchmarkMutableMatrix810x810Subtract(b *testing.B) {
+	g := MutableMatrixGenerator[int]{MatrixSize: 810, Random: rand.Int}
+	MatrixSubtractRunner(b, g, 10)
+}
+
+func BenchmarkImmutableMatrix810x810Subtract(b *testing.B) {
+	g := ImmutableMatrixGenerator[int]{MatrixSize: 810, Random: rand.Int}
+	MatrixSubtractRunner(b, g, 10)
+}
+
+func BenchmarkMutableMatrix810x810ZipWith(b *testing.B) {
+	g := MutableMatrixGenerator[int]{MatrixSize: 810, Random: rand.Int}
+	MatrixZipWithRunner(b, g, 10)
+}
+
+func BenchmarkMutableMatrix810x810Map(b *testing.B) {
+	g := MutableMatrixGenerator[int]{MatrixSize: 810, Random: rand.Int}
+	MatrixMapRunner(b, g, 10)
+}
+
+func BenchmarkImmutableMatrix810x810ZipWith(b *testing.B) {
+	g := ImmutableMatrixGenerator[int]{MatrixSize: 810, Random: rand.Int}
+	MatrixZipWithRunner(b, g, 10)
+}
+
+func BenchmarkImmutableMatrix810x810Map(b *testing.B) {
+	g := ImmutableMatrixGenerator[int]{MatrixSize: 810, Random: rand.Int}
+	MatrixMapRunner(b, g, 10)
+}
+
+func BenchmarkMutableMatrix810x810Power(b *testing.B) {
+	g := MutableMatrixGenerator[int]{MatrixSize: 810, Random: rand.Int}
+	MatrixPowerRunner(b, g, 10)
+}
+
+func BenchmarkImmutableMatrix810x810Power(b *testing.B) {
+	g := ImmutableMatrixGenerator[int]{MatrixSize: 810, Random: rand.Int}
+	MatrixPowerRunner(b, g, 10)
+}
+
+func BenchmarkImmutableMatrix810x810New(b *testing.B) {
+	ImmutableMatrixNewRunner(b, 810, immutable.New[int])
+}
+
+func BenchmarkImmutableMatrix810x810NewUnsafeNoCopy(b *testing.B) {
+	ImmutableMatrixNewRunner(b, 810, immutable.NewUnsafeNoCopy[int])
+}
+
+func BenchmarkImmutableMatrix810x810Transpose(b *testing.B) {
+	ImmutableMatrixTransposeRunner(b, 810, transposeCopy)
+}
+
+func BenchmarkImmutableMatrix810x810TransposeView(b *testing.B) {
+	ImmutableMatrixTransposeRunner(b, 810, transposeView)
+}
+
+func BenchmarkImmutableMatrix810x810Quadrants(b *testing.B) {
+	ImmutableMatrixQuadrantsRunner(b, 810, quadrantCopy)
+}
+
+func BenchmarkImmutableMatrix810x810QuadrantsView(b *testing.B) {
+	ImmutableMatrixQuadrantsRunner(b, 810, quadrantView)
+}
+
+func BenchmarkMutableFloat64Matrix10x10Add(b *testing.B) {
+	g := MutableMatrixGenerator[float64]{MatrixSize: 10, Random: rand.Float64}
+	MatrixAddRunner(b, g, 10)
+}
+
+func BenchmarkImmutableFloat64Matrix10x10Add(b *testing.B) {
+	g := ImmutableMatrixGenerator[float64]{MatrixSize: 10, Random: rand.Float64}
+	MatrixAddRunner(b, g, 10)
+}
+
+func BenchmarkMutableFloat64Matrix10x10Scalar(b *testing.B) {
+	g := MutableMatrixGenerator[float64]{MatrixSize: 10, Random: rand.Float64}
+	MatrixScalarRunner(b, g, 10)
+}
+
+func BenchmarkImmutableFloat64Matrix10x10Scalar(b *testing.B) {
+	g := ImmutableMatrixGenerator[float64]{MatrixSize: 10, Random: rand.Float64}
+	MatrixScalarRunner(b, g, 10)
+}
+
+func BenchmarkMutableFloat64Matrix10x10Multiply(b *testing.B) {
+	g := MutableMatrixGenerator[float64]{MatrixSize: 10, Random: rand.Float64}
+	MatrixMultiplyRunner(b, g, 10)
+}
+
+func BenchmarkImmutableFloat64Matrix10x10Multiply(b *testing.B) {
+	g := ImmutableMatrixGenerator[float64]{MatrixSize: 10, Random: rand.Float64}
+	MatrixMultiplyRunner(b, g, 10)
+}
+
+func BenchmarkMutableFloat64Matrix10x10Subtract(b *testing.B) {
+	g := MutableMatrixGenerator[float64]{MatrixSize: 10, Random: rand.Float64}
+	MatrixSubtractRunner(b, g, 10)
+}
+
+func BenchmarkImmutableFloat64Matrix10x10Subtract(b *testing.B) {
+	g := ImmutableMatrixGenerator[float64]{MatrixSize: 10, Random: rand.Float64}
+	MatrixSubtractRunner(b, g, 10)
+}
+
+func BenchmarkMutableFloat64Matrix30x30Add(b *testing.B) {
+	g := MutableMatrixGenerator[float64]{MatrixSize: 30, Random: rand.Float64}
+	MatrixAddRunner(b, g, 10)
+}
+
+func BenchmarkImmutableFloat64Matrix30x30Add(b *testing.B) {
+	g := ImmutableMatrixGenerator[float64]{MatrixSize: 30, Random: rand.Float64}
+	MatrixAddRunner(b, g, 10)
+}
+
+func BenchmarkMutableFloat64Matrix30x30Scalar(b *testing.B) {
+	g := MutableMatrixGenerator[float64]{MatrixSize: 30, Random: rand.Float64}
+	MatrixScalarRunner(b, g, 10)
+}
+
+func BenchmarkImmutableFloat64Matrix30x30Scalar(b *testing.B) {
+	g := ImmutableMatrixGenerator[float64]{MatrixSize: 30, Random: rand.Float64}
+	MatrixScalarRunner(b, g, 10)
+}
+
+func BenchmarkMutableFloat64Matrix30x30Multiply(b *testing.B) {
+	g := MutableMatrixGenerator[float64]{MatrixSize: 30, Random: rand.Float64}
+	MatrixMultiplyRunner(b, g, 10)
+}
+
+func BenchmarkImmutableFloat64Matrix30x30Multiply(b *testing.B) {
+	g := ImmutableMatrixGenerator[float64]{MatrixSize: 30, Random: rand.Float64}
+	MatrixMultiplyRunner(b, g, 10)
+}
+
+func BenchmarkMutableFloat64Matrix30x30Subtract(b *testing.B) {
+	g := MutableMatrixGenerator[float64]{MatrixSize: 30, Random: rand.Float64}
+	MatrixSubtractRunner(b, g, 10)
+}
+
+func BenchmarkImmutableFloat64Matrix30x30Subtract(b *testing.B) {
+	g := ImmutableMatrixGenerator[float64]{MatrixSize: 30, Random: rand.Float64}
+	MatrixSubtractRunner(b, g, 10)
+}
+
+func BenchmarkMutableFloat64Matrix90x90Add(b *testing.B) {
+	g := MutableMatrixGenerator[float64]{MatrixSize: 90, Random: rand.Float64}
+	MatrixAddRunner(b, g, 10)
+}
+
+func BenchmarkImmutableFloat64Matrix90x90Add(b *testing.B) {
+	g := ImmutableMatrixGenerator[float64]{MatrixSize: 90, Random: rand.Float64}
+	MatrixAddRunner(b, g, 10)
+}
+
+func BenchmarkMutableFloat64Matrix90x90Scalar(b *testing.B) {
+	g := MutableMatrixGenerator[float64]{MatrixSize: 90, Random: rand.Float64}
+	MatrixScalarRunner(b, g, 10)
+}
+
+func BenchmarkImmutableFloat64Matrix90x90Scalar(b *testing.B) {
+	g := ImmutableMatrixGenerator[float64]{MatrixSize: 90, Random: rand.Float64}
+	MatrixScalarRunner(b, g, 10)
+}
+
+func BenchmarkMutableFloat64Matrix90x90Multiply(b *testing.B) {
+	g := MutableMatrixGenerator[float64]{MatrixSize: 90, Random: rand.Float64}
+	MatrixMultiplyRunner(b, g, 10)
+}
+
+func BenchmarkImmutableFloat64Matrix90x90Multiply(b *testing.B) {
+	g := ImmutableMatrixGenerator[float64]{MatrixSize: 90, Random: rand.Float64}
+	MatrixMultiplyRunner(b, g, 10)
+}
+
+func BenchmarkMutableFloat64Matrix90x90Subtract(b *testing.B) {
+	g := MutableMatrixGenerator[float64]{MatrixSize: 90, Random: rand.Float64}
+	MatrixSubtractRunner(b, g, 10)
+}
+
+func BenchmarkImmutableFloat64Matrix90x90Subtract(b *testing.B) {
+	g := ImmutableMatrixGenerator[float64]{MatrixSize: 90, Random: rand.Float64}
+	MatrixSubtractRunner(b, g, 10)
+}
+
+func BenchmarkMutableFloat64Matrix270x270Add(b *testing.B) {
+	g := MutableMatrixGenerator[float64]{MatrixSize: 270, Random: rand.Float64}
+	MatrixAddRunner(b, g, 10)
+}
+
+func BenchmarkImmutableFloat64Matrix270x270Add(b *testing.B) {
+	g := ImmutableMatrixGenerator[float64]{MatrixSize: 270, Random: rand.Float64}
+	MatrixAddRunner(b, g, 10)
+}
+
+func BenchmarkMutableFloat64Matrix270x270Scalar(b *testing.B) {
+	g := MutableMatrixGenerator[float64]{MatrixSize: 270, Random: rand.Float64}
+	MatrixScalarRunner(b, g, 10)
+}
+
+func BenchmarkImmutableFloat64Matrix270x270Scalar(b *testing.B) {
+	g := ImmutableMatrixGenerator[float64]{MatrixSize: 270, Random: rand.Float64}
+	MatrixScalarRunner(b, g, 10)
+}
+
+func BenchmarkMutableFloat64Matrix270x270Multiply(b *testing.B) {
+	g := MutableMatrixGenerator[float64]{MatrixSize: 270, Random: rand.Float64}
+	MatrixMultiplyRunner(b, g, 10)
+}
+
+func BenchmarkImmutableFloat64Matrix270x270Multiply(b *testing.B) {
+	g := ImmutableMatrixGenerator[float64]{MatrixSize: 270, Random: rand.Float64}
+	MatrixMultiplyRunner(b, g, 10)
+}
+
+func BenchmarkMutableFloat64Matrix270x270Subtract(b *testing.B) {
+	g := MutableMatrixGenerator[float64]{MatrixSize: 270, Random: rand.Float64}
+	MatrixSubtractRunner(b, g, 10)
+}
+
+func BenchmarkImmutableFloat64Matrix270x270Subtract(b *testing.B) {
+	g := ImmutableMatrixGenerator[float64]{MatrixSize: 270, Random: rand.Float64}
+	MatrixSubtractRunner(b, g, 10)
+}
+
+func BenchmarkMutableFloat64Matrix810x810Add(b *testing.B) {
+	g := MutableMatrixGenerator[float64]{MatrixSize: 810, Random: rand.Float64}
+	MatrixAddRunner(b, g, 10)
+}
+
+func BenchmarkImmutableFloat64Matrix810x810Add(b *testing.B) {
+	g := ImmutableMatrixGenerator[float64]{MatrixSize: 810, Random: rand.Float64}
+	MatrixAddRunner(b, g, 10)
+}
+
+func BenchmarkMutableFloat64Matrix810x810Scalar(b *testing.B) {
+	g := MutableMatrixGenerator[float64]{MatrixSize: 810, Random: rand.Float64}
+	MatrixScalarRunner(b, g, 10)
+}
+
+func BenchmarkImmutableFloat64Matrix810x810Scalar(b *testing.B) {
+	g := ImmutableMatrixGenerator[float64]{MatrixSize: 810, Random: rand.Float64}
+	MatrixScalarRunner(b, g, 10)
+}
+
+func BenchmarkMutableFloat64Matrix810x810Multiply(b *testing.B) {
+	g := MutableMatrixGenerator[float64]{MatrixSize: 810, Random: rand.Float64}
+	MatrixMultiplyRunner(b, g, 10)
+}
+
+func BenchmarkImmutableFloat64Matrix810x810Multiply(b *testing.B) {
+	g := ImmutableMatrixGenerator[float64]{MatrixSize: 810, Random: rand.Float64}
+	MatrixMultiplyRunner(b, g, 10)
+}
+
+func BenchmarkMutableFloat64Matrix810x810Subtract(b *testing.B) {
+	g := MutableMatrixGenerator[float64]{MatrixSize: 810, Random: rand.Float64}
+	MatrixSubtractRunner(b, g, 10)
+}
+
+func BenchmarkImmutableFloat64Matrix810x810Subtract(b *testing.B) {
+	g := ImmutableMatrixGenerator[float64]{MatrixSize: 810, Random: rand.Float64}
 	MatrixSubtractRunner(b, g, 10)
 }
+
+func BenchmarkMutableFactoryMatrix10x10OverflowPolicies(b *testing.B) {
+	g := MutableMatrixGenerator[int]{MatrixSize: 10, Random: smallInt}
+	OverflowPolicyRunner(b, g, mutable.Factory[int]{}, 10)
+}
+
+func BenchmarkImmutableFactoryMatrix10x10OverflowPolicies(b *testing.B) {
+	g := ImmutableMatrixGenerator[int]{MatrixSize: 10, Random: smallInt}
+	OverflowPolicyRunner(b, g, immutable.Factory[int]{}, 10)
+}
+
+func BenchmarkMutableFactoryMatrix30x30OverflowPolicies(b *testing.B) {
+	g := MutableMatrixGenerator[int]{MatrixSize: 30, Random: smallInt}
+	OverflowPolicyRunner(b, g, mutable.Factory[int]{}, 10)
+}
+
+func BenchmarkImmutableFactoryMatrix30x30OverflowPolicies(b *testing.B) {
+	g := ImmutableMatrixGenerator[int]{MatrixSize: 30, Random: smallInt}
+	OverflowPolicyRunner(b, g, immutable.Factory[int]{}, 10)
+}
+
+func BenchmarkMutableFactoryMatrix90x90OverflowPolicies(b *testing.B) {
+	g := MutableMatrixGenerator[int]{MatrixSize: 90, Random: smallInt}
+	OverflowPolicyRunner(b, g, mutable.Factory[int]{}, 10)
+}
+
+func BenchmarkImmutableFactoryMatrix90x90OverflowPolicies(b *testing.B) {
+	g := ImmutableMatrixGenerator[int]{MatrixSize: 90, Random: smallInt}
+	OverflowPolicyRunner(b, g, immutable.Factory[int]{}, 10)
+}
+
+func BenchmarkMutableFactoryMatrix270x270OverflowPolicies(b *testing.B) {
+	g := MutableMatrixGenerator[int]{MatrixSize: 270, Random: smallInt}
+	OverflowPolicyRunner(b, g, mutable.Factory[int]{}, 10)
+}
+
+func BenchmarkImmutableFactoryMatrix270x270OverflowPolicies(b *testing.B) {
+	g := ImmutableMatrixGenerator[int]{MatrixSize: 270, Random: smallInt}
+	OverflowPolicyRunner(b, g, immutable.Factory[int]{}, 10)
+}
+
+func BenchmarkMutableFactoryMatrix810x810OverflowPolicies(b *testing.B) {
+	g := MutableMatrixGenerator[int]{MatrixSize: 810, Random: smallInt}
+	OverflowPolicyRunner(b, g, mutable.Factory[int]{}, 10)
+}
+
+func BenchmarkImmutableFactoryMatrix810x810OverflowPolicies(b *testing.B) {
+	g := ImmutableMatrixGenerator[int]{MatrixSize: 810, Random: smallInt}
+	OverflowPolicyRunner(b, g, immutable.Factory[int]{}, 10)
+}
+
+func BenchmarkMutableBigIntMatrix10x10Add(b *testing.B) {
+	g := MutableBigMatrixGenerator[big.Int, *big.Int]{MatrixSize: 10, Random: randomBigInt, FromInt64: big.NewInt}
+	BigMatrixAddRunner(b, g, 10)
+}
+
+func BenchmarkImmutableBigIntMatrix10x10Add(b *testing.B) {
+	g := ImmutableBigMatrixGenerator[big.Int, *big.Int]{MatrixSize: 10, Random: randomBigInt, FromInt64: big.NewInt}
+	BigMatrixAddRunner(b, g, 10)
+}
+
+func BenchmarkMutableBigIntMatrix10x10Scalar(b *testing.B) {
+	g := MutableBigMatrixGenerator[big.Int, *big.Int]{MatrixSize: 10, Random: randomBigInt, FromInt64: big.NewInt}
+	BigMatrixScalarRunner(b, g, 10)
+}
+
+func BenchmarkImmutableBigIntMatrix10x10Scalar(b *testing.B) {
+	g := ImmutableBigMatrixGenerator[big.Int, *big.Int]{MatrixSize: 10, Random: randomBigInt, FromInt64: big.NewInt}
+	BigMatrixScalarRunner(b, g, 10)
+}
+
+func BenchmarkMutableBigIntMatrix10x10Multiply(b *testing.B) {
+	g := MutableBigMatrixGenerator[big.Int, *big.Int]{MatrixSize: 10, Random: randomBigInt, FromInt64: big.NewInt}
+	BigMatrixMultiplyRunner(b, g, 10)
+}
+
+func BenchmarkImmutableBigIntMatrix10x10Multiply(b *testing.B) {
+	g := ImmutableBigMatrixGenerator[big.Int, *big.Int]{MatrixSize: 10, Random: randomBigInt, FromInt64: big.NewInt}
+	BigMatrixMultiplyRunner(b, g, 10)
+}
+
+func BenchmarkMutableBigIntMatrix10x10Subtract(b *testing.B) {
+	g := MutableBigMatrixGenerator[big.Int, *big.Int]{MatrixSize: 10, Random: randomBigInt, FromInt64: big.NewInt}
+	BigMatrixSubtractRunner(b, g, 10)
+}
+
+func BenchmarkImmutableBigIntMatrix10x10Subtract(b *testing.B) {
+	g := ImmutableBigMatrixGenerator[big.Int, *big.Int]{MatrixSize: 10, Random: randomBigInt, FromInt64: big.NewInt}
+	BigMatrixSubtractRunner(b, g, 10)
+}
+
+func BenchmarkMutableBigIntMatrix10x10Power(b *testing.B) {
+	g := MutableBigMatrixGenerator[big.Int, *big.Int]{MatrixSize: 10, Random: randomBigInt, FromInt64: big.NewInt}
+	BigMatrixPowerRunner(b, g, 10)
+}
+
+func BenchmarkImmutableBigIntMatrix10x10Power(b *testing.B) {
+	g := ImmutableBigMatrixGenerator[big.Int, *big.Int]{MatrixSize: 10, Random: randomBigInt, FromInt64: big.NewInt}
+	BigMatrixPowerRunner(b, g, 10)
+}
+
+func BenchmarkMutableBigRatMatrix10x10Add(b *testing.B) {
+	g := MutableBigMatrixGenerator[big.Rat, *big.Rat]{MatrixSize: 10, Random: randomBigRat, FromInt64: new(big.Rat).SetInt64}
+	BigMatrixAddRunner(b, g, 10)
+}
+
+func BenchmarkImmutableBigRatMatrix10x10Add(b *testing.B) {
+	g := ImmutableBigMatrixGenerator[big.Rat, *big.Rat]{MatrixSize: 10, Random: randomBigRat, FromInt64: new(big.Rat).SetInt64}
+	BigMatrixAddRunner(b, g, 10)
+}
+
+func BenchmarkMutableBigRatMatrix10x10Scalar(b *testing.B) {
+	g := MutableBigMatrixGenerator[big.Rat, *big.Rat]{MatrixSize: 10, Random: randomBigRat, FromInt64: new(big.Rat).SetInt64}
+	BigMatrixScalarRunner(b, g, 10)
+}
+
+func BenchmarkImmutableBigRatMatrix10x10Scalar(b *testing.B) {
+	g := ImmutableBigMatrixGenerator[big.Rat, *big.Rat]{MatrixSize: 10, Random: randomBigRat, FromInt64: new(big.Rat).SetInt64}
+	BigMatrixScalarRunner(b, g, 10)
+}
+
+func BenchmarkMutableBigRatMatrix10x10Multiply(b *testing.B) {
+	g := MutableBigMatrixGenerator[big.Rat, *big.Rat]{MatrixSize: 10, Random: randomBigRat, FromInt64: new(big.Rat).SetInt64}
+	BigMatrixMultiplyRunner(b, g, 10)
+}
+
+func BenchmarkImmutableBigRatMatrix10x10Multiply(b *testing.B) {
+	g := ImmutableBigMatrixGenerator[big.Rat, *big.Rat]{MatrixSize: 10, Random: randomBigRat, FromInt64: new(big.Rat).SetInt64}
+	BigMatrixMultiplyRunner(b, g, 10)
+}
+
+func BenchmarkMutableBigRatMatrix10x10Subtract(b *testing.B) {
+	g := MutableBigMatrixGenerator[big.Rat, *big.Rat]{MatrixSize: 10, Random: randomBigRat, FromInt64: new(big.Rat).SetInt64}
+	BigMatrixSubtractRunner(b, g, 10)
+}
+
+func BenchmarkImmutableBigRatMatrix10x10Subtract(b *testing.B) {
+	g := ImmutableBigMatrixGenerator[big.Rat, *big.Rat]{MatrixSize: 10, Random: randomBigRat, FromInt64: new(big.Rat).SetInt64}
+	BigMatrixSubtractRunner(b, g, 10)
+}
+
+func BenchmarkMutableBigIntMatrix30x30Add(b *testing.B) {
+	g := MutableBigMatrixGenerator[big.Int, *big.Int]{MatrixSize: 30, Random: randomBigInt, FromInt64: big.NewInt}
+	BigMatrixAddRunner(b, g, 10)
+}
+
+func BenchmarkImmutableBigIntMatrix30x30Add(b *testing.B) {
+	g := ImmutableBigMatrixGenerator[big.Int, *big.Int]{MatrixSize: 30, Random: randomBigInt, FromInt64: big.NewInt}
+	BigMatrixAddRunner(b, g, 10)
+}
+
+func BenchmarkMutableBigIntMatrix30x30Scalar(b *testing.B) {
+	g := MutableBigMatrixGenerator[big.Int, *big.Int]{MatrixSize: 30, Random: randomBigInt, FromInt64: big.NewInt}
+	BigMatrixScalarRunner(b, g, 10)
+}
+
+func BenchmarkImmutableBigIntMatrix30x30Scalar(b *testing.B) {
+	g := ImmutableBigMatrixGenerator[big.Int, *big.Int]{MatrixSize: 30, Random: randomBigInt, FromInt64: big.NewInt}
+	BigMatrixScalarRunner(b, g, 10)
+}
+
+func BenchmarkMutableBigIntMatrix30x30Multiply(b *testing.B) {
+	g := MutableBigMatrixGenerator[big.Int, *big.Int]{MatrixSize: 30, Random: randomBigInt, FromInt64: big.NewInt}
+	BigMatrixMultiplyRunner(b, g, 10)
+}
+
+func BenchmarkImmutableBigIntMatrix30x30Multiply(b *testing.B) {
+	g := ImmutableBigMatrixGenerator[big.Int, *big.Int]{MatrixSize: 30, Random: randomBigInt, FromInt64: big.NewInt}
+	BigMatrixMultiplyRunner(b, g, 10)
+}
+
+func BenchmarkMutableBigIntMatrix30x30Subtract(b *testing.B) {
+	g := MutableBigMatrixGenerator[big.Int, *big.Int]{MatrixSize: 30, Random: randomBigInt, FromInt64: big.NewInt}
+	BigMatrixSubtractRunner(b, g, 10)
+}
+
+func BenchmarkImmutableBigIntMatrix30x30Subtract(b *testing.B) {
+	g := ImmutableBigMatrixGenerator[big.Int, *big.Int]{MatrixSize: 30, Random: randomBigInt, FromInt64: big.NewInt}
+	BigMatrixSubtractRunner(b, g, 10)
+}
+
+func BenchmarkMutableBigIntMatrix30x30Power(b *testing.B) {
+	g := MutableBigMatrixGenerator[big.Int, *big.Int]{MatrixSize: 30, Random: randomBigInt, FromInt64: big.NewInt}
+	BigMatrixPowerRunner(b, g, 10)
+}
+
+func BenchmarkImmutableBigIntMatrix30x30Power(b *testing.B) {
+	g := ImmutableBigMatrixGenerator[big.Int, *big.Int]{MatrixSize: 30, Random: randomBigInt, FromInt64: big.NewInt}
+	BigMatrixPowerRunner(b, g, 10)
+}
+
+func BenchmarkMutableBigRatMatrix30x30Add(b *testing.B) {
+	g := MutableBigMatrixGenerator[big.Rat, *big.Rat]{MatrixSize: 30, Random: randomBigRat, FromInt64: new(big.Rat).SetInt64}
+	BigMatrixAddRunner(b, g, 10)
+}
+
+func BenchmarkImmutableBigRatMatrix30x30Add(b *testing.B) {
+	g := ImmutableBigMatrixGenerator[big.Rat, *big.Rat]{MatrixSize: 30, Random: randomBigRat, FromInt64: new(big.Rat).SetInt64}
+	BigMatrixAddRunner(b, g, 10)
+}
+
+func BenchmarkMutableBigRatMatrix30x30Scalar(b *testing.B) {
+	g := MutableBigMatrixGenerator[big.Rat, *big.Rat]{MatrixSize: 30, Random: randomBigRat, FromInt64: new(big.Rat).SetInt64}
+	BigMatrixScalarRunner(b, g, 10)
+}
+
+func BenchmarkImmutableBigRatMatrix30x30Scalar(b *testing.B) {
+	g := ImmutableBigMatrixGenerator[big.Rat, *big.Rat]{MatrixSize: 30, Random: randomBigRat, FromInt64: new(big.Rat).SetInt64}
+	BigMatrixScalarRunner(b, g, 10)
+}
+
+func BenchmarkMutableBigRatMatrix30x30Multiply(b *testing.B) {
+	g := MutableBigMatrixGenerator[big.Rat, *big.Rat]{MatrixSize: 30, Random: randomBigRat, FromInt64: new(big.Rat).SetInt64}
+	BigMatrixMultiplyRunner(b, g, 10)
+}
+
+func BenchmarkImmutableBigRatMatrix30x30Multiply(b *testing.B) {
+	g := ImmutableBigMatrixGenerator[big.Rat, *big.Rat]{MatrixSize: 30, Random: randomBigRat, FromInt64: new(big.Rat).SetInt64}
+	BigMatrixMultiplyRunner(b, g, 10)
+}
+
+func BenchmarkMutableBigRatMatrix30x30Subtract(b *testing.B) {
+	g := MutableBigMatrixGenerator[big.Rat, *big.Rat]{MatrixSize: 30, Random: randomBigRat, FromInt64: new(big.Rat).SetInt64}
+	BigMatrixSubtractRunner(b, g, 10)
+}
+
+func BenchmarkImmutableBigRatMatrix30x30Subtract(b *testing.B) {
+	g := ImmutableBigMatrixGenerator[big.Rat, *big.Rat]{MatrixSize: 30, Random: randomBigRat, FromInt64: new(big.Rat).SetInt64}
+	BigMatrixSubtractRunner(b, g, 10)
+}
+
+func BenchmarkMutableBigIntMatrix90x90Add(b *testing.B) {
+	g := MutableBigMatrixGenerator[big.Int, *big.Int]{MatrixSize: 90, Random: randomBigInt, FromInt64: big.NewInt}
+	BigMatrixAddRunner(b, g, 10)
+}
+
+func BenchmarkImmutableBigIntMatrix90x90Add(b *testing.B) {
+	g := ImmutableBigMatrixGenerator[big.Int, *big.Int]{MatrixSize: 90, Random: randomBigInt, FromInt64: big.NewInt}
+	BigMatrixAddRunner(b, g, 10)
+}
+
+func BenchmarkMutableBigIntMatrix90x90Scalar(b *testing.B) {
+	g := MutableBigMatrixGenerator[big.Int, *big.Int]{MatrixSize: 90, Random: randomBigInt, FromInt64: big.NewInt}
+	BigMatrixScalarRunner(b, g, 10)
+}
+
+func BenchmarkImmutableBigIntMatrix90x90Scalar(b *testing.B) {
+	g := ImmutableBigMatrixGenerator[big.Int, *big.Int]{MatrixSize: 90, Random: randomBigInt, FromInt64: big.NewInt}
+	BigMatrixScalarRunner(b, g, 10)
+}
+
+func BenchmarkMutableBigIntMatrix90x90Multiply(b *testing.B) {
+	g := MutableBigMatrixGenerator[big.Int, *big.Int]{MatrixSize: 90, Random: randomBigInt, FromInt64: big.NewInt}
+	BigMatrixMultiplyRunner(b, g, 10)
+}
+
+func BenchmarkImmutableBigIntMatrix90x90Multiply(b *testing.B) {
+	g := ImmutableBigMatrixGenerator[big.Int, *big.Int]{MatrixSize: 90, Random: randomBigInt, FromInt64: big.NewInt}
+	BigMatrixMultiplyRunner(b, g, 10)
+}
+
+func BenchmarkMutableBigIntMatrix90x90Subtract(b *testing.B) {
+	g := MutableBigMatrixGenerator[big.Int, *big.Int]{MatrixSize: 90, Random: randomBigInt, FromInt64: big.NewInt}
+	BigMatrixSubtractRunner(b, g, 10)
+}
+
+func BenchmarkImmutableBigIntMatrix90x90Subtract(b *testing.B) {
+	g := ImmutableBigMatrixGenerator[big.Int, *big.Int]{MatrixSize: 90, Random: randomBigInt, FromInt64: big.NewInt}
+	BigMatrixSubtractRunner(b, g, 10)
+}
+
+func BenchmarkMutableBigIntMatrix90x90Power(b *testing.B) {
+	g := MutableBigMatrixGenerator[big.Int, *big.Int]{MatrixSize: 90, Random: randomBigInt, FromInt64: big.NewInt}
+	BigMatrixPowerRunner(b, g, 10)
+}
+
+func BenchmarkImmutableBigIntMatrix90x90Power(b *testing.B) {
+	g := ImmutableBigMatrixGenerator[big.Int, *big.Int]{MatrixSize: 90, Random: randomBigInt, FromInt64: big.NewInt}
+	BigMatrixPowerRunner(b, g, 10)
+}
+
+func BenchmarkMutableBigRatMatrix90x90Add(b *testing.B) {
+	g := MutableBigMatrixGenerator[big.Rat, *big.Rat]{MatrixSize: 90, Random: randomBigRat, FromInt64: new(big.Rat).SetInt64}
+	BigMatrixAddRunner(b, g, 10)
+}
+
+func BenchmarkImmutableBigRatMatrix90x90Add(b *testing.B) {
+	g := ImmutableBigMatrixGenerator[big.Rat, *big.Rat]{MatrixSize: 90, Random: randomBigRat, FromInt64: new(big.Rat).SetInt64}
+	BigMatrixAddRunner(b, g, 10)
+}
+
+func BenchmarkMutableBigRatMatrix90x90Scalar(b *testing.B) {
+	g := MutableBigMatrixGenerator[big.Rat, *big.Rat]{MatrixSize: 90, Random: randomBigRat, FromInt64: new(big.Rat).SetInt64}
+	BigMatrixScalarRunner(b, g, 10)
+}
+
+func BenchmarkImmutableBigRatMatrix90x90Scalar(b *testing.B) {
+	g := ImmutableBigMatrixGenerator[big.Rat, *big.Rat]{MatrixSize: 90, Random: randomBigRat, FromInt64: new(big.Rat).SetInt64}
+	BigMatrixScalarRunner(b, g, 10)
+}
+
+func BenchmarkMutableBigRatMatrix90x90Multiply(b *testing.B) {
+	g := MutableBigMatrixGenerator[big.Rat, *big.Rat]{MatrixSize: 90, Random: randomBigRat, FromInt64: new(big.Rat).SetInt64}
+	BigMatrixMultiplyRunner(b, g, 10)
+}
+
+func BenchmarkImmutableBigRatMatrix90x90Multiply(b *testing.B) {
+	g := ImmutableBigMatrixGenerator[big.Rat, *big.Rat]{MatrixSize: 90, Random: randomBigRat, FromInt64: new(big.Rat).SetInt64}
+	BigMatrixMultiplyRunner(b, g, 10)
+}
+
+func BenchmarkMutableBigRatMatrix90x90Subtract(b *testing.B) {
+	g := MutableBigMatrixGenerator[big.Rat, *big.Rat]{MatrixSize: 90, Random: randomBigRat, FromInt64: new(big.Rat).SetInt64}
+	BigMatrixSubtractRunner(b, g, 10)
+}
+
+func BenchmarkImmutableBigRatMatrix90x90Subtract(b *testing.B) {
+	g := ImmutableBigMatrixGenerator[big.Rat, *big.Rat]{MatrixSize: 90, Random: randomBigRat, FromInt64: new(big.Rat).SetInt64}
+	BigMatrixSubtractRunner(b, g, 10)
+}
+
+func BenchmarkMutableFloat64Matrix10x10LU(b *testing.B) {
+	MutableLURunner(b, 10)
+}
+
+func BenchmarkImmutableFloat64Matrix10x10LU(b *testing.B) {
+	ImmutableLURunner(b, 10)
+}
+
+func BenchmarkMutableFloat64Matrix30x30LU(b *testing.B) {
+	MutableLURunner(b, 30)
+}
+
+func BenchmarkImmutableFloat64Matrix30x30LU(b *testing.B) {
+	ImmutableLURunner(b, 30)
+}
+
+func BenchmarkMutableFloat64Matrix90x90LU(b *testing.B) {
+	MutableLURunner(b, 90)
+}
+
+func BenchmarkImmutableFloat64Matrix90x90LU(b *testing.B) {
+	ImmutableLURunner(b, 90)
+}
+
+func BenchmarkMutableFloat64Matrix270x270LU(b *testing.B) {
+	MutableLURunner(b, 270)
+}
+
+func BenchmarkImmutableFloat64Matrix270x270LU(b *testing.B) {
+	ImmutableLURunner(b, 270)
+}
+
+func BenchmarkMutableBigRatMatrix10x10LU(b *testing.B) {
+	MutableBigRatLURunner(b, 10)
+}
+
+func BenchmarkImmutableBigRatMatrix10x10LU(b *testing.B) {
+	ImmutableBigRatLURunner(b, 10)
+}
+
+func BenchmarkMutableBigRatMatrix30x30LU(b *testing.B) {
+	MutableBigRatLURunner(b, 30)
+}
+
+func BenchmarkImmutableBigRatMatrix30x30LU(b *testing.B) {
+	ImmutableBigRatLURunner(b, 30)
+}
@@ -0,0 +1,427 @@
+package immutable
+
+import (
+	"github.com/chris-tomich/immutability-benchmarking"
+	"github.com/chris-tomich/immutability-benchmarking/internal/power"
+	"github.com/chris-tomich/immutability-benchmarking/internal/shape"
+	sliceimmutable "github.com/chris-tomich/immutability-benchmarking/slice/immutable"
+	"github.com/chris-tomich/immutability-benchmarking/sparse/internal/csr"
+)
+
+// Matrix is an immutable sparse matrix in compressed sparse row format with non-mutating operations.
+type Matrix[T immutabilitybenchmarking.Number] struct {
+	data csr.Matrix[T]
+}
+
+// New creates a new immutable matrix holding the non-zero elements of the given dense values.
+func New[T immutabilitybenchmarking.Number](matrix [][]T) (Matrix[T], error) {
+	data, err := csr.FromRows(matrix)
+	if err != nil {
+		return Matrix[T]{}, err
+	}
+
+	return Matrix[T]{data: data}, nil
+}
+
+// NewEmpty createas a new matrix of zeros with the given dimensions.
+func NewEmpty[T immutabilitybenchmarking.Number](width int, height int) Matrix[T] {
+	if width <= 0 || height <= 0 {
+		panic(&immutabilitybenchmarking.InvalidDimensionsError{Rows: height, Cols: width})
+	}
+
+	return Matrix[T]{data: csr.Zeros[T](height, width)}
+}
+
+// FromMatrix creates a new immutable matrix holding the non-zero elements of any other matrix.
+func FromMatrix[T immutabilitybenchmarking.Number](m immutabilitybenchmarking.Matrix[T]) Matrix[T] {
+	return Matrix[T]{data: other(m)}
+}
+
+// FromSlice creates a new immutable matrix holding the non-zero elements of a slice backed matrix.
+func FromSlice[T immutabilitybenchmarking.Number](m sliceimmutable.Matrix[T]) Matrix[T] {
+	return Matrix[T]{data: csr.From[T](m)}
+}
+
+// ToSlice creates a slice backed matrix holding every element of this matrix.
+func (m1 Matrix[T]) ToSlice() sliceimmutable.Matrix[T] {
+	m, err := sliceimmutable.NewUnsafeNoCopy(m1.data.Dense())
+	if err != nil {
+		panic(err)
+	}
+
+	return m
+}
+
+// other returns the elements of m in compressed sparse row format, only converting them if m is not
+// already a matrix from this package.
+func other[T immutabilitybenchmarking.Number](m immutabilitybenchmarking.Matrix[T]) csr.Matrix[T] {
+	if o, ok := m.(Matrix[T]); ok {
+		return o.data
+	}
+
+	return csr.From(m)
+}
+
+// Width returns the number of columns in the matrix.
+func (m1 Matrix[T]) Width() int {
+	return m1.data.Cols
+}
+
+// Height returns the number of rows in the matrix.
+func (m1 Matrix[T]) Height() int {
+	return m1.data.Rows
+}
+
+// NonZeros returns the number of elements stored by the matrix.
+func (m1 Matrix[T]) NonZeros() int {
+	return m1.data.NonZeros()
+}
+
+// ForEachNonZero calls f with every stored element in row-major order.
+func (m1 Matrix[T]) ForEachNonZero(f func(row int, col int, v T)) {
+	m1.data.ForEachNonZero(f)
+}
+
+// Get returns the element at the provided coordinates.
+func (m1 Matrix[T]) Get(row int, col int) T {
+	return m1.data.Get(row, col)
+}
+
+// At returns the element at the provided coordinates or an OutOfBoundsError if they are outside the matrix.
+func (m1 Matrix[T]) At(row int, col int) (T, error) {
+	if row < 0 || row >= m1.Height() || col < 0 || col >= m1.Width() {
+		var zero T
+		return zero, &immutabilitybenchmarking.OutOfBoundsError{Row: row, Col: col, Rows: m1.Height(), Cols: m1.Width()}
+	}
+
+	return m1.data.Get(row, col), nil
+}
+
+// MustAt returns the element at the provided coordinates and panics with an OutOfBoundsError if they are
+// outside the matrix.
+func (m1 Matrix[T]) MustAt(row int, col int) T {
+	v, err := m1.At(row, col)
+	if err != nil {
+		panic(err)
+	}
+
+	return v
+}
+
+// Equals will compare a matrix against this matrix and return if they are equal.
+func (m1 Matrix[T]) Equals(m2 immutabilitybenchmarking.Matrix[T]) bool {
+	if m1.Height() != m2.Height() || m1.Width() != m2.Width() {
+		return false
+	}
+
+	return m1.data.Equals(other(m2))
+}
+
+// Add will add the values of a matrix to this matrix.
+func (m1 Matrix[T]) Add(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Height() != m2.Height() || m1.Width() != m2.Width() {
+		return Matrix[T]{}, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "Add",
+			LeftRows:  m1.Height(),
+			LeftCols:  m1.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	return Matrix[T]{data: m1.data.Add(other(m2))}, nil
+}
+
+// Subtract will subtract the values of a matrix from this matrix.
+func (m1 Matrix[T]) Subtract(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Height() != m2.Height() || m1.Width() != m2.Width() {
+		return Matrix[T]{}, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "Subtract",
+			LeftRows:  m1.Height(),
+			LeftCols:  m1.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	return Matrix[T]{data: m1.data.Subtract(other(m2))}, nil
+}
+
+// ScalarMultiply will multiply this matrix by a given scalar value.
+func (m1 Matrix[T]) ScalarMultiply(s T) immutabilitybenchmarking.Matrix[T] {
+	return Matrix[T]{data: m1.data.ScalarMultiply(s)}
+}
+
+// Transpose will transpose this matrix.
+func (m1 Matrix[T]) Transpose() immutabilitybenchmarking.Matrix[T] {
+	return Matrix[T]{data: m1.data.Transpose()}
+}
+
+// MatrixMultiply will multiple the given matrix against this matrix.
+func (m1 Matrix[T]) MatrixMultiply(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Width() != m2.Height() {
+		return Matrix[T]{}, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "MatrixMultiply",
+			LeftRows:  m1.Height(),
+			LeftCols:  m1.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	return Matrix[T]{data: m1.data.Multiply(other(m2))}, nil
+}
+
+// Map will apply f to every element of this matrix.
+func (m1 Matrix[T]) Map(f func(v T) T) immutabilitybenchmarking.Matrix[T] {
+	return Matrix[T]{data: m1.data.Map(f)}
+}
+
+// MapIndexed will apply f to every element of this matrix along with its coordinates.
+func (m1 Matrix[T]) MapIndexed(f func(row int, col int, v T) T) immutabilitybenchmarking.Matrix[T] {
+	return Matrix[T]{data: m1.data.MapIndexed(f)}
+}
+
+// ZipWith will combine each element of this matrix with the element at the same coordinates of the given
+// matrix using f.
+func (m1 Matrix[T]) ZipWith(m2 immutabilitybenchmarking.Matrix[T], f func(a T, b T) T) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Height() != m2.Height() || m1.Width() != m2.Width() {
+		return Matrix[T]{}, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "ZipWith",
+			LeftRows:  m1.Height(),
+			LeftCols:  m1.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	return Matrix[T]{data: m1.data.ZipWith(other(m2), f)}, nil
+}
+
+// Fold will combine every element of this matrix into a single value, starting from initial and visiting
+// the elements in row-major order.
+func (m1 Matrix[T]) Fold(initial T, f func(acc T, v T) T) T {
+	return m1.data.Fold(initial, f)
+}
+
+// RowReduce will fold each row of this matrix into a single value starting from initial, returning a
+// column of the results.
+func (m1 Matrix[T]) RowReduce(initial T, f func(acc T, v T) T) immutabilitybenchmarking.Matrix[T] {
+	return Matrix[T]{data: m1.data.RowReduce(initial, f)}
+}
+
+// ColReduce will fold each column of this matrix into a single value starting from initial, returning a
+// row of the results.
+func (m1 Matrix[T]) ColReduce(initial T, f func(acc T, v T) T) immutabilitybenchmarking.Matrix[T] {
+	return Matrix[T]{data: m1.data.ColReduce(initial, f)}
+}
+
+// HadamardProduct will multiply each element of this matrix by the element at the same coordinates of the
+// given matrix.
+func (m1 Matrix[T]) HadamardProduct(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Height() != m2.Height() || m1.Width() != m2.Width() {
+		return Matrix[T]{}, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "HadamardProduct",
+			LeftRows:  m1.Height(),
+			LeftCols:  m1.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	return Matrix[T]{data: m1.data.Hadamard(other(m2))}, nil
+}
+
+// ElementwiseDivide will divide each element of this matrix by the element at the same coordinates of the
+// given matrix, returning a DivisionByZeroError if any of them is zero.
+func (m1 Matrix[T]) ElementwiseDivide(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Height() != m2.Height() || m1.Width() != m2.Width() {
+		return Matrix[T]{}, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "ElementwiseDivide",
+			LeftRows:  m1.Height(),
+			LeftCols:  m1.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	data, err := m1.data.Divide(other(m2))
+	if err != nil {
+		return Matrix[T]{}, err
+	}
+
+	return Matrix[T]{data: data}, nil
+}
+
+// KroneckerProduct will multiply every element of this matrix by the whole of the given matrix, returning
+// the blocks of products.
+func (m1 Matrix[T]) KroneckerProduct(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	return Matrix[T]{data: m1.data.Kronecker(other(m2))}, nil
+}
+
+// Trace will sum the elements on the diagonal of this matrix, returning a NotSquareError if it is not square.
+func (m1 Matrix[T]) Trace() (T, error) {
+	if m1.Height() != m1.Width() {
+		var zero T
+		return zero, &immutabilitybenchmarking.NotSquareError{Rows: m1.Height(), Cols: m1.Width()}
+	}
+
+	return m1.data.Trace(), nil
+}
+
+// Sum will add together every element of this matrix.
+func (m1 Matrix[T]) Sum() T {
+	return m1.data.Sum()
+}
+
+// Min will return the smallest element of this matrix. Complex elements are ordered by their real parts and
+// then by their imaginary parts.
+func (m1 Matrix[T]) Min() T {
+	return m1.data.Get(m1.data.ArgMin())
+}
+
+// Max will return the largest element of this matrix. Complex elements are ordered by their real parts and
+// then by their imaginary parts.
+func (m1 Matrix[T]) Max() T {
+	return m1.data.Get(m1.data.ArgMax())
+}
+
+// ArgMin will return the coordinates of the first smallest element of this matrix in row-major order.
+func (m1 Matrix[T]) ArgMin() (int, int) {
+	return m1.data.ArgMin()
+}
+
+// ArgMax will return the coordinates of the first largest element of this matrix in row-major order.
+func (m1 Matrix[T]) ArgMax() (int, int) {
+	return m1.data.ArgMax()
+}
+
+// FrobeniusNorm will return the square root of the sum of the squared absolute values of the elements of
+// this matrix.
+func (m1 Matrix[T]) FrobeniusNorm() float64 {
+	return m1.data.FrobeniusNorm()
+}
+
+// OneNorm will return the largest sum of the absolute values in a column of this matrix.
+func (m1 Matrix[T]) OneNorm() float64 {
+	return m1.data.OneNorm()
+}
+
+// InfNorm will return the largest sum of the absolute values in a row of this matrix.
+func (m1 Matrix[T]) InfNorm() float64 {
+	return m1.data.InfNorm()
+}
+
+// Rank will return the number of linearly independent rows of this matrix, treating values within rounding
+// error of zero as zero.
+func (m1 Matrix[T]) Rank() int {
+	return m1.data.Rank()
+}
+
+// Power will raise this matrix to the power n by repeated squaring, returning a NotSquareError if it is not
+// square or a NegativeExponentError if n is negative. The zeroth power is the identity matrix.
+func (m1 Matrix[T]) Power(n int) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Height() != m1.Width() {
+		return Matrix[T]{}, &immutabilitybenchmarking.NotSquareError{Rows: m1.Height(), Cols: m1.Width()}
+	}
+
+	if n < 0 {
+		return Matrix[T]{}, &immutabilitybenchmarking.NegativeExponentError{Exponent: n}
+	}
+
+	return power.BySquaring[immutabilitybenchmarking.Matrix[T]](m1, n, func() (immutabilitybenchmarking.Matrix[T], error) {
+		return Factory[T]{}.Identity(m1.Height())
+	}, nil)
+}
+
+// Reshape will read the elements of this matrix in row-major order into a matrix with the given dimensions,
+// returning a ReshapeError if it would not hold the same number of elements. The stored values stay in the
+// same order, so the result shares them with this matrix.
+func (m1 Matrix[T]) Reshape(rows int, cols int) (immutabilitybenchmarking.Matrix[T], error) {
+	if err := shape.CheckReshape(m1, rows, cols); err != nil {
+		return Matrix[T]{}, err
+	}
+
+	return Matrix[T]{data: m1.data.Reshape(rows, cols)}, nil
+}
+
+// HConcat will place the given matrix to the right of this matrix, returning a DimensionMismatchError if
+// they do not have the same number of rows.
+func (m1 Matrix[T]) HConcat(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if err := shape.CheckHConcat(m1, m2); err != nil {
+		return Matrix[T]{}, err
+	}
+
+	return Matrix[T]{data: m1.data.HConcat(other(m2))}, nil
+}
+
+// VConcat will place the given matrix below this matrix, returning a DimensionMismatchError if they do not
+// have the same number of columns.
+func (m1 Matrix[T]) VConcat(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	return m1.stack("VConcat", m2)
+}
+
+// Stack will place the given matrices below this matrix in order, returning a DimensionMismatchError for
+// the first that does not have the same number of columns.
+func (m1 Matrix[T]) Stack(ms ...immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	return m1.stack("Stack", ms...)
+}
+
+func (m1 Matrix[T]) stack(op string, ms ...immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if err := shape.CheckStack(op, m1, ms...); err != nil {
+		return Matrix[T]{}, err
+	}
+
+	data := make([]csr.Matrix[T], len(ms))
+
+	for i, m2 := range ms {
+		data[i] = other(m2)
+	}
+
+	return Matrix[T]{data: m1.data.Stack(data...)}, nil
+}
+
+// SplitRows will split this matrix into its rows before at and its rows from at onwards, returning an
+// InvalidDimensionsError if either part would be empty. Both parts share the stored values of this matrix.
+func (m1 Matrix[T]) SplitRows(at int) (immutabilitybenchmarking.Matrix[T], immutabilitybenchmarking.Matrix[T], error) {
+	if err := shape.CheckSplitRows(m1, at); err != nil {
+		return Matrix[T]{}, Matrix[T]{}, err
+	}
+
+	top, bottom := m1.data.SplitRows(at)
+
+	return Matrix[T]{data: top}, Matrix[T]{data: bottom}, nil
+}
+
+// SplitCols will split this matrix into its columns before at and its columns from at onwards, returning an
+// InvalidDimensionsError if either part would be empty.
+func (m1 Matrix[T]) SplitCols(at int) (immutabilitybenchmarking.Matrix[T], immutabilitybenchmarking.Matrix[T], error) {
+	if err := shape.CheckSplitCols(m1, at); err != nil {
+		return Matrix[T]{}, Matrix[T]{}, err
+	}
+
+	left, right := m1.data.SplitCols(at)
+
+	return Matrix[T]{data: left}, Matrix[T]{data: right}, nil
+}
+
+// Pad will surround this matrix with the given number of rows and columns of zeros on each side, which are
+// not stored, so the result shares the stored values of this matrix. It returns a PaddingError if any of them
+// is negative.
+func (m1 Matrix[T]) Pad(top int, bottom int, left int, right int) (immutabilitybenchmarking.Matrix[T], error) {
+	if err := shape.CheckPad(top, bottom, left, right); err != nil {
+		return Matrix[T]{}, err
+	}
+
+	return Matrix[T]{data: m1.data.Pad(top, bottom, left, right)}, nil
+}
+
+// Flip will reverse the order of the rows or the columns of this matrix.
+func (m1 Matrix[T]) Flip(axis immutabilitybenchmarking.Axis) immutabilitybenchmarking.Matrix[T] {
+	return Matrix[T]{data: m1.data.Flip(axis)}
+}
+
+// Rotate90 will turn this matrix a quarter turn clockwise.
+func (m1 Matrix[T]) Rotate90() immutabilitybenchmarking.Matrix[T] {
+	return Matrix[T]{data: m1.data.Rotate90()}
+}
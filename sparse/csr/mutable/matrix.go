@@ -0,0 +1,495 @@
+package mutable
+
+import (
+	"github.com/chris-tomich/immutability-benchmarking"
+	"github.com/chris-tomich/immutability-benchmarking/internal/power"
+	"github.com/chris-tomich/immutability-benchmarking/internal/shape"
+	slicemutable "github.com/chris-tomich/immutability-benchmarking/slice/mutable"
+	"github.com/chris-tomich/immutability-benchmarking/sparse/internal/csr"
+)
+
+// Matrix is a sparse matrix in compressed sparse row format with mutating operations.
+type Matrix[T immutabilitybenchmarking.Number] struct {
+	data csr.Matrix[T]
+}
+
+// New creates a new matrix holding the non-zero elements of the given dense values.
+func New[T immutabilitybenchmarking.Number](matrix [][]T) (*Matrix[T], error) {
+	data, err := csr.FromRows(matrix)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Matrix[T]{data: data}, nil
+}
+
+// NewEmpty createas a new matrix of zeros with the given dimensions.
+func NewEmpty[T immutabilitybenchmarking.Number](width int, height int) (*Matrix[T], error) {
+	if width <= 0 || height <= 0 {
+		return nil, &immutabilitybenchmarking.InvalidDimensionsError{Rows: height, Cols: width}
+	}
+
+	return &Matrix[T]{data: csr.Zeros[T](height, width)}, nil
+}
+
+// FromMatrix creates a new matrix holding the non-zero elements of any other matrix.
+func FromMatrix[T immutabilitybenchmarking.Number](m immutabilitybenchmarking.Matrix[T]) *Matrix[T] {
+	data := other(m)
+
+	// The elements of another matrix from this package must not be shared as they are changed in place.
+	if _, ok := m.(*Matrix[T]); ok {
+		data = data.Clone()
+	}
+
+	return &Matrix[T]{data: data}
+}
+
+// FromSlice creates a new matrix holding the non-zero elements of a slice backed matrix.
+func FromSlice[T immutabilitybenchmarking.Number](m *slicemutable.Matrix[T]) *Matrix[T] {
+	return &Matrix[T]{data: csr.From[T](m)}
+}
+
+// ToSlice creates a slice backed matrix holding every element of this matrix.
+func (m *Matrix[T]) ToSlice() *slicemutable.Matrix[T] {
+	s, err := slicemutable.New(m.data.Dense())
+	if err != nil {
+		panic(err)
+	}
+
+	return s
+}
+
+// other returns the elements of m in compressed sparse row format, only converting them if m is not
+// already a matrix from this package.
+func other[T immutabilitybenchmarking.Number](m immutabilitybenchmarking.Matrix[T]) csr.Matrix[T] {
+	if o, ok := m.(*Matrix[T]); ok {
+		return o.data
+	}
+
+	return csr.From(m)
+}
+
+// Set replaces the element at the provided coordinates in place.
+func (m *Matrix[T]) Set(row int, col int, v T) {
+	m.data.Set(row, col, v)
+}
+
+// Width returns the number of columns in the matrix.
+func (m *Matrix[T]) Width() int {
+	return m.data.Cols
+}
+
+// Height returns the number of rows in the matrix.
+func (m *Matrix[T]) Height() int {
+	return m.data.Rows
+}
+
+// NonZeros returns the number of elements stored by the matrix.
+func (m *Matrix[T]) NonZeros() int {
+	return m.data.NonZeros()
+}
+
+// ForEachNonZero calls f with every stored element in row-major order.
+func (m *Matrix[T]) ForEachNonZero(f func(row int, col int, v T)) {
+	m.data.ForEachNonZero(f)
+}
+
+// Get returns the element at the provided coordinates.
+func (m *Matrix[T]) Get(row int, col int) T {
+	return m.data.Get(row, col)
+}
+
+// At returns the element at the provided coordinates or an OutOfBoundsError if they are outside the matrix.
+func (m *Matrix[T]) At(row int, col int) (T, error) {
+	if row < 0 || row >= m.Height() || col < 0 || col >= m.Width() {
+		var zero T
+		return zero, &immutabilitybenchmarking.OutOfBoundsError{Row: row, Col: col, Rows: m.Height(), Cols: m.Width()}
+	}
+
+	return m.data.Get(row, col), nil
+}
+
+// MustAt returns the element at the provided coordinates and panics with an OutOfBoundsError if they are
+// outside the matrix.
+func (m *Matrix[T]) MustAt(row int, col int) T {
+	v, err := m.At(row, col)
+	if err != nil {
+		panic(err)
+	}
+
+	return v
+}
+
+// Equals will compare a matrix against this matrix and return if they are equal.
+func (m *Matrix[T]) Equals(m2 immutabilitybenchmarking.Matrix[T]) bool {
+	if m.Height() != m2.Height() || m.Width() != m2.Width() {
+		return false
+	}
+
+	return m.data.Equals(other(m2))
+}
+
+// Add will add the values of a matrix to this matrix.
+func (m *Matrix[T]) Add(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m.Height() != m2.Height() || m.Width() != m2.Width() {
+		return nil, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "Add",
+			LeftRows:  m.Height(),
+			LeftCols:  m.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	m.data = m.data.Add(other(m2))
+
+	return m, nil
+}
+
+// Subtract will subtract the values of a matrix from this matrix.
+func (m *Matrix[T]) Subtract(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m.Height() != m2.Height() || m.Width() != m2.Width() {
+		return nil, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "Subtract",
+			LeftRows:  m.Height(),
+			LeftCols:  m.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	m.data = m.data.Subtract(other(m2))
+
+	return m, nil
+}
+
+// ScalarMultiply will multiply this matrix by a given scalar value.
+func (m *Matrix[T]) ScalarMultiply(s T) immutabilitybenchmarking.Matrix[T] {
+	m.data = m.data.ScalarMultiply(s)
+
+	return m
+}
+
+// Transpose will transpose this matrix.
+func (m *Matrix[T]) Transpose() immutabilitybenchmarking.Matrix[T] {
+	m.data = m.data.Transpose()
+
+	return m
+}
+
+// MatrixMultiply will multiple the given matrix against this matrix.
+func (m *Matrix[T]) MatrixMultiply(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m.Width() != m2.Height() {
+		return nil, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "MatrixMultiply",
+			LeftRows:  m.Height(),
+			LeftCols:  m.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	m.data = m.data.Multiply(other(m2))
+
+	return m, nil
+}
+
+// Map will apply f to every element of this matrix.
+func (m *Matrix[T]) Map(f func(v T) T) immutabilitybenchmarking.Matrix[T] {
+	m.data = m.data.Map(f)
+
+	return m
+}
+
+// MapIndexed will apply f to every element of this matrix along with its coordinates.
+func (m *Matrix[T]) MapIndexed(f func(row int, col int, v T) T) immutabilitybenchmarking.Matrix[T] {
+	m.data = m.data.MapIndexed(f)
+
+	return m
+}
+
+// ZipWith will combine each element of this matrix with the element at the same coordinates of the given
+// matrix using f.
+func (m *Matrix[T]) ZipWith(m2 immutabilitybenchmarking.Matrix[T], f func(a T, b T) T) (immutabilitybenchmarking.Matrix[T], error) {
+	if m.Height() != m2.Height() || m.Width() != m2.Width() {
+		return nil, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "ZipWith",
+			LeftRows:  m.Height(),
+			LeftCols:  m.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	m.data = m.data.ZipWith(other(m2), f)
+
+	return m, nil
+}
+
+// Fold will combine every element of this matrix into a single value, starting from initial and visiting
+// the elements in row-major order.
+func (m *Matrix[T]) Fold(initial T, f func(acc T, v T) T) T {
+	return m.data.Fold(initial, f)
+}
+
+// RowReduce will fold each row of this matrix into a single value starting from initial, replacing this
+// matrix with a column of the results.
+func (m *Matrix[T]) RowReduce(initial T, f func(acc T, v T) T) immutabilitybenchmarking.Matrix[T] {
+	m.data = m.data.RowReduce(initial, f)
+
+	return m
+}
+
+// ColReduce will fold each column of this matrix into a single value starting from initial, replacing this
+// matrix with a row of the results.
+func (m *Matrix[T]) ColReduce(initial T, f func(acc T, v T) T) immutabilitybenchmarking.Matrix[T] {
+	m.data = m.data.ColReduce(initial, f)
+
+	return m
+}
+
+// HadamardProduct will multiply each element of this matrix by the element at the same coordinates of the
+// given matrix.
+func (m *Matrix[T]) HadamardProduct(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m.Height() != m2.Height() || m.Width() != m2.Width() {
+		return nil, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "HadamardProduct",
+			LeftRows:  m.Height(),
+			LeftCols:  m.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	m.data = m.data.Hadamard(other(m2))
+
+	return m, nil
+}
+
+// ElementwiseDivide will divide each element of this matrix by the element at the same coordinates of the
+// given matrix. A DivisionByZeroError leaves this matrix as it was.
+func (m *Matrix[T]) ElementwiseDivide(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m.Height() != m2.Height() || m.Width() != m2.Width() {
+		return nil, &immutabilitybenchmarking.DimensionMismatchError{
+			Op:        "ElementwiseDivide",
+			LeftRows:  m.Height(),
+			LeftCols:  m.Width(),
+			RightRows: m2.Height(),
+			RightCols: m2.Width(),
+		}
+	}
+
+	data, err := m.data.Divide(other(m2))
+	if err != nil {
+		return nil, err
+	}
+
+	m.data = data
+
+	return m, nil
+}
+
+// KroneckerProduct will multiply every element of this matrix by the whole of the given matrix, replacing
+// this matrix with the blocks of products.
+func (m *Matrix[T]) KroneckerProduct(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	m.data = m.data.Kronecker(other(m2))
+
+	return m, nil
+}
+
+// Trace will sum the elements on the diagonal of this matrix, returning a NotSquareError if it is not square.
+func (m *Matrix[T]) Trace() (T, error) {
+	if m.Height() != m.Width() {
+		var zero T
+		return zero, &immutabilitybenchmarking.NotSquareError{Rows: m.Height(), Cols: m.Width()}
+	}
+
+	return m.data.Trace(), nil
+}
+
+// Sum will add together every element of this matrix.
+func (m *Matrix[T]) Sum() T {
+	return m.data.Sum()
+}
+
+// Min will return the smallest element of this matrix. Complex elements are ordered by their real parts and
+// then by their imaginary parts.
+func (m *Matrix[T]) Min() T {
+	return m.data.Get(m.data.ArgMin())
+}
+
+// Max will return the largest element of this matrix. Complex elements are ordered by their real parts and
+// then by their imaginary parts.
+func (m *Matrix[T]) Max() T {
+	return m.data.Get(m.data.ArgMax())
+}
+
+// ArgMin will return the coordinates of the first smallest element of this matrix in row-major order.
+func (m *Matrix[T]) ArgMin() (int, int) {
+	return m.data.ArgMin()
+}
+
+// ArgMax will return the coordinates of the first largest element of this matrix in row-major order.
+func (m *Matrix[T]) ArgMax() (int, int) {
+	return m.data.ArgMax()
+}
+
+// FrobeniusNorm will return the square root of the sum of the squared absolute values of the elements of
+// this matrix.
+func (m *Matrix[T]) FrobeniusNorm() float64 {
+	return m.data.FrobeniusNorm()
+}
+
+// OneNorm will return the largest sum of the absolute values in a column of this matrix.
+func (m *Matrix[T]) OneNorm() float64 {
+	return m.data.OneNorm()
+}
+
+// InfNorm will return the largest sum of the absolute values in a row of this matrix.
+func (m *Matrix[T]) InfNorm() float64 {
+	return m.data.InfNorm()
+}
+
+// Rank will return the number of linearly independent rows of this matrix, treating values within rounding
+// error of zero as zero.
+func (m *Matrix[T]) Rank() int {
+	return m.data.Rank()
+}
+
+// clone returns a copy of this matrix that does not share its elements.
+func (m *Matrix[T]) clone() *Matrix[T] {
+	return &Matrix[T]{data: m.data.Clone()}
+}
+
+// Power will raise this matrix to the power n in place by repeated squaring, returning a NotSquareError if it
+// is not square or a NegativeExponentError if n is negative. The zeroth power is the identity matrix.
+func (m *Matrix[T]) Power(n int) (immutabilitybenchmarking.Matrix[T], error) {
+	if m.Height() != m.Width() {
+		return nil, &immutabilitybenchmarking.NotSquareError{Rows: m.Height(), Cols: m.Width()}
+	}
+
+	if n < 0 {
+		return nil, &immutabilitybenchmarking.NegativeExponentError{Exponent: n}
+	}
+
+	// Squaring replaces the elements of m, so the first power kept for the result has to be a copy.
+	p, err := power.BySquaring[immutabilitybenchmarking.Matrix[T]](m, n, func() (immutabilitybenchmarking.Matrix[T], error) {
+		return Factory[T]{}.Identity(m.Height())
+	}, func(b immutabilitybenchmarking.Matrix[T]) immutabilitybenchmarking.Matrix[T] {
+		return b.(*Matrix[T]).clone()
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	m.data = p.(*Matrix[T]).data
+
+	return m, nil
+}
+
+// Reshape will read the elements of this matrix in row-major order into the given dimensions, returning a
+// ReshapeError if they would not hold the same number of elements. Only the positions of the stored
+// elements change.
+func (m *Matrix[T]) Reshape(rows int, cols int) (immutabilitybenchmarking.Matrix[T], error) {
+	if err := shape.CheckReshape(m, rows, cols); err != nil {
+		return nil, err
+	}
+
+	m.data = m.data.Reshape(rows, cols)
+
+	return m, nil
+}
+
+// HConcat will append the columns of the given matrix to the rows of this matrix, returning a
+// DimensionMismatchError if they do not have the same number of rows.
+func (m *Matrix[T]) HConcat(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if err := shape.CheckHConcat(m, m2); err != nil {
+		return nil, err
+	}
+
+	m.data = m.data.HConcat(other(m2))
+
+	return m, nil
+}
+
+// VConcat will append the rows of the given matrix to this matrix, returning a DimensionMismatchError if they
+// do not have the same number of columns.
+func (m *Matrix[T]) VConcat(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	return m.stack("VConcat", m2)
+}
+
+// Stack will append the rows of each of the given matrices to this matrix in order, returning a
+// DimensionMismatchError for the first that does not have the same number of columns.
+func (m *Matrix[T]) Stack(ms ...immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	return m.stack("Stack", ms...)
+}
+
+func (m *Matrix[T]) stack(op string, ms ...immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if err := shape.CheckStack(op, m, ms...); err != nil {
+		return nil, err
+	}
+
+	data := make([]csr.Matrix[T], len(ms))
+
+	for i, m2 := range ms {
+		data[i] = other(m2)
+	}
+
+	m.data = m.data.Stack(data...)
+
+	return m, nil
+}
+
+// SplitRows will keep the rows of this matrix before at and return them along with a new matrix holding the
+// rows from at onwards, returning an InvalidDimensionsError if either part would be empty. Set inserts and
+// removes elements in place, so the new matrix gets a copy of its elements rather than sharing them.
+func (m *Matrix[T]) SplitRows(at int) (immutabilitybenchmarking.Matrix[T], immutabilitybenchmarking.Matrix[T], error) {
+	if err := shape.CheckSplitRows(m, at); err != nil {
+		return nil, nil, err
+	}
+
+	top, bottom := m.data.SplitRows(at)
+	m.data = top
+
+	return m, &Matrix[T]{data: bottom.Clone()}, nil
+}
+
+// SplitCols will keep the columns of this matrix before at and return them along with a new matrix holding
+// the columns from at onwards, returning an InvalidDimensionsError if either part would be empty.
+func (m *Matrix[T]) SplitCols(at int) (immutabilitybenchmarking.Matrix[T], immutabilitybenchmarking.Matrix[T], error) {
+	if err := shape.CheckSplitCols(m, at); err != nil {
+		return nil, nil, err
+	}
+
+	left, right := m.data.SplitCols(at)
+	m.data = left
+
+	return m, &Matrix[T]{data: right}, nil
+}
+
+// Pad will surround this matrix with the given number of rows and columns of zeros on each side, which are
+// not stored, so only the positions of the stored elements change. It returns a PaddingError if any of them
+// is negative.
+func (m *Matrix[T]) Pad(top int, bottom int, left int, right int) (immutabilitybenchmarking.Matrix[T], error) {
+	if err := shape.CheckPad(top, bottom, left, right); err != nil {
+		return nil, err
+	}
+
+	m.data = m.data.Pad(top, bottom, left, right)
+
+	return m, nil
+}
+
+// Flip will reverse the order of the rows or the columns of this matrix.
+func (m *Matrix[T]) Flip(axis immutabilitybenchmarking.Axis) immutabilitybenchmarking.Matrix[T] {
+	m.data = m.data.Flip(axis)
+
+	return m
+}
+
+// Rotate90 will turn this matrix a quarter turn clockwise.
+func (m *Matrix[T]) Rotate90() immutabilitybenchmarking.Matrix[T] {
+	m.data = m.data.Rotate90()
+
+	return m
+}
@@ -0,0 +1,186 @@
+package mutable
+
+import (
+	"testing"
+
+	"github.com/chris-tomich/immutability-benchmarking"
+	"github.com/chris-tomich/immutability-benchmarking/matrixtest"
+	slicemutable "github.com/chris-tomich/immutability-benchmarking/slice/mutable"
+)
+
+func mustNew[T immutabilitybenchmarking.Number](matrix [][]T) *Matrix[T] {
+	m, err := New(matrix)
+	if err != nil {
+		panic(err)
+	}
+
+	return m
+}
+
+func mustNewSlice[T immutabilitybenchmarking.Number](matrix [][]T) *slicemutable.Matrix[T] {
+	m, err := slicemutable.New(matrix)
+	if err != nil {
+		panic(err)
+	}
+
+	return m
+}
+
+func TestZerosAreNotStored(t *testing.T) {
+	m := mustNew([][]int{
+		{0, 0, 3},
+		{0, 0, 0},
+		{1, 0, 0},
+	})
+
+	if m.NonZeros() != 2 {
+		t.Errorf("expected 2 stored elements but got %d", m.NonZeros())
+	}
+
+	difference, err := m.Subtract(mustNew([][]int{
+		{0, 0, 3},
+		{0, 0, 0},
+		{0, 0, 0},
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if n := difference.(*Matrix[int]).NonZeros(); n != 1 {
+		t.Errorf("expected the cancelled element to be removed but %d are stored", n)
+	}
+}
+
+func TestMixedWithDense(t *testing.T) {
+	rows := [][]int{
+		{0, 2, 0},
+		{0, 0, 0},
+		{4, 0, 5},
+	}
+
+	other := [][]int{
+		{1, 1, 1},
+		{0, 2, 0},
+		{3, 0, 0},
+	}
+
+	if !mustNew(rows).Equals(mustNewSlice(rows)) || !mustNewSlice(rows).Equals(mustNew(rows)) {
+		t.Errorf("expected the sparse and dense matrices to be equal")
+	}
+
+	sum, err := mustNew(rows).Add(mustNewSlice(other))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !sum.Equals(mustNewSlice([][]int{{1, 3, 1}, {0, 2, 0}, {7, 0, 5}})) {
+		t.Errorf("unexpected sum %v", sum)
+	}
+
+	product, err := mustNew(rows).MatrixMultiply(mustNewSlice(other))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected, _ := mustNewSlice(rows).MatrixMultiply(mustNewSlice(other))
+	if !product.Equals(expected) {
+		t.Errorf("expected %v but got %v", expected, product)
+	}
+
+	if _, ok := product.(*Matrix[int]); !ok {
+		t.Errorf("expected the product to stay sparse but got %T", product)
+	}
+}
+
+func TestSliceConversion(t *testing.T) {
+	rows := [][]float64{
+		{0, 0.5, 0, 0},
+		{0, 0, 0, 0},
+		{-1, 0, 0, 2},
+	}
+
+	m := FromSlice(mustNewSlice(rows))
+	if m.NonZeros() != 3 {
+		t.Errorf("expected 3 stored elements but got %d", m.NonZeros())
+	}
+
+	if !m.ToSlice().Equals(mustNewSlice(rows)) {
+		t.Errorf("expected converting back to a slice matrix to give the original values")
+	}
+
+	if !FromMatrix[float64](m.ToSlice()).Equals(m) {
+		t.Errorf("expected FromMatrix to give the original values")
+	}
+}
+
+func TestForEachNonZeroIsRowMajor(t *testing.T) {
+	m := mustNew([][]int{
+		{0, 1, 0},
+		{2, 0, 3},
+		{0, 4, 0},
+	}).Transpose().(*Matrix[int])
+
+	var visited []int
+	m.ForEachNonZero(func(row int, col int, v int) {
+		if m.Get(row, col) != v {
+			t.Errorf("expected %d at %d,%d but got %d", m.Get(row, col), row, col, v)
+		}
+
+		visited = append(visited, v)
+	})
+
+	expected := []int{2, 1, 4, 3}
+	for i := range expected {
+		if i >= len(visited) || visited[i] != expected[i] {
+			t.Fatalf("expected %v but visited %v", expected, visited)
+		}
+	}
+}
+
+func TestSet(t *testing.T) {
+	m := mustNew([][]int{
+		{0, 1},
+		{2, 0},
+	})
+
+	m.Set(0, 0, 5)
+	m.Set(0, 1, 0)
+	m.Set(1, 1, 7)
+
+	if !m.Equals(mustNewSlice([][]int{{5, 0}, {2, 7}})) {
+		t.Errorf("unexpected elements after Set")
+	}
+
+	if m.NonZeros() != 3 {
+		t.Errorf("expected 3 stored elements but got %d", m.NonZeros())
+	}
+}
+
+func TestFromMatrixCopies(t *testing.T) {
+	m1 := mustNew([][]int{{1, 2}})
+	m2 := FromMatrix[int](m1)
+
+	m1.Set(0, 0, 9)
+
+	if m2.Get(0, 0) != 1 {
+		t.Errorf("expected FromMatrix to copy the elements")
+	}
+}
+
+func TestMutableCSRMatrixConformance(t *testing.T) {
+	t.Run("int", func(t *testing.T) {
+		matrixtest.RunConformance[int](t, Factory[int]{})
+	})
+
+	t.Run("uint8", func(t *testing.T) {
+		matrixtest.RunConformance[uint8](t, Factory[uint8]{})
+	})
+
+	t.Run("float64", func(t *testing.T) {
+		matrixtest.RunConformance[float64](t, Factory[float64]{})
+	})
+
+	t.Run("complex128", func(t *testing.T) {
+		matrixtest.RunConformance[complex128](t, Factory[complex128]{})
+	})
+}
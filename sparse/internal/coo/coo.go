@@ -0,0 +1,552 @@
+// Package coo implements the coordinate format, which stores a row, column and value for every non-zero
+// element of a matrix.
+package coo
+
+import (
+	"math"
+	"sort"
+
+	"github.com/chris-tomich/immutability-benchmarking"
+	"github.com/chris-tomich/immutability-benchmarking/internal/numeric"
+	"github.com/chris-tomich/immutability-benchmarking/sparse/internal/csr"
+)
+
+// Matrix holds the non-zero elements of a matrix as coordinates sorted in row-major order. Zeros are never
+// stored, so two matrices are equal exactly when their fields are.
+type Matrix[T immutabilitybenchmarking.Number] struct {
+	Rows   int
+	Cols   int
+	RowIdx []int
+	ColIdx []int
+	Values []T
+}
+
+// Zeros creates a rows x cols matrix without any non-zero elements.
+func Zeros[T immutabilitybenchmarking.Number](rows int, cols int) Matrix[T] {
+	return Matrix[T]{Rows: rows, Cols: cols}
+}
+
+// FromCSR converts a matrix from compressed sparse row format.
+func FromCSR[T immutabilitybenchmarking.Number](c csr.Matrix[T]) Matrix[T] {
+	m := Matrix[T]{
+		Rows:   c.Rows,
+		Cols:   c.Cols,
+		RowIdx: make([]int, 0, c.NonZeros()),
+		ColIdx: make([]int, 0, c.NonZeros()),
+		Values: make([]T, 0, c.NonZeros()),
+	}
+
+	c.ForEachNonZero(func(row int, col int, v T) {
+		m.RowIdx = append(m.RowIdx, row)
+		m.ColIdx = append(m.ColIdx, col)
+		m.Values = append(m.Values, v)
+	})
+
+	return m
+}
+
+// CSR converts m to compressed sparse row format.
+func (m Matrix[T]) CSR() csr.Matrix[T] {
+	c := csr.Zeros[T](m.Rows, m.Cols)
+	c.ColIdx = append([]int(nil), m.ColIdx...)
+	c.Values = append([]T(nil), m.Values...)
+
+	for _, r := range m.RowIdx {
+		c.RowPtr[r+1]++
+	}
+
+	for r := 0; r < m.Rows; r++ {
+		c.RowPtr[r+1] += c.RowPtr[r]
+	}
+
+	return c
+}
+
+// Clone returns a copy of m that shares none of its storage.
+func (m Matrix[T]) Clone() Matrix[T] {
+	return Matrix[T]{
+		Rows:   m.Rows,
+		Cols:   m.Cols,
+		RowIdx: append([]int(nil), m.RowIdx...),
+		ColIdx: append([]int(nil), m.ColIdx...),
+		Values: append([]T(nil), m.Values...),
+	}
+}
+
+// append adds v to the end of the matrix being built unless it is zero.
+func (m *Matrix[T]) append(row int, col int, v T) {
+	if v != 0 {
+		m.RowIdx = append(m.RowIdx, row)
+		m.ColIdx = append(m.ColIdx, col)
+		m.Values = append(m.Values, v)
+	}
+}
+
+// before reports whether element i comes before the coordinates row, col in row-major order.
+func (m Matrix[T]) before(i int, row int, col int) bool {
+	return m.RowIdx[i] < row || (m.RowIdx[i] == row && m.ColIdx[i] < col)
+}
+
+// find returns where the element at row, col is or would be stored and whether it is stored.
+func (m Matrix[T]) find(row int, col int) (int, bool) {
+	i := sort.Search(len(m.Values), func(i int) bool {
+		return !m.before(i, row, col)
+	})
+
+	return i, i < len(m.Values) && m.RowIdx[i] == row && m.ColIdx[i] == col
+}
+
+// Get returns the element at the provided coordinates.
+func (m Matrix[T]) Get(row int, col int) T {
+	if i, ok := m.find(row, col); ok {
+		return m.Values[i]
+	}
+
+	return 0
+}
+
+// Set stores v at the provided coordinates in place, inserting or removing the element as needed.
+func (m *Matrix[T]) Set(row int, col int, v T) {
+	i, ok := m.find(row, col)
+
+	switch {
+	case ok && v != 0:
+		m.Values[i] = v
+	case ok:
+		m.RowIdx = append(m.RowIdx[:i], m.RowIdx[i+1:]...)
+		m.ColIdx = append(m.ColIdx[:i], m.ColIdx[i+1:]...)
+		m.Values = append(m.Values[:i], m.Values[i+1:]...)
+	case v != 0:
+		m.RowIdx = append(m.RowIdx, 0)
+		copy(m.RowIdx[i+1:], m.RowIdx[i:])
+		m.RowIdx[i] = row
+
+		m.ColIdx = append(m.ColIdx, 0)
+		copy(m.ColIdx[i+1:], m.ColIdx[i:])
+		m.ColIdx[i] = col
+
+		m.Values = append(m.Values, 0)
+		copy(m.Values[i+1:], m.Values[i:])
+		m.Values[i] = v
+	}
+}
+
+// NonZeros returns the number of stored elements.
+func (m Matrix[T]) NonZeros() int {
+	return len(m.Values)
+}
+
+// ForEachNonZero calls f with every stored element in row-major order.
+func (m Matrix[T]) ForEachNonZero(f func(row int, col int, v T)) {
+	for i := range m.Values {
+		f(m.RowIdx[i], m.ColIdx[i], m.Values[i])
+	}
+}
+
+// Dense returns the elements as rows of dense values.
+func (m Matrix[T]) Dense() [][]T {
+	rows := make([][]T, m.Rows)
+
+	for r := 0; r < m.Rows; r++ {
+		rows[r] = make([]T, m.Cols)
+	}
+
+	for i := range m.Values {
+		rows[m.RowIdx[i]][m.ColIdx[i]] = m.Values[i]
+	}
+
+	return rows
+}
+
+// Equals reports whether m and m2 hold the same elements.
+func (m Matrix[T]) Equals(m2 Matrix[T]) bool {
+	if m.Rows != m2.Rows || m.Cols != m2.Cols || len(m.Values) != len(m2.Values) {
+		return false
+	}
+
+	for i := range m.Values {
+		if m.RowIdx[i] != m2.RowIdx[i] || m.ColIdx[i] != m2.ColIdx[i] || m.Values[i] != m2.Values[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Add returns the sum of m and m2, which must have the same dimensions.
+func (m Matrix[T]) Add(m2 Matrix[T]) Matrix[T] {
+	return m.merge(m2, func(x T, y T) T { return x + y })
+}
+
+// Subtract returns the difference of m and m2, which must have the same dimensions.
+func (m Matrix[T]) Subtract(m2 Matrix[T]) Matrix[T] {
+	return m.merge(m2, func(x T, y T) T { return x - y })
+}
+
+// merge combines the coordinates of m and m2 in a single pass as both are in row-major order.
+func (m Matrix[T]) merge(m2 Matrix[T], f func(x T, y T) T) Matrix[T] {
+	n := Zeros[T](m.Rows, m.Cols)
+	n.RowIdx = make([]int, 0, len(m.Values)+len(m2.Values))
+	n.ColIdx = make([]int, 0, len(m.Values)+len(m2.Values))
+	n.Values = make([]T, 0, len(m.Values)+len(m2.Values))
+
+	i, j := 0, 0
+
+	for i < len(m.Values) || j < len(m2.Values) {
+		switch {
+		case j == len(m2.Values) || (i < len(m.Values) && m.before(i, m2.RowIdx[j], m2.ColIdx[j])):
+			n.append(m.RowIdx[i], m.ColIdx[i], f(m.Values[i], 0))
+			i++
+		case i == len(m.Values) || m2.before(j, m.RowIdx[i], m.ColIdx[i]):
+			n.append(m2.RowIdx[j], m2.ColIdx[j], f(0, m2.Values[j]))
+			j++
+		default:
+			n.append(m.RowIdx[i], m.ColIdx[i], f(m.Values[i], m2.Values[j]))
+			i++
+			j++
+		}
+	}
+
+	return n
+}
+
+// ScalarMultiply returns m with every element multiplied by s.
+func (m Matrix[T]) ScalarMultiply(s T) Matrix[T] {
+	n := Zeros[T](m.Rows, m.Cols)
+	n.RowIdx = make([]int, 0, len(m.Values))
+	n.ColIdx = make([]int, 0, len(m.Values))
+	n.Values = make([]T, 0, len(m.Values))
+
+	for i := range m.Values {
+		// Integer products can wrap around to zero, so each one is checked.
+		n.append(m.RowIdx[i], m.ColIdx[i], m.Values[i]*s)
+	}
+
+	return n
+}
+
+// Transpose returns the transpose of m.
+func (m Matrix[T]) Transpose() Matrix[T] {
+	t := Matrix[T]{
+		Rows:   m.Cols,
+		Cols:   m.Rows,
+		RowIdx: append([]int(nil), m.ColIdx...),
+		ColIdx: append([]int(nil), m.RowIdx...),
+		Values: append([]T(nil), m.Values...),
+	}
+
+	// The elements are already in order of their new column, so a stable sort by the new row restores
+	// row-major order.
+	sort.Stable(byRow[T](t))
+
+	return t
+}
+
+// Multiply returns the product of m and m2, where m.Cols must equal m2.Rows. The coordinate format has no
+// way to find the elements of a row without searching, so the product is calculated in compressed sparse
+// row format.
+func (m Matrix[T]) Multiply(m2 Matrix[T]) Matrix[T] {
+	return FromCSR(m.CSR().Multiply(m2.CSR()))
+}
+
+// byRow sorts the elements of a matrix by row alone.
+type byRow[T immutabilitybenchmarking.Number] Matrix[T]
+
+func (m byRow[T]) Len() int {
+	return len(m.Values)
+}
+
+func (m byRow[T]) Less(i int, j int) bool {
+	return m.RowIdx[i] < m.RowIdx[j]
+}
+
+func (m byRow[T]) Swap(i int, j int) {
+	m.RowIdx[i], m.RowIdx[j] = m.RowIdx[j], m.RowIdx[i]
+	m.ColIdx[i], m.ColIdx[j] = m.ColIdx[j], m.ColIdx[i]
+	m.Values[i], m.Values[j] = m.Values[j], m.Values[i]
+}
+
+// Map returns m with f applied to every element. When f maps zero to zero only the stored elements are
+// visited, otherwise the elements are mapped in compressed sparse row format so each row can be walked.
+func (m Matrix[T]) Map(f func(v T) T) Matrix[T] {
+	if f(0) != 0 {
+		return FromCSR(m.CSR().Map(f))
+	}
+
+	n := Zeros[T](m.Rows, m.Cols)
+	n.RowIdx = make([]int, 0, len(m.Values))
+	n.ColIdx = make([]int, 0, len(m.Values))
+	n.Values = make([]T, 0, len(m.Values))
+
+	for i := range m.Values {
+		n.append(m.RowIdx[i], m.ColIdx[i], f(m.Values[i]))
+	}
+
+	return n
+}
+
+// MapIndexed returns m with f applied to every element and its coordinates. Every element has to be visited,
+// so the elements are mapped in compressed sparse row format.
+func (m Matrix[T]) MapIndexed(f func(row int, col int, v T) T) Matrix[T] {
+	return FromCSR(m.CSR().MapIndexed(f))
+}
+
+// ZipWith combines m and m2, which must have the same dimensions, element by element using f. When f maps
+// two zeros to zero only the coordinates stored by either are visited, otherwise the matrices are combined in
+// compressed sparse row format.
+func (m Matrix[T]) ZipWith(m2 Matrix[T], f func(x T, y T) T) Matrix[T] {
+	if f(0, 0) == 0 {
+		return m.merge(m2, f)
+	}
+
+	return FromCSR(m.CSR().ZipWith(m2.CSR(), f))
+}
+
+// Fold combines every element of m, including its zeros, into a single value in row-major order.
+func (m Matrix[T]) Fold(initial T, f func(acc T, v T) T) T {
+	return m.CSR().Fold(initial, f)
+}
+
+// RowReduce folds each row of m into a single value, returning a column of the results.
+func (m Matrix[T]) RowReduce(initial T, f func(acc T, v T) T) Matrix[T] {
+	return FromCSR(m.CSR().RowReduce(initial, f))
+}
+
+// ColReduce folds each column of m into a single value, returning a row of the results.
+func (m Matrix[T]) ColReduce(initial T, f func(acc T, v T) T) Matrix[T] {
+	return FromCSR(m.CSR().ColReduce(initial, f))
+}
+
+// Hadamard returns the product of m and m2, which must have the same dimensions, element by element.
+func (m Matrix[T]) Hadamard(m2 Matrix[T]) Matrix[T] {
+	return m.merge(m2, func(x T, y T) T { return x * y })
+}
+
+// Divide returns m divided by m2, which must have the same dimensions, element by element, returning a
+// DivisionByZeroError for the first element m2 does not store.
+func (m Matrix[T]) Divide(m2 Matrix[T]) (Matrix[T], error) {
+	c, err := m.CSR().Divide(m2.CSR())
+	if err != nil {
+		return Matrix[T]{}, err
+	}
+
+	return FromCSR(c), nil
+}
+
+// Kronecker returns the Kronecker product of m and m2. The product is built a row at a time, so it is
+// calculated in compressed sparse row format.
+func (m Matrix[T]) Kronecker(m2 Matrix[T]) Matrix[T] {
+	return FromCSR(m.CSR().Kronecker(m2.CSR()))
+}
+
+// Trace adds together the elements on the diagonal of m.
+func (m Matrix[T]) Trace() T {
+	var trace T
+
+	for i := range m.Values {
+		if m.RowIdx[i] == m.ColIdx[i] {
+			trace += m.Values[i]
+		}
+	}
+
+	return trace
+}
+
+// Sum adds together the stored elements of m.
+func (m Matrix[T]) Sum() T {
+	var sum T
+
+	for _, v := range m.Values {
+		sum += v
+	}
+
+	return sum
+}
+
+// ArgMin returns the coordinates of the first smallest element of m in row-major order.
+func (m Matrix[T]) ArgMin() (int, int) {
+	return m.extreme(numeric.LessFunc[T]())
+}
+
+// ArgMax returns the coordinates of the first largest element of m in row-major order.
+func (m Matrix[T]) ArgMax() (int, int) {
+	less := numeric.LessFunc[T]()
+
+	return m.extreme(func(v T, best T) bool { return less(best, v) })
+}
+
+// extreme finds the best of the stored elements and only compares it with zero, at the position of the first
+// element that is not stored, when there is one. The coordinates are in row-major order, so the first gap
+// is where the position of an element first differs from its index.
+func (m Matrix[T]) extreme(better func(v T, best T) bool) (int, int) {
+	best, gap := -1, 0
+
+	for i, v := range m.Values {
+		if gap == i && m.RowIdx[i]*m.Cols+m.ColIdx[i] == i {
+			gap++
+		}
+
+		if best < 0 || better(v, m.Values[best]) {
+			best = i
+		}
+	}
+
+	if gap < m.Rows*m.Cols && (best < 0 || better(0, m.Values[best])) {
+		return gap / m.Cols, gap % m.Cols
+	}
+
+	return m.RowIdx[best], m.ColIdx[best]
+}
+
+// FrobeniusNorm returns the square root of the sum of the squared absolute values of the stored elements.
+func (m Matrix[T]) FrobeniusNorm() float64 {
+	abs := numeric.AbsFunc[T]()
+	var sum float64
+
+	for _, v := range m.Values {
+		a := abs(v)
+		sum += a * a
+	}
+
+	return math.Sqrt(sum)
+}
+
+// OneNorm returns the largest sum of the absolute values in a column of m.
+func (m Matrix[T]) OneNorm() float64 {
+	return largest(m.Cols, m.ColIdx, m.Values)
+}
+
+// InfNorm returns the largest sum of the absolute values in a row of m.
+func (m Matrix[T]) InfNorm() float64 {
+	return largest(m.Rows, m.RowIdx, m.Values)
+}
+
+// largest sums the absolute values into n buckets by the given indices and returns the largest sum.
+func largest[T immutabilitybenchmarking.Number](n int, indices []int, values []T) float64 {
+	abs := numeric.AbsFunc[T]()
+	sums := make([]float64, n)
+
+	for i, v := range values {
+		sums[indices[i]] += abs(v)
+	}
+
+	var l float64
+
+	for _, sum := range sums {
+		l = math.Max(l, sum)
+	}
+
+	return l
+}
+
+// Rank returns the number of linearly independent rows of m, which is calculated in compressed sparse row
+// format.
+func (m Matrix[T]) Rank() int {
+	return m.CSR().Rank()
+}
+
+// Reshape returns the elements of m read in row-major order into a rows x cols matrix, where rows*cols must
+// equal m.Rows*m.Cols. The stored elements stay in the same order, so the result shares Values with m.
+func (m Matrix[T]) Reshape(rows int, cols int) Matrix[T] {
+	n := Matrix[T]{Rows: rows, Cols: cols, RowIdx: make([]int, len(m.Values)), ColIdx: make([]int, len(m.Values)), Values: m.Values}
+
+	for i := range m.Values {
+		j := m.RowIdx[i]*m.Cols + m.ColIdx[i]
+		n.RowIdx[i], n.ColIdx[i] = j/cols, j%cols
+	}
+
+	return n
+}
+
+// HConcat returns m2 placed to the right of m, where m.Rows must equal m2.Rows. The rows are interleaved,
+// so the result is built in compressed sparse row format.
+func (m Matrix[T]) HConcat(m2 Matrix[T]) Matrix[T] {
+	return FromCSR(m.CSR().HConcat(m2.CSR()))
+}
+
+// Stack returns ms placed below m in order, where each of them must have m.Cols columns.
+func (m Matrix[T]) Stack(ms ...Matrix[T]) Matrix[T] {
+	all := append([]Matrix[T]{m}, ms...)
+	rows, stored := 0, 0
+
+	for _, a := range all {
+		rows += a.Rows
+		stored += len(a.Values)
+	}
+
+	n := Matrix[T]{Rows: rows, Cols: m.Cols, RowIdx: make([]int, 0, stored), ColIdx: make([]int, 0, stored), Values: make([]T, 0, stored)}
+	offset := 0
+
+	for _, a := range all {
+		for _, r := range a.RowIdx {
+			n.RowIdx = append(n.RowIdx, offset+r)
+		}
+
+		n.ColIdx = append(n.ColIdx, a.ColIdx...)
+		n.Values = append(n.Values, a.Values...)
+		offset += a.Rows
+	}
+
+	return n
+}
+
+// SplitRows returns the rows of m before at and the rows from at onwards, where at must be between 1 and
+// m.Rows-1. The elements of each part are contiguous in m, so both share ColIdx and Values with it.
+func (m Matrix[T]) SplitRows(at int) (Matrix[T], Matrix[T]) {
+	p := sort.SearchInts(m.RowIdx, at)
+
+	top := Matrix[T]{Rows: at, Cols: m.Cols, RowIdx: m.RowIdx[:p:p], ColIdx: m.ColIdx[:p:p], Values: m.Values[:p:p]}
+	bottom := Matrix[T]{Rows: m.Rows - at, Cols: m.Cols, RowIdx: make([]int, len(m.Values)-p), ColIdx: m.ColIdx[p:], Values: m.Values[p:]}
+
+	for i := range bottom.RowIdx {
+		bottom.RowIdx[i] = m.RowIdx[p+i] - at
+	}
+
+	return top, bottom
+}
+
+// SplitCols returns the columns of m before at and the columns from at onwards, where at must be between 1
+// and m.Cols-1.
+func (m Matrix[T]) SplitCols(at int) (Matrix[T], Matrix[T]) {
+	left, right := Zeros[T](m.Rows, at), Zeros[T](m.Rows, m.Cols-at)
+
+	for i, v := range m.Values {
+		if m.ColIdx[i] < at {
+			left.append(m.RowIdx[i], m.ColIdx[i], v)
+		} else {
+			right.append(m.RowIdx[i], m.ColIdx[i]-at, v)
+		}
+	}
+
+	return left, right
+}
+
+// Pad returns m surrounded by the given number of rows and columns of zeros on each side. Padding only moves
+// the stored elements, so the result shares Values with m.
+func (m Matrix[T]) Pad(top int, bottom int, left int, right int) Matrix[T] {
+	n := Matrix[T]{
+		Rows:   top + m.Rows + bottom,
+		Cols:   left + m.Cols + right,
+		RowIdx: make([]int, len(m.Values)),
+		ColIdx: make([]int, len(m.Values)),
+		Values: m.Values,
+	}
+
+	for i := range m.Values {
+		n.RowIdx[i], n.ColIdx[i] = top+m.RowIdx[i], left+m.ColIdx[i]
+	}
+
+	return n
+}
+
+// Flip returns m with the order of its rows or columns reversed. Either way the elements of each row have
+// to be found, so the result is built in compressed sparse row format.
+func (m Matrix[T]) Flip(axis immutabilitybenchmarking.Axis) Matrix[T] {
+	return FromCSR(m.CSR().Flip(axis))
+}
+
+// Rotate90 returns m turned a quarter turn clockwise, which is built in compressed sparse row format like
+// Flip.
+func (m Matrix[T]) Rotate90() Matrix[T] {
+	return FromCSR(m.CSR().Rotate90())
+}
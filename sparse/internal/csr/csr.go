@@ -0,0 +1,813 @@
+// Package csr implements the compressed sparse row format the sparse matrices use for their arithmetic.
+package csr
+
+import (
+	"math"
+	"sort"
+
+	"github.com/chris-tomich/immutability-benchmarking"
+	"github.com/chris-tomich/immutability-benchmarking/internal/numeric"
+	"github.com/chris-tomich/immutability-benchmarking/sparse"
+)
+
+// Matrix holds the non-zero elements of a matrix in compressed sparse row format. The elements of row r are
+// Values[RowPtr[r]:RowPtr[r+1]] and their columns, which are in ascending order, are the same range of
+// ColIdx. Zeros are never stored, so two matrices are equal exactly when their fields are.
+type Matrix[T immutabilitybenchmarking.Number] struct {
+	Rows   int
+	Cols   int
+	RowPtr []int
+	ColIdx []int
+	Values []T
+}
+
+// Zeros creates a rows x cols matrix without any non-zero elements.
+func Zeros[T immutabilitybenchmarking.Number](rows int, cols int) Matrix[T] {
+	return Matrix[T]{Rows: rows, Cols: cols, RowPtr: make([]int, rows+1)}
+}
+
+// FromFunc creates a rows x cols matrix with each element set to the result of f.
+func FromFunc[T immutabilitybenchmarking.Number](rows int, cols int, f func(row int, col int) T) Matrix[T] {
+	m := Zeros[T](rows, cols)
+
+	for r := 0; r < rows; r++ {
+		for c := 0; c < cols; c++ {
+			m.append(c, f(r, c))
+		}
+
+		m.RowPtr[r+1] = len(m.Values)
+	}
+
+	return m
+}
+
+// FromRows creates a matrix from rectangular dense values, returning the same errors as the dense backends
+// when they are empty or jagged.
+func FromRows[T immutabilitybenchmarking.Number](rows [][]T) (Matrix[T], error) {
+	if len(rows) == 0 || len(rows[0]) == 0 {
+		return Matrix[T]{}, &immutabilitybenchmarking.InvalidDimensionsError{Rows: len(rows)}
+	}
+
+	for r := 1; r < len(rows); r++ {
+		if len(rows[r]) != len(rows[0]) {
+			return Matrix[T]{}, &immutabilitybenchmarking.JaggedRowsError{Row: r, Width: len(rows[r]), Expected: len(rows[0])}
+		}
+	}
+
+	return FromFunc(len(rows), len(rows[0]), func(row int, col int) T {
+		return rows[row][col]
+	}), nil
+}
+
+// From converts any matrix to compressed sparse row format. Sparse matrices are converted from their
+// non-zero elements while every element of a dense matrix has to be read.
+func From[T immutabilitybenchmarking.Number](m immutabilitybenchmarking.Matrix[T]) Matrix[T] {
+	s, ok := m.(sparse.Matrix[T])
+	if !ok {
+		return FromFunc(m.Height(), m.Width(), m.Get)
+	}
+
+	c := Zeros[T](m.Height(), m.Width())
+	c.ColIdx = make([]int, 0, s.NonZeros())
+	c.Values = make([]T, 0, s.NonZeros())
+
+	s.ForEachNonZero(func(row int, col int, v T) {
+		c.ColIdx = append(c.ColIdx, col)
+		c.Values = append(c.Values, v)
+		c.RowPtr[row+1]++
+	})
+
+	for r := 0; r < c.Rows; r++ {
+		c.RowPtr[r+1] += c.RowPtr[r]
+	}
+
+	return c
+}
+
+// Clone returns a copy of m that shares none of its storage.
+func (m Matrix[T]) Clone() Matrix[T] {
+	return Matrix[T]{
+		Rows:   m.Rows,
+		Cols:   m.Cols,
+		RowPtr: append([]int(nil), m.RowPtr...),
+		ColIdx: append([]int(nil), m.ColIdx...),
+		Values: append([]T(nil), m.Values...),
+	}
+}
+
+// append adds v to the end of the row being built unless it is zero.
+func (m *Matrix[T]) append(col int, v T) {
+	if v != 0 {
+		m.ColIdx = append(m.ColIdx, col)
+		m.Values = append(m.Values, v)
+	}
+}
+
+// find returns where the element at row, col is or would be stored and whether it is stored.
+func (m Matrix[T]) find(row int, col int) (int, bool) {
+	start, end := m.RowPtr[row], m.RowPtr[row+1]
+	i := start + sort.SearchInts(m.ColIdx[start:end], col)
+
+	return i, i < end && m.ColIdx[i] == col
+}
+
+// Get returns the element at the provided coordinates.
+func (m Matrix[T]) Get(row int, col int) T {
+	if i, ok := m.find(row, col); ok {
+		return m.Values[i]
+	}
+
+	return 0
+}
+
+// Set stores v at the provided coordinates in place, inserting or removing the element as needed.
+func (m *Matrix[T]) Set(row int, col int, v T) {
+	i, ok := m.find(row, col)
+
+	switch {
+	case ok && v != 0:
+		m.Values[i] = v
+		return
+	case ok:
+		m.ColIdx = append(m.ColIdx[:i], m.ColIdx[i+1:]...)
+		m.Values = append(m.Values[:i], m.Values[i+1:]...)
+
+		for r := row + 1; r <= m.Rows; r++ {
+			m.RowPtr[r]--
+		}
+	case v != 0:
+		m.ColIdx = append(m.ColIdx, 0)
+		copy(m.ColIdx[i+1:], m.ColIdx[i:])
+		m.ColIdx[i] = col
+
+		m.Values = append(m.Values, 0)
+		copy(m.Values[i+1:], m.Values[i:])
+		m.Values[i] = v
+
+		for r := row + 1; r <= m.Rows; r++ {
+			m.RowPtr[r]++
+		}
+	}
+}
+
+// NonZeros returns the number of stored elements.
+func (m Matrix[T]) NonZeros() int {
+	return len(m.Values)
+}
+
+// ForEachNonZero calls f with every stored element in row-major order.
+func (m Matrix[T]) ForEachNonZero(f func(row int, col int, v T)) {
+	for r := 0; r < m.Rows; r++ {
+		for i := m.RowPtr[r]; i < m.RowPtr[r+1]; i++ {
+			f(r, m.ColIdx[i], m.Values[i])
+		}
+	}
+}
+
+// Dense returns the elements as rows of dense values.
+func (m Matrix[T]) Dense() [][]T {
+	rows := make([][]T, m.Rows)
+
+	for r := 0; r < m.Rows; r++ {
+		rows[r] = make([]T, m.Cols)
+
+		for i := m.RowPtr[r]; i < m.RowPtr[r+1]; i++ {
+			rows[r][m.ColIdx[i]] = m.Values[i]
+		}
+	}
+
+	return rows
+}
+
+// Equals reports whether m and m2 hold the same elements.
+func (m Matrix[T]) Equals(m2 Matrix[T]) bool {
+	if m.Rows != m2.Rows || m.Cols != m2.Cols || len(m.Values) != len(m2.Values) {
+		return false
+	}
+
+	for r := 0; r <= m.Rows; r++ {
+		if m.RowPtr[r] != m2.RowPtr[r] {
+			return false
+		}
+	}
+
+	for i := range m.Values {
+		if m.ColIdx[i] != m2.ColIdx[i] || m.Values[i] != m2.Values[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Add returns the sum of m and m2, which must have the same dimensions.
+func (m Matrix[T]) Add(m2 Matrix[T]) Matrix[T] {
+	return m.merge(m2, func(x T, y T) T { return x + y })
+}
+
+// Subtract returns the difference of m and m2, which must have the same dimensions.
+func (m Matrix[T]) Subtract(m2 Matrix[T]) Matrix[T] {
+	return m.merge(m2, func(x T, y T) T { return x - y })
+}
+
+// merge combines the rows of m and m2 element by element, visiting only the columns stored by either.
+func (m Matrix[T]) merge(m2 Matrix[T], f func(x T, y T) T) Matrix[T] {
+	n := Zeros[T](m.Rows, m.Cols)
+	n.ColIdx = make([]int, 0, len(m.Values)+len(m2.Values))
+	n.Values = make([]T, 0, len(m.Values)+len(m2.Values))
+
+	for r := 0; r < m.Rows; r++ {
+		i, iEnd := m.RowPtr[r], m.RowPtr[r+1]
+		j, jEnd := m2.RowPtr[r], m2.RowPtr[r+1]
+
+		for i < iEnd || j < jEnd {
+			switch {
+			case j == jEnd || (i < iEnd && m.ColIdx[i] < m2.ColIdx[j]):
+				n.append(m.ColIdx[i], f(m.Values[i], 0))
+				i++
+			case i == iEnd || m2.ColIdx[j] < m.ColIdx[i]:
+				n.append(m2.ColIdx[j], f(0, m2.Values[j]))
+				j++
+			default:
+				n.append(m.ColIdx[i], f(m.Values[i], m2.Values[j]))
+				i++
+				j++
+			}
+		}
+
+		n.RowPtr[r+1] = len(n.Values)
+	}
+
+	return n
+}
+
+// ScalarMultiply returns m with every element multiplied by s.
+func (m Matrix[T]) ScalarMultiply(s T) Matrix[T] {
+	n := Zeros[T](m.Rows, m.Cols)
+	n.ColIdx = make([]int, 0, len(m.Values))
+	n.Values = make([]T, 0, len(m.Values))
+
+	for r := 0; r < m.Rows; r++ {
+		for i := m.RowPtr[r]; i < m.RowPtr[r+1]; i++ {
+			// Integer products can wrap around to zero, so each one is checked.
+			n.append(m.ColIdx[i], m.Values[i]*s)
+		}
+
+		n.RowPtr[r+1] = len(n.Values)
+	}
+
+	return n
+}
+
+// Transpose returns the transpose of m.
+func (m Matrix[T]) Transpose() Matrix[T] {
+	t := Zeros[T](m.Cols, m.Rows)
+	t.ColIdx = make([]int, len(m.Values))
+	t.Values = make([]T, len(m.Values))
+
+	for _, c := range m.ColIdx {
+		t.RowPtr[c+1]++
+	}
+
+	for r := 0; r < t.Rows; r++ {
+		t.RowPtr[r+1] += t.RowPtr[r]
+	}
+
+	// next holds where the next element of each row of the transpose goes. Visiting the rows of m in order
+	// keeps the columns of the transpose in ascending order.
+	next := make([]int, t.Rows)
+	copy(next, t.RowPtr)
+
+	for r := 0; r < m.Rows; r++ {
+		for i := m.RowPtr[r]; i < m.RowPtr[r+1]; i++ {
+			c := m.ColIdx[i]
+			t.ColIdx[next[c]] = r
+			t.Values[next[c]] = m.Values[i]
+			next[c]++
+		}
+	}
+
+	return t
+}
+
+// Multiply returns the product of m and m2, where m.Cols must equal m2.Rows. Each row of the product is
+// accumulated from the rows of m2 selected by the non-zero elements in the same row of m.
+func (m Matrix[T]) Multiply(m2 Matrix[T]) Matrix[T] {
+	n := Zeros[T](m.Rows, m2.Cols)
+
+	accumulator := make([]T, m2.Cols)
+	touched := make([]bool, m2.Cols)
+	var cols []int
+
+	for r := 0; r < m.Rows; r++ {
+		cols = cols[:0]
+
+		for i := m.RowPtr[r]; i < m.RowPtr[r+1]; i++ {
+			k, v := m.ColIdx[i], m.Values[i]
+
+			for j := m2.RowPtr[k]; j < m2.RowPtr[k+1]; j++ {
+				c := m2.ColIdx[j]
+
+				if !touched[c] {
+					touched[c] = true
+					cols = append(cols, c)
+				}
+
+				accumulator[c] += v * m2.Values[j]
+			}
+		}
+
+		sort.Ints(cols)
+
+		for _, c := range cols {
+			n.append(c, accumulator[c])
+			accumulator[c] = 0
+			touched[c] = false
+		}
+
+		n.RowPtr[r+1] = len(n.Values)
+	}
+
+	return n
+}
+
+// scatter writes every element of row r, including its zeros, into dst.
+func (m Matrix[T]) scatter(r int, dst []T) {
+	for c := range dst {
+		dst[c] = 0
+	}
+
+	for i := m.RowPtr[r]; i < m.RowPtr[r+1]; i++ {
+		dst[m.ColIdx[i]] = m.Values[i]
+	}
+}
+
+// Map returns m with f applied to every element. When f maps zero to zero only the stored elements are
+// visited, otherwise every element is.
+func (m Matrix[T]) Map(f func(v T) T) Matrix[T] {
+	if f(0) != 0 {
+		return m.MapIndexed(func(_ int, _ int, v T) T { return f(v) })
+	}
+
+	n := Zeros[T](m.Rows, m.Cols)
+	n.ColIdx = make([]int, 0, len(m.Values))
+	n.Values = make([]T, 0, len(m.Values))
+
+	for r := 0; r < m.Rows; r++ {
+		for i := m.RowPtr[r]; i < m.RowPtr[r+1]; i++ {
+			n.append(m.ColIdx[i], f(m.Values[i]))
+		}
+
+		n.RowPtr[r+1] = len(n.Values)
+	}
+
+	return n
+}
+
+// MapIndexed returns m with f applied to every element and its coordinates. Every element has to be visited
+// as f can depend on where it is.
+func (m Matrix[T]) MapIndexed(f func(row int, col int, v T) T) Matrix[T] {
+	n := Zeros[T](m.Rows, m.Cols)
+	row := make([]T, m.Cols)
+
+	for r := 0; r < m.Rows; r++ {
+		m.scatter(r, row)
+
+		for c, v := range row {
+			n.append(c, f(r, c, v))
+		}
+
+		n.RowPtr[r+1] = len(n.Values)
+	}
+
+	return n
+}
+
+// ZipWith combines m and m2, which must have the same dimensions, element by element using f. When f maps
+// two zeros to zero only the columns stored by either are visited, otherwise every element is.
+func (m Matrix[T]) ZipWith(m2 Matrix[T], f func(x T, y T) T) Matrix[T] {
+	if f(0, 0) == 0 {
+		return m.merge(m2, f)
+	}
+
+	n := Zeros[T](m.Rows, m.Cols)
+	row, row2 := make([]T, m.Cols), make([]T, m.Cols)
+
+	for r := 0; r < m.Rows; r++ {
+		m.scatter(r, row)
+		m2.scatter(r, row2)
+
+		for c := range row {
+			n.append(c, f(row[c], row2[c]))
+		}
+
+		n.RowPtr[r+1] = len(n.Values)
+	}
+
+	return n
+}
+
+// Fold combines every element of m, including its zeros, into a single value in row-major order.
+func (m Matrix[T]) Fold(initial T, f func(acc T, v T) T) T {
+	acc := initial
+	row := make([]T, m.Cols)
+
+	for r := 0; r < m.Rows; r++ {
+		m.scatter(r, row)
+
+		for _, v := range row {
+			acc = f(acc, v)
+		}
+	}
+
+	return acc
+}
+
+// RowReduce folds each row of m into a single value, returning a column of the results.
+func (m Matrix[T]) RowReduce(initial T, f func(acc T, v T) T) Matrix[T] {
+	n := Zeros[T](m.Rows, 1)
+	row := make([]T, m.Cols)
+
+	for r := 0; r < m.Rows; r++ {
+		m.scatter(r, row)
+		acc := initial
+
+		for _, v := range row {
+			acc = f(acc, v)
+		}
+
+		n.append(0, acc)
+		n.RowPtr[r+1] = len(n.Values)
+	}
+
+	return n
+}
+
+// ColReduce folds each column of m into a single value, returning a row of the results.
+func (m Matrix[T]) ColReduce(initial T, f func(acc T, v T) T) Matrix[T] {
+	n := Zeros[T](1, m.Cols)
+	row := make([]T, m.Cols)
+	acc := make([]T, m.Cols)
+
+	for c := range acc {
+		acc[c] = initial
+	}
+
+	for r := 0; r < m.Rows; r++ {
+		m.scatter(r, row)
+
+		for c, v := range row {
+			acc[c] = f(acc[c], v)
+		}
+	}
+
+	for c, v := range acc {
+		n.append(c, v)
+	}
+
+	n.RowPtr[1] = len(n.Values)
+
+	return n
+}
+
+// Hadamard returns the product of m and m2, which must have the same dimensions, element by element.
+func (m Matrix[T]) Hadamard(m2 Matrix[T]) Matrix[T] {
+	return m.merge(m2, func(x T, y T) T { return x * y })
+}
+
+// Divide returns m divided by m2, which must have the same dimensions, element by element. Zeros are never
+// stored, so m2 can only be divided by if it stores every element, and otherwise a DivisionByZeroError is
+// returned for the first it is missing.
+func (m Matrix[T]) Divide(m2 Matrix[T]) (Matrix[T], error) {
+	if r, c, ok := m2.firstZero(); ok {
+		return Matrix[T]{}, &immutabilitybenchmarking.DivisionByZeroError{Op: "ElementwiseDivide", Row: r, Col: c}
+	}
+
+	n := Zeros[T](m.Rows, m.Cols)
+	n.ColIdx = make([]int, 0, len(m.Values))
+	n.Values = make([]T, 0, len(m.Values))
+
+	for r := 0; r < m.Rows; r++ {
+		for i := m.RowPtr[r]; i < m.RowPtr[r+1]; i++ {
+			// Every row of m2 is full, so the divisor for a column is at the same offset in its row.
+			n.append(m.ColIdx[i], m.Values[i]/m2.Values[m2.RowPtr[r]+m.ColIdx[i]])
+		}
+
+		n.RowPtr[r+1] = len(n.Values)
+	}
+
+	return n, nil
+}
+
+// Kronecker returns the Kronecker product of m and m2, which only has to multiply the non-zero elements of
+// each.
+func (m Matrix[T]) Kronecker(m2 Matrix[T]) Matrix[T] {
+	n := Zeros[T](m.Rows*m2.Rows, m.Cols*m2.Cols)
+	n.ColIdx = make([]int, 0, len(m.Values)*len(m2.Values))
+	n.Values = make([]T, 0, len(m.Values)*len(m2.Values))
+
+	for r1 := 0; r1 < m.Rows; r1++ {
+		for r2 := 0; r2 < m2.Rows; r2++ {
+			for i := m.RowPtr[r1]; i < m.RowPtr[r1+1]; i++ {
+				for j := m2.RowPtr[r2]; j < m2.RowPtr[r2+1]; j++ {
+					n.append(m.ColIdx[i]*m2.Cols+m2.ColIdx[j], m.Values[i]*m2.Values[j])
+				}
+			}
+
+			n.RowPtr[r1*m2.Rows+r2+1] = len(n.Values)
+		}
+	}
+
+	return n
+}
+
+// firstZero returns the coordinates of the first element in row-major order that m does not store, or false
+// when it stores every element.
+func (m Matrix[T]) firstZero() (int, int, bool) {
+	for r := 0; r < m.Rows; r++ {
+		start, end := m.RowPtr[r], m.RowPtr[r+1]
+
+		if end-start != m.Cols {
+			c := 0
+			for i := start; i < end && m.ColIdx[i] == c; i++ {
+				c++
+			}
+
+			return r, c, true
+		}
+	}
+
+	return 0, 0, false
+}
+
+// rowOf returns the row holding the stored element at index i.
+func (m Matrix[T]) rowOf(i int) int {
+	return sort.Search(m.Rows, func(r int) bool { return m.RowPtr[r+1] > i })
+}
+
+// Trace adds together the elements on the diagonal of m.
+func (m Matrix[T]) Trace() T {
+	var trace T
+
+	for i := 0; i < m.Rows && i < m.Cols; i++ {
+		trace += m.Get(i, i)
+	}
+
+	return trace
+}
+
+// Sum adds together the stored elements of m.
+func (m Matrix[T]) Sum() T {
+	var sum T
+
+	for _, v := range m.Values {
+		sum += v
+	}
+
+	return sum
+}
+
+// ArgMin returns the coordinates of the first smallest element of m in row-major order.
+func (m Matrix[T]) ArgMin() (int, int) {
+	return m.extreme(numeric.LessFunc[T]())
+}
+
+// ArgMax returns the coordinates of the first largest element of m in row-major order.
+func (m Matrix[T]) ArgMax() (int, int) {
+	less := numeric.LessFunc[T]()
+
+	return m.extreme(func(v T, best T) bool { return less(best, v) })
+}
+
+// extreme finds the best of the stored elements and only compares it with zero, at the position of the first
+// element that is not stored, when there is one.
+func (m Matrix[T]) extreme(better func(v T, best T) bool) (int, int) {
+	best := -1
+
+	for i, v := range m.Values {
+		if best < 0 || better(v, m.Values[best]) {
+			best = i
+		}
+	}
+
+	if r, c, ok := m.firstZero(); ok && (best < 0 || better(0, m.Values[best])) {
+		return r, c
+	}
+
+	return m.rowOf(best), m.ColIdx[best]
+}
+
+// FrobeniusNorm returns the square root of the sum of the squared absolute values of the stored elements.
+func (m Matrix[T]) FrobeniusNorm() float64 {
+	abs := numeric.AbsFunc[T]()
+	var sum float64
+
+	for _, v := range m.Values {
+		a := abs(v)
+		sum += a * a
+	}
+
+	return math.Sqrt(sum)
+}
+
+// OneNorm returns the largest sum of the absolute values in a column of m.
+func (m Matrix[T]) OneNorm() float64 {
+	abs := numeric.AbsFunc[T]()
+	sums := make([]float64, m.Cols)
+
+	for i, v := range m.Values {
+		sums[m.ColIdx[i]] += abs(v)
+	}
+
+	var norm float64
+
+	for _, sum := range sums {
+		norm = math.Max(norm, sum)
+	}
+
+	return norm
+}
+
+// InfNorm returns the largest sum of the absolute values in a row of m.
+func (m Matrix[T]) InfNorm() float64 {
+	abs := numeric.AbsFunc[T]()
+	var norm float64
+
+	for r := 0; r < m.Rows; r++ {
+		var sum float64
+
+		for i := m.RowPtr[r]; i < m.RowPtr[r+1]; i++ {
+			sum += abs(m.Values[i])
+		}
+
+		norm = math.Max(norm, sum)
+	}
+
+	return norm
+}
+
+// Rank returns the number of linearly independent rows of m. Elimination fills in the zeros, so it works on
+// a dense copy.
+func (m Matrix[T]) Rank() int {
+	row := make([]T, m.Cols)
+
+	return numeric.Rank(m.Rows, m.Cols, func(r int) []T {
+		m.scatter(r, row)
+		return row
+	})
+}
+
+// Reshape returns the elements of m read in row-major order into a rows x cols matrix, where rows*cols must
+// equal m.Rows*m.Cols. The stored elements stay in the same order, so the result shares Values with m.
+func (m Matrix[T]) Reshape(rows int, cols int) Matrix[T] {
+	n := Zeros[T](rows, cols)
+	n.ColIdx = make([]int, len(m.ColIdx))
+	n.Values = m.Values
+
+	for r := 0; r < m.Rows; r++ {
+		for i := m.RowPtr[r]; i < m.RowPtr[r+1]; i++ {
+			j := r*m.Cols + m.ColIdx[i]
+			n.ColIdx[i] = j % cols
+			n.RowPtr[j/cols+1]++
+		}
+	}
+
+	for r := 0; r < rows; r++ {
+		n.RowPtr[r+1] += n.RowPtr[r]
+	}
+
+	return n
+}
+
+// HConcat returns m2 placed to the right of m, where m.Rows must equal m2.Rows.
+func (m Matrix[T]) HConcat(m2 Matrix[T]) Matrix[T] {
+	n := Zeros[T](m.Rows, m.Cols+m2.Cols)
+	n.ColIdx = make([]int, 0, len(m.Values)+len(m2.Values))
+	n.Values = make([]T, 0, len(m.Values)+len(m2.Values))
+
+	for r := 0; r < m.Rows; r++ {
+		n.ColIdx = append(n.ColIdx, m.ColIdx[m.RowPtr[r]:m.RowPtr[r+1]]...)
+		n.Values = append(n.Values, m.Values[m.RowPtr[r]:m.RowPtr[r+1]]...)
+
+		for i := m2.RowPtr[r]; i < m2.RowPtr[r+1]; i++ {
+			n.ColIdx = append(n.ColIdx, m.Cols+m2.ColIdx[i])
+			n.Values = append(n.Values, m2.Values[i])
+		}
+
+		n.RowPtr[r+1] = len(n.Values)
+	}
+
+	return n
+}
+
+// Stack returns ms placed below m in order, where each of them must have m.Cols columns.
+func (m Matrix[T]) Stack(ms ...Matrix[T]) Matrix[T] {
+	all := append([]Matrix[T]{m}, ms...)
+	rows, stored := 0, 0
+
+	for _, a := range all {
+		rows += a.Rows
+		stored += len(a.Values)
+	}
+
+	n := Matrix[T]{Rows: rows, Cols: m.Cols, RowPtr: make([]int, 1, rows+1)}
+	n.ColIdx = make([]int, 0, stored)
+	n.Values = make([]T, 0, stored)
+
+	for _, a := range all {
+		for _, p := range a.RowPtr[1:] {
+			n.RowPtr = append(n.RowPtr, len(n.Values)+p)
+		}
+
+		n.ColIdx = append(n.ColIdx, a.ColIdx...)
+		n.Values = append(n.Values, a.Values...)
+	}
+
+	return n
+}
+
+// SplitRows returns the rows of m before at and the rows from at onwards, where at must be between 1 and
+// m.Rows-1. The elements of each part are contiguous in m, so both share ColIdx and Values with it.
+func (m Matrix[T]) SplitRows(at int) (Matrix[T], Matrix[T]) {
+	p := m.RowPtr[at]
+
+	top := Matrix[T]{Rows: at, Cols: m.Cols, RowPtr: m.RowPtr[: at+1 : at+1], ColIdx: m.ColIdx[:p:p], Values: m.Values[:p:p]}
+	bottom := Matrix[T]{Rows: m.Rows - at, Cols: m.Cols, RowPtr: make([]int, m.Rows-at+1), ColIdx: m.ColIdx[p:], Values: m.Values[p:]}
+
+	for r := range bottom.RowPtr {
+		bottom.RowPtr[r] = m.RowPtr[at+r] - p
+	}
+
+	return top, bottom
+}
+
+// SplitCols returns the columns of m before at and the columns from at onwards, where at must be between 1
+// and m.Cols-1.
+func (m Matrix[T]) SplitCols(at int) (Matrix[T], Matrix[T]) {
+	left, right := Zeros[T](m.Rows, at), Zeros[T](m.Rows, m.Cols-at)
+
+	for r := 0; r < m.Rows; r++ {
+		start, end := m.RowPtr[r], m.RowPtr[r+1]
+		i := start + sort.SearchInts(m.ColIdx[start:end], at)
+
+		left.ColIdx = append(left.ColIdx, m.ColIdx[start:i]...)
+		left.Values = append(left.Values, m.Values[start:i]...)
+		left.RowPtr[r+1] = len(left.Values)
+
+		for ; i < end; i++ {
+			right.ColIdx = append(right.ColIdx, m.ColIdx[i]-at)
+			right.Values = append(right.Values, m.Values[i])
+		}
+
+		right.RowPtr[r+1] = len(right.Values)
+	}
+
+	return left, right
+}
+
+// Pad returns m surrounded by the given number of rows and columns of zeros on each side. Padding only moves
+// the stored elements, so the result shares Values with m.
+func (m Matrix[T]) Pad(top int, bottom int, left int, right int) Matrix[T] {
+	n := Zeros[T](top+m.Rows+bottom, left+m.Cols+right)
+	n.ColIdx = make([]int, len(m.ColIdx))
+	n.Values = m.Values
+
+	for i, c := range m.ColIdx {
+		n.ColIdx[i] = left + c
+	}
+
+	for r := top; r < n.Rows; r++ {
+		n.RowPtr[r+1] = m.RowPtr[min(r-top+1, m.Rows)]
+	}
+
+	return n
+}
+
+// Flip returns m with the order of its rows or columns reversed.
+func (m Matrix[T]) Flip(axis immutabilitybenchmarking.Axis) Matrix[T] {
+	n := Zeros[T](m.Rows, m.Cols)
+	n.ColIdx = make([]int, 0, len(m.Values))
+	n.Values = make([]T, 0, len(m.Values))
+
+	for r := 0; r < m.Rows; r++ {
+		if axis == immutabilitybenchmarking.RowAxis {
+			start, end := m.RowPtr[m.Rows-1-r], m.RowPtr[m.Rows-r]
+			n.ColIdx = append(n.ColIdx, m.ColIdx[start:end]...)
+			n.Values = append(n.Values, m.Values[start:end]...)
+		} else {
+			for i := m.RowPtr[r+1] - 1; i >= m.RowPtr[r]; i-- {
+				n.ColIdx = append(n.ColIdx, m.Cols-1-m.ColIdx[i])
+				n.Values = append(n.Values, m.Values[i])
+			}
+		}
+
+		n.RowPtr[r+1] = len(n.Values)
+	}
+
+	return n
+}
+
+// Rotate90 returns m turned a quarter turn clockwise, which is the transpose of m with its rows reversed.
+func (m Matrix[T]) Rotate90() Matrix[T] {
+	return m.Flip(immutabilitybenchmarking.RowAxis).Transpose()
+}
@@ -0,0 +1,15 @@
+// Package sparse defines what the sparse matrices in sparse/csr and sparse/coo have in common. Sparse
+// matrices store only their non-zero elements, so operations between them take time proportional to the
+// number of non-zero elements rather than to the size of the matrix.
+package sparse
+
+import "github.com/chris-tomich/immutability-benchmarking"
+
+// Matrix is a matrix that stores only its non-zero elements.
+type Matrix[T immutabilitybenchmarking.Number] interface {
+	immutabilitybenchmarking.Matrix[T]
+	// NonZeros returns the number of elements stored by the matrix.
+	NonZeros() int
+	// ForEachNonZero calls f with every stored element in row-major order.
+	ForEachNonZero(f func(row int, col int, v T))
+}
@@ -0,0 +1,170 @@
+package sparse_test
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/chris-tomich/immutability-benchmarking"
+	sliceimmutable "github.com/chris-tomich/immutability-benchmarking/slice/immutable"
+	slicemutable "github.com/chris-tomich/immutability-benchmarking/slice/mutable"
+	cooimmutable "github.com/chris-tomich/immutability-benchmarking/sparse/coo/immutable"
+	coomutable "github.com/chris-tomich/immutability-benchmarking/sparse/coo/mutable"
+	csrimmutable "github.com/chris-tomich/immutability-benchmarking/sparse/csr/immutable"
+	csrmutable "github.com/chris-tomich/immutability-benchmarking/sparse/csr/mutable"
+)
+
+// The dense slice backends are included so the sparse backends can be compared against them.
+var backends = []struct {
+	name    string
+	factory immutabilitybenchmarking.Factory[int]
+}{
+	{name: "slice/mutable", factory: slicemutable.Factory[int]{}},
+	{name: "slice/immutable", factory: sliceimmutable.Factory[int]{}},
+	{name: "csr/mutable", factory: csrmutable.Factory[int]{}},
+	{name: "csr/immutable", factory: csrimmutable.Factory[int]{}},
+	{name: "coo/mutable", factory: coomutable.Factory[int]{}},
+	{name: "coo/immutable", factory: cooimmutable.Factory[int]{}},
+}
+
+var sizes = []int{90, 270}
+
+// densities are the fractions of elements that are not zero.
+var densities = []float64{0.01, 0.05, 0.2}
+
+func generateMatrix(f immutabilitybenchmarking.Factory[int], size int, density float64) immutabilitybenchmarking.Matrix[int] {
+	m, err := f.FromFunc(size, size, func(row int, col int) int {
+		if rand.Float64() < density {
+			return rand.Int()
+		}
+
+		return 0
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return m
+}
+
+// copyMatrix creates a copy of m with the same factory so mutable matrices can be reused.
+func copyMatrix(f immutabilitybenchmarking.Factory[int], m immutabilitybenchmarking.Matrix[int]) immutabilitybenchmarking.Matrix[int] {
+	c, err := f.FromFunc(m.Height(), m.Width(), m.Get)
+	if err != nil {
+		panic(err)
+	}
+
+	return c
+}
+
+// runDensities runs a benchmark for every backend, size and density, passing it the factory and a pair of
+// matrices of that density.
+func runDensities(b *testing.B, runner func(b *testing.B, f immutabilitybenchmarking.Factory[int], mm1 []immutabilitybenchmarking.Matrix[int], mm2 []immutabilitybenchmarking.Matrix[int])) {
+	const totalMatrices = 10
+
+	for _, backend := range backends {
+		for _, size := range sizes {
+			for _, density := range densities {
+				b.Run(fmt.Sprintf("%s/%dx%d/%g%%", backend.name, size, size, density*100), func(b *testing.B) {
+					mm1 := make([]immutabilitybenchmarking.Matrix[int], totalMatrices)
+					mm2 := make([]immutabilitybenchmarking.Matrix[int], totalMatrices)
+
+					for i := 0; i < totalMatrices; i++ {
+						mm1[i] = generateMatrix(backend.factory, size, density)
+						mm2[i] = generateMatrix(backend.factory, size, density)
+					}
+
+					b.ResetTimer()
+					runner(b, backend.factory, mm1, mm2)
+				})
+			}
+		}
+	}
+}
+
+// Adding and subtracting the same matrix repeatedly leaves the pattern of non-zero elements unchanged after
+// the first iteration, so the density stays close to the one being benchmarked.
+func BenchmarkSparseAdd(b *testing.B) {
+	runDensities(b, func(b *testing.B, f immutabilitybenchmarking.Factory[int], mm1 []immutabilitybenchmarking.Matrix[int], mm2 []immutabilitybenchmarking.Matrix[int]) {
+		for i := 0; i < b.N; i++ {
+			for j := range mm1 {
+				mm1[j], _ = mm1[j].Add(mm2[j])
+			}
+		}
+	})
+}
+
+func BenchmarkSparseSubtract(b *testing.B) {
+	runDensities(b, func(b *testing.B, f immutabilitybenchmarking.Factory[int], mm1 []immutabilitybenchmarking.Matrix[int], mm2 []immutabilitybenchmarking.Matrix[int]) {
+		for i := 0; i < b.N; i++ {
+			for j := range mm1 {
+				mm1[j], _ = mm1[j].Subtract(mm2[j])
+			}
+		}
+	})
+}
+
+// Multiplying by an odd number never turns a non-zero int into zero, so the density is unchanged.
+func BenchmarkSparseScalar(b *testing.B) {
+	runDensities(b, func(b *testing.B, f immutabilitybenchmarking.Factory[int], mm1 []immutabilitybenchmarking.Matrix[int], mm2 []immutabilitybenchmarking.Matrix[int]) {
+		for i := 0; i < b.N; i++ {
+			for j := range mm1 {
+				mm1[j] = mm1[j].ScalarMultiply(3)
+			}
+		}
+	})
+}
+
+func BenchmarkSparseTranspose(b *testing.B) {
+	runDensities(b, func(b *testing.B, f immutabilitybenchmarking.Factory[int], mm1 []immutabilitybenchmarking.Matrix[int], mm2 []immutabilitybenchmarking.Matrix[int]) {
+		for i := 0; i < b.N; i++ {
+			for j := range mm1 {
+				mm1[j] = mm1[j].Transpose()
+			}
+		}
+	})
+}
+
+// Products are denser than their operands, so every iteration multiplies a fresh copy of the original
+// operand rather than the previous product.
+func BenchmarkSparseMultiply(b *testing.B) {
+	runDensities(b, func(b *testing.B, f immutabilitybenchmarking.Factory[int], mm1 []immutabilitybenchmarking.Matrix[int], mm2 []immutabilitybenchmarking.Matrix[int]) {
+		operands := make([]immutabilitybenchmarking.Matrix[int], len(mm1))
+
+		for i := 0; i < b.N; i++ {
+			b.StopTimer()
+			for j := range mm1 {
+				operands[j] = copyMatrix(f, mm1[j])
+			}
+			b.StartTimer()
+
+			for j := range operands {
+				operands[j].MatrixMultiply(mm2[j])
+			}
+		}
+	})
+}
+
+// BenchmarkSparseMultiplyDense multiplies sparse matrices by dense slice backed ones.
+func BenchmarkSparseMultiplyDense(b *testing.B) {
+	runDensities(b, func(b *testing.B, f immutabilitybenchmarking.Factory[int], mm1 []immutabilitybenchmarking.Matrix[int], mm2 []immutabilitybenchmarking.Matrix[int]) {
+		dense := make([]immutabilitybenchmarking.Matrix[int], len(mm2))
+		for j := range mm2 {
+			dense[j] = generateMatrix(sliceimmutable.Factory[int]{}, mm2[j].Height(), 1)
+		}
+
+		operands := make([]immutabilitybenchmarking.Matrix[int], len(mm1))
+
+		for i := 0; i < b.N; i++ {
+			b.StopTimer()
+			for j := range mm1 {
+				operands[j] = copyMatrix(f, mm1[j])
+			}
+			b.StartTimer()
+
+			for j := range operands {
+				operands[j].MatrixMultiply(dense[j])
+			}
+		}
+	})
+}
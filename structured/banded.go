@@ -0,0 +1,458 @@
+package structured
+
+import (
+	"github.com/chris-tomich/immutability-benchmarking"
+	"github.com/chris-tomich/immutability-benchmarking/internal/numeric"
+	"github.com/chris-tomich/immutability-benchmarking/internal/power"
+	"github.com/chris-tomich/immutability-benchmarking/internal/shape"
+	"github.com/pkg/errors"
+)
+
+// Banded is an immutable matrix whose only non-zero elements are within a band around the diagonal,
+// reaching lower elements below it and upper elements above it. Each row stores only its part of the band.
+type Banded[T immutabilitybenchmarking.Number] struct {
+	rows   int
+	cols   int
+	lower  int
+	upper  int
+	values []T
+}
+
+// NewBanded creates a banded matrix from a copy of the given values, returning a StructureError if any value
+// outside the band is not zero. Bandwidths wider than the matrix are narrowed to fit it.
+func NewBanded[T immutabilitybenchmarking.Number](matrix [][]T, lower int, upper int) (Banded[T], error) {
+	if lower < 0 || upper < 0 {
+		panic(errors.Errorf("the bandwidths %d and %d must not be negative", lower, upper))
+	}
+
+	if err := validate(matrix); err != nil {
+		return Banded[T]{}, err
+	}
+
+	m := newBanded[T](len(matrix), len(matrix[0]), lower, upper)
+
+	for r := 0; r < m.rows; r++ {
+		from, to := m.span(r)
+
+		for c := 0; c < m.cols; c++ {
+			switch {
+			case c >= from && c < to:
+				m.values[m.index(r, c)] = matrix[r][c]
+			case matrix[r][c] != 0:
+				return Banded[T]{}, &immutabilitybenchmarking.StructureError{Structure: "banded", Row: r, Col: c}
+			}
+		}
+	}
+
+	return m, nil
+}
+
+// newBanded creates a banded matrix of zeros.
+func newBanded[T immutabilitybenchmarking.Number](rows int, cols int, lower int, upper int) Banded[T] {
+	lower = min(lower, rows-1)
+	upper = min(upper, cols-1)
+
+	return Banded[T]{
+		rows:   rows,
+		cols:   cols,
+		lower:  lower,
+		upper:  upper,
+		values: make([]T, rows*(lower+upper+1)),
+	}
+}
+
+// index returns where the element at row, col is stored, where col must be within the band of row.
+func (m1 Banded[T]) index(row int, col int) int {
+	return row*(m1.lower+m1.upper+1) + col - row + m1.lower
+}
+
+// span returns the first column of the band in row and the column after its last.
+func (m1 Banded[T]) span(row int) (int, int) {
+	return max(0, row-m1.lower), min(m1.cols, row+m1.upper+1)
+}
+
+// Bandwidths returns how far the band reaches below and above the diagonal.
+func (m1 Banded[T]) Bandwidths() (int, int) {
+	return m1.lower, m1.upper
+}
+
+// Width returns the number of columns in the matrix.
+func (m1 Banded[T]) Width() int {
+	return m1.cols
+}
+
+// Height returns the number of rows in the matrix.
+func (m1 Banded[T]) Height() int {
+	return m1.rows
+}
+
+// Get returns the element at the provided coordinates.
+func (m1 Banded[T]) Get(row int, col int) T {
+	if col < row-m1.lower || col > row+m1.upper {
+		return 0
+	}
+
+	return m1.values[m1.index(row, col)]
+}
+
+// At returns the element at the provided coordinates or an OutOfBoundsError if they are outside the matrix.
+func (m1 Banded[T]) At(row int, col int) (T, error) {
+	return at[T](m1, row, col)
+}
+
+// MustAt returns the element at the provided coordinates and panics with an OutOfBoundsError if they are
+// outside the matrix.
+func (m1 Banded[T]) MustAt(row int, col int) T {
+	return mustAt[T](m1, row, col)
+}
+
+// Equals will compare a matrix against this matrix and return if they are equal.
+func (m1 Banded[T]) Equals(m2 immutabilitybenchmarking.Matrix[T]) bool {
+	if b, ok := m2.(Banded[T]); ok && m1.rows == b.rows && m1.cols == b.cols && m1.lower == b.lower && m1.upper == b.upper {
+		return equalValues(m1.values, b.values)
+	}
+
+	return equals[T](m1, m2)
+}
+
+// combine applies f to the elements of m1 and b within the wider of their bands.
+func (m1 Banded[T]) combine(b Banded[T], f func(x T, y T) T) Banded[T] {
+	m := newBanded[T](m1.rows, m1.cols, max(m1.lower, b.lower), max(m1.upper, b.upper))
+
+	for r := 0; r < m.rows; r++ {
+		from, to := m.span(r)
+
+		for c := from; c < to; c++ {
+			m.values[m.index(r, c)] = f(m1.Get(r, c), b.Get(r, c))
+		}
+	}
+
+	return m
+}
+
+// Add will add the values of a matrix to this matrix. The sum of two banded matrices is banded.
+func (m1 Banded[T]) Add(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Height() != m2.Height() || m1.Width() != m2.Width() {
+		return Banded[T]{}, mismatch[T]("Add", m1, m2)
+	}
+
+	if b, ok := m2.(Banded[T]); ok {
+		return m1.combine(b, func(x T, y T) T { return x + y }), nil
+	}
+
+	return dense[T](m1).Add(m2)
+}
+
+// Subtract will subtract the values of a matrix from this matrix. The difference of two banded matrices is
+// banded.
+func (m1 Banded[T]) Subtract(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Height() != m2.Height() || m1.Width() != m2.Width() {
+		return Banded[T]{}, mismatch[T]("Subtract", m1, m2)
+	}
+
+	if b, ok := m2.(Banded[T]); ok {
+		return m1.combine(b, func(x T, y T) T { return x - y }), nil
+	}
+
+	return dense[T](m1).Subtract(m2)
+}
+
+// ScalarMultiply will multiply this matrix by a given scalar value.
+func (m1 Banded[T]) ScalarMultiply(s T) immutabilitybenchmarking.Matrix[T] {
+	return Banded[T]{rows: m1.rows, cols: m1.cols, lower: m1.lower, upper: m1.upper, values: scaleValues(m1.values, s)}
+}
+
+// Transpose will transpose this matrix, swapping the bandwidths below and above the diagonal.
+func (m1 Banded[T]) Transpose() immutabilitybenchmarking.Matrix[T] {
+	m := newBanded[T](m1.cols, m1.rows, m1.upper, m1.lower)
+
+	for r := 0; r < m1.rows; r++ {
+		from, to := m1.span(r)
+
+		for c := from; c < to; c++ {
+			m.values[m.index(c, r)] = m1.values[m1.index(r, c)]
+		}
+	}
+
+	return m
+}
+
+// MatrixMultiply will multiple the given matrix against this matrix. The product of two banded matrices is
+// banded with the bandwidths of both added together, and any other product skips the zeros outside the band.
+func (m1 Banded[T]) MatrixMultiply(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Width() != m2.Height() {
+		return Banded[T]{}, mismatch[T]("MatrixMultiply", m1, m2)
+	}
+
+	b, ok := m2.(Banded[T])
+	if !ok {
+		return multiply[T](m1, m2, m1.span), nil
+	}
+
+	m := newBanded[T](m1.rows, b.cols, m1.lower+b.lower, m1.upper+b.upper)
+
+	for r := 0; r < m1.rows; r++ {
+		from, to := m1.span(r)
+
+		for k := from; k < to; k++ {
+			v := m1.values[m1.index(r, k)]
+			kFrom, kTo := b.span(k)
+
+			for c := kFrom; c < kTo; c++ {
+				m.values[m.index(r, c)] += v * b.values[b.index(k, c)]
+			}
+		}
+	}
+
+	return m, nil
+}
+
+// Map will apply f to every element of this matrix. When f maps zero to zero the result is banded.
+func (m1 Banded[T]) Map(f func(v T) T) immutabilitybenchmarking.Matrix[T] {
+	if f(0) != 0 {
+		return dense[T](m1).Map(f)
+	}
+
+	return Banded[T]{rows: m1.rows, cols: m1.cols, lower: m1.lower, upper: m1.upper, values: mapValues(m1.values, f)}
+}
+
+// MapIndexed will apply f to every element of this matrix along with its coordinates. As f can depend on
+// where an element is, the result is dense.
+func (m1 Banded[T]) MapIndexed(f func(row int, col int, v T) T) immutabilitybenchmarking.Matrix[T] {
+	return dense[T](m1).MapIndexed(f)
+}
+
+// ZipWith will combine each element of this matrix with the element at the same coordinates of the given
+// matrix using f. When both are banded and f maps two zeros to
+// zero the result is banded within the wider of their bands.
+func (m1 Banded[T]) ZipWith(m2 immutabilitybenchmarking.Matrix[T], f func(a T, b T) T) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Height() != m2.Height() || m1.Width() != m2.Width() {
+		return Banded[T]{}, mismatch[T]("ZipWith", m1, m2)
+	}
+
+	if o, ok := m2.(Banded[T]); ok && f(0, 0) == 0 {
+		return m1.combine(o, f), nil
+	}
+
+	return dense[T](m1).ZipWith(m2, f)
+}
+
+// Fold will combine every element of this matrix into a single value, starting from initial and visiting
+// the elements in row-major order.
+func (m1 Banded[T]) Fold(initial T, f func(acc T, v T) T) T {
+	return fold[T](m1, initial, f)
+}
+
+// RowReduce will fold each row of this matrix into a single value starting from initial, returning a
+// column of the results.
+func (m1 Banded[T]) RowReduce(initial T, f func(acc T, v T) T) immutabilitybenchmarking.Matrix[T] {
+	return rowReduce[T](m1, initial, f)
+}
+
+// ColReduce will fold each column of this matrix into a single value starting from initial, returning a
+// row of the results.
+func (m1 Banded[T]) ColReduce(initial T, f func(acc T, v T) T) immutabilitybenchmarking.Matrix[T] {
+	return colReduce[T](m1, initial, f)
+}
+
+// combineWith applies f to the elements of m1 within its band and the elements of m2 at the same
+// coordinates.
+func (m1 Banded[T]) combineWith(m2 immutabilitybenchmarking.Matrix[T], f func(x T, y T) T) Banded[T] {
+	m := newBanded[T](m1.rows, m1.cols, m1.lower, m1.upper)
+
+	for r := 0; r < m.rows; r++ {
+		from, to := m.span(r)
+
+		for c := from; c < to; c++ {
+			m.values[m.index(r, c)] = f(m1.values[m1.index(r, c)], m2.Get(r, c))
+		}
+	}
+
+	return m
+}
+
+// HadamardProduct will multiply each element of this matrix by the element at the same coordinates of the
+// given matrix. The zeros outside the band stay zero, so the product is banded whatever the given matrix.
+func (m1 Banded[T]) HadamardProduct(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Height() != m2.Height() || m1.Width() != m2.Width() {
+		return Banded[T]{}, mismatch[T]("HadamardProduct", m1, m2)
+	}
+
+	return m1.combineWith(m2, func(x T, y T) T { return x * y }), nil
+}
+
+// ElementwiseDivide will divide each element of this matrix by the element at the same coordinates of the
+// given matrix, returning a DivisionByZeroError if any of them is zero. The quotient is banded.
+func (m1 Banded[T]) ElementwiseDivide(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Height() != m2.Height() || m1.Width() != m2.Width() {
+		return Banded[T]{}, mismatch[T]("ElementwiseDivide", m1, m2)
+	}
+
+	if err := divisor(m2); err != nil {
+		return Banded[T]{}, err
+	}
+
+	return m1.combineWith(m2, func(x T, y T) T { return x / y }), nil
+}
+
+// KroneckerProduct will multiply every element of this matrix by the whole of the given matrix. The band of
+// each block lands at a different offset from the diagonal of the product, so the product is dense.
+func (m1 Banded[T]) KroneckerProduct(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	return dense[T](m1).KroneckerProduct(m2)
+}
+
+// Trace will sum the elements on the diagonal of this matrix, returning a NotSquareError if it is not square.
+func (m1 Banded[T]) Trace() (T, error) {
+	if m1.rows != m1.cols {
+		var zero T
+		return zero, &immutabilitybenchmarking.NotSquareError{Rows: m1.rows, Cols: m1.cols}
+	}
+
+	return trace[T](m1), nil
+}
+
+// Sum will add together every element of this matrix. The values outside the matrix at the ends of the band
+// are stored as zeros, so every stored value can be added.
+func (m1 Banded[T]) Sum() T {
+	return sumValues(m1.values)
+}
+
+// Min will return the smallest element of this matrix. Complex elements are ordered by their real parts and
+// then by their imaginary parts.
+func (m1 Banded[T]) Min() T {
+	return m1.Get(m1.ArgMin())
+}
+
+// Max will return the largest element of this matrix. Complex elements are ordered by their real parts and
+// then by their imaginary parts.
+func (m1 Banded[T]) Max() T {
+	return m1.Get(m1.ArgMax())
+}
+
+// ArgMin will return the coordinates of the first smallest element of this matrix in row-major order.
+func (m1 Banded[T]) ArgMin() (int, int) {
+	return numeric.ArgMin(m1.Height(), numeric.RowsOf[T](m1))
+}
+
+// ArgMax will return the coordinates of the first largest element of this matrix in row-major order.
+func (m1 Banded[T]) ArgMax() (int, int) {
+	return numeric.ArgMax(m1.Height(), numeric.RowsOf[T](m1))
+}
+
+// FrobeniusNorm will return the square root of the sum of the squared absolute values of the elements of
+// this matrix.
+func (m1 Banded[T]) FrobeniusNorm() float64 {
+	return frobenius(m1.values)
+}
+
+// OneNorm will return the largest sum of the absolute values in a column of this matrix.
+func (m1 Banded[T]) OneNorm() float64 {
+	return numeric.OneNorm(m1.Height(), m1.Width(), numeric.RowsOf[T](m1))
+}
+
+// InfNorm will return the largest sum of the absolute values in a row of this matrix.
+func (m1 Banded[T]) InfNorm() float64 {
+	return numeric.InfNorm(m1.Height(), numeric.RowsOf[T](m1))
+}
+
+// Rank will return the number of linearly independent rows of this matrix, treating values within rounding
+// error of zero as zero.
+func (m1 Banded[T]) Rank() int {
+	return numeric.Rank(m1.Height(), m1.Width(), numeric.RowsOf[T](m1))
+}
+
+// Power will raise this matrix to the power n by repeated squaring, returning a NotSquareError if it is not
+// square or a NegativeExponentError if n is negative. Each squaring doubles the bandwidths until the band
+// covers the whole matrix, and the zeroth power is the identity matrix with no band either side of the
+// diagonal.
+func (m1 Banded[T]) Power(n int) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.rows != m1.cols {
+		return Banded[T]{}, &immutabilitybenchmarking.NotSquareError{Rows: m1.rows, Cols: m1.cols}
+	}
+
+	if n < 0 {
+		return Banded[T]{}, &immutabilitybenchmarking.NegativeExponentError{Exponent: n}
+	}
+
+	return power.BySquaring[immutabilitybenchmarking.Matrix[T]](m1, n, func() (immutabilitybenchmarking.Matrix[T], error) {
+		m := newBanded[T](m1.rows, m1.cols, 0, 0)
+
+		for i := 0; i < m.rows; i++ {
+			m.values[m.index(i, i)] = 1
+		}
+
+		return m, nil
+	}, nil)
+}
+
+// Reshape will read the elements of this matrix in row-major order into a dense matrix with the given
+// dimensions, returning a ReshapeError if it would not hold the same number of elements.
+func (m1 Banded[T]) Reshape(rows int, cols int) (immutabilitybenchmarking.Matrix[T], error) {
+	return dense[T](m1).Reshape(rows, cols)
+}
+
+// HConcat will place the given matrix to the right of this matrix in a dense matrix, returning a
+// DimensionMismatchError if they do not have the same number of rows.
+func (m1 Banded[T]) HConcat(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	return dense[T](m1).HConcat(m2)
+}
+
+// VConcat will place the given matrix below this matrix in a dense matrix, returning a DimensionMismatchError
+// if they do not have the same number of columns.
+func (m1 Banded[T]) VConcat(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	return dense[T](m1).VConcat(m2)
+}
+
+// Stack will place the given matrices below this matrix in order in a dense matrix, returning a
+// DimensionMismatchError for the first that does not have the same number of columns.
+func (m1 Banded[T]) Stack(ms ...immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	return dense[T](m1).Stack(ms...)
+}
+
+// SplitRows will split this matrix into dense matrices of its rows before at and its rows from at onwards,
+// returning an InvalidDimensionsError if either part would be empty.
+func (m1 Banded[T]) SplitRows(at int) (immutabilitybenchmarking.Matrix[T], immutabilitybenchmarking.Matrix[T], error) {
+	return dense[T](m1).SplitRows(at)
+}
+
+// SplitCols will split this matrix into dense matrices of its columns before at and its columns from at
+// onwards, returning an InvalidDimensionsError if either part would be empty.
+func (m1 Banded[T]) SplitCols(at int) (immutabilitybenchmarking.Matrix[T], immutabilitybenchmarking.Matrix[T], error) {
+	return dense[T](m1).SplitCols(at)
+}
+
+// Pad will surround this matrix with the given number of rows and columns of zeros on each side. Padding
+// the top and left by the same amount keeps every element the same distance from the diagonal, so the
+// result has the same bandwidths, otherwise it is dense. It returns a PaddingError if any of
+// them is negative.
+func (m1 Banded[T]) Pad(top int, bottom int, left int, right int) (immutabilitybenchmarking.Matrix[T], error) {
+	if top != left {
+		return dense[T](m1).Pad(top, bottom, left, right)
+	}
+
+	if err := shape.CheckPad(top, bottom, left, right); err != nil {
+		return Banded[T]{}, err
+	}
+
+	m := newBanded[T](top+m1.rows+bottom, left+m1.cols+right, m1.lower, m1.upper)
+
+	for r := 0; r < m1.rows; r++ {
+		from, to := m1.span(r)
+
+		for c := from; c < to; c++ {
+			m.values[m.index(top+r, left+c)] = m1.values[m1.index(r, c)]
+		}
+	}
+
+	return m, nil
+}
+
+// Flip will reverse the order of the rows or the columns of this matrix in a dense matrix.
+func (m1 Banded[T]) Flip(axis immutabilitybenchmarking.Axis) immutabilitybenchmarking.Matrix[T] {
+	return dense[T](m1).Flip(axis)
+}
+
+// Rotate90 will turn this matrix a quarter turn clockwise in a dense matrix.
+func (m1 Banded[T]) Rotate90() immutabilitybenchmarking.Matrix[T] {
+	return dense[T](m1).Rotate90()
+}
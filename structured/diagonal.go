@@ -0,0 +1,360 @@
+package structured
+
+import (
+	"github.com/chris-tomich/immutability-benchmarking"
+	"github.com/chris-tomich/immutability-benchmarking/internal/numeric"
+	"github.com/chris-tomich/immutability-benchmarking/internal/power"
+	"github.com/chris-tomich/immutability-benchmarking/internal/shape"
+)
+
+// Diagonal is an immutable square matrix whose only non-zero elements are on its diagonal. Only the diagonal
+// is stored.
+type Diagonal[T immutabilitybenchmarking.Number] struct {
+	values []T
+}
+
+// NewDiagonal creates a diagonal matrix with a copy of the given diagonal values.
+func NewDiagonal[T immutabilitybenchmarking.Number](values []T) (Diagonal[T], error) {
+	if len(values) == 0 {
+		return Diagonal[T]{}, &immutabilitybenchmarking.InvalidDimensionsError{}
+	}
+
+	return Diagonal[T]{values: append([]T(nil), values...)}, nil
+}
+
+// Width returns the number of columns in the matrix.
+func (m1 Diagonal[T]) Width() int {
+	return len(m1.values)
+}
+
+// Height returns the number of rows in the matrix.
+func (m1 Diagonal[T]) Height() int {
+	return len(m1.values)
+}
+
+// Get returns the element at the provided coordinates.
+func (m1 Diagonal[T]) Get(row int, col int) T {
+	if row != col {
+		return 0
+	}
+
+	return m1.values[row]
+}
+
+// At returns the element at the provided coordinates or an OutOfBoundsError if they are outside the matrix.
+func (m1 Diagonal[T]) At(row int, col int) (T, error) {
+	return at[T](m1, row, col)
+}
+
+// MustAt returns the element at the provided coordinates and panics with an OutOfBoundsError if they are
+// outside the matrix.
+func (m1 Diagonal[T]) MustAt(row int, col int) T {
+	return mustAt[T](m1, row, col)
+}
+
+// Equals will compare a matrix against this matrix and return if they are equal.
+func (m1 Diagonal[T]) Equals(m2 immutabilitybenchmarking.Matrix[T]) bool {
+	if d, ok := m2.(Diagonal[T]); ok {
+		return equalValues(m1.values, d.values)
+	}
+
+	return equals[T](m1, m2)
+}
+
+// Add will add the values of a matrix to this matrix. The sum of two diagonal matrices is diagonal.
+func (m1 Diagonal[T]) Add(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Height() != m2.Height() || m1.Width() != m2.Width() {
+		return Diagonal[T]{}, mismatch[T]("Add", m1, m2)
+	}
+
+	if d, ok := m2.(Diagonal[T]); ok {
+		return Diagonal[T]{values: addValues(m1.values, d.values)}, nil
+	}
+
+	return dense[T](m1).Add(m2)
+}
+
+// Subtract will subtract the values of a matrix from this matrix. The difference of two diagonal matrices is
+// diagonal.
+func (m1 Diagonal[T]) Subtract(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Height() != m2.Height() || m1.Width() != m2.Width() {
+		return Diagonal[T]{}, mismatch[T]("Subtract", m1, m2)
+	}
+
+	if d, ok := m2.(Diagonal[T]); ok {
+		return Diagonal[T]{values: subtractValues(m1.values, d.values)}, nil
+	}
+
+	return dense[T](m1).Subtract(m2)
+}
+
+// ScalarMultiply will multiply this matrix by a given scalar value.
+func (m1 Diagonal[T]) ScalarMultiply(s T) immutabilitybenchmarking.Matrix[T] {
+	return Diagonal[T]{values: scaleValues(m1.values, s)}
+}
+
+// Transpose will transpose this matrix. A diagonal matrix is its own transpose.
+func (m1 Diagonal[T]) Transpose() immutabilitybenchmarking.Matrix[T] {
+	return m1
+}
+
+// MatrixMultiply will multiple the given matrix against this matrix. The product of two diagonal matrices is
+// diagonal, and any other product only needs each row of the given matrix scaled by a diagonal value.
+func (m1 Diagonal[T]) MatrixMultiply(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Width() != m2.Height() {
+		return Diagonal[T]{}, mismatch[T]("MatrixMultiply", m1, m2)
+	}
+
+	d, ok := m2.(Diagonal[T])
+	if !ok {
+		return multiply[T](m1, m2, func(row int) (int, int) { return row, row + 1 }), nil
+	}
+
+	m := Diagonal[T]{values: make([]T, len(m1.values))}
+
+	for i := range m1.values {
+		m.values[i] = m1.values[i] * d.values[i]
+	}
+
+	return m, nil
+}
+
+// Map will apply f to every element of this matrix. When f maps zero to zero the result is diagonal.
+func (m1 Diagonal[T]) Map(f func(v T) T) immutabilitybenchmarking.Matrix[T] {
+	if f(0) != 0 {
+		return dense[T](m1).Map(f)
+	}
+
+	return Diagonal[T]{values: mapValues(m1.values, f)}
+}
+
+// MapIndexed will apply f to every element of this matrix along with its coordinates. As f can depend on
+// where an element is, the result is dense.
+func (m1 Diagonal[T]) MapIndexed(f func(row int, col int, v T) T) immutabilitybenchmarking.Matrix[T] {
+	return dense[T](m1).MapIndexed(f)
+}
+
+// ZipWith will combine each element of this matrix with the element at the same coordinates of the given
+// matrix using f. When both are diagonal and f maps two zeros
+// to zero the result is diagonal.
+func (m1 Diagonal[T]) ZipWith(m2 immutabilitybenchmarking.Matrix[T], f func(a T, b T) T) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Height() != m2.Height() || m1.Width() != m2.Width() {
+		return Diagonal[T]{}, mismatch[T]("ZipWith", m1, m2)
+	}
+
+	if o, ok := m2.(Diagonal[T]); ok && f(0, 0) == 0 {
+		return Diagonal[T]{values: zipValues(m1.values, o.values, f)}, nil
+	}
+
+	return dense[T](m1).ZipWith(m2, f)
+}
+
+// Fold will combine every element of this matrix into a single value, starting from initial and visiting
+// the elements in row-major order.
+func (m1 Diagonal[T]) Fold(initial T, f func(acc T, v T) T) T {
+	return fold[T](m1, initial, f)
+}
+
+// RowReduce will fold each row of this matrix into a single value starting from initial, returning a
+// column of the results.
+func (m1 Diagonal[T]) RowReduce(initial T, f func(acc T, v T) T) immutabilitybenchmarking.Matrix[T] {
+	return rowReduce[T](m1, initial, f)
+}
+
+// ColReduce will fold each column of this matrix into a single value starting from initial, returning a
+// row of the results.
+func (m1 Diagonal[T]) ColReduce(initial T, f func(acc T, v T) T) immutabilitybenchmarking.Matrix[T] {
+	return colReduce[T](m1, initial, f)
+}
+
+// HadamardProduct will multiply each element of this matrix by the element at the same coordinates of the
+// given matrix. The zeros off the diagonal stay zero, so the product is diagonal whatever the given matrix.
+func (m1 Diagonal[T]) HadamardProduct(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Height() != m2.Height() || m1.Width() != m2.Width() {
+		return Diagonal[T]{}, mismatch[T]("HadamardProduct", m1, m2)
+	}
+
+	m := Diagonal[T]{values: make([]T, len(m1.values))}
+
+	for i := range m1.values {
+		m.values[i] = m1.values[i] * m2.Get(i, i)
+	}
+
+	return m, nil
+}
+
+// ElementwiseDivide will divide each element of this matrix by the element at the same coordinates of the
+// given matrix, returning a DivisionByZeroError if any of them is zero. The quotient is diagonal.
+func (m1 Diagonal[T]) ElementwiseDivide(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Height() != m2.Height() || m1.Width() != m2.Width() {
+		return Diagonal[T]{}, mismatch[T]("ElementwiseDivide", m1, m2)
+	}
+
+	if err := divisor(m2); err != nil {
+		return Diagonal[T]{}, err
+	}
+
+	m := Diagonal[T]{values: make([]T, len(m1.values))}
+
+	for i := range m1.values {
+		m.values[i] = m1.values[i] / m2.Get(i, i)
+	}
+
+	return m, nil
+}
+
+// KroneckerProduct will multiply every element of this matrix by the whole of the given matrix. The
+// Kronecker product of two diagonal matrices is diagonal.
+func (m1 Diagonal[T]) KroneckerProduct(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	d, ok := m2.(Diagonal[T])
+	if !ok {
+		return dense[T](m1).KroneckerProduct(m2)
+	}
+
+	m := Diagonal[T]{values: make([]T, 0, len(m1.values)*len(d.values))}
+
+	for _, v1 := range m1.values {
+		for _, v2 := range d.values {
+			m.values = append(m.values, v1*v2)
+		}
+	}
+
+	return m, nil
+}
+
+// Trace will sum the elements on the diagonal of this matrix, which are the only ones stored.
+func (m1 Diagonal[T]) Trace() (T, error) {
+	return sumValues(m1.values), nil
+}
+
+// Sum will add together every element of this matrix, which only needs the diagonal.
+func (m1 Diagonal[T]) Sum() T {
+	return sumValues(m1.values)
+}
+
+// Min will return the smallest element of this matrix. Complex elements are ordered by their real parts and
+// then by their imaginary parts.
+func (m1 Diagonal[T]) Min() T {
+	return m1.Get(m1.ArgMin())
+}
+
+// Max will return the largest element of this matrix. Complex elements are ordered by their real parts and
+// then by their imaginary parts.
+func (m1 Diagonal[T]) Max() T {
+	return m1.Get(m1.ArgMax())
+}
+
+// ArgMin will return the coordinates of the first smallest element of this matrix in row-major order.
+func (m1 Diagonal[T]) ArgMin() (int, int) {
+	return numeric.ArgMin(m1.Height(), numeric.RowsOf[T](m1))
+}
+
+// ArgMax will return the coordinates of the first largest element of this matrix in row-major order.
+func (m1 Diagonal[T]) ArgMax() (int, int) {
+	return numeric.ArgMax(m1.Height(), numeric.RowsOf[T](m1))
+}
+
+// FrobeniusNorm will return the square root of the sum of the squared absolute values of the elements of
+// this matrix.
+func (m1 Diagonal[T]) FrobeniusNorm() float64 {
+	return frobenius(m1.values)
+}
+
+// OneNorm will return the largest sum of the absolute values in a column of this matrix.
+func (m1 Diagonal[T]) OneNorm() float64 {
+	return numeric.OneNorm(m1.Height(), m1.Width(), numeric.RowsOf[T](m1))
+}
+
+// InfNorm will return the largest sum of the absolute values in a row of this matrix.
+func (m1 Diagonal[T]) InfNorm() float64 {
+	return numeric.InfNorm(m1.Height(), numeric.RowsOf[T](m1))
+}
+
+// Rank will return the number of linearly independent rows of this matrix, treating values within rounding
+// error of zero as zero.
+func (m1 Diagonal[T]) Rank() int {
+	return numeric.Rank(m1.Height(), m1.Width(), numeric.RowsOf[T](m1))
+}
+
+// Power will raise this matrix to the power n by repeated squaring, returning a NegativeExponentError if n is
+// negative. Every power of a diagonal matrix is diagonal, with the zeroth being the identity matrix.
+func (m1 Diagonal[T]) Power(n int) (immutabilitybenchmarking.Matrix[T], error) {
+	if n < 0 {
+		return Diagonal[T]{}, &immutabilitybenchmarking.NegativeExponentError{Exponent: n}
+	}
+
+	return power.BySquaring[immutabilitybenchmarking.Matrix[T]](m1, n, func() (immutabilitybenchmarking.Matrix[T], error) {
+		m := Diagonal[T]{values: make([]T, len(m1.values))}
+
+		for i := range m.values {
+			m.values[i] = 1
+		}
+
+		return m, nil
+	}, nil)
+}
+
+// Reshape will read the elements of this matrix in row-major order into a dense matrix with the given
+// dimensions, returning a ReshapeError if it would not hold the same number of elements.
+func (m1 Diagonal[T]) Reshape(rows int, cols int) (immutabilitybenchmarking.Matrix[T], error) {
+	return dense[T](m1).Reshape(rows, cols)
+}
+
+// HConcat will place the given matrix to the right of this matrix in a dense matrix, returning a
+// DimensionMismatchError if they do not have the same number of rows.
+func (m1 Diagonal[T]) HConcat(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	return dense[T](m1).HConcat(m2)
+}
+
+// VConcat will place the given matrix below this matrix in a dense matrix, returning a DimensionMismatchError
+// if they do not have the same number of columns.
+func (m1 Diagonal[T]) VConcat(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	return dense[T](m1).VConcat(m2)
+}
+
+// Stack will place the given matrices below this matrix in order in a dense matrix, returning a
+// DimensionMismatchError for the first that does not have the same number of columns.
+func (m1 Diagonal[T]) Stack(ms ...immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	return dense[T](m1).Stack(ms...)
+}
+
+// SplitRows will split this matrix into dense matrices of its rows before at and its rows from at onwards,
+// returning an InvalidDimensionsError if either part would be empty.
+func (m1 Diagonal[T]) SplitRows(at int) (immutabilitybenchmarking.Matrix[T], immutabilitybenchmarking.Matrix[T], error) {
+	return dense[T](m1).SplitRows(at)
+}
+
+// SplitCols will split this matrix into dense matrices of its columns before at and its columns from at
+// onwards, returning an InvalidDimensionsError if either part would be empty.
+func (m1 Diagonal[T]) SplitCols(at int) (immutabilitybenchmarking.Matrix[T], immutabilitybenchmarking.Matrix[T], error) {
+	return dense[T](m1).SplitCols(at)
+}
+
+// Pad will surround this matrix with the given number of rows and columns of zeros on each side. Padding
+// the top and left by the same amount, and the bottom and right by the same amount, keeps the diagonal on
+// the diagonal so the result is diagonal, otherwise it is dense. It returns a PaddingError if any of
+// them is negative.
+func (m1 Diagonal[T]) Pad(top int, bottom int, left int, right int) (immutabilitybenchmarking.Matrix[T], error) {
+	if top != left || bottom != right {
+		return dense[T](m1).Pad(top, bottom, left, right)
+	}
+
+	if err := shape.CheckPad(top, bottom, left, right); err != nil {
+		return Diagonal[T]{}, err
+	}
+
+	m := Diagonal[T]{values: make([]T, top+len(m1.values)+bottom)}
+	copy(m.values[top:], m1.values)
+
+	return m, nil
+}
+
+// Flip will reverse the order of the rows or the columns of this matrix in a dense matrix.
+func (m1 Diagonal[T]) Flip(axis immutabilitybenchmarking.Axis) immutabilitybenchmarking.Matrix[T] {
+	return dense[T](m1).Flip(axis)
+}
+
+// Rotate90 will turn this matrix a quarter turn clockwise in a dense matrix.
+func (m1 Diagonal[T]) Rotate90() immutabilitybenchmarking.Matrix[T] {
+	return dense[T](m1).Rotate90()
+}
@@ -0,0 +1,314 @@
+// Package structured provides immutable matrices whose structure lets them store only the elements that
+// can be non-zero, or only one of each pair of equal elements. Operations that preserve a structure return
+// a matrix with that structure, and the rest fall back to dense slice backed matrices.
+package structured
+
+import (
+	"math"
+
+	"github.com/chris-tomich/immutability-benchmarking"
+	"github.com/chris-tomich/immutability-benchmarking/internal/numeric"
+	sliceimmutable "github.com/chris-tomich/immutability-benchmarking/slice/immutable"
+)
+
+// validate checks the given values form a non-empty rectangular matrix.
+func validate[T immutabilitybenchmarking.Number](matrix [][]T) error {
+	if len(matrix) == 0 || len(matrix[0]) == 0 {
+		return &immutabilitybenchmarking.InvalidDimensionsError{Rows: len(matrix)}
+	}
+
+	for r := 1; r < len(matrix); r++ {
+		if len(matrix[r]) != len(matrix[0]) {
+			return &immutabilitybenchmarking.JaggedRowsError{Row: r, Width: len(matrix[r]), Expected: len(matrix[0])}
+		}
+	}
+
+	return nil
+}
+
+// validateSquare checks the given values form a non-empty square matrix.
+func validateSquare[T immutabilitybenchmarking.Number](matrix [][]T) error {
+	if err := validate(matrix); err != nil {
+		return err
+	}
+
+	if len(matrix) != len(matrix[0]) {
+		return &immutabilitybenchmarking.NotSquareError{Rows: len(matrix), Cols: len(matrix[0])}
+	}
+
+	return nil
+}
+
+// dense returns the elements of m as a slice backed immutable matrix.
+func dense[T immutabilitybenchmarking.Number](m immutabilitybenchmarking.Matrix[T]) sliceimmutable.Matrix[T] {
+	if d, ok := m.(sliceimmutable.Matrix[T]); ok {
+		return d
+	}
+
+	rows := make([][]T, m.Height())
+
+	for r := 0; r < m.Height(); r++ {
+		rows[r] = make([]T, m.Width())
+
+		for c := 0; c < m.Width(); c++ {
+			rows[r][c] = m.Get(r, c)
+		}
+	}
+
+	d, err := sliceimmutable.NewUnsafeNoCopy(rows)
+	if err != nil {
+		panic(err)
+	}
+
+	return d
+}
+
+// equals compares two matrices element by element.
+func equals[T immutabilitybenchmarking.Number](m1 immutabilitybenchmarking.Matrix[T], m2 immutabilitybenchmarking.Matrix[T]) bool {
+	if m1.Height() != m2.Height() || m1.Width() != m2.Width() {
+		return false
+	}
+
+	for r := 0; r < m1.Height(); r++ {
+		for c := 0; c < m1.Width(); c++ {
+			if m1.Get(r, c) != m2.Get(r, c) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// at returns the element of m at the provided coordinates or an OutOfBoundsError if they are outside it.
+func at[T immutabilitybenchmarking.Number](m immutabilitybenchmarking.Matrix[T], row int, col int) (T, error) {
+	if row < 0 || row >= m.Height() || col < 0 || col >= m.Width() {
+		var zero T
+		return zero, &immutabilitybenchmarking.OutOfBoundsError{Row: row, Col: col, Rows: m.Height(), Cols: m.Width()}
+	}
+
+	return m.Get(row, col), nil
+}
+
+// mustAt returns the element of m at the provided coordinates and panics if they are outside it.
+func mustAt[T immutabilitybenchmarking.Number](m immutabilitybenchmarking.Matrix[T], row int, col int) T {
+	v, err := at(m, row, col)
+	if err != nil {
+		panic(err)
+	}
+
+	return v
+}
+
+// mismatch returns the error for an operation given matrices with incompatible dimensions.
+func mismatch[T immutabilitybenchmarking.Number](op string, m1 immutabilitybenchmarking.Matrix[T], m2 immutabilitybenchmarking.Matrix[T]) error {
+	return &immutabilitybenchmarking.DimensionMismatchError{
+		Op:        op,
+		LeftRows:  m1.Height(),
+		LeftCols:  m1.Width(),
+		RightRows: m2.Height(),
+		RightCols: m2.Width(),
+	}
+}
+
+// multiply calculates the dense product of m1 and m2 where the only elements of row r of m1 that can be
+// non-zero are in the columns from span(r) up to but not including the second value span(r) returns.
+func multiply[T immutabilitybenchmarking.Number](m1 immutabilitybenchmarking.Matrix[T], m2 immutabilitybenchmarking.Matrix[T], span func(row int) (int, int)) sliceimmutable.Matrix[T] {
+	rows := make([][]T, m1.Height())
+
+	for r := 0; r < m1.Height(); r++ {
+		rows[r] = make([]T, m2.Width())
+		from, to := span(r)
+
+		for k := from; k < to; k++ {
+			v := m1.Get(r, k)
+
+			for c := 0; c < m2.Width(); c++ {
+				rows[r][c] += v * m2.Get(k, c)
+			}
+		}
+	}
+
+	d, err := sliceimmutable.NewUnsafeNoCopy(rows)
+	if err != nil {
+		panic(err)
+	}
+
+	return d
+}
+
+// equalValues compares the stored values of two matrices with the same structure.
+func equalValues[T immutabilitybenchmarking.Number](v1 []T, v2 []T) bool {
+	if len(v1) != len(v2) {
+		return false
+	}
+
+	for i := range v1 {
+		if v1[i] != v2[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// addValues adds the stored values of two matrices with the same structure.
+func addValues[T immutabilitybenchmarking.Number](v1 []T, v2 []T) []T {
+	values := make([]T, len(v1))
+
+	for i := range v1 {
+		values[i] = v1[i] + v2[i]
+	}
+
+	return values
+}
+
+// subtractValues subtracts the stored values of two matrices with the same structure.
+func subtractValues[T immutabilitybenchmarking.Number](v1 []T, v2 []T) []T {
+	values := make([]T, len(v1))
+
+	for i := range v1 {
+		values[i] = v1[i] - v2[i]
+	}
+
+	return values
+}
+
+// scaleValues multiplies the stored values of a matrix by s.
+func scaleValues[T immutabilitybenchmarking.Number](v []T, s T) []T {
+	values := make([]T, len(v))
+
+	for i := range v {
+		values[i] = v[i] * s
+	}
+
+	return values
+}
+
+// mapValues applies f to the stored values of a matrix.
+func mapValues[T immutabilitybenchmarking.Number](v []T, f func(v T) T) []T {
+	values := make([]T, len(v))
+
+	for i := range v {
+		values[i] = f(v[i])
+	}
+
+	return values
+}
+
+// zipValues combines the stored values of two matrices with the same structure using f.
+func zipValues[T immutabilitybenchmarking.Number](v1 []T, v2 []T, f func(a T, b T) T) []T {
+	values := make([]T, len(v1))
+
+	for i := range v1 {
+		values[i] = f(v1[i], v2[i])
+	}
+
+	return values
+}
+
+// fold combines every element of m, including the zeros a structure does not store, in row-major order.
+func fold[T immutabilitybenchmarking.Number](m immutabilitybenchmarking.Matrix[T], initial T, f func(acc T, v T) T) T {
+	acc := initial
+
+	for r := 0; r < m.Height(); r++ {
+		for c := 0; c < m.Width(); c++ {
+			acc = f(acc, m.Get(r, c))
+		}
+	}
+
+	return acc
+}
+
+// rowReduce folds each row of m into a single value, returning a dense column of the results.
+func rowReduce[T immutabilitybenchmarking.Number](m immutabilitybenchmarking.Matrix[T], initial T, f func(acc T, v T) T) sliceimmutable.Matrix[T] {
+	rows := make([][]T, m.Height())
+
+	for r := 0; r < m.Height(); r++ {
+		acc := initial
+
+		for c := 0; c < m.Width(); c++ {
+			acc = f(acc, m.Get(r, c))
+		}
+
+		rows[r] = []T{acc}
+	}
+
+	d, err := sliceimmutable.NewUnsafeNoCopy(rows)
+	if err != nil {
+		panic(err)
+	}
+
+	return d
+}
+
+// colReduce folds each column of m into a single value, returning a dense row of the results.
+func colReduce[T immutabilitybenchmarking.Number](m immutabilitybenchmarking.Matrix[T], initial T, f func(acc T, v T) T) sliceimmutable.Matrix[T] {
+	acc := make([]T, m.Width())
+
+	for c := range acc {
+		acc[c] = initial
+	}
+
+	for r := 0; r < m.Height(); r++ {
+		for c := 0; c < m.Width(); c++ {
+			acc[c] = f(acc[c], m.Get(r, c))
+		}
+	}
+
+	d, err := sliceimmutable.NewUnsafeNoCopy([][]T{acc})
+	if err != nil {
+		panic(err)
+	}
+
+	return d
+}
+
+// divisor returns a DivisionByZeroError for the first zero element of m in row-major order, or nil if it has
+// none.
+func divisor[T immutabilitybenchmarking.Number](m immutabilitybenchmarking.Matrix[T]) error {
+	for r := 0; r < m.Height(); r++ {
+		for c := 0; c < m.Width(); c++ {
+			if m.Get(r, c) == 0 {
+				return &immutabilitybenchmarking.DivisionByZeroError{Op: "ElementwiseDivide", Row: r, Col: c}
+			}
+		}
+	}
+
+	return nil
+}
+
+// trace adds together the elements on the diagonal of a square matrix.
+func trace[T immutabilitybenchmarking.Number](m immutabilitybenchmarking.Matrix[T]) T {
+	var sum T
+
+	for i := 0; i < m.Height(); i++ {
+		sum += m.Get(i, i)
+	}
+
+	return sum
+}
+
+// sumValues adds together the stored values of a matrix whose structure stores each element at most once.
+func sumValues[T immutabilitybenchmarking.Number](v []T) T {
+	var sum T
+
+	for i := range v {
+		sum += v[i]
+	}
+
+	return sum
+}
+
+// frobenius returns the Frobenius norm of a matrix whose structure stores each element at most once.
+func frobenius[T immutabilitybenchmarking.Number](v []T) float64 {
+	abs := numeric.AbsFunc[T]()
+	var sum float64
+
+	for i := range v {
+		a := abs(v[i])
+		sum += a * a
+	}
+
+	return math.Sqrt(sum)
+}
@@ -0,0 +1,456 @@
+package structured
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/chris-tomich/immutability-benchmarking"
+	sliceimmutable "github.com/chris-tomich/immutability-benchmarking/slice/immutable"
+)
+
+// structure builds a random matrix with a structure along with the same values as dense rows.
+type structure struct {
+	name  string
+	build func(r *rand.Rand, rows int, cols int) (immutabilitybenchmarking.Matrix[int], bool)
+}
+
+// randomRows returns rows x cols random values where keep reports which elements may be non-zero.
+func randomRows(r *rand.Rand, rows int, cols int, keep func(row int, col int) bool) [][]int {
+	values := make([][]int, rows)
+
+	for i := range values {
+		values[i] = make([]int, cols)
+
+		for j := range values[i] {
+			if keep(i, j) {
+				values[i][j] = r.Intn(19) - 9
+			}
+		}
+	}
+
+	return values
+}
+
+func mustMatrix[M immutabilitybenchmarking.Matrix[int]](m M, err error) (immutabilitybenchmarking.Matrix[int], bool) {
+	if err != nil {
+		panic(err)
+	}
+
+	return m, true
+}
+
+var structures = []structure{
+	{name: "Dense", build: func(r *rand.Rand, rows int, cols int) (immutabilitybenchmarking.Matrix[int], bool) {
+		return mustMatrix(sliceimmutable.New(randomRows(r, rows, cols, func(int, int) bool { return true })))
+	}},
+	{name: "Diagonal", build: func(r *rand.Rand, rows int, cols int) (immutabilitybenchmarking.Matrix[int], bool) {
+		if rows != cols {
+			return nil, false
+		}
+
+		values := make([]int, rows)
+		for i := range values {
+			values[i] = r.Intn(19) - 9
+		}
+
+		return mustMatrix(NewDiagonal(values))
+	}},
+	{name: "UpperTriangular", build: func(r *rand.Rand, rows int, cols int) (immutabilitybenchmarking.Matrix[int], bool) {
+		if rows != cols {
+			return nil, false
+		}
+
+		return mustMatrix(NewUpperTriangular(randomRows(r, rows, cols, func(row int, col int) bool { return col >= row })))
+	}},
+	{name: "LowerTriangular", build: func(r *rand.Rand, rows int, cols int) (immutabilitybenchmarking.Matrix[int], bool) {
+		if rows != cols {
+			return nil, false
+		}
+
+		return mustMatrix(NewLowerTriangular(randomRows(r, rows, cols, func(row int, col int) bool { return col <= row })))
+	}},
+	{name: "Banded", build: func(r *rand.Rand, rows int, cols int) (immutabilitybenchmarking.Matrix[int], bool) {
+		lower, upper := r.Intn(3), r.Intn(3)
+
+		return mustMatrix(NewBanded(randomRows(r, rows, cols, func(row int, col int) bool {
+			return col >= row-lower && col <= row+upper
+		}), lower, upper))
+	}},
+	{name: "Symmetric", build: func(r *rand.Rand, rows int, cols int) (immutabilitybenchmarking.Matrix[int], bool) {
+		if rows != cols {
+			return nil, false
+		}
+
+		values := randomRows(r, rows, cols, func(row int, col int) bool { return col >= row })
+		for i := range values {
+			for j := 0; j < i; j++ {
+				values[i][j] = values[j][i]
+			}
+		}
+
+		return mustMatrix(NewSymmetric(values))
+	}},
+}
+
+// reference copies a matrix into the dense backend the structured types fall back to.
+func reference(m immutabilitybenchmarking.Matrix[int]) sliceimmutable.Matrix[int] {
+	return dense(m)
+}
+
+// reductions describes the results of every reduction of m.
+func reductions(m immutabilitybenchmarking.Matrix[int]) string {
+	trace, err := m.Trace()
+	minRow, minCol := m.ArgMin()
+	maxRow, maxCol := m.ArgMax()
+
+	return fmt.Sprintf("trace %v %v, sum %v, min %v at %d,%d, max %v at %d,%d, norms %v %v %v, rank %d",
+		trace, err, m.Sum(), m.Min(), minRow, minCol, m.Max(), maxRow, maxCol,
+		m.FrobeniusNorm(), m.OneNorm(), m.InfNorm(), m.Rank())
+}
+
+// rearrangements describes the results of every reshaping operation on m, using other as the second operand.
+func rearrangements(m immutabilitybenchmarking.Matrix[int], other immutabilitybenchmarking.Matrix[int]) string {
+	var b strings.Builder
+
+	describe := func(op string, result immutabilitybenchmarking.Matrix[int], err error) {
+		if err != nil {
+			fmt.Fprintf(&b, "%s: %v\n", op, err)
+			return
+		}
+
+		fmt.Fprintf(&b, "%s: %v\n", op, reference(result))
+	}
+
+	reshaped, err := m.Reshape(1, m.Height()*m.Width())
+	describe("Reshape", reshaped, err)
+
+	hconcat, err := m.HConcat(other)
+	describe("HConcat", hconcat, err)
+
+	vconcat, err := m.VConcat(other)
+	describe("VConcat", vconcat, err)
+
+	stacked, err := m.Stack(other, m)
+	describe("Stack", stacked, err)
+
+	top, bottom, err := m.SplitRows(1)
+	describe("SplitRows top", top, err)
+	describe("SplitRows bottom", bottom, err)
+
+	left, right, err := m.SplitCols(m.Width() / 2)
+	describe("SplitCols left", left, err)
+	describe("SplitCols right", right, err)
+
+	padded, err := m.Pad(1, 2, 0, 1)
+	describe("Pad", padded, err)
+
+	square, err := m.Pad(1, 1, 1, 1)
+	describe("Pad square", square, err)
+
+	describe("Flip rows", m.Flip(immutabilitybenchmarking.RowAxis), nil)
+	describe("Flip cols", m.Flip(immutabilitybenchmarking.ColAxis), nil)
+	describe("Rotate90", m.Rotate90(), nil)
+
+	return b.String()
+}
+
+func TestOperationsMatchDense(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	for _, s1 := range structures {
+		for _, s2 := range structures {
+			t.Run(fmt.Sprintf("%s/%s", s1.name, s2.name), func(t *testing.T) {
+				for i := 0; i < 50; i++ {
+					n, k, p := r.Intn(5)+1, r.Intn(5)+1, r.Intn(5)+1
+
+					a, ok := s1.build(r, n, n)
+					if !ok {
+						t.Fatalf("%s could not be built square", s1.name)
+					}
+
+					b, _ := s2.build(r, n, n)
+
+					expectedSum, _ := reference(a).Add(b)
+					sum, err := a.Add(b)
+					if err != nil || !reference(sum).Equals(expectedSum) {
+						t.Fatalf("Add of %v and %v gave %v, %v", reference(a), reference(b), sum, err)
+					}
+
+					expectedDifference, _ := reference(a).Subtract(b)
+					difference, err := a.Subtract(b)
+					if err != nil || !reference(difference).Equals(expectedDifference) {
+						t.Fatalf("Subtract of %v and %v gave %v, %v", reference(a), reference(b), difference, err)
+					}
+
+					expectedProduct, _ := reference(a).MatrixMultiply(b)
+					product, err := a.MatrixMultiply(b)
+					if err != nil || !reference(product).Equals(expectedProduct) {
+						t.Fatalf("MatrixMultiply of %v and %v gave %v, %v", reference(a), reference(b), product, err)
+					}
+
+					expectedZip, _ := reference(a).ZipWith(b, func(x int, y int) int { return x*y - y })
+					zip, err := a.ZipWith(b, func(x int, y int) int { return x*y - y })
+					if err != nil || !reference(zip).Equals(expectedZip) {
+						t.Fatalf("ZipWith of %v and %v gave %v, %v", reference(a), reference(b), zip, err)
+					}
+
+					expectedZipZeros, _ := reference(a).ZipWith(b, func(x int, y int) int { return x - y + 1 })
+					zipZeros, err := a.ZipWith(b, func(x int, y int) int { return x - y + 1 })
+					if err != nil || !reference(zipZeros).Equals(expectedZipZeros) {
+						t.Fatalf("ZipWith of %v and %v gave %v, %v", reference(a), reference(b), zipZeros, err)
+					}
+
+					expectedHadamard, _ := reference(a).HadamardProduct(b)
+					hadamard, err := a.HadamardProduct(b)
+					if err != nil || !reference(hadamard).Equals(expectedHadamard) {
+						t.Fatalf("HadamardProduct of %v and %v gave %v, %v", reference(a), reference(b), hadamard, err)
+					}
+
+					// Dividing by b itself checks the zeros are reported at the same place as the dense result.
+					for _, divisor := range []immutabilitybenchmarking.Matrix[int]{b, b.Map(func(v int) int { return v*v + 1 })} {
+						expectedQuotient, expectedErr := reference(a).ElementwiseDivide(divisor)
+						quotient, err := a.ElementwiseDivide(divisor)
+						if fmt.Sprint(err) != fmt.Sprint(expectedErr) || (err == nil && !reference(quotient).Equals(expectedQuotient)) {
+							t.Fatalf("ElementwiseDivide of %v and %v gave %v, %v", reference(a), reference(divisor), quotient, err)
+						}
+					}
+
+					expectedKronecker, _ := reference(a).KroneckerProduct(b)
+					kronecker, err := a.KroneckerProduct(b)
+					if err != nil || !reference(kronecker).Equals(expectedKronecker) {
+						t.Fatalf("KroneckerProduct of %v and %v gave %v, %v", reference(a), reference(b), kronecker, err)
+					}
+
+					expectedPower, _ := reference(a).Power(i % 7)
+					power, err := a.Power(i % 7)
+					if err != nil || !reference(power).Equals(expectedPower) {
+						t.Fatalf("Power %d of %v gave %v, %v", i%7, reference(a), power, err)
+					}
+
+					if rearrangements(a, b) != rearrangements(reference(a), reference(b)) {
+						t.Fatalf("rearrangements of %v and %v gave\n%s\nbut expected\n%s", reference(a), reference(b),
+							rearrangements(a, b), rearrangements(reference(a), reference(b)))
+					}
+
+					if a.Equals(b) != reference(a).Equals(reference(b)) {
+						t.Fatalf("Equals of %v and %v disagrees with the dense result", reference(a), reference(b))
+					}
+
+					// Rectangular operands are only possible for the dense and banded structures.
+					if c, ok := s1.build(r, k, p); ok {
+						d, _ := structures[0].build(r, p, n)
+
+						expected, _ := reference(c).MatrixMultiply(d)
+						product, err := c.MatrixMultiply(d)
+						if err != nil || !reference(product).Equals(expected) {
+							t.Fatalf("MatrixMultiply of %v and %v gave %v, %v", reference(c), reference(d), product, err)
+						}
+
+						if !reference(c.Transpose()).Equals(reference(c).Transpose()) {
+							t.Fatalf("Transpose of %v gave %v", reference(c), reference(c.Transpose()))
+						}
+
+						if !reference(c.ScalarMultiply(-3)).Equals(reference(c).ScalarMultiply(-3)) {
+							t.Fatalf("ScalarMultiply of %v gave %v", reference(c), reference(c.ScalarMultiply(-3)))
+						}
+
+						for _, f := range []func(v int) int{func(v int) int { return v * v }, func(v int) int { return v - 1 }} {
+							if !reference(c.Map(f)).Equals(reference(c).Map(f)) {
+								t.Fatalf("Map of %v gave %v", reference(c), reference(c.Map(f)))
+							}
+						}
+
+						indexed := func(row int, col int, v int) int { return v + row - col }
+						if !reference(c.MapIndexed(indexed)).Equals(reference(c).MapIndexed(indexed)) {
+							t.Fatalf("MapIndexed of %v gave %v", reference(c), reference(c.MapIndexed(indexed)))
+						}
+
+						expectedPower, expectedErr := reference(c).Power(2)
+						power, err := c.Power(2)
+						if fmt.Sprint(err) != fmt.Sprint(expectedErr) || (err == nil && !reference(power).Equals(expectedPower)) {
+							t.Fatalf("Power 2 of %v gave %v, %v", reference(c), power, err)
+						}
+
+						if reductions(c) != reductions(reference(c)) {
+							t.Fatalf("reductions of %v gave %s but expected %s", reference(c), reductions(c), reductions(reference(c)))
+						}
+
+						horner := func(acc int, v int) int { return acc*3 + v }
+						if c.Fold(1, horner) != reference(c).Fold(1, horner) {
+							t.Fatalf("Fold of %v gave %v", reference(c), c.Fold(1, horner))
+						}
+
+						if !reference(c.RowReduce(1, horner)).Equals(reference(c).RowReduce(1, horner)) {
+							t.Fatalf("RowReduce of %v gave %v", reference(c), reference(c.RowReduce(1, horner)))
+						}
+
+						if !reference(c.ColReduce(1, horner)).Equals(reference(c).ColReduce(1, horner)) {
+							t.Fatalf("ColReduce of %v gave %v", reference(c), reference(c.ColReduce(1, horner)))
+						}
+
+						if rearrangements(c, d) != rearrangements(reference(c), reference(d)) {
+							t.Fatalf("rearrangements of %v and %v gave\n%s\nbut expected\n%s", reference(c), reference(d),
+								rearrangements(c, d), rearrangements(reference(c), reference(d)))
+						}
+					}
+				}
+			})
+		}
+	}
+}
+
+func TestStructureIsPreserved(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+
+	for _, s := range structures[1:] {
+		t.Run(s.name, func(t *testing.T) {
+			a, _ := s.build(r, 4, 4)
+			b, _ := s.build(r, 4, 4)
+
+			sum, _ := a.Add(b)
+			difference, _ := a.Subtract(b)
+
+			results := map[string]immutabilitybenchmarking.Matrix[int]{
+				"Add":            sum,
+				"Subtract":       difference,
+				"ScalarMultiply": a.ScalarMultiply(2),
+				"Map":            a.Map(func(v int) int { return -v }),
+			}
+
+			zip, _ := a.ZipWith(b, func(x int, y int) int { return x * y })
+			results["ZipWith"] = zip
+
+			if s.name != "Symmetric" {
+				product, _ := a.MatrixMultiply(b)
+				results["MatrixMultiply"] = product
+			}
+
+			hadamard, _ := a.HadamardProduct(b)
+			results["HadamardProduct"] = hadamard
+
+			quotient, _ := a.ElementwiseDivide(b.Map(func(v int) int { return v*v + 1 }))
+			results["ElementwiseDivide"] = quotient
+
+			power, _ := a.Power(3)
+			results["Power"] = power
+
+			if s.name != "Banded" {
+				kronecker, _ := a.KroneckerProduct(b)
+				results["KroneckerProduct"] = kronecker
+			}
+
+			padded, _ := a.Pad(1, 2, 1, 2)
+			results["Pad"] = padded
+
+			for op, m := range results {
+				if fmt.Sprintf("%T", m) != fmt.Sprintf("%T", a) {
+					t.Errorf("expected %s to return a %T but got %T", op, a, m)
+				}
+			}
+		})
+	}
+}
+
+func TestTransposeSharesTriangularValues(t *testing.T) {
+	u, err := NewUpperTriangular([][]int{{1, 2}, {0, 3}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	l, ok := u.Transpose().(LowerTriangular[int])
+	if !ok || &l.values[0] != &u.values[0] {
+		t.Errorf("expected the transpose to be a LowerTriangular sharing the values")
+	}
+
+	if l.Get(1, 0) != 2 || l.Get(0, 1) != 0 {
+		t.Errorf("unexpected transpose %v", reference(l))
+	}
+
+	if _, ok := l.Transpose().(UpperTriangular[int]); !ok {
+		t.Errorf("expected the transpose of a LowerTriangular to be an UpperTriangular")
+	}
+}
+
+func TestBandedProductBandwidths(t *testing.T) {
+	a, _ := NewBanded([][]int{{1, 2, 0, 0}, {3, 4, 5, 0}, {0, 6, 7, 8}, {0, 0, 9, 1}}, 1, 1)
+
+	product, err := a.MatrixMultiply(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	lower, upper := product.(Banded[int]).Bandwidths()
+	if lower != 2 || upper != 2 {
+		t.Errorf("expected bandwidths of 2 and 2 but got %d and %d", lower, upper)
+	}
+
+	wide, _ := NewBanded([][]int{{1, 2}, {3, 4}}, 5, 5)
+	if lower, upper := wide.Bandwidths(); lower != 1 || upper != 1 {
+		t.Errorf("expected the bandwidths to be narrowed to 1 and 1 but got %d and %d", lower, upper)
+	}
+}
+
+func TestConstructorErrors(t *testing.T) {
+	var structureErr *immutabilitybenchmarking.StructureError
+
+	if _, err := NewUpperTriangular([][]int{{1, 2}, {3, 4}}); !errors.As(err, &structureErr) || *structureErr != (immutabilitybenchmarking.StructureError{Structure: "upper triangular", Row: 1, Col: 0}) {
+		t.Errorf("expected a StructureError at 1,0 but got %v", err)
+	}
+
+	if _, err := NewLowerTriangular([][]int{{1, 2}, {0, 4}}); !errors.As(err, &structureErr) || structureErr.Row != 0 || structureErr.Col != 1 {
+		t.Errorf("expected a StructureError at 0,1 but got %v", err)
+	}
+
+	if _, err := NewSymmetric([][]int{{1, 2}, {3, 4}}); !errors.As(err, &structureErr) || structureErr.Row != 1 || structureErr.Col != 0 {
+		t.Errorf("expected a StructureError at 1,0 but got %v", err)
+	}
+
+	if _, err := NewBanded([][]int{{1, 0, 5}, {0, 1, 0}}, 0, 1); !errors.As(err, &structureErr) || structureErr.Row != 0 || structureErr.Col != 2 {
+		t.Errorf("expected a StructureError at 0,2 but got %v", err)
+	}
+
+	var notSquare *immutabilitybenchmarking.NotSquareError
+	if _, err := NewSymmetric([][]int{{1, 2, 3}, {2, 1, 0}}); !errors.As(err, &notSquare) {
+		t.Errorf("expected a NotSquareError but got %v", err)
+	}
+
+	var invalid *immutabilitybenchmarking.InvalidDimensionsError
+	if _, err := NewDiagonal([]int{}); !errors.As(err, &invalid) {
+		t.Errorf("expected an InvalidDimensionsError but got %v", err)
+	}
+
+	var jagged *immutabilitybenchmarking.JaggedRowsError
+	if _, err := NewBanded([][]int{{1, 2}, {3}}, 1, 1); !errors.As(err, &jagged) {
+		t.Errorf("expected a JaggedRowsError but got %v", err)
+	}
+}
+
+func TestAtOutOfBounds(t *testing.T) {
+	d, _ := NewDiagonal([]int{1, 2})
+
+	var bounds *immutabilitybenchmarking.OutOfBoundsError
+	if _, err := d.At(2, 0); !errors.As(err, &bounds) {
+		t.Errorf("expected an OutOfBoundsError but got %v", err)
+	}
+
+	if v := d.MustAt(1, 1); v != 2 {
+		t.Errorf("expected 2 but got %d", v)
+	}
+}
+
+func TestDimensionMismatch(t *testing.T) {
+	d, _ := NewDiagonal([]int{1, 2})
+	s, _ := NewSymmetric([][]int{{1, 2, 3}, {2, 1, 0}, {3, 0, 1}})
+
+	var mismatch *immutabilitybenchmarking.DimensionMismatchError
+	if _, err := d.Add(s); !errors.As(err, &mismatch) {
+		t.Errorf("expected a DimensionMismatchError but got %v", err)
+	}
+
+	if _, err := s.MatrixMultiply(d); !errors.As(err, &mismatch) {
+		t.Errorf("expected a DimensionMismatchError but got %v", err)
+	}
+}
@@ -0,0 +1,372 @@
+package structured
+
+import (
+	"github.com/chris-tomich/immutability-benchmarking"
+	"github.com/chris-tomich/immutability-benchmarking/internal/numeric"
+	"github.com/chris-tomich/immutability-benchmarking/internal/power"
+	"github.com/chris-tomich/immutability-benchmarking/internal/shape"
+)
+
+// Symmetric is an immutable square matrix that is equal to its transpose. Only the elements on and above the
+// diagonal are stored, in the same layout as an UpperTriangular.
+type Symmetric[T immutabilitybenchmarking.Number] struct {
+	n      int
+	values []T
+}
+
+// NewSymmetric creates a symmetric matrix from a copy of the given square values, returning a StructureError
+// for the first value below the diagonal that differs from its mirror above it.
+func NewSymmetric[T immutabilitybenchmarking.Number](matrix [][]T) (Symmetric[T], error) {
+	if err := validateSquare(matrix); err != nil {
+		return Symmetric[T]{}, err
+	}
+
+	n := len(matrix)
+	m := Symmetric[T]{n: n, values: make([]T, n*(n+1)/2)}
+
+	for r := 0; r < n; r++ {
+		for c := 0; c < n; c++ {
+			switch {
+			case c >= r:
+				m.values[packedIndex(n, r, c)] = matrix[r][c]
+			case matrix[r][c] != matrix[c][r]:
+				return Symmetric[T]{}, &immutabilitybenchmarking.StructureError{Structure: "symmetric", Row: r, Col: c}
+			}
+		}
+	}
+
+	return m, nil
+}
+
+// Width returns the number of columns in the matrix.
+func (m1 Symmetric[T]) Width() int {
+	return m1.n
+}
+
+// Height returns the number of rows in the matrix.
+func (m1 Symmetric[T]) Height() int {
+	return m1.n
+}
+
+// Get returns the element at the provided coordinates.
+func (m1 Symmetric[T]) Get(row int, col int) T {
+	if row > col {
+		row, col = col, row
+	}
+
+	return m1.values[packedIndex(m1.n, row, col)]
+}
+
+// At returns the element at the provided coordinates or an OutOfBoundsError if they are outside the matrix.
+func (m1 Symmetric[T]) At(row int, col int) (T, error) {
+	return at[T](m1, row, col)
+}
+
+// MustAt returns the element at the provided coordinates and panics with an OutOfBoundsError if they are
+// outside the matrix.
+func (m1 Symmetric[T]) MustAt(row int, col int) T {
+	return mustAt[T](m1, row, col)
+}
+
+// Equals will compare a matrix against this matrix and return if they are equal.
+func (m1 Symmetric[T]) Equals(m2 immutabilitybenchmarking.Matrix[T]) bool {
+	if s, ok := m2.(Symmetric[T]); ok {
+		return m1.n == s.n && equalValues(m1.values, s.values)
+	}
+
+	return equals[T](m1, m2)
+}
+
+// Add will add the values of a matrix to this matrix. The sum of two symmetric matrices is symmetric.
+func (m1 Symmetric[T]) Add(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Height() != m2.Height() || m1.Width() != m2.Width() {
+		return Symmetric[T]{}, mismatch[T]("Add", m1, m2)
+	}
+
+	if s, ok := m2.(Symmetric[T]); ok {
+		return Symmetric[T]{n: m1.n, values: addValues(m1.values, s.values)}, nil
+	}
+
+	return dense[T](m1).Add(m2)
+}
+
+// Subtract will subtract the values of a matrix from this matrix. The difference of two symmetric matrices
+// is symmetric.
+func (m1 Symmetric[T]) Subtract(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Height() != m2.Height() || m1.Width() != m2.Width() {
+		return Symmetric[T]{}, mismatch[T]("Subtract", m1, m2)
+	}
+
+	if s, ok := m2.(Symmetric[T]); ok {
+		return Symmetric[T]{n: m1.n, values: subtractValues(m1.values, s.values)}, nil
+	}
+
+	return dense[T](m1).Subtract(m2)
+}
+
+// ScalarMultiply will multiply this matrix by a given scalar value.
+func (m1 Symmetric[T]) ScalarMultiply(s T) immutabilitybenchmarking.Matrix[T] {
+	return Symmetric[T]{n: m1.n, values: scaleValues(m1.values, s)}
+}
+
+// Transpose will transpose this matrix. A symmetric matrix is its own transpose.
+func (m1 Symmetric[T]) Transpose() immutabilitybenchmarking.Matrix[T] {
+	return m1
+}
+
+// MatrixMultiply will multiple the given matrix against this matrix. Products of symmetric matrices are not
+// symmetric in general, so the product is dense.
+func (m1 Symmetric[T]) MatrixMultiply(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Width() != m2.Height() {
+		return Symmetric[T]{}, mismatch[T]("MatrixMultiply", m1, m2)
+	}
+
+	return multiply[T](m1, m2, func(int) (int, int) { return 0, m1.n }), nil
+}
+
+// Map will apply f to every element of this matrix. Mirrored elements stay equal, so the result is symmetric.
+func (m1 Symmetric[T]) Map(f func(v T) T) immutabilitybenchmarking.Matrix[T] {
+	return Symmetric[T]{n: m1.n, values: mapValues(m1.values, f)}
+}
+
+// MapIndexed will apply f to every element of this matrix along with its coordinates. As f can depend on
+// where an element is, the result is dense.
+func (m1 Symmetric[T]) MapIndexed(f func(row int, col int, v T) T) immutabilitybenchmarking.Matrix[T] {
+	return dense[T](m1).MapIndexed(f)
+}
+
+// ZipWith will combine each element of this matrix with the element at the same coordinates of the given
+// matrix using f. Combining two symmetric matrices gives a
+// symmetric matrix.
+func (m1 Symmetric[T]) ZipWith(m2 immutabilitybenchmarking.Matrix[T], f func(a T, b T) T) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Height() != m2.Height() || m1.Width() != m2.Width() {
+		return Symmetric[T]{}, mismatch[T]("ZipWith", m1, m2)
+	}
+
+	if o, ok := m2.(Symmetric[T]); ok {
+		return Symmetric[T]{n: m1.n, values: zipValues(m1.values, o.values, f)}, nil
+	}
+
+	return dense[T](m1).ZipWith(m2, f)
+}
+
+// Fold will combine every element of this matrix into a single value, starting from initial and visiting
+// the elements in row-major order.
+func (m1 Symmetric[T]) Fold(initial T, f func(acc T, v T) T) T {
+	return fold[T](m1, initial, f)
+}
+
+// RowReduce will fold each row of this matrix into a single value starting from initial, returning a
+// column of the results.
+func (m1 Symmetric[T]) RowReduce(initial T, f func(acc T, v T) T) immutabilitybenchmarking.Matrix[T] {
+	return rowReduce[T](m1, initial, f)
+}
+
+// ColReduce will fold each column of this matrix into a single value starting from initial, returning a
+// row of the results.
+func (m1 Symmetric[T]) ColReduce(initial T, f func(acc T, v T) T) immutabilitybenchmarking.Matrix[T] {
+	return colReduce[T](m1, initial, f)
+}
+
+// HadamardProduct will multiply each element of this matrix by the element at the same coordinates of the
+// given matrix. The product of two symmetric matrices is symmetric.
+func (m1 Symmetric[T]) HadamardProduct(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Height() != m2.Height() || m1.Width() != m2.Width() {
+		return Symmetric[T]{}, mismatch[T]("HadamardProduct", m1, m2)
+	}
+
+	if s, ok := m2.(Symmetric[T]); ok {
+		return Symmetric[T]{n: m1.n, values: zipValues(m1.values, s.values, func(x T, y T) T { return x * y })}, nil
+	}
+
+	return dense[T](m1).HadamardProduct(m2)
+}
+
+// ElementwiseDivide will divide each element of this matrix by the element at the same coordinates of the
+// given matrix, returning a DivisionByZeroError if any of them is zero. The quotient of two symmetric
+// matrices is symmetric.
+func (m1 Symmetric[T]) ElementwiseDivide(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Height() != m2.Height() || m1.Width() != m2.Width() {
+		return Symmetric[T]{}, mismatch[T]("ElementwiseDivide", m1, m2)
+	}
+
+	s, ok := m2.(Symmetric[T])
+	if !ok {
+		return dense[T](m1).ElementwiseDivide(m2)
+	}
+
+	if err := divisor[T](s); err != nil {
+		return Symmetric[T]{}, err
+	}
+
+	return Symmetric[T]{n: m1.n, values: zipValues(m1.values, s.values, func(x T, y T) T { return x / y })}, nil
+}
+
+// KroneckerProduct will multiply every element of this matrix by the whole of the given matrix. The
+// transpose of a Kronecker product is the product of the transposes, so the product of two symmetric
+// matrices is symmetric.
+func (m1 Symmetric[T]) KroneckerProduct(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	s, ok := m2.(Symmetric[T])
+	if !ok {
+		return dense[T](m1).KroneckerProduct(m2)
+	}
+
+	n := m1.n * s.n
+	m := Symmetric[T]{n: n, values: make([]T, n*(n+1)/2)}
+
+	for r := 0; r < n; r++ {
+		for c := r; c < n; c++ {
+			m.values[packedIndex(n, r, c)] = m1.Get(r/s.n, c/s.n) * s.Get(r%s.n, c%s.n)
+		}
+	}
+
+	return m, nil
+}
+
+// Trace will sum the elements on the diagonal of this matrix. Symmetric matrices are always square.
+func (m1 Symmetric[T]) Trace() (T, error) {
+	return trace[T](m1), nil
+}
+
+// Sum will add together every element of this matrix.
+func (m1 Symmetric[T]) Sum() T {
+	return numeric.Sum(m1.n, numeric.RowsOf[T](m1))
+}
+
+// Min will return the smallest element of this matrix. Complex elements are ordered by their real parts and
+// then by their imaginary parts.
+func (m1 Symmetric[T]) Min() T {
+	return m1.Get(m1.ArgMin())
+}
+
+// Max will return the largest element of this matrix. Complex elements are ordered by their real parts and
+// then by their imaginary parts.
+func (m1 Symmetric[T]) Max() T {
+	return m1.Get(m1.ArgMax())
+}
+
+// ArgMin will return the coordinates of the first smallest element of this matrix in row-major order.
+func (m1 Symmetric[T]) ArgMin() (int, int) {
+	return numeric.ArgMin(m1.Height(), numeric.RowsOf[T](m1))
+}
+
+// ArgMax will return the coordinates of the first largest element of this matrix in row-major order.
+func (m1 Symmetric[T]) ArgMax() (int, int) {
+	return numeric.ArgMax(m1.Height(), numeric.RowsOf[T](m1))
+}
+
+// FrobeniusNorm will return the square root of the sum of the squared absolute values of the elements of
+// this matrix.
+func (m1 Symmetric[T]) FrobeniusNorm() float64 {
+	return numeric.FrobeniusNorm(m1.n, numeric.RowsOf[T](m1))
+}
+
+// OneNorm will return the largest sum of the absolute values in a column of this matrix.
+func (m1 Symmetric[T]) OneNorm() float64 {
+	return numeric.OneNorm(m1.Height(), m1.Width(), numeric.RowsOf[T](m1))
+}
+
+// InfNorm will return the largest sum of the absolute values in a row of this matrix.
+func (m1 Symmetric[T]) InfNorm() float64 {
+	return numeric.InfNorm(m1.Height(), numeric.RowsOf[T](m1))
+}
+
+// Rank will return the number of linearly independent rows of this matrix, treating values within rounding
+// error of zero as zero.
+func (m1 Symmetric[T]) Rank() int {
+	return numeric.Rank(m1.Height(), m1.Width(), numeric.RowsOf[T](m1))
+}
+
+// Power will raise this matrix to the power n by repeated squaring, returning a NegativeExponentError if n is
+// negative. The products along the way are dense, but every power of a symmetric matrix is symmetric, so the
+// result is packed back into one.
+func (m1 Symmetric[T]) Power(n int) (immutabilitybenchmarking.Matrix[T], error) {
+	if n < 0 {
+		return Symmetric[T]{}, &immutabilitybenchmarking.NegativeExponentError{Exponent: n}
+	}
+
+	p, err := power.BySquaring[immutabilitybenchmarking.Matrix[T]](m1, n, func() (immutabilitybenchmarking.Matrix[T], error) {
+		return Symmetric[T]{n: m1.n, values: packedIdentity[T](m1.n)}, nil
+	}, nil)
+	if err != nil {
+		return Symmetric[T]{}, err
+	}
+
+	if s, ok := p.(Symmetric[T]); ok {
+		return s, nil
+	}
+
+	m := Symmetric[T]{n: m1.n, values: make([]T, len(m1.values))}
+
+	for r := 0; r < m1.n; r++ {
+		for c := r; c < m1.n; c++ {
+			m.values[packedIndex(m1.n, r, c)] = p.Get(r, c)
+		}
+	}
+
+	return m, nil
+}
+
+// Reshape will read the elements of this matrix in row-major order into a dense matrix with the given
+// dimensions, returning a ReshapeError if it would not hold the same number of elements.
+func (m1 Symmetric[T]) Reshape(rows int, cols int) (immutabilitybenchmarking.Matrix[T], error) {
+	return dense[T](m1).Reshape(rows, cols)
+}
+
+// HConcat will place the given matrix to the right of this matrix in a dense matrix, returning a
+// DimensionMismatchError if they do not have the same number of rows.
+func (m1 Symmetric[T]) HConcat(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	return dense[T](m1).HConcat(m2)
+}
+
+// VConcat will place the given matrix below this matrix in a dense matrix, returning a DimensionMismatchError
+// if they do not have the same number of columns.
+func (m1 Symmetric[T]) VConcat(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	return dense[T](m1).VConcat(m2)
+}
+
+// Stack will place the given matrices below this matrix in order in a dense matrix, returning a
+// DimensionMismatchError for the first that does not have the same number of columns.
+func (m1 Symmetric[T]) Stack(ms ...immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	return dense[T](m1).Stack(ms...)
+}
+
+// SplitRows will split this matrix into dense matrices of its rows before at and its rows from at onwards,
+// returning an InvalidDimensionsError if either part would be empty.
+func (m1 Symmetric[T]) SplitRows(at int) (immutabilitybenchmarking.Matrix[T], immutabilitybenchmarking.Matrix[T], error) {
+	return dense[T](m1).SplitRows(at)
+}
+
+// SplitCols will split this matrix into dense matrices of its columns before at and its columns from at
+// onwards, returning an InvalidDimensionsError if either part would be empty.
+func (m1 Symmetric[T]) SplitCols(at int) (immutabilitybenchmarking.Matrix[T], immutabilitybenchmarking.Matrix[T], error) {
+	return dense[T](m1).SplitCols(at)
+}
+
+// Pad will surround this matrix with the given number of rows and columns of zeros on each side. Padding
+// the top and left by the same amount, and the bottom and right by the same amount, keeps each pair of
+// mirrored elements mirrored so the result is symmetric, otherwise it is dense. It returns a PaddingError
+// if any of them is negative.
+func (m1 Symmetric[T]) Pad(top int, bottom int, left int, right int) (immutabilitybenchmarking.Matrix[T], error) {
+	if top != left || bottom != right {
+		return dense[T](m1).Pad(top, bottom, left, right)
+	}
+
+	if err := shape.CheckPad(top, bottom, left, right); err != nil {
+		return Symmetric[T]{}, err
+	}
+
+	n := top + m1.n + bottom
+
+	return Symmetric[T]{n: n, values: padPacked(m1.n, m1.values, n, top)}, nil
+}
+
+// Flip will reverse the order of the rows or the columns of this matrix in a dense matrix.
+func (m1 Symmetric[T]) Flip(axis immutabilitybenchmarking.Axis) immutabilitybenchmarking.Matrix[T] {
+	return dense[T](m1).Flip(axis)
+}
+
+// Rotate90 will turn this matrix a quarter turn clockwise in a dense matrix.
+func (m1 Symmetric[T]) Rotate90() immutabilitybenchmarking.Matrix[T] {
+	return dense[T](m1).Rotate90()
+}
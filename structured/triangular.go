@@ -0,0 +1,788 @@
+package structured
+
+import (
+	"github.com/chris-tomich/immutability-benchmarking"
+	"github.com/chris-tomich/immutability-benchmarking/internal/numeric"
+	"github.com/chris-tomich/immutability-benchmarking/internal/power"
+	"github.com/chris-tomich/immutability-benchmarking/internal/shape"
+)
+
+// UpperTriangular is an immutable square matrix whose elements below the diagonal are zero. The rest are
+// stored a row at a time, each row starting at the diagonal.
+type UpperTriangular[T immutabilitybenchmarking.Number] struct {
+	n      int
+	values []T
+}
+
+// LowerTriangular is an immutable square matrix whose elements above the diagonal are zero. The rest are
+// stored a column at a time, each column starting at the diagonal, which is the same layout as the transpose
+// stored as an UpperTriangular so transposing either shares the values.
+type LowerTriangular[T immutabilitybenchmarking.Number] struct {
+	n      int
+	values []T
+}
+
+// packedIndex returns where the element at row, col of an n x n upper triangular matrix is stored, where
+// row must not be greater than col.
+func packedIndex(n int, row int, col int) int {
+	return row*n - row*(row-1)/2 + col - row
+}
+
+// packedIdentity returns the stored values of an n x n identity matrix in the packed layout.
+func packedIdentity[T immutabilitybenchmarking.Number](n int) []T {
+	values := make([]T, n*(n+1)/2)
+
+	for i := 0; i < n; i++ {
+		values[packedIndex(n, i, i)] = 1
+	}
+
+	return values
+}
+
+// padPacked returns the stored values of an n x n matrix in the packed layout moved down the diagonal by
+// offset within a size x size matrix.
+func padPacked[T immutabilitybenchmarking.Number](n int, values []T, size int, offset int) []T {
+	padded := make([]T, size*(size+1)/2)
+
+	for r := 0; r < n; r++ {
+		for c := r; c < n; c++ {
+			padded[packedIndex(size, offset+r, offset+c)] = values[packedIndex(n, r, c)]
+		}
+	}
+
+	return padded
+}
+
+// NewUpperTriangular creates an upper triangular matrix from a copy of the given square values, returning a
+// StructureError if any value below the diagonal is not zero.
+func NewUpperTriangular[T immutabilitybenchmarking.Number](matrix [][]T) (UpperTriangular[T], error) {
+	if err := validateSquare(matrix); err != nil {
+		return UpperTriangular[T]{}, err
+	}
+
+	n := len(matrix)
+	m := UpperTriangular[T]{n: n, values: make([]T, n*(n+1)/2)}
+
+	for r := 0; r < n; r++ {
+		for c := 0; c < n; c++ {
+			switch {
+			case c >= r:
+				m.values[packedIndex(n, r, c)] = matrix[r][c]
+			case matrix[r][c] != 0:
+				return UpperTriangular[T]{}, &immutabilitybenchmarking.StructureError{Structure: "upper triangular", Row: r, Col: c}
+			}
+		}
+	}
+
+	return m, nil
+}
+
+// NewLowerTriangular creates a lower triangular matrix from a copy of the given square values, returning a
+// StructureError if any value above the diagonal is not zero.
+func NewLowerTriangular[T immutabilitybenchmarking.Number](matrix [][]T) (LowerTriangular[T], error) {
+	if err := validateSquare(matrix); err != nil {
+		return LowerTriangular[T]{}, err
+	}
+
+	n := len(matrix)
+	m := LowerTriangular[T]{n: n, values: make([]T, n*(n+1)/2)}
+
+	for r := 0; r < n; r++ {
+		for c := 0; c < n; c++ {
+			switch {
+			case c <= r:
+				m.values[packedIndex(n, c, r)] = matrix[r][c]
+			case matrix[r][c] != 0:
+				return LowerTriangular[T]{}, &immutabilitybenchmarking.StructureError{Structure: "lower triangular", Row: r, Col: c}
+			}
+		}
+	}
+
+	return m, nil
+}
+
+// Width returns the number of columns in the matrix.
+func (m1 UpperTriangular[T]) Width() int {
+	return m1.n
+}
+
+// Height returns the number of rows in the matrix.
+func (m1 UpperTriangular[T]) Height() int {
+	return m1.n
+}
+
+// Get returns the element at the provided coordinates.
+func (m1 UpperTriangular[T]) Get(row int, col int) T {
+	if row > col {
+		return 0
+	}
+
+	return m1.values[packedIndex(m1.n, row, col)]
+}
+
+// At returns the element at the provided coordinates or an OutOfBoundsError if they are outside the matrix.
+func (m1 UpperTriangular[T]) At(row int, col int) (T, error) {
+	return at[T](m1, row, col)
+}
+
+// MustAt returns the element at the provided coordinates and panics with an OutOfBoundsError if they are
+// outside the matrix.
+func (m1 UpperTriangular[T]) MustAt(row int, col int) T {
+	return mustAt[T](m1, row, col)
+}
+
+// Equals will compare a matrix against this matrix and return if they are equal.
+func (m1 UpperTriangular[T]) Equals(m2 immutabilitybenchmarking.Matrix[T]) bool {
+	if u, ok := m2.(UpperTriangular[T]); ok {
+		return m1.n == u.n && equalValues(m1.values, u.values)
+	}
+
+	return equals[T](m1, m2)
+}
+
+// Add will add the values of a matrix to this matrix. The sum of two upper triangular matrices is upper
+// triangular.
+func (m1 UpperTriangular[T]) Add(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Height() != m2.Height() || m1.Width() != m2.Width() {
+		return UpperTriangular[T]{}, mismatch[T]("Add", m1, m2)
+	}
+
+	if u, ok := m2.(UpperTriangular[T]); ok {
+		return UpperTriangular[T]{n: m1.n, values: addValues(m1.values, u.values)}, nil
+	}
+
+	return dense[T](m1).Add(m2)
+}
+
+// Subtract will subtract the values of a matrix from this matrix. The difference of two upper triangular
+// matrices is upper triangular.
+func (m1 UpperTriangular[T]) Subtract(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Height() != m2.Height() || m1.Width() != m2.Width() {
+		return UpperTriangular[T]{}, mismatch[T]("Subtract", m1, m2)
+	}
+
+	if u, ok := m2.(UpperTriangular[T]); ok {
+		return UpperTriangular[T]{n: m1.n, values: subtractValues(m1.values, u.values)}, nil
+	}
+
+	return dense[T](m1).Subtract(m2)
+}
+
+// ScalarMultiply will multiply this matrix by a given scalar value.
+func (m1 UpperTriangular[T]) ScalarMultiply(s T) immutabilitybenchmarking.Matrix[T] {
+	return UpperTriangular[T]{n: m1.n, values: scaleValues(m1.values, s)}
+}
+
+// Transpose will transpose this matrix into a lower triangular matrix that shares its values.
+func (m1 UpperTriangular[T]) Transpose() immutabilitybenchmarking.Matrix[T] {
+	return LowerTriangular[T]{n: m1.n, values: m1.values}
+}
+
+// MatrixMultiply will multiple the given matrix against this matrix. The product of two upper triangular
+// matrices is upper triangular, and any other product skips the zeros below the diagonal.
+func (m1 UpperTriangular[T]) MatrixMultiply(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Width() != m2.Height() {
+		return UpperTriangular[T]{}, mismatch[T]("MatrixMultiply", m1, m2)
+	}
+
+	u, ok := m2.(UpperTriangular[T])
+	if !ok {
+		return multiply[T](m1, m2, func(row int) (int, int) { return row, m1.n }), nil
+	}
+
+	m := UpperTriangular[T]{n: m1.n, values: make([]T, len(m1.values))}
+
+	for r := 0; r < m1.n; r++ {
+		for c := r; c < m1.n; c++ {
+			var product T
+			for k := r; k <= c; k++ {
+				product += m1.values[packedIndex(m1.n, r, k)] * u.values[packedIndex(m1.n, k, c)]
+			}
+			m.values[packedIndex(m1.n, r, c)] = product
+		}
+	}
+
+	return m, nil
+}
+
+// Map will apply f to every element of this matrix. When f maps zero to zero the result is upper triangular.
+func (m1 UpperTriangular[T]) Map(f func(v T) T) immutabilitybenchmarking.Matrix[T] {
+	if f(0) != 0 {
+		return dense[T](m1).Map(f)
+	}
+
+	return UpperTriangular[T]{n: m1.n, values: mapValues(m1.values, f)}
+}
+
+// MapIndexed will apply f to every element of this matrix along with its coordinates. As f can depend on
+// where an element is, the result is dense.
+func (m1 UpperTriangular[T]) MapIndexed(f func(row int, col int, v T) T) immutabilitybenchmarking.Matrix[T] {
+	return dense[T](m1).MapIndexed(f)
+}
+
+// ZipWith will combine each element of this matrix with the element at the same coordinates of the given
+// matrix using f. When both are upper triangular and f maps two
+// zeros to zero the result is upper triangular.
+func (m1 UpperTriangular[T]) ZipWith(m2 immutabilitybenchmarking.Matrix[T], f func(a T, b T) T) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Height() != m2.Height() || m1.Width() != m2.Width() {
+		return UpperTriangular[T]{}, mismatch[T]("ZipWith", m1, m2)
+	}
+
+	if o, ok := m2.(UpperTriangular[T]); ok && f(0, 0) == 0 {
+		return UpperTriangular[T]{n: m1.n, values: zipValues(m1.values, o.values, f)}, nil
+	}
+
+	return dense[T](m1).ZipWith(m2, f)
+}
+
+// Fold will combine every element of this matrix into a single value, starting from initial and visiting
+// the elements in row-major order.
+func (m1 UpperTriangular[T]) Fold(initial T, f func(acc T, v T) T) T {
+	return fold[T](m1, initial, f)
+}
+
+// RowReduce will fold each row of this matrix into a single value starting from initial, returning a
+// column of the results.
+func (m1 UpperTriangular[T]) RowReduce(initial T, f func(acc T, v T) T) immutabilitybenchmarking.Matrix[T] {
+	return rowReduce[T](m1, initial, f)
+}
+
+// ColReduce will fold each column of this matrix into a single value starting from initial, returning a
+// row of the results.
+func (m1 UpperTriangular[T]) ColReduce(initial T, f func(acc T, v T) T) immutabilitybenchmarking.Matrix[T] {
+	return colReduce[T](m1, initial, f)
+}
+
+// combineWith applies f to the elements of m1 on and above the diagonal and the elements of m2 at the same
+// coordinates.
+func (m1 UpperTriangular[T]) combineWith(m2 immutabilitybenchmarking.Matrix[T], f func(x T, y T) T) UpperTriangular[T] {
+	m := UpperTriangular[T]{n: m1.n, values: make([]T, len(m1.values))}
+
+	for r := 0; r < m1.n; r++ {
+		for c := r; c < m1.n; c++ {
+			i := packedIndex(m1.n, r, c)
+			m.values[i] = f(m1.values[i], m2.Get(r, c))
+		}
+	}
+
+	return m
+}
+
+// HadamardProduct will multiply each element of this matrix by the element at the same coordinates of the
+// given matrix. The zeros below the diagonal stay zero, so the product is upper triangular whatever the
+// given matrix.
+func (m1 UpperTriangular[T]) HadamardProduct(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Height() != m2.Height() || m1.Width() != m2.Width() {
+		return UpperTriangular[T]{}, mismatch[T]("HadamardProduct", m1, m2)
+	}
+
+	return m1.combineWith(m2, func(x T, y T) T { return x * y }), nil
+}
+
+// ElementwiseDivide will divide each element of this matrix by the element at the same coordinates of the
+// given matrix, returning a DivisionByZeroError if any of them is zero. The quotient is upper triangular.
+func (m1 UpperTriangular[T]) ElementwiseDivide(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Height() != m2.Height() || m1.Width() != m2.Width() {
+		return UpperTriangular[T]{}, mismatch[T]("ElementwiseDivide", m1, m2)
+	}
+
+	if err := divisor(m2); err != nil {
+		return UpperTriangular[T]{}, err
+	}
+
+	return m1.combineWith(m2, func(x T, y T) T { return x / y }), nil
+}
+
+// kronecker calculates the Kronecker product of two upper triangular matrices. An element below the diagonal
+// of the product is either in a block below the diagonal of m1 or below the diagonal of its block of u, so
+// the product is upper triangular.
+func (m1 UpperTriangular[T]) kronecker(u UpperTriangular[T]) UpperTriangular[T] {
+	n := m1.n * u.n
+	m := UpperTriangular[T]{n: n, values: make([]T, n*(n+1)/2)}
+
+	for r := 0; r < n; r++ {
+		for c := r; c < n; c++ {
+			m.values[packedIndex(n, r, c)] = m1.Get(r/u.n, c/u.n) * u.Get(r%u.n, c%u.n)
+		}
+	}
+
+	return m
+}
+
+// KroneckerProduct will multiply every element of this matrix by the whole of the given matrix. The
+// Kronecker product of two upper triangular matrices is upper triangular.
+func (m1 UpperTriangular[T]) KroneckerProduct(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if u, ok := m2.(UpperTriangular[T]); ok {
+		return m1.kronecker(u), nil
+	}
+
+	return dense[T](m1).KroneckerProduct(m2)
+}
+
+// Trace will sum the elements on the diagonal of this matrix. Triangular matrices are always square.
+func (m1 UpperTriangular[T]) Trace() (T, error) {
+	return trace[T](m1), nil
+}
+
+// Sum will add together every element of this matrix.
+func (m1 UpperTriangular[T]) Sum() T {
+	return sumValues(m1.values)
+}
+
+// Min will return the smallest element of this matrix. Complex elements are ordered by their real parts and
+// then by their imaginary parts.
+func (m1 UpperTriangular[T]) Min() T {
+	return m1.Get(m1.ArgMin())
+}
+
+// Max will return the largest element of this matrix. Complex elements are ordered by their real parts and
+// then by their imaginary parts.
+func (m1 UpperTriangular[T]) Max() T {
+	return m1.Get(m1.ArgMax())
+}
+
+// ArgMin will return the coordinates of the first smallest element of this matrix in row-major order.
+func (m1 UpperTriangular[T]) ArgMin() (int, int) {
+	return numeric.ArgMin(m1.Height(), numeric.RowsOf[T](m1))
+}
+
+// ArgMax will return the coordinates of the first largest element of this matrix in row-major order.
+func (m1 UpperTriangular[T]) ArgMax() (int, int) {
+	return numeric.ArgMax(m1.Height(), numeric.RowsOf[T](m1))
+}
+
+// FrobeniusNorm will return the square root of the sum of the squared absolute values of the elements of
+// this matrix.
+func (m1 UpperTriangular[T]) FrobeniusNorm() float64 {
+	return frobenius(m1.values)
+}
+
+// OneNorm will return the largest sum of the absolute values in a column of this matrix.
+func (m1 UpperTriangular[T]) OneNorm() float64 {
+	return numeric.OneNorm(m1.Height(), m1.Width(), numeric.RowsOf[T](m1))
+}
+
+// InfNorm will return the largest sum of the absolute values in a row of this matrix.
+func (m1 UpperTriangular[T]) InfNorm() float64 {
+	return numeric.InfNorm(m1.Height(), numeric.RowsOf[T](m1))
+}
+
+// Rank will return the number of linearly independent rows of this matrix, treating values within rounding
+// error of zero as zero.
+func (m1 UpperTriangular[T]) Rank() int {
+	return numeric.Rank(m1.Height(), m1.Width(), numeric.RowsOf[T](m1))
+}
+
+// Power will raise this matrix to the power n by repeated squaring, returning a NegativeExponentError if n is
+// negative. Every power of an upper triangular matrix is upper triangular, with the zeroth being the identity
+// matrix.
+func (m1 UpperTriangular[T]) Power(n int) (immutabilitybenchmarking.Matrix[T], error) {
+	if n < 0 {
+		return UpperTriangular[T]{}, &immutabilitybenchmarking.NegativeExponentError{Exponent: n}
+	}
+
+	return power.BySquaring[immutabilitybenchmarking.Matrix[T]](m1, n, func() (immutabilitybenchmarking.Matrix[T], error) {
+		return UpperTriangular[T]{n: m1.n, values: packedIdentity[T](m1.n)}, nil
+	}, nil)
+}
+
+// Width returns the number of columns in the matrix.
+func (m1 LowerTriangular[T]) Width() int {
+	return m1.n
+}
+
+// Height returns the number of rows in the matrix.
+func (m1 LowerTriangular[T]) Height() int {
+	return m1.n
+}
+
+// Get returns the element at the provided coordinates.
+func (m1 LowerTriangular[T]) Get(row int, col int) T {
+	if row < col {
+		return 0
+	}
+
+	return m1.values[packedIndex(m1.n, col, row)]
+}
+
+// At returns the element at the provided coordinates or an OutOfBoundsError if they are outside the matrix.
+func (m1 LowerTriangular[T]) At(row int, col int) (T, error) {
+	return at[T](m1, row, col)
+}
+
+// MustAt returns the element at the provided coordinates and panics with an OutOfBoundsError if they are
+// outside the matrix.
+func (m1 LowerTriangular[T]) MustAt(row int, col int) T {
+	return mustAt[T](m1, row, col)
+}
+
+// Equals will compare a matrix against this matrix and return if they are equal.
+func (m1 LowerTriangular[T]) Equals(m2 immutabilitybenchmarking.Matrix[T]) bool {
+	if l, ok := m2.(LowerTriangular[T]); ok {
+		return m1.n == l.n && equalValues(m1.values, l.values)
+	}
+
+	return equals[T](m1, m2)
+}
+
+// Add will add the values of a matrix to this matrix. The sum of two lower triangular matrices is lower
+// triangular.
+func (m1 LowerTriangular[T]) Add(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Height() != m2.Height() || m1.Width() != m2.Width() {
+		return LowerTriangular[T]{}, mismatch[T]("Add", m1, m2)
+	}
+
+	if l, ok := m2.(LowerTriangular[T]); ok {
+		return LowerTriangular[T]{n: m1.n, values: addValues(m1.values, l.values)}, nil
+	}
+
+	return dense[T](m1).Add(m2)
+}
+
+// Subtract will subtract the values of a matrix from this matrix. The difference of two lower triangular
+// matrices is lower triangular.
+func (m1 LowerTriangular[T]) Subtract(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Height() != m2.Height() || m1.Width() != m2.Width() {
+		return LowerTriangular[T]{}, mismatch[T]("Subtract", m1, m2)
+	}
+
+	if l, ok := m2.(LowerTriangular[T]); ok {
+		return LowerTriangular[T]{n: m1.n, values: subtractValues(m1.values, l.values)}, nil
+	}
+
+	return dense[T](m1).Subtract(m2)
+}
+
+// ScalarMultiply will multiply this matrix by a given scalar value.
+func (m1 LowerTriangular[T]) ScalarMultiply(s T) immutabilitybenchmarking.Matrix[T] {
+	return LowerTriangular[T]{n: m1.n, values: scaleValues(m1.values, s)}
+}
+
+// Transpose will transpose this matrix into an upper triangular matrix that shares its values.
+func (m1 LowerTriangular[T]) Transpose() immutabilitybenchmarking.Matrix[T] {
+	return UpperTriangular[T]{n: m1.n, values: m1.values}
+}
+
+// MatrixMultiply will multiple the given matrix against this matrix. The product of two lower triangular
+// matrices is lower triangular, and any other product skips the zeros above the diagonal.
+func (m1 LowerTriangular[T]) MatrixMultiply(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Width() != m2.Height() {
+		return LowerTriangular[T]{}, mismatch[T]("MatrixMultiply", m1, m2)
+	}
+
+	l, ok := m2.(LowerTriangular[T])
+	if !ok {
+		return multiply[T](m1, m2, func(row int) (int, int) { return 0, row + 1 }), nil
+	}
+
+	m := LowerTriangular[T]{n: m1.n, values: make([]T, len(m1.values))}
+
+	for r := 0; r < m1.n; r++ {
+		for c := 0; c <= r; c++ {
+			var product T
+			for k := c; k <= r; k++ {
+				product += m1.values[packedIndex(m1.n, k, r)] * l.values[packedIndex(m1.n, c, k)]
+			}
+			m.values[packedIndex(m1.n, c, r)] = product
+		}
+	}
+
+	return m, nil
+}
+
+// Map will apply f to every element of this matrix. When f maps zero to zero the result is lower triangular.
+func (m1 LowerTriangular[T]) Map(f func(v T) T) immutabilitybenchmarking.Matrix[T] {
+	if f(0) != 0 {
+		return dense[T](m1).Map(f)
+	}
+
+	return LowerTriangular[T]{n: m1.n, values: mapValues(m1.values, f)}
+}
+
+// MapIndexed will apply f to every element of this matrix along with its coordinates. As f can depend on
+// where an element is, the result is dense.
+func (m1 LowerTriangular[T]) MapIndexed(f func(row int, col int, v T) T) immutabilitybenchmarking.Matrix[T] {
+	return dense[T](m1).MapIndexed(f)
+}
+
+// ZipWith will combine each element of this matrix with the element at the same coordinates of the given
+// matrix using f. When both are lower triangular and f maps two
+// zeros to zero the result is lower triangular.
+func (m1 LowerTriangular[T]) ZipWith(m2 immutabilitybenchmarking.Matrix[T], f func(a T, b T) T) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Height() != m2.Height() || m1.Width() != m2.Width() {
+		return LowerTriangular[T]{}, mismatch[T]("ZipWith", m1, m2)
+	}
+
+	if o, ok := m2.(LowerTriangular[T]); ok && f(0, 0) == 0 {
+		return LowerTriangular[T]{n: m1.n, values: zipValues(m1.values, o.values, f)}, nil
+	}
+
+	return dense[T](m1).ZipWith(m2, f)
+}
+
+// Fold will combine every element of this matrix into a single value, starting from initial and visiting
+// the elements in row-major order.
+func (m1 LowerTriangular[T]) Fold(initial T, f func(acc T, v T) T) T {
+	return fold[T](m1, initial, f)
+}
+
+// RowReduce will fold each row of this matrix into a single value starting from initial, returning a
+// column of the results.
+func (m1 LowerTriangular[T]) RowReduce(initial T, f func(acc T, v T) T) immutabilitybenchmarking.Matrix[T] {
+	return rowReduce[T](m1, initial, f)
+}
+
+// ColReduce will fold each column of this matrix into a single value starting from initial, returning a
+// row of the results.
+func (m1 LowerTriangular[T]) ColReduce(initial T, f func(acc T, v T) T) immutabilitybenchmarking.Matrix[T] {
+	return colReduce[T](m1, initial, f)
+}
+
+// combineWith applies f to the elements of m1 on and below the diagonal and the elements of m2 at the same
+// coordinates.
+func (m1 LowerTriangular[T]) combineWith(m2 immutabilitybenchmarking.Matrix[T], f func(x T, y T) T) LowerTriangular[T] {
+	m := LowerTriangular[T]{n: m1.n, values: make([]T, len(m1.values))}
+
+	for c := 0; c < m1.n; c++ {
+		for r := c; r < m1.n; r++ {
+			i := packedIndex(m1.n, c, r)
+			m.values[i] = f(m1.values[i], m2.Get(r, c))
+		}
+	}
+
+	return m
+}
+
+// HadamardProduct will multiply each element of this matrix by the element at the same coordinates of the
+// given matrix. The zeros above the diagonal stay zero, so the product is lower triangular whatever the
+// given matrix.
+func (m1 LowerTriangular[T]) HadamardProduct(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Height() != m2.Height() || m1.Width() != m2.Width() {
+		return LowerTriangular[T]{}, mismatch[T]("HadamardProduct", m1, m2)
+	}
+
+	return m1.combineWith(m2, func(x T, y T) T { return x * y }), nil
+}
+
+// ElementwiseDivide will divide each element of this matrix by the element at the same coordinates of the
+// given matrix, returning a DivisionByZeroError if any of them is zero. The quotient is lower triangular.
+func (m1 LowerTriangular[T]) ElementwiseDivide(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	if m1.Height() != m2.Height() || m1.Width() != m2.Width() {
+		return LowerTriangular[T]{}, mismatch[T]("ElementwiseDivide", m1, m2)
+	}
+
+	if err := divisor(m2); err != nil {
+		return LowerTriangular[T]{}, err
+	}
+
+	return m1.combineWith(m2, func(x T, y T) T { return x / y }), nil
+}
+
+// KroneckerProduct will multiply every element of this matrix by the whole of the given matrix. The
+// Kronecker product of two lower triangular matrices is the transpose of the product of their upper
+// triangular transposes, which share their values.
+func (m1 LowerTriangular[T]) KroneckerProduct(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	l, ok := m2.(LowerTriangular[T])
+	if !ok {
+		return dense[T](m1).KroneckerProduct(m2)
+	}
+
+	u := UpperTriangular[T]{n: m1.n, values: m1.values}.kronecker(UpperTriangular[T]{n: l.n, values: l.values})
+
+	return LowerTriangular[T]{n: u.n, values: u.values}, nil
+}
+
+// Trace will sum the elements on the diagonal of this matrix. Triangular matrices are always square.
+func (m1 LowerTriangular[T]) Trace() (T, error) {
+	return trace[T](m1), nil
+}
+
+// Sum will add together every element of this matrix.
+func (m1 LowerTriangular[T]) Sum() T {
+	return sumValues(m1.values)
+}
+
+// Min will return the smallest element of this matrix. Complex elements are ordered by their real parts and
+// then by their imaginary parts.
+func (m1 LowerTriangular[T]) Min() T {
+	return m1.Get(m1.ArgMin())
+}
+
+// Max will return the largest element of this matrix. Complex elements are ordered by their real parts and
+// then by their imaginary parts.
+func (m1 LowerTriangular[T]) Max() T {
+	return m1.Get(m1.ArgMax())
+}
+
+// ArgMin will return the coordinates of the first smallest element of this matrix in row-major order.
+func (m1 LowerTriangular[T]) ArgMin() (int, int) {
+	return numeric.ArgMin(m1.Height(), numeric.RowsOf[T](m1))
+}
+
+// ArgMax will return the coordinates of the first largest element of this matrix in row-major order.
+func (m1 LowerTriangular[T]) ArgMax() (int, int) {
+	return numeric.ArgMax(m1.Height(), numeric.RowsOf[T](m1))
+}
+
+// FrobeniusNorm will return the square root of the sum of the squared absolute values of the elements of
+// this matrix.
+func (m1 LowerTriangular[T]) FrobeniusNorm() float64 {
+	return frobenius(m1.values)
+}
+
+// OneNorm will return the largest sum of the absolute values in a column of this matrix.
+func (m1 LowerTriangular[T]) OneNorm() float64 {
+	return numeric.OneNorm(m1.Height(), m1.Width(), numeric.RowsOf[T](m1))
+}
+
+// InfNorm will return the largest sum of the absolute values in a row of this matrix.
+func (m1 LowerTriangular[T]) InfNorm() float64 {
+	return numeric.InfNorm(m1.Height(), numeric.RowsOf[T](m1))
+}
+
+// Rank will return the number of linearly independent rows of this matrix, treating values within rounding
+// error of zero as zero.
+func (m1 LowerTriangular[T]) Rank() int {
+	return numeric.Rank(m1.Height(), m1.Width(), numeric.RowsOf[T](m1))
+}
+
+// Power will raise this matrix to the power n by repeated squaring, returning a NegativeExponentError if n is
+// negative. Every power of a lower triangular matrix is lower triangular, with the zeroth being the identity
+// matrix.
+func (m1 LowerTriangular[T]) Power(n int) (immutabilitybenchmarking.Matrix[T], error) {
+	if n < 0 {
+		return LowerTriangular[T]{}, &immutabilitybenchmarking.NegativeExponentError{Exponent: n}
+	}
+
+	return power.BySquaring[immutabilitybenchmarking.Matrix[T]](m1, n, func() (immutabilitybenchmarking.Matrix[T], error) {
+		return LowerTriangular[T]{n: m1.n, values: packedIdentity[T](m1.n)}, nil
+	}, nil)
+}
+
+// Reshape will read the elements of this matrix in row-major order into a dense matrix with the given
+// dimensions, returning a ReshapeError if it would not hold the same number of elements.
+func (m1 UpperTriangular[T]) Reshape(rows int, cols int) (immutabilitybenchmarking.Matrix[T], error) {
+	return dense[T](m1).Reshape(rows, cols)
+}
+
+// HConcat will place the given matrix to the right of this matrix in a dense matrix, returning a
+// DimensionMismatchError if they do not have the same number of rows.
+func (m1 UpperTriangular[T]) HConcat(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	return dense[T](m1).HConcat(m2)
+}
+
+// VConcat will place the given matrix below this matrix in a dense matrix, returning a DimensionMismatchError
+// if they do not have the same number of columns.
+func (m1 UpperTriangular[T]) VConcat(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	return dense[T](m1).VConcat(m2)
+}
+
+// Stack will place the given matrices below this matrix in order in a dense matrix, returning a
+// DimensionMismatchError for the first that does not have the same number of columns.
+func (m1 UpperTriangular[T]) Stack(ms ...immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	return dense[T](m1).Stack(ms...)
+}
+
+// SplitRows will split this matrix into dense matrices of its rows before at and its rows from at onwards,
+// returning an InvalidDimensionsError if either part would be empty.
+func (m1 UpperTriangular[T]) SplitRows(at int) (immutabilitybenchmarking.Matrix[T], immutabilitybenchmarking.Matrix[T], error) {
+	return dense[T](m1).SplitRows(at)
+}
+
+// SplitCols will split this matrix into dense matrices of its columns before at and its columns from at
+// onwards, returning an InvalidDimensionsError if either part would be empty.
+func (m1 UpperTriangular[T]) SplitCols(at int) (immutabilitybenchmarking.Matrix[T], immutabilitybenchmarking.Matrix[T], error) {
+	return dense[T](m1).SplitCols(at)
+}
+
+// Pad will surround this matrix with the given number of rows and columns of zeros on each side. Padding
+// the top and left by the same amount, and the bottom and right by the same amount, keeps the diagonal on
+// the diagonal so the result is upper triangular, otherwise it is dense. It returns a PaddingError if
+// any of them is negative.
+func (m1 UpperTriangular[T]) Pad(top int, bottom int, left int, right int) (immutabilitybenchmarking.Matrix[T], error) {
+	if top != left || bottom != right {
+		return dense[T](m1).Pad(top, bottom, left, right)
+	}
+
+	if err := shape.CheckPad(top, bottom, left, right); err != nil {
+		return UpperTriangular[T]{}, err
+	}
+
+	n := top + m1.n + bottom
+
+	return UpperTriangular[T]{n: n, values: padPacked(m1.n, m1.values, n, top)}, nil
+}
+
+// Flip will reverse the order of the rows or the columns of this matrix in a dense matrix.
+func (m1 UpperTriangular[T]) Flip(axis immutabilitybenchmarking.Axis) immutabilitybenchmarking.Matrix[T] {
+	return dense[T](m1).Flip(axis)
+}
+
+// Rotate90 will turn this matrix a quarter turn clockwise in a dense matrix.
+func (m1 UpperTriangular[T]) Rotate90() immutabilitybenchmarking.Matrix[T] {
+	return dense[T](m1).Rotate90()
+}
+
+// Reshape will read the elements of this matrix in row-major order into a dense matrix with the given
+// dimensions, returning a ReshapeError if it would not hold the same number of elements.
+func (m1 LowerTriangular[T]) Reshape(rows int, cols int) (immutabilitybenchmarking.Matrix[T], error) {
+	return dense[T](m1).Reshape(rows, cols)
+}
+
+// HConcat will place the given matrix to the right of this matrix in a dense matrix, returning a
+// DimensionMismatchError if they do not have the same number of rows.
+func (m1 LowerTriangular[T]) HConcat(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	return dense[T](m1).HConcat(m2)
+}
+
+// VConcat will place the given matrix below this matrix in a dense matrix, returning a DimensionMismatchError
+// if they do not have the same number of columns.
+func (m1 LowerTriangular[T]) VConcat(m2 immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	return dense[T](m1).VConcat(m2)
+}
+
+// Stack will place the given matrices below this matrix in order in a dense matrix, returning a
+// DimensionMismatchError for the first that does not have the same number of columns.
+func (m1 LowerTriangular[T]) Stack(ms ...immutabilitybenchmarking.Matrix[T]) (immutabilitybenchmarking.Matrix[T], error) {
+	return dense[T](m1).Stack(ms...)
+}
+
+// SplitRows will split this matrix into dense matrices of its rows before at and its rows from at onwards,
+// returning an InvalidDimensionsError if either part would be empty.
+func (m1 LowerTriangular[T]) SplitRows(at int) (immutabilitybenchmarking.Matrix[T], immutabilitybenchmarking.Matrix[T], error) {
+	return dense[T](m1).SplitRows(at)
+}
+
+// SplitCols will split this matrix into dense matrices of its columns before at and its columns from at
+// onwards, returning an InvalidDimensionsError if either part would be empty.
+func (m1 LowerTriangular[T]) SplitCols(at int) (immutabilitybenchmarking.Matrix[T], immutabilitybenchmarking.Matrix[T], error) {
+	return dense[T](m1).SplitCols(at)
+}
+
+// Pad will surround this matrix with the given number of rows and columns of zeros on each side. Padding
+// the top and left by the same amount, and the bottom and right by the same amount, keeps the diagonal on
+// the diagonal so the result is lower triangular, otherwise it is dense. It returns a PaddingError if
+// any of them is negative.
+func (m1 LowerTriangular[T]) Pad(top int, bottom int, left int, right int) (immutabilitybenchmarking.Matrix[T], error) {
+	if top != left || bottom != right {
+		return dense[T](m1).Pad(top, bottom, left, right)
+	}
+
+	if err := shape.CheckPad(top, bottom, left, right); err != nil {
+		return LowerTriangular[T]{}, err
+	}
+
+	n := top + m1.n + bottom
+
+	return LowerTriangular[T]{n: n, values: padPacked(m1.n, m1.values, n, top)}, nil
+}
+
+// Flip will reverse the order of the rows or the columns of this matrix in a dense matrix.
+func (m1 LowerTriangular[T]) Flip(axis immutabilitybenchmarking.Axis) immutabilitybenchmarking.Matrix[T] {
+	return dense[T](m1).Flip(axis)
+}
+
+// Rotate90 will turn this matrix a quarter turn clockwise in a dense matrix.
+func (m1 LowerTriangular[T]) Rotate90() immutabilitybenchmarking.Matrix[T] {
+	return dense[T](m1).Rotate90()
+}